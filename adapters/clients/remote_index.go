@@ -20,6 +20,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -59,6 +61,9 @@ func (c *RemoteIndex) PutObject(ctx context.Context, hostName, indexName,
 	}
 
 	clusterapi.IndicesPayloads.SingleObject.SetContentTypeHeaderReq(req)
+	if obj.IfMatch != nil {
+		req.Header.Set("If-Match", strconv.FormatInt(*obj.IfMatch, 10))
+	}
 	res, err := c.client.Do(req)
 	if err != nil {
 		return errors.Wrap(err, "send http request")
@@ -66,14 +71,24 @@ func (c *RemoteIndex) PutObject(ctx context.Context, hostName, indexName,
 
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(res.Body)
-		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return writeStatusErr(res)
 	}
 
 	return nil
 }
 
+// writeStatusErr turns the response of a failed write into an error. A failed
+// IfMatch condition is passed on as objects.ErrPreconditionFailed.
+func writeStatusErr(res *http.Response) error {
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode == http.StatusPreconditionFailed {
+		return objects.NewErrPreconditionFailed("%s",
+			strings.TrimSpace(string(body)))
+	}
+	return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
+		body)
+}
+
 func duplicateErr(in error, count int) []error {
 	out := make([]error, count)
 	for i := range out {
@@ -240,6 +255,99 @@ func (c *RemoteIndex) GetObject(ctx context.Context, hostName, indexName,
 	return obj, nil
 }
 
+func (c *RemoteIndex) ObjectVersions(ctx context.Context, hostName, indexName,
+	shardName string, id strfmt.UUID,
+) ([]objects.StoredObjectVersion, error) {
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/%s/versions", indexName, shardName, id)
+	method := http.MethodGet
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return nil, errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
+			body)
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.ObjectVersionList.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, errors.Errorf("unknown content type %s", ct)
+	}
+
+	versionsBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+
+	versions, err := clusterapi.IndicesPayloads.ObjectVersionList.Unmarshal(versionsBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+
+	return versions, nil
+}
+
+func (c *RemoteIndex) ObjectAsOf(ctx context.Context, hostName, indexName,
+	shardName string, id strfmt.UUID, asOf int64,
+) (*storobj.Object, error) {
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/%s/versions", indexName, shardName, id)
+	method := http.MethodGet
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+	q := url.Query()
+	q.Set("asOf", strconv.FormatInt(asOf, 10))
+	url.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		// this is a legitimate case - the object did not exist at that time,
+		// don't try to unmarshal anything
+		return nil, nil
+	}
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return nil, errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
+			body)
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.SingleObject.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, errors.Errorf("unknown content type %s", ct)
+	}
+
+	objBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+
+	obj, err := clusterapi.IndicesPayloads.SingleObject.Unmarshal(objBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+
+	return obj, nil
+}
+
 func (c *RemoteIndex) Exists(ctx context.Context, hostName, indexName,
 	shardName string, id strfmt.UUID,
 ) (bool, error) {
@@ -336,9 +444,7 @@ func (c *RemoteIndex) MergeObject(ctx context.Context, hostName, indexName,
 
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(res.Body)
-		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return writeStatusErr(res)
 	}
 
 	return nil
@@ -402,6 +508,58 @@ func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 	return objs, nil
 }
 
+func (c *RemoteIndex) ObjectsByDocID(ctx context.Context, hostName, indexName,
+	shardName string, docIDs []uint64,
+) ([]*storobj.Object, error) {
+	docIDsBytes, err := json.Marshal(docIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal doc ids")
+	}
+
+	docIDsEncoded := base64.StdEncoding.EncodeToString(docIDsBytes)
+
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects", indexName, shardName)
+	method := http.MethodGet
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+	q := url.Query()
+	q.Set("docIDs", docIDsEncoded)
+	url.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return nil, errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
+			body)
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.ObjectList.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, errors.Errorf("unexpected content type: %s", ct)
+	}
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	objs, err := clusterapi.IndicesPayloads.ObjectList.Unmarshal(bodyBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal objects")
+	}
+
+	return objs, nil
+}
+
 func (c *RemoteIndex) SearchShard(ctx context.Context, hostName, indexName,
 	shardName string, vector []float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
@@ -24,6 +24,7 @@ package clients
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/http"
@@ -32,8 +33,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
 )
 
 func TestRemoteIndexIncreaseRF(t *testing.T) {
@@ -207,6 +213,93 @@ func TestRemoteIndexShardStatus(t *testing.T) {
 	})
 }
 
+func TestRemoteIndexObjectVersions(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx  = context.Background()
+		id   = strfmt.UUID("c6f85bf5-c3b7-4c1d-bd51-e899f9605336")
+		path = "/indices/C1/shards/S1/objects/" + id.String() + "/versions"
+		fs   = newFakeRemoteIndexServer(t, http.MethodGet, path)
+		obj  = &storobj.Object{
+			MarshallerVersion: 1,
+			Object:            models.Object{ID: id, Class: "C1", LastUpdateTimeUnix: 1000},
+		}
+	)
+	ts := fs.server(t)
+	defer ts.Close()
+	client := newRemoteIndex(ts.Client())
+	fs.doAfter = func(w http.ResponseWriter, r *http.Request) {
+		switch asOf := r.URL.Query().Get("asOf"); asOf {
+		case "":
+			clusterapi.IndicesPayloads.ObjectVersionList.SetContentTypeHeader(w)
+			bytes, _ := clusterapi.IndicesPayloads.ObjectVersionList.Marshal(
+				[]objects.StoredObjectVersion{
+					{Version: 2000, Deleted: true},
+					{Version: 1000, Object: obj},
+				})
+			w.Write(bytes)
+		case "1500":
+			clusterapi.IndicesPayloads.SingleObject.SetContentTypeHeader(w)
+			bytes, _ := clusterapi.IndicesPayloads.SingleObject.Marshal(obj)
+			w.Write(bytes)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+
+	t.Run("Versions", func(t *testing.T) {
+		versions, err := client.ObjectVersions(ctx, fs.host, "C1", "S1", id)
+		require.Nil(t, err)
+		require.Len(t, versions, 2)
+		assert.True(t, versions[0].Deleted)
+		assert.Equal(t, int64(1000), versions[1].Version)
+		assert.Equal(t, id, versions[1].Object.ID())
+	})
+	t.Run("AsOf", func(t *testing.T) {
+		res, err := client.ObjectAsOf(ctx, fs.host, "C1", "S1", id, 1500)
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, id, res.ID())
+	})
+	t.Run("AsOfBeforeCreation", func(t *testing.T) {
+		res, err := client.ObjectAsOf(ctx, fs.host, "C1", "S1", id, 500)
+		assert.Nil(t, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestRemoteIndexObjectsByDocID(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx  = context.Background()
+		id   = strfmt.UUID("c6f85bf5-c3b7-4c1d-bd51-e899f9605336")
+		path = "/indices/C1/shards/S1/objects"
+		fs   = newFakeRemoteIndexServer(t, http.MethodGet, path)
+	)
+	ts := fs.server(t)
+	defer ts.Close()
+	client := newRemoteIndex(ts.Client())
+	fs.doAfter = func(w http.ResponseWriter, r *http.Request) {
+		docIDs, _ := base64.StdEncoding.DecodeString(r.URL.Query().Get("docIDs"))
+		if string(docIDs) != "[3,7]" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		clusterapi.IndicesPayloads.ObjectList.SetContentTypeHeader(w)
+		bytes, _ := clusterapi.IndicesPayloads.ObjectList.Marshal([]*storobj.Object{{
+			MarshallerVersion: 1,
+			Object:            models.Object{ID: id, Class: "C1"},
+		}})
+		w.Write(bytes)
+	}
+
+	objs, err := client.ObjectsByDocID(ctx, fs.host, "C1", "S1", []uint64{3, 7})
+	require.Nil(t, err)
+	require.Len(t, objs, 1)
+	assert.Equal(t, id, objs[0].ID())
+}
+
 func TestRemoteIndexPutFile(t *testing.T) {
 	t.Parallel()
 	var (
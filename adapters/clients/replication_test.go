@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorprecision"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 )
@@ -420,8 +421,9 @@ func TestReplicationFetchObject(t *testing.T) {
 					"stringProp": "abc",
 				},
 			},
-			Vector:    []float32{1, 2, 3, 4, 5},
-			VectorLen: 5,
+			Vector:          []float32{1, 2, 3, 4, 5},
+			VectorLen:       5,
+			VectorPrecision: vectorprecision.Float32,
 		},
 	}
 
@@ -452,8 +454,9 @@ func TestReplicationFetchObjects(t *testing.T) {
 						"stringProp": "abc",
 					},
 				},
-				Vector:    []float32{1, 2, 3, 4, 5},
-				VectorLen: 5,
+				Vector:          []float32{1, 2, 3, 4, 5},
+				VectorLen:       5,
+				VectorPrecision: vectorprecision.Float32,
 			},
 		},
 		{
@@ -466,8 +469,9 @@ func TestReplicationFetchObjects(t *testing.T) {
 						"floatProp": float64(123),
 					},
 				},
-				Vector:    []float32{10, 20, 30, 40, 50},
-				VectorLen: 5,
+				Vector:          []float32{10, 20, 30, 40, 50},
+				VectorLen:       5,
+				VectorPrecision: vectorprecision.Float32,
 			},
 		},
 	}
@@ -66,8 +66,20 @@ const (
 	WhereValueRangeGeoCoordinatesLongitude = "The longitude (in decimal format) of the geoCoordinates to search around."
 	WhereValueRangeDistance                = "The distance from the point specified via geoCoordinates."
 	WhereValueRangeDistanceMax             = "The maximum distance from the point specified geoCoordinates."
+	WhereValuePolygon                      = "Specify the corners of a polygon as geo-coordinates (latitude and longitude as decimals). The search will return any result which is located within the polygon. The polygon is closed automatically."
+	WhereValuePolygonCoordinates           = "The corners of the polygon in order."
+	WhereValueBoundingBox                  = "Specify the top left and bottom right corner of a rectangle as geo-coordinates (latitude and longitude as decimals). The search will return any result which is located within the rectangle."
+	WhereValueBoundingBoxTopLeft           = "The top left corner of the rectangle."
+	WhereValueBoundingBoxBottomRight       = "The bottom right corner of the rectangle."
+	WhereValueGeoCoordinatesLatitude       = "The latitude (in decimal format) of the geoCoordinates."
+	WhereValueGeoCoordinatesLongitude      = "The longitude (in decimal format) of the geoCoordinates."
 	WhereValueText                         = "Specify a Text value that the target property will be compared to"
 	WhereValueDate                         = "Specify a Date value that the target property will be compared to"
+	WhereValueTextArray                    = "Specify a list of Text values to be used with the ContainsAny or ContainsAll operators"
+	WhereValueIntArray                     = "Specify a list of Integer values to be used with the ContainsAny or ContainsAll operators"
+	WhereValueNumberArray                  = "Specify a list of Float values to be used with the ContainsAny or ContainsAll operators"
+	WhereValueBooleanArray                 = "Specify a list of Boolean values to be used with the ContainsAny or ContainsAll operators"
+	WhereValueDateArray                    = "Specify a list of Date values to be used with the ContainsAny or ContainsAll operators"
 )
 
 // Properties and Classes filter elements (used by Fetch and Introspect Where filters)
@@ -144,6 +156,7 @@ const (
 const (
 	SortPath  = "Specify the path from the Objects fields to the property name (e.g. ['Get', 'City', 'population'] leads to the 'population' property of a 'City' object)"
 	SortOrder = "Specify the sort order, either ascending (asc) which is default or descending (desc)"
+	SortNear  = "Sort a geoCoordinates property by the distance to this point instead of by its value, ascending (asc) returns the nearest results first"
 )
 
 const (
@@ -152,3 +165,27 @@ const (
 	GroupByGroups          = "Specify the number of groups to be created"
 	GroupByObjectsPerGroup = "Specify the number of max objects in group"
 )
+
+const (
+	MMR       = "Re-select the results of a vector search by maximal marginal relevance to make them more diverse"
+	MMRLambda = "Specify how relevance is weighed against diversity between 0 and 1, where 1 is relevance only, defaults to 0.5"
+	MMRFetchK = "Specify the number of candidates fetched from the vector index, defaults to four times the limit"
+)
+
+const (
+	Boost                 = "Re-score the results by combining their score with decay and field value factor functions over their properties"
+	BoostDecay            = "Score the results by the distance of a date, number or geoCoordinates property from an origin"
+	BoostDecayProperty    = "The date, number, int or geoCoordinates property to score"
+	BoostDecayFunction    = "The shape of the decay, defaults to gauss"
+	BoostDecayOrigin      = "The origin from which distances are computed: an RFC3339 timestamp or \"now\" (default) for dates, a number for numbers or \"lat,lon\" for geoCoordinates"
+	BoostDecayScale       = "The distance from origin plus offset at which the score equals decay: a duration such as \"7d\" or \"12h\" for dates, a number for numbers or a distance such as \"10km\" or \"500m\" for geoCoordinates"
+	BoostDecayOffset      = "The distance from the origin within which the score is not decayed, in the same format as the scale"
+	BoostDecayDecay       = "The score at a distance of scale from origin plus offset, between 0 and 1, defaults to 0.5"
+	BoostFieldValueFactor = "Score the results by the value of a number or int property"
+	BoostFactorProperty   = "The number or int property to score"
+	BoostFactor           = "The factor which the property value is multiplied with, defaults to 1"
+	BoostFactorModifier   = "The modifier which is applied to the multiplied property value, defaults to none"
+	BoostFactorMissing    = "The value used for objects without a value for the property, if not set these objects are not affected"
+	BoostScoreMode        = "Specify how the scores of the functions are combined, defaults to multiply"
+	BoostBoostMode        = "Specify how the combined function score is combined with the score of the search, defaults to multiply"
+)
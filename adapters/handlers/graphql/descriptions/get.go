@@ -46,3 +46,14 @@ const NetworkGetClassUUID = "The UUID of a Object, assigned by the Weaviate netw
 
 const ConsistencyLevel = "Determines how many replicas must acknowledge a request " +
 	"before it is considered successful. Can be 'ONE', 'QUORUM', or 'ALL'"
+
+// Highlights
+const (
+	GetHighlights = "Fragments of the stored text around the terms which matched " +
+		"the bm25 or hybrid query, only available for those queries"
+	GetHighlightsProperties        = "The text properties to highlight, all text properties if omitted"
+	GetHighlightsFragmentSize      = "The number of characters of each fragment"
+	GetHighlightsNumberOfFragments = "The maximum number of fragments per property"
+	GetHighlightsPreTag            = "The tag inserted before each matched term"
+	GetHighlightsPostTag           = "The tag inserted after each matched term"
+)
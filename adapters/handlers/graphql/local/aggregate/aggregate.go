@@ -236,6 +236,9 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 	case schema.DataTypeUUID, schema.DataTypeUUIDArray:
 		// not aggregatable
 		return nil, nil
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		// not aggregatable as a whole
+		return nil, nil
 	default:
 		return nil, fmt.Errorf(schema.ErrorNoSuchDatatype+": %s", dataType)
 	}
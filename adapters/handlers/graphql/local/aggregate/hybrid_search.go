@@ -16,6 +16,7 @@ import (
 	"os"
 
 	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -54,6 +55,10 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Vector search",
 			Type:        graphql.NewList(graphql.Float),
 		},
+		"fusionType": &graphql.InputObjectFieldConfig{
+			Description: "How the sparse and dense results are combined, defaults to rankedFusion",
+			Type:        common_filters.HybridFusionTypeEnum(fmt.Sprintf("AggregateObjects%s", class.Class)),
+		},
 	}
 
 	if os.Getenv("ENABLE_EXPERIMENTAL_HYBRID_OPERANDS") != "" {
@@ -36,6 +36,17 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		args.AutoCut = autocut.(int)
 	}
 
+	fuzziness, ok := source["fuzziness"]
+	if ok {
+		args.Fuzziness = fuzziness.(int)
+	}
+
+	fuzzyPenalty, ok := source["fuzzyPenalty"]
+	if ok {
+		penalty := fuzzyPenalty.(float64)
+		args.FuzzyPenalty = &penalty
+	}
+
 	args.AdditionalExplanations = explainScore
 	args.Type = "bm25"
 
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func ExtractBoost(source map[string]interface{}) searchparams.Boost {
+	var args searchparams.Boost
+
+	if decay, ok := source["decay"].([]interface{}); ok {
+		for _, d := range decay {
+			args.Decay = append(args.Decay, extractBoostDecay(d.(map[string]interface{})))
+		}
+	}
+
+	if factors, ok := source["fieldValueFactor"].([]interface{}); ok {
+		for _, f := range factors {
+			args.FieldValueFactors = append(args.FieldValueFactors,
+				extractBoostFieldValueFactor(f.(map[string]interface{})))
+		}
+	}
+
+	if scoreMode, ok := source["scoreMode"].(string); ok {
+		args.ScoreMode = scoreMode
+	}
+
+	if boostMode, ok := source["boostMode"].(string); ok {
+		args.BoostMode = boostMode
+	}
+
+	return args
+}
+
+func extractBoostDecay(source map[string]interface{}) searchparams.BoostDecay {
+	var args searchparams.BoostDecay
+
+	args.Property, _ = source["property"].(string)
+	args.Function, _ = source["function"].(string)
+	args.Origin, _ = source["origin"].(string)
+	args.Scale, _ = source["scale"].(string)
+	args.Offset, _ = source["offset"].(string)
+	args.Decay, _ = source["decay"].(float64)
+
+	return args
+}
+
+func extractBoostFieldValueFactor(source map[string]interface{}) searchparams.BoostFieldValueFactor {
+	var args searchparams.BoostFieldValueFactor
+
+	args.Property, _ = source["property"].(string)
+	args.Factor, _ = source["factor"].(float64)
+	args.Modifier, _ = source["modifier"].(string)
+	if missing, ok := source["missing"].(float64); ok {
+		args.Missing = &missing
+	}
+
+	return args
+}
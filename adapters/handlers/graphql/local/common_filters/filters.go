@@ -26,18 +26,22 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type: graphql.NewEnum(graphql.EnumConfig{
 				Name: fmt.Sprintf("%sWhereOperatorEnum", path),
 				Values: graphql.EnumValueConfigMap{
-					"And":              &graphql.EnumValueConfig{},
-					"Like":             &graphql.EnumValueConfig{},
-					"Or":               &graphql.EnumValueConfig{},
-					"Equal":            &graphql.EnumValueConfig{},
-					"Not":              &graphql.EnumValueConfig{},
-					"NotEqual":         &graphql.EnumValueConfig{},
-					"GreaterThan":      &graphql.EnumValueConfig{},
-					"GreaterThanEqual": &graphql.EnumValueConfig{},
-					"LessThan":         &graphql.EnumValueConfig{},
-					"LessThanEqual":    &graphql.EnumValueConfig{},
-					"WithinGeoRange":   &graphql.EnumValueConfig{},
-					"IsNull":           &graphql.EnumValueConfig{},
+					"And":                  &graphql.EnumValueConfig{},
+					"Like":                 &graphql.EnumValueConfig{},
+					"Or":                   &graphql.EnumValueConfig{},
+					"Equal":                &graphql.EnumValueConfig{},
+					"Not":                  &graphql.EnumValueConfig{},
+					"NotEqual":             &graphql.EnumValueConfig{},
+					"GreaterThan":          &graphql.EnumValueConfig{},
+					"GreaterThanEqual":     &graphql.EnumValueConfig{},
+					"LessThan":             &graphql.EnumValueConfig{},
+					"LessThanEqual":        &graphql.EnumValueConfig{},
+					"WithinGeoRange":       &graphql.EnumValueConfig{},
+					"IsNull":               &graphql.EnumValueConfig{},
+					"WithinGeoPolygon":     &graphql.EnumValueConfig{},
+					"WithinGeoBoundingBox": &graphql.EnumValueConfig{},
+					"ContainsAny":          &graphql.EnumValueConfig{},
+					"ContainsAll":          &graphql.EnumValueConfig{},
 				},
 				Description: descriptions.WhereOperatorEnum,
 			}),
@@ -75,6 +79,36 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type:        newGeoRangeInputObject(path),
 			Description: descriptions.WhereValueRange,
 		},
+		"valueTextArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.String),
+			Description: descriptions.WhereValueTextArray,
+		},
+		"valueIntArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.Int),
+			Description: descriptions.WhereValueIntArray,
+		},
+		"valueNumberArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.Float),
+			Description: descriptions.WhereValueNumberArray,
+		},
+		"valueBooleanArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.Boolean),
+			Description: descriptions.WhereValueBooleanArray,
+		},
+		"valueDateArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.String),
+			Description: descriptions.WhereValueDateArray,
+		},
+	}
+
+	geoCoordinates := newGeoCoordinatesInputObject(path)
+	commonFilters["valueGeoPolygon"] = &graphql.InputObjectFieldConfig{
+		Type:        newGeoPolygonInputObject(path, geoCoordinates),
+		Description: descriptions.WhereValuePolygon,
+	}
+	commonFilters["valueGeoBoundingBox"] = &graphql.InputObjectFieldConfig{
+		Type:        newGeoBoundingBoxInputObject(path, geoCoordinates),
+		Description: descriptions.WhereValueBoundingBox,
 	}
 
 	// Recurse into the same time.
@@ -137,3 +171,51 @@ func newGeoRangeDistanceInputObject(path string) *graphql.InputObject {
 		},
 	})
 }
+
+func newGeoCoordinatesInputObject(path string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoCoordinatesInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"latitude": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: descriptions.WhereValueGeoCoordinatesLatitude,
+			},
+			"longitude": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: descriptions.WhereValueGeoCoordinatesLongitude,
+			},
+		},
+	})
+}
+
+func newGeoPolygonInputObject(path string,
+	geoCoordinates *graphql.InputObject,
+) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoPolygonInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"coordinates": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(geoCoordinates))),
+				Description: descriptions.WhereValuePolygonCoordinates,
+			},
+		},
+	})
+}
+
+func newGeoBoundingBoxInputObject(path string,
+	geoCoordinates *graphql.InputObject,
+) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoBoundingBoxInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"topLeft": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(geoCoordinates),
+				Description: descriptions.WhereValueBoundingBoxTopLeft,
+			},
+			"bottomRight": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(geoCoordinates),
+				Description: descriptions.WhereValueBoundingBoxBottomRight,
+			},
+		},
+	})
+}
@@ -14,10 +14,30 @@ package common_filters
 import (
 	"fmt"
 
+	"github.com/tailor-inc/graphql"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
+// HybridFusionTypeEnum is the type of the fusionType argument of hybrid
+// searches, the prefix keeps the type name unique per class
+func HybridFusionTypeEnum(prefix string) *graphql.Enum {
+	return graphql.NewEnum(graphql.EnumConfig{
+		Name: fmt.Sprintf("%sHybridFusionTypeEnum", prefix),
+		Values: graphql.EnumValueConfigMap{
+			searchparams.FusionTypeRanked: &graphql.EnumValueConfig{
+				Description: "Combine the results by the reciprocal of their ranks",
+			},
+			searchparams.FusionTypeRelativeScore: &graphql.EnumValueConfig{
+				Description: "Combine the min-max normalized scores of the results",
+			},
+			searchparams.FusionTypeDistributionBased: &graphql.EnumValueConfig{
+				Description: "Combine the scores of the results normalized by their mean and standard deviation",
+			},
+		},
+	})
+}
+
 func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*searchparams.HybridSearch, error) {
 	var subsearches []interface{}
 	operandsI := source["operands"]
@@ -106,6 +126,10 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		}
 	}
 
+	if fusionType, ok := source["fusionType"]; ok {
+		args.FusionType = fusionType.(string)
+	}
+
 	args.Type = "hybrid"
 	return &args, nil
 }
@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func ExtractMMR(source map[string]interface{}) searchparams.MMR {
+	var args searchparams.MMR
+
+	lambda, ok := source["lambda"]
+	if ok {
+		value := lambda.(float64)
+		args.Lambda = &value
+	}
+
+	fetchK := source["fetchK"]
+	if fetchK != nil {
+		args.FetchK = fetchK.(int)
+	}
+
+	return args
+}
@@ -233,6 +233,130 @@ func TestExtractFilterGeoLocation(t *testing.T) {
 	})
 }
 
+func TestExtractFilterGeoShapes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("polygon", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorWithinGeoPolygon,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("location"),
+			},
+			Value: &filters.Value{
+				Value: filters.GeoPolygon{
+					Coordinates: []*models.GeoCoordinates{
+						{Latitude: ptFloat32(0.5), Longitude: ptFloat32(0.5)},
+						{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.5)},
+						{Latitude: ptFloat32(1), Longitude: ptFloat32(1.5)},
+					},
+				},
+				Type: schema.DataTypeGeoCoordinates,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: {
+			path: ["location"],
+			operator: WithinGeoPolygon,
+			valueGeoPolygon: { coordinates: [
+				{ latitude: 0.5, longitude: 0.5 },
+				{ latitude: 1.5, longitude: 0.5 },
+				{ latitude: 1, longitude: 1.5 }
+			] }
+		}) }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("bounding box", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorWithinGeoBoundingBox,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("location"),
+			},
+			Value: &filters.Value{
+				Value: filters.GeoBoundingBox{
+					TopLeft:     &models.GeoCoordinates{Latitude: ptFloat32(1.5), Longitude: ptFloat32(0.5)},
+					BottomRight: &models.GeoCoordinates{Latitude: ptFloat32(0.5), Longitude: ptFloat32(1.5)},
+				},
+				Type: schema.DataTypeGeoCoordinates,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: {
+			path: ["location"],
+			operator: WithinGeoBoundingBox,
+			valueGeoBoundingBox: {
+				topLeft: { latitude: 1.5, longitude: 0.5 },
+				bottomRight: { latitude: 0.5, longitude: 1.5 }
+			}
+		}) }`
+		resolver.AssertResolve(t, query)
+	})
+}
+
+func TestExtractFilterContains(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ContainsAny with texts", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorContainsAny,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("colors"),
+			},
+			Value: &filters.Value{
+				Value: []string{"red", "blue"},
+				Type:  schema.DataTypeTextArray,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: {
+			path: ["colors"],
+			operator: ContainsAny,
+			valueTextArray: ["red", "blue"]
+		}) }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("ContainsAll with ints", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorContainsAll,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("sizes"),
+			},
+			Value: &filters.Value{
+				Value: []int{1, 2},
+				Type:  schema.DataTypeIntArray,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: {
+			path: ["sizes"],
+			operator: ContainsAll,
+			valueIntArray: [1, 2]
+		}) }`
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestExtractFilterNestedField(t *testing.T) {
 	t.Parallel()
 
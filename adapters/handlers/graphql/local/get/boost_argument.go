@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/usecases/traverser/boost"
+)
+
+func boostArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sBoostInpObj", prefix),
+				Fields:      boostFields(prefix),
+				Description: descriptions.Boost,
+			},
+		),
+	}
+}
+
+func boostFields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"decay": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostDecay,
+			Type: graphql.NewList(graphql.NewInputObject(
+				graphql.InputObjectConfig{
+					Name:   fmt.Sprintf("%sBoostDecayInpObj", prefix),
+					Fields: boostDecayFields(prefix),
+				},
+			)),
+		},
+		"fieldValueFactor": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostFieldValueFactor,
+			Type: graphql.NewList(graphql.NewInputObject(
+				graphql.InputObjectConfig{
+					Name:   fmt.Sprintf("%sBoostFieldValueFactorInpObj", prefix),
+					Fields: boostFieldValueFactorFields(prefix),
+				},
+			)),
+		},
+		"scoreMode": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostScoreMode,
+			Type: graphql.NewEnum(graphql.EnumConfig{
+				Name: fmt.Sprintf("%sBoostScoreModeEnum", prefix),
+				Values: graphql.EnumValueConfigMap{
+					boost.ScoreModeMultiply: &graphql.EnumValueConfig{},
+					boost.ScoreModeSum:      &graphql.EnumValueConfig{},
+					boost.ScoreModeAvg:      &graphql.EnumValueConfig{},
+					boost.ScoreModeMax:      &graphql.EnumValueConfig{},
+					boost.ScoreModeMin:      &graphql.EnumValueConfig{},
+				},
+			}),
+		},
+		"boostMode": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostBoostMode,
+			Type: graphql.NewEnum(graphql.EnumConfig{
+				Name: fmt.Sprintf("%sBoostBoostModeEnum", prefix),
+				Values: graphql.EnumValueConfigMap{
+					boost.BoostModeMultiply: &graphql.EnumValueConfig{},
+					boost.BoostModeSum:      &graphql.EnumValueConfig{},
+					boost.BoostModeReplace:  &graphql.EnumValueConfig{},
+				},
+			}),
+		},
+	}
+}
+
+func boostDecayFields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"property": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostDecayProperty,
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"function": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostDecayFunction,
+			Type: graphql.NewEnum(graphql.EnumConfig{
+				Name: fmt.Sprintf("%sBoostDecayFunctionEnum", prefix),
+				Values: graphql.EnumValueConfigMap{
+					boost.DecayGauss:  &graphql.EnumValueConfig{},
+					boost.DecayLinear: &graphql.EnumValueConfig{},
+					boost.DecayExp:    &graphql.EnumValueConfig{},
+				},
+			}),
+		},
+		"origin": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostDecayOrigin,
+			Type:        graphql.String,
+		},
+		"scale": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostDecayScale,
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"offset": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostDecayOffset,
+			Type:        graphql.String,
+		},
+		"decay": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostDecayDecay,
+			Type:        graphql.Float,
+		},
+	}
+}
+
+func boostFieldValueFactorFields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"property": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostFactorProperty,
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"factor": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostFactor,
+			Type:        graphql.Float,
+		},
+		"modifier": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostFactorModifier,
+			Type: graphql.NewEnum(graphql.EnumConfig{
+				Name: fmt.Sprintf("%sBoostModifierEnum", prefix),
+				Values: graphql.EnumValueConfigMap{
+					boost.ModifierNone:       &graphql.EnumValueConfig{},
+					boost.ModifierLog1p:      &graphql.EnumValueConfig{},
+					boost.ModifierLn1p:       &graphql.EnumValueConfig{},
+					boost.ModifierSquare:     &graphql.EnumValueConfig{},
+					boost.ModifierSqrt:       &graphql.EnumValueConfig{},
+					boost.ModifierReciprocal: &graphql.EnumValueConfig{},
+				},
+			}),
+		},
+		"missing": &graphql.InputObjectFieldConfig{
+			Description: descriptions.BoostFactorMissing,
+			Type:        graphql.Float,
+		},
+	}
+}
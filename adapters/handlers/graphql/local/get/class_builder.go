@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/traverser/highlighter"
 )
 
 type classBuilder struct {
@@ -119,6 +120,9 @@ func (b *classBuilder) classObject(class *models.Class) *graphql.Object {
 				if propertyType.IsPrimitive() {
 					classProperties[property.Name] = b.primitiveField(propertyType, property,
 						class.Class)
+				} else if propertyType.IsNested() {
+					classProperties[property.Name] = b.nestedField(propertyType, property,
+						class.Class)
 				} else {
 					classProperties[property.Name] = b.referenceField(propertyType, property,
 						class.Class)
@@ -145,6 +149,7 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
 	additionalProperties["group"] = b.additionalGroupField(classProperties, class)
+	additionalProperties["highlights"] = b.additionalHighlightsField(class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = b.isConsistentField()
 	}
@@ -232,6 +237,45 @@ func (b *classBuilder) isConsistentField() *graphql.Field {
 	}
 }
 
+func (b *classBuilder) additionalHighlightsField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.GetHighlights,
+		Args: graphql.FieldConfigArgument{
+			"properties": &graphql.ArgumentConfig{
+				Description: descriptions.GetHighlightsProperties,
+				Type:        graphql.NewList(graphql.String),
+			},
+			"fragmentSize": &graphql.ArgumentConfig{
+				Description:  descriptions.GetHighlightsFragmentSize,
+				Type:         graphql.Int,
+				DefaultValue: highlighter.DefaultFragmentSize,
+			},
+			"numberOfFragments": &graphql.ArgumentConfig{
+				Description:  descriptions.GetHighlightsNumberOfFragments,
+				Type:         graphql.Int,
+				DefaultValue: highlighter.DefaultNumberOfFragments,
+			},
+			"preTag": &graphql.ArgumentConfig{
+				Description:  descriptions.GetHighlightsPreTag,
+				Type:         graphql.String,
+				DefaultValue: highlighter.DefaultPreTag,
+			},
+			"postTag": &graphql.ArgumentConfig{
+				Description:  descriptions.GetHighlightsPostTag,
+				Type:         graphql.String,
+				DefaultValue: highlighter.DefaultPostTag,
+			},
+		},
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalHighlights", class.Class),
+			Fields: graphql.Fields{
+				"property":  &graphql.Field{Type: graphql.String},
+				"fragments": &graphql.Field{Type: graphql.NewList(graphql.String)},
+			},
+		})),
+	}
+}
+
 func (b *classBuilder) additionalGroupField(classProperties graphql.Fields, class *models.Class) *graphql.Field {
 	hitsFields := graphql.Fields{
 		"_additional": &graphql.Field{
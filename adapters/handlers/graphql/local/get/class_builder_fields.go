@@ -137,6 +137,56 @@ func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
 	}
 }
 
+func (b *classBuilder) nestedField(propertyType schema.PropertyDataType,
+	property *models.Property, className string,
+) *graphql.Field {
+	return &graphql.Field{
+		Description: property.Description,
+		Name:        property.Name,
+		Type: b.nestedFieldType(propertyType.AsNested(), className, property.Name,
+			property.NestedProperties),
+	}
+}
+
+// nestedFieldType builds the object type of the nested properties of an
+// object or object[] property, the path of the property keeps the type name
+// unique per class
+func (b *classBuilder) nestedFieldType(dataType schema.DataType, className, path string,
+	nestedProperties []*models.NestedProperty,
+) graphql.Output {
+	fields := graphql.Fields{}
+	for _, np := range nestedProperties {
+		propertyType, err := b.schema.FindPropertyDataType(np.DataType)
+		if err != nil {
+			// We can't return an error in this FieldsThunk function, so we need to panic
+			panic(fmt.Sprintf("buildGetClass: wrong propertyType for %s.%s.%s; %s",
+				className, path, np.Name, err.Error()))
+		}
+
+		if propertyType.IsNested() {
+			fields[np.Name] = &graphql.Field{
+				Description: np.Description,
+				Name:        np.Name,
+				Type: b.nestedFieldType(propertyType.AsNested(), className,
+					path+"_"+np.Name, np.NestedProperties),
+			}
+			continue
+		}
+
+		fields[np.Name] = b.primitiveField(propertyType,
+			&models.Property{Name: np.Name, Description: np.Description}, className)
+	}
+
+	obj := graphql.NewObject(graphql.ObjectConfig{
+		Name:   fmt.Sprintf("%s%sNestedObj", className, path),
+		Fields: fields,
+	})
+	if dataType == schema.DataTypeObjectArray {
+		return graphql.NewList(obj)
+	}
+	return obj
+}
+
 func newGeoCoordinatesObject(className string, propertyName string) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
 		Description: "GeoCoordinates as latitude and longitude in decimal form",
@@ -226,6 +276,8 @@ func buildGetClassField(classObject *graphql.Object,
 			"where":      whereArgument(class.Class),
 			"group":      groupArgument(class.Class),
 			"groupBy":    groupByArgument(class.Class),
+			"mmr":        mmrArgument(class.Class),
+			"boost":      boostArgument(class.Class),
 		},
 		Resolve: newResolver(modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -411,6 +463,18 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			groupByParams = &p
 		}
 
+		var mmrParams *searchparams.MMR
+		if mmr, ok := p.Args["mmr"]; ok {
+			p := common_filters.ExtractMMR(mmr.(map[string]interface{}))
+			mmrParams = &p
+		}
+
+		var boostParams *searchparams.Boost
+		if boost, ok := p.Args["boost"]; ok {
+			p := common_filters.ExtractBoost(boost.(map[string]interface{}))
+			boostParams = &p
+		}
+
 		params := dto.GetParams{
 			Filters:               filters,
 			ClassName:             className,
@@ -427,6 +491,8 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			HybridSearch:          hybridParams,
 			ReplicationProperties: replProps,
 			GroupBy:               groupByParams,
+			MMR:                   mmrParams,
+			Boost:                 boostParams,
 		}
 
 		// need to perform vector search by distance
@@ -523,6 +589,24 @@ func isPrimitive(selectionSet *ast.SelectionSet) bool {
 	return false
 }
 
+// isNestedObject distinguishes the selection of the nested properties of an
+// object or object[] property from the selection of a reference, as the
+// latter always selects the referenced classes with fragments
+func isNestedObject(selectionSet *ast.SelectionSet, ac *additionalCheck) bool {
+	isNested := false
+	for _, subSelection := range selectionSet.Selections {
+		subsectionField, ok := subSelection.(*ast.Field)
+		if !ok {
+			return false
+		}
+		if subsectionField.Name.Value != "__typename" &&
+			!ac.isAdditional(subsectionField.Name.Value) {
+			isNested = true
+		}
+	}
+	return isNested
+}
+
 type additionalCheck struct {
 	modulesProvider ModulesProvider
 }
@@ -532,7 +616,7 @@ func (ac *additionalCheck) isAdditional(name string) bool {
 		name == "distance" || name == "id" || name == "vector" ||
 		name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 		name == "score" || name == "explainScore" || name == "isConsistent" ||
-		name == "group" {
+		name == "group" || name == "highlights" {
 		return true
 	}
 	if ac.isModuleAdditional(name) {
@@ -581,7 +665,8 @@ func extractProperties(className string, selections *ast.SelectionSet,
 		name := field.Name.Value
 		property := search.SelectProperty{Name: name}
 
-		property.IsPrimitive = isPrimitive(field.SelectionSet)
+		property.IsPrimitive = isPrimitive(field.SelectionSet) ||
+			isNestedObject(field.SelectionSet, additionalCheck)
 		if !property.IsPrimitive {
 			// We can interpret this property in different ways
 			for _, subSelection := range field.SelectionSet.Selections {
@@ -633,6 +718,10 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.IsConsistent = true
 							continue
 						}
+						if additionalProperty == "highlights" {
+							additionalProps.Highlights = extractHighlightParams(s.Arguments)
+							continue
+						}
 						if additionalProperty == "group" {
 							additionalProps.Group = true
 							additionalGroupHitProperties, err := extractGroupHitProperties(className, additionalProps, subSelection, fragments, modulesProvider)
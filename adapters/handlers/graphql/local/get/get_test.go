@@ -349,6 +349,85 @@ func TestExtractPhoneNumberField(t *testing.T) {
 	}
 }
 
+func TestExtractNestedObjectFields(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name           string
+		query          string
+		expectedParams dto.GetParams
+		resolverReturn interface{}
+		expectedResult interface{}
+	}
+
+	tests := []test{
+		{
+			name:  "with object",
+			query: "{ Get { SomeAction { address { city zipCodes } } } }",
+			expectedParams: dto.GetParams{
+				ClassName:  "SomeAction",
+				Properties: []search.SelectProperty{{Name: "address", IsPrimitive: true}},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"address": map[string]interface{}{
+						"city":     "Amsterdam",
+						"zipCodes": []interface{}{float64(1011), float64(1012)},
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"address": map[string]interface{}{
+					"city":     "Amsterdam",
+					"zipCodes": []interface{}{1011, 1012},
+				},
+			},
+		},
+		{
+			name:  "with object array and deeper nested object",
+			query: "{ Get { SomeAction { visits { place { name } } } } }",
+			expectedParams: dto.GetParams{
+				ClassName:  "SomeAction",
+				Properties: []search.SelectProperty{{Name: "visits", IsPrimitive: true}},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"visits": []interface{}{
+						map[string]interface{}{
+							"date":  "2023-01-01T00:00:00Z",
+							"place": map[string]interface{}{"name": "Rijksmuseum"},
+						},
+						map[string]interface{}{
+							"place": map[string]interface{}{"name": "Vondelpark"},
+						},
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"visits": []interface{}{
+					map[string]interface{}{
+						"place": map[string]interface{}{"name": "Rijksmuseum"},
+					},
+					map[string]interface{}{
+						"place": map[string]interface{}{"name": "Vondelpark"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resolver := newMockResolver()
+
+			resolver.On("GetClass", test.expectedParams).
+				Return(test.resolverReturn, nil).Once()
+			result := resolver.AssertResolve(t, test.query)
+			assert.Equal(t, test.expectedResult, result.Get("Get", "SomeAction").Result.([]interface{})[0])
+		})
+	}
+}
+
 func TestExtractAdditionalFields(t *testing.T) {
 	// We don't need to explicitly test every subselection as we did on
 	// phoneNumber as these fields have fixed keys. So we can simply check for
@@ -477,6 +556,41 @@ func TestExtractAdditionalFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "with _additional highlights",
+			query: `{ Get { SomeAction { _additional { highlights(properties: ["name"], fragmentSize: 50, preTag: "[", postTag: "]") { property fragments } } } } }`,
+			expectedParams: dto.GetParams{
+				ClassName: "SomeAction",
+				AdditionalProperties: additional.Properties{
+					Highlights: &additional.HighlightParams{
+						Properties:        []string{"name"},
+						FragmentSize:      50,
+						NumberOfFragments: 3,
+						PreTag:            "[",
+						PostTag:           "]",
+					},
+				},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"highlights": []*additional.Highlight{
+							{Property: "name", Fragments: []string{"[foo] bar"}},
+						},
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"_additional": map[string]interface{}{
+					"highlights": []interface{}{
+						map[string]interface{}{
+							"property":  "name",
+							"fragments": []interface{}{"[foo] bar"},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:  "with _additional classification",
 			query: "{ Get { SomeAction { _additional { classification { id completed classifiedFields scope basedOn }  } } } }",
@@ -1180,6 +1294,118 @@ func TestNearCustomTextRanker(t *testing.T) {
 	})
 }
 
+func TestHybridFusionType(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("with relative score fusion", func(t *testing.T) {
+		query := `{ Get { SomeThing(hybrid: {
+								query: "apple"
+								alpha: 0.5
+								fusionType: relativeScoreFusion
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			HybridSearch: &searchparams.HybridSearch{
+				SubSearches: []searchparams.WeightedSearchResult(nil),
+				Query:       "apple",
+				Alpha:       0.5,
+				Type:        "hybrid",
+				FusionType:  searchparams.FusionTypeRelativeScore,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("without fusion type", func(t *testing.T) {
+		query := `{ Get { SomeThing(hybrid: {
+								query: "apple"
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			HybridSearch: &searchparams.HybridSearch{
+				SubSearches: []searchparams.WeightedSearchResult(nil),
+				Query:       "apple",
+				Alpha:       0.75,
+				Type:        "hybrid",
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with unknown fusion type", func(t *testing.T) {
+		query := `{ Get { SomeThing(hybrid: {
+								query: "apple"
+								fusionType: someFusion
+							}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
+func TestMMR(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("with lambda and fetchK", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+							}
+							limit: 5
+							mmr: {
+								lambda: 0.7
+								fetchK: 50
+							}) { intField } } }`
+
+		lambda := 0.7
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{Limit: 5},
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.123, 0.984},
+			},
+			MMR: &searchparams.MMR{Lambda: &lambda, FetchK: 50},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with defaults", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearVector: {
+								vector: [0.123, 0.984]
+							}
+							mmr: {}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.123, 0.984},
+			},
+			MMR: &searchparams.MMR{},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestNearVectorRanker(t *testing.T) {
 	t.Parallel()
 
@@ -2227,6 +2453,28 @@ func TestSort(t *testing.T) {
 
 				tt.resolver.AssertResolve(t, query)
 			})
+
+			t.Run("sort by distance to a point", func(t *testing.T) {
+				query := `{ Get { SomeAction(sort:[{
+										path: ["location"] order: asc near: {latitude: 52.5, longitude: 13.25}
+									}]) { intField } } }`
+
+				lat, lon := float32(52.5), float32(13.25)
+				expectedParams := dto.GetParams{
+					ClassName:  "SomeAction",
+					Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+					Sort: []filters.Sort{{
+						Path:  []string{"location"},
+						Order: "asc",
+						Near:  &models.GeoCoordinates{Latitude: &lat, Longitude: &lon},
+					}},
+				}
+
+				tt.resolver.On("GetClass", expectedParams).
+					Return([]interface{}{}, nil).Once()
+
+				tt.resolver.AssertResolve(t, query)
+			})
 		})
 	}
 }
@@ -2284,3 +2532,84 @@ func timeMust(t strfmt.DateTime, err error) strfmt.DateTime {
 
 	return t
 }
+
+func TestBoost(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("with decay and field value factor functions", func(t *testing.T) {
+		query := `{ Get { SomeThing(bm25: {query: "apple"}
+							boost: {
+								decay: [{
+									property: "dateField"
+									function: exp
+									origin: "now"
+									scale: "7d"
+									offset: "1d"
+									decay: 0.3
+								}, {
+									property: "geoField"
+									origin: "52.37,4.89"
+									scale: "10km"
+								}]
+								fieldValueFactor: [{
+									property: "intField"
+									factor: 1.5
+									modifier: log1p
+									missing: 1
+								}]
+								scoreMode: sum
+								boostMode: replace
+							}) { intField } } }`
+
+		missing := 1.0
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type:  "bm25",
+				Query: "apple",
+			},
+			Boost: &searchparams.Boost{
+				Decay: []searchparams.BoostDecay{
+					{
+						Property: "dateField",
+						Function: "exp",
+						Origin:   "now",
+						Scale:    "7d",
+						Offset:   "1d",
+						Decay:    0.3,
+					},
+					{
+						Property: "geoField",
+						Origin:   "52.37,4.89",
+						Scale:    "10km",
+					},
+				},
+				FieldValueFactors: []searchparams.BoostFieldValueFactor{
+					{
+						Property: "intField",
+						Factor:   1.5,
+						Modifier: "log1p",
+						Missing:  &missing,
+					},
+				},
+				ScoreMode: "sum",
+				BoostMode: "replace",
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with an unknown decay function", func(t *testing.T) {
+		query := `{ Get { SomeThing(boost: {
+								decay: [{property: "intField", function: step, scale: "1"}]
+							}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query)
+	})
+}
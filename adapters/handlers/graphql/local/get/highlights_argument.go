@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"strconv"
+
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/usecases/traverser/highlighter"
+)
+
+// extractHighlightParams parses the arguments of the highlights field, the
+// defaults of the field are not applied to the arguments of the selection
+func extractHighlightParams(args []*ast.Argument) *additional.HighlightParams {
+	out := &additional.HighlightParams{
+		FragmentSize:      highlighter.DefaultFragmentSize,
+		NumberOfFragments: highlighter.DefaultNumberOfFragments,
+		PreTag:            highlighter.DefaultPreTag,
+		PostTag:           highlighter.DefaultPostTag,
+	}
+
+	for _, arg := range args {
+		switch arg.Name.Value {
+		case "properties":
+			switch value := arg.Value.(type) {
+			case *ast.ListValue:
+				out.Properties = make([]string, 0, len(value.Values))
+				for _, prop := range value.Values {
+					if s, ok := prop.(*ast.StringValue); ok {
+						out.Properties = append(out.Properties, s.Value)
+					}
+				}
+			case *ast.StringValue:
+				// graphql allows to pass a single value for a list
+				out.Properties = []string{value.Value}
+			}
+		case "fragmentSize":
+			if value, ok := arg.Value.(*ast.IntValue); ok {
+				out.FragmentSize, _ = strconv.Atoi(value.Value)
+			}
+		case "numberOfFragments":
+			if value, ok := arg.Value.(*ast.IntValue); ok {
+				out.NumberOfFragments, _ = strconv.Atoi(value.Value)
+			}
+		case "preTag":
+			if value, ok := arg.Value.(*ast.StringValue); ok {
+				out.PreTag = value.Value
+			}
+		case "postTag":
+			if value, ok := arg.Value.(*ast.StringValue); ok {
+				out.PostTag = value.Value
+			}
+		default:
+			// ignore what we don't recognize
+		}
+	}
+
+	return out
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/tailor-inc/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -55,6 +56,10 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Vector search",
 			Type:        graphql.NewList(graphql.Float),
 		},
+		"fusionType": &graphql.InputObjectFieldConfig{
+			Description: "How the sparse and dense results are combined, defaults to rankedFusion",
+			Type:        common_filters.HybridFusionTypeEnum(fmt.Sprintf("GetObjects%s", class.Class)),
+		},
 		"properties": &graphql.InputObjectFieldConfig{
 			Description: "Which properties should be included in the sparse search",
 			Type:        graphql.NewList(graphql.String),
@@ -49,5 +49,21 @@ func sortFields(prefix string) graphql.InputObjectConfigFieldMap {
 				},
 			}),
 		},
+		"near": &graphql.InputObjectFieldConfig{
+			Description: descriptions.SortNear,
+			Type: graphql.NewInputObject(graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sSortInpObjNearInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"latitude": &graphql.InputObjectFieldConfig{
+						Type:        graphql.NewNonNull(graphql.Float),
+						Description: descriptions.WhereValueGeoCoordinatesLatitude,
+					},
+					"longitude": &graphql.InputObjectFieldConfig{
+						Type:        graphql.NewNonNull(graphql.Float),
+						Description: descriptions.WhereValueGeoCoordinatesLongitude,
+					},
+				},
+			}),
+		},
 	}
 }
@@ -43,5 +43,13 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "Cut off number of results after the Nth extrema. Off by default, negative numbers mean off.",
 			Type:        graphql.Int,
 		},
+		"fuzziness": &graphql.InputObjectFieldConfig{
+			Description: "Maximum edit distance (0-2) of matched terms for all query terms without an explicit fuzziness such as colour~1",
+			Type:        graphql.Int,
+		},
+		"fuzzyPenalty": &graphql.InputObjectFieldConfig{
+			Description: "Fraction (0-1) by which the score of a term matched through fuzziness is reduced per edit. Defaults to 0.5",
+			Type:        graphql.Float,
+		},
 	}
 }
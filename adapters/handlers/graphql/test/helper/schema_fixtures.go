@@ -67,6 +67,40 @@ func CreateSimpleSchema(vectorizer string) schema.Schema {
 							Name:     "phone",
 							DataType: []string{"phoneNumber"},
 						},
+						{
+							Name:     "address",
+							DataType: []string{"object"},
+							NestedProperties: []*models.NestedProperty{
+								{
+									Name:     "city",
+									DataType: []string{"text"},
+								},
+								{
+									Name:     "zipCodes",
+									DataType: []string{"int[]"},
+								},
+							},
+						},
+						{
+							Name:     "visits",
+							DataType: []string{"object[]"},
+							NestedProperties: []*models.NestedProperty{
+								{
+									Name:     "date",
+									DataType: []string{"date"},
+								},
+								{
+									Name:     "place",
+									DataType: []string{"object"},
+									NestedProperties: []*models.NestedProperty{
+										{
+											Name:     "name",
+											DataType: []string{"text"},
+										},
+									},
+								},
+							},
+						},
 						{
 							Name:     "hasAction",
 							DataType: []string{"SomeAction"},
@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+var operatorsFromProto = map[pb.Filters_Operator]string{
+	pb.Filters_OPERATOR_EQUAL:              models.WhereFilterOperatorEqual,
+	pb.Filters_OPERATOR_NOT_EQUAL:          models.WhereFilterOperatorNotEqual,
+	pb.Filters_OPERATOR_GREATER_THAN:       models.WhereFilterOperatorGreaterThan,
+	pb.Filters_OPERATOR_GREATER_THAN_EQUAL: models.WhereFilterOperatorGreaterThanEqual,
+	pb.Filters_OPERATOR_LESS_THAN:          models.WhereFilterOperatorLessThan,
+	pb.Filters_OPERATOR_LESS_THAN_EQUAL:    models.WhereFilterOperatorLessThanEqual,
+	pb.Filters_OPERATOR_AND:                models.WhereFilterOperatorAnd,
+	pb.Filters_OPERATOR_OR:                 models.WhereFilterOperatorOr,
+	pb.Filters_OPERATOR_LIKE:               models.WhereFilterOperatorLike,
+	pb.Filters_OPERATOR_IS_NULL:            models.WhereFilterOperatorIsNull,
+	pb.Filters_OPERATOR_CONTAINS_ANY:       models.WhereFilterOperatorContainsAny,
+	pb.Filters_OPERATOR_CONTAINS_ALL:       models.WhereFilterOperatorContainsAll,
+}
+
+// extractFilters translates the proto filters into the REST representation,
+// so that they are parsed and checked exactly like a REST or GraphQL where
+// filter.
+func extractFilters(in *pb.Filters, className string) (*filters.LocalFilter, error) {
+	where, err := whereFilterFromProto(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterext.Parse(where, className)
+}
+
+func whereFilterFromProto(in *pb.Filters) (*models.WhereFilter, error) {
+	operator, ok := operatorsFromProto[in.Operator]
+	if !ok {
+		return nil, fmt.Errorf("unsupported operator: %s", in.Operator)
+	}
+
+	out := &models.WhereFilter{
+		Operator: operator,
+		Path:     in.On,
+	}
+
+	for i, operand := range in.Filters {
+		child, err := whereFilterFromProto(operand)
+		if err != nil {
+			return nil, fmt.Errorf("operand at position %d: %w", i, err)
+		}
+		out.Operands = append(out.Operands, child)
+	}
+
+	switch value := in.TestValue.(type) {
+	case *pb.Filters_ValueText:
+		out.ValueText = &value.ValueText
+	case *pb.Filters_ValueInt:
+		out.ValueInt = &value.ValueInt
+	case *pb.Filters_ValueBoolean:
+		out.ValueBoolean = &value.ValueBoolean
+	case *pb.Filters_ValueNumber:
+		out.ValueNumber = &value.ValueNumber
+	case *pb.Filters_ValueTextArray:
+		out.ValueTextArray = nonNilSlice(value.ValueTextArray.GetValues())
+	case *pb.Filters_ValueIntArray:
+		out.ValueIntArray = nonNilSlice(value.ValueIntArray.GetValues())
+	case *pb.Filters_ValueBooleanArray:
+		out.ValueBooleanArray = nonNilSlice(value.ValueBooleanArray.GetValues())
+	case *pb.Filters_ValueNumberArray:
+		out.ValueNumberArray = nonNilSlice(value.ValueNumberArray.GetValues())
+	}
+
+	return out, nil
+}
+
+// nonNilSlice makes sure an empty array value is still recognized as set, so
+// that it is rejected with a meaningful error instead of being ignored
+func nonNilSlice[T any](in []T) []T {
+	if in == nil {
+		return []T{}
+	}
+	return in
+}
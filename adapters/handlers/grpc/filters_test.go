@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+func TestExtractFilters(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          *pb.Filters
+		expected    *filters.Clause
+		expectedErr bool
+	}{
+		{
+			name: "equal on text",
+			in: &pb.Filters{
+				Operator:  pb.Filters_OPERATOR_EQUAL,
+				On:        []string{"name"},
+				TestValue: &pb.Filters_ValueText{ValueText: "foo"},
+			},
+			expected: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "Car", Property: "name"},
+				Value:    &filters.Value{Value: "foo", Type: schema.DataTypeText},
+			},
+		},
+		{
+			name: "contains any on text array",
+			in: &pb.Filters{
+				Operator:  pb.Filters_OPERATOR_CONTAINS_ANY,
+				On:        []string{"colors"},
+				TestValue: &pb.Filters_ValueTextArray{ValueTextArray: &pb.TextArray{Values: []string{"red", "blue"}}},
+			},
+			expected: &filters.Clause{
+				Operator: filters.OperatorContainsAny,
+				On:       &filters.Path{Class: "Car", Property: "colors"},
+				Value:    &filters.Value{Value: []string{"red", "blue"}, Type: schema.DataTypeTextArray},
+			},
+		},
+		{
+			name: "nested contains all on int array",
+			in: &pb.Filters{
+				Operator: pb.Filters_OPERATOR_AND,
+				Filters: []*pb.Filters{
+					{
+						Operator:  pb.Filters_OPERATOR_CONTAINS_ALL,
+						On:        []string{"sizes"},
+						TestValue: &pb.Filters_ValueIntArray{ValueIntArray: &pb.IntArray{Values: []int64{1, 2}}},
+					},
+					{
+						Operator:  pb.Filters_OPERATOR_GREATER_THAN,
+						On:        []string{"price"},
+						TestValue: &pb.Filters_ValueNumber{ValueNumber: 1.5},
+					},
+				},
+			},
+			expected: &filters.Clause{
+				Operator: filters.OperatorAnd,
+				Operands: []filters.Clause{
+					{
+						Operator: filters.OperatorContainsAll,
+						On:       &filters.Path{Class: "Car", Property: "sizes"},
+						Value:    &filters.Value{Value: []int{1, 2}, Type: schema.DataTypeIntArray},
+					},
+					{
+						Operator: filters.OperatorGreaterThan,
+						On:       &filters.Path{Class: "Car", Property: "price"},
+						Value:    &filters.Value{Value: 1.5, Type: schema.DataTypeNumber},
+					},
+				},
+			},
+		},
+		{
+			name: "empty array",
+			in: &pb.Filters{
+				Operator:  pb.Filters_OPERATOR_CONTAINS_ALL,
+				On:        []string{"colors"},
+				TestValue: &pb.Filters_ValueTextArray{ValueTextArray: &pb.TextArray{}},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unspecified operator",
+			in: &pb.Filters{
+				On:        []string{"name"},
+				TestValue: &pb.Filters_ValueText{ValueText: "foo"},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := extractFilters(tt.in, "Car")
+			if tt.expectedErr {
+				require.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, filter.Root)
+		})
+	}
+}
@@ -28,17 +28,20 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	pb "github.com/weaviate/weaviate/grpc"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/traverser"
 	"google.golang.org/grpc"
 )
 
 func CreateGRPCServer(state *state.State) *GRPCServer {
 	s := grpc.NewServer()
+	shutdown := make(chan struct{})
 
 	pb.RegisterWeaviateServer(s, &Server{
 		traverser: state.Traverser,
@@ -47,9 +50,12 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 			state.APIKey, state.OIDC),
 		allowAnonymousAccess: state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		schemaManager:        state.SchemaManager,
+		authorizer:           state.Authorizer,
+		changes:              state.DB,
+		shutdown:             shutdown,
 	})
 
-	return &GRPCServer{s}
+	return &GRPCServer{s, shutdown}
 }
 
 func StartAndListen(s *GRPCServer, state *state.State) error {
@@ -69,6 +75,14 @@ func StartAndListen(s *GRPCServer, state *state.State) error {
 
 type GRPCServer struct {
 	*grpc.Server
+	shutdown chan struct{}
+}
+
+// GracefulStop ends all open subscriptions before stopping the server, as
+// these streams would otherwise keep it from ever completing
+func (s *GRPCServer) GracefulStop() {
+	close(s.shutdown)
+	s.Server.GracefulStop()
 }
 
 type Server struct {
@@ -77,6 +91,9 @@ type Server struct {
 	authComposer         composer.TokenFunc
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
+	authorizer           authorization.Authorizer
+	changes              changeSubscriber
+	shutdown             chan struct{}
 }
 
 func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
@@ -260,6 +277,19 @@ func extractAdditionalProps(asMap map[string]any, searchParams dto.GetParams) (*
 		}
 	}
 
+	if searchParams.AdditionalProperties.Highlights != nil {
+		highlights, ok := additionalPropertiesMap["highlights"].([]*additional.Highlight)
+		if ok {
+			additionalProps.Highlights = make([]*pb.Highlight, len(highlights))
+			for i, highlight := range highlights {
+				additionalProps.Highlights[i] = &pb.Highlight{
+					Property:  highlight.Property,
+					Fragments: highlight.Fragments,
+				}
+			}
+		}
+	}
+
 	return additionalProps, nil
 }
 
@@ -342,6 +372,13 @@ func extractPropertiesRequest(reqProps *pb.Properties) []search.SelectProperty {
 	return props
 }
 
+var fusionTypesFromProto = map[pb.HybridSearchParams_FusionType]string{
+	pb.HybridSearchParams_FUSION_TYPE_UNSPECIFIED:        "",
+	pb.HybridSearchParams_FUSION_TYPE_RANKED:             searchparams.FusionTypeRanked,
+	pb.HybridSearchParams_FUSION_TYPE_RELATIVE_SCORE:     searchparams.FusionTypeRelativeScore,
+	pb.HybridSearchParams_FUSION_TYPE_DISTRIBUTION_BASED: searchparams.FusionTypeDistributionBased,
+}
+
 func searchParamsFromProto(req *pb.SearchRequest) (dto.GetParams, error) {
 	out := dto.GetParams{}
 	out.ClassName = req.ClassName
@@ -364,10 +401,26 @@ func searchParamsFromProto(req *pb.SearchRequest) (dto.GetParams, error) {
 		out.AdditionalProperties.Score = req.AdditionalProperties.Score
 		out.AdditionalProperties.Certainty = req.AdditionalProperties.Certainty
 		explainScore = req.AdditionalProperties.ExplainScore
+
+		if hp := req.AdditionalProperties.Highlights; hp != nil {
+			out.AdditionalProperties.Highlights = &additional.HighlightParams{
+				Properties:        hp.Properties,
+				FragmentSize:      int(hp.FragmentSize),
+				NumberOfFragments: int(hp.NumberOfFragments),
+				PreTag:            hp.PreTag,
+				PostTag:           hp.PostTag,
+			}
+			// highlights are created from the stored properties
+			out.AdditionalProperties.NoProps = false
+		}
 	}
 
 	if hs := req.HybridSearch; hs != nil {
-		out.HybridSearch = &searchparams.HybridSearch{Query: hs.Query, Properties: hs.Properties, Vector: hs.Vector, Alpha: float64(hs.Alpha)}
+		fusionType, ok := fusionTypesFromProto[hs.FusionType]
+		if !ok {
+			return out, fmt.Errorf("hybrid: unknown fusion type %v", hs.FusionType)
+		}
+		out.HybridSearch = &searchparams.HybridSearch{Query: hs.Query, Properties: hs.Properties, Vector: hs.Vector, Alpha: float64(hs.Alpha), FusionType: fusionType}
 	}
 
 	if bm25 := req.Bm25Search; bm25 != nil {
@@ -418,6 +471,14 @@ func searchParamsFromProto(req *pb.SearchRequest) (dto.GetParams, error) {
 		}
 	}
 
+	if req.Filters != nil {
+		filter, err := extractFilters(req.Filters, req.ClassName)
+		if err != nil {
+			return out, fmt.Errorf("filters: %w", err)
+		}
+		out.Filters = filter
+	}
+
 	out.Pagination = &filters.Pagination{}
 	if req.Limit > 0 {
 		out.Pagination.Limit = int(req.Limit)
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/changelog"
+	pb "github.com/weaviate/weaviate/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type changeSubscriber interface {
+	SubscribeChanges(ctx context.Context, className, shardName string,
+		fromOffset uint64, fn func(changelog.Change) error) error
+}
+
+// Subscribe streams the changes recorded in the change logs of the local
+// shards of a class, starting at the requested offset. The stream stays open
+// and delivers new changes as they happen until the client cancels it.
+func (s *Server) Subscribe(req *pb.SubscribeRequest, stream pb.Weaviate_SubscribeServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	if req.ClassName == "" {
+		return fmt.Errorf("class name must be set")
+	}
+
+	path := fmt.Sprintf("objects/%s", req.ClassName)
+	if err := s.authorizer.Authorize(principal, "list", path); err != nil {
+		return err
+	}
+
+	return s.changes.SubscribeChanges(ctx, req.ClassName, req.Shard, req.FromOffset,
+		func(change changelog.Change) error {
+			event, err := changeToProto(change)
+			if err != nil {
+				return err
+			}
+			return stream.Send(event)
+		})
+}
+
+func changeToProto(change changelog.Change) (*pb.ChangeEvent, error) {
+	event := &pb.ChangeEvent{
+		Offset:    change.Offset,
+		Type:      changeTypeToProto(change.Type),
+		ClassName: change.Class,
+		Shard:     change.Shard,
+		Id:        change.ID.String(),
+		Timestamp: change.Timestamp,
+	}
+
+	if obj := change.Object; obj != nil {
+		event.Vector = obj.Vector
+		event.CreationTimeUnix = obj.CreationTimeUnix
+		event.LastUpdateTimeUnix = obj.LastUpdateTimeUnix
+
+		if props, ok := obj.Properties.(map[string]interface{}); ok && len(props) > 0 {
+			properties, err := structpb.NewStruct(props)
+			if err != nil {
+				return nil, errors.Wrapf(err, "convert properties of change %d", change.Offset)
+			}
+			event.Properties = properties
+		}
+	}
+
+	return event, nil
+}
+
+func changeTypeToProto(typ changelog.Type) pb.ChangeEvent_Type {
+	switch typ {
+	case changelog.TypeCreate:
+		return pb.ChangeEvent_TYPE_CREATE
+	case changelog.TypeUpdate:
+		return pb.ChangeEvent_TYPE_UPDATE
+	case changelog.TypeDelete:
+		return pb.ChangeEvent_TYPE_DELETE
+	case changelog.TypeReference:
+		return pb.ChangeEvent_TYPE_REFERENCE
+	default:
+		return pb.ChangeEvent_TYPE_UNSPECIFIED
+	}
+}
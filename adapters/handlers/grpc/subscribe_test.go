@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+func TestChangeToProto(t *testing.T) {
+	t.Run("update with object", func(t *testing.T) {
+		event, err := changeToProto(changelog.Change{
+			Offset:    7,
+			Type:      changelog.TypeUpdate,
+			Class:     "Car",
+			Shard:     "abc",
+			ID:        "73f2eb5f-5abf-447a-81ca-74b1dd168247",
+			Timestamp: 1000,
+			Object: &models.Object{
+				Properties: map[string]interface{}{
+					"name": "foo",
+					"tags": []interface{}{"a", "b"},
+				},
+				Vector:             []float32{1, 2},
+				CreationTimeUnix:   500,
+				LastUpdateTimeUnix: 1000,
+			},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(7), event.Offset)
+		assert.Equal(t, pb.ChangeEvent_TYPE_UPDATE, event.Type)
+		assert.Equal(t, "Car", event.ClassName)
+		assert.Equal(t, "abc", event.Shard)
+		assert.Equal(t, "73f2eb5f-5abf-447a-81ca-74b1dd168247", event.Id)
+		assert.Equal(t, int64(1000), event.Timestamp)
+		assert.Equal(t, []float32{1, 2}, event.Vector)
+		assert.Equal(t, int64(500), event.CreationTimeUnix)
+		assert.Equal(t, int64(1000), event.LastUpdateTimeUnix)
+		assert.Equal(t, map[string]interface{}{
+			"name": "foo",
+			"tags": []interface{}{"a", "b"},
+		}, event.Properties.AsMap())
+	})
+
+	t.Run("delete without object", func(t *testing.T) {
+		event, err := changeToProto(changelog.Change{
+			Offset: 8,
+			Type:   changelog.TypeDelete,
+			Class:  "Car",
+			ID:     "73f2eb5f-5abf-447a-81ca-74b1dd168247",
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, pb.ChangeEvent_TYPE_DELETE, event.Type)
+		assert.Nil(t, event.Properties)
+		assert.Nil(t, event.Vector)
+	})
+}
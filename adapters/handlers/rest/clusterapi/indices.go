@@ -19,6 +19,7 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"strconv"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -42,6 +43,7 @@ type indices struct {
 	regexpObjectsFind         *regexp.Regexp
 	regexpObjectsAggregations *regexp.Regexp
 	regexpObject              *regexp.Regexp
+	regexpObjectVersions      *regexp.Regexp
 	regexpReferences          *regexp.Regexp
 	regexpShardsStatus        *regexp.Regexp
 	regexpShardFiles          *regexp.Regexp
@@ -62,6 +64,8 @@ const (
 		`\/shards\/([A-Za-z0-9]+)\/objects\/_find`
 	urlPatternObjectsAggregations = `\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects\/_aggregations`
+	urlPatternObjectVersions = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects\/([A-Za-z0-9_+-]+)\/versions`
 	urlPatternObject = `\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects\/([A-Za-z0-9_+-]+)`
 	urlPatternReferences = `\/indices\/([A-Za-z0-9_+-]+)` +
@@ -94,6 +98,12 @@ type shards interface {
 		mergeDoc objects.MergeDocument) error
 	MultiGetObjects(ctx context.Context, indexName, shardName string,
 		id []strfmt.UUID) ([]*storobj.Object, error)
+	ObjectsByDocID(ctx context.Context, indexName, shardName string,
+		docIDs []uint64) ([]*storobj.Object, error)
+	ObjectVersions(ctx context.Context, indexName, shardName string,
+		id strfmt.UUID) ([]objects.StoredObjectVersion, error)
+	ObjectAsOf(ctx context.Context, indexName, shardName string,
+		id strfmt.UUID, asOf int64) (*storobj.Object, error)
 	Search(ctx context.Context, indexName, shardName string,
 		vector []float32, distance float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
@@ -136,6 +146,7 @@ func NewIndices(shards shards, db db) *indices {
 		regexpObjectsFind:         regexp.MustCompile(urlPatternObjectsFind),
 		regexpObjectsAggregations: regexp.MustCompile(urlPatternObjectsAggregations),
 		regexpObject:              regexp.MustCompile(urlPatternObject),
+		regexpObjectVersions:      regexp.MustCompile(urlPatternObjectVersions),
 		regexpReferences:          regexp.MustCompile(urlPatternReferences),
 		regexpShardsStatus:        regexp.MustCompile(urlPatternShardsStatus),
 		regexpShardFiles:          regexp.MustCompile(urlPatternShardFiles),
@@ -186,6 +197,14 @@ func (i *indices) Indices() http.Handler {
 			}
 
 			i.getObjectsDigest().ServeHTTP(w, r)
+		case i.regexpObjectVersions.MatchString(path):
+			if r.Method != http.MethodGet {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.getObjectVersions().ServeHTTP(w, r)
+			return
 		case i.regexpObject.MatchString(path):
 			if r.Method == http.MethodGet {
 				i.getObject().ServeHTTP(w, r)
@@ -204,6 +223,10 @@ func (i *indices) Indices() http.Handler {
 			return
 
 		case i.regexpObjects.MatchString(path):
+			if r.Method == http.MethodGet && r.URL.Query().Has("docIDs") {
+				i.getObjectsByDocID().ServeHTTP(w, r)
+				return
+			}
 			if r.Method == http.MethodGet {
 				i.getObjectsMulti().ServeHTTP(w, r)
 				return
@@ -315,14 +338,34 @@ func (i *indices) postObjectSingle(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	if h := r.Header.Get("If-Match"); h != "" {
+		ifMatch, err := strconv.ParseInt(h, 10, 64)
+		if err != nil {
+			http.Error(w, errors.Wrap(err, "parse If-Match header").Error(),
+				http.StatusBadRequest)
+			return
+		}
+		obj.IfMatch = &ifMatch
+	}
+
 	if err := i.shards.PutObject(r.Context(), index, shard, obj); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), writeErrorStatus(err))
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// writeErrorStatus maps a failed write to its status code, so that the
+// calling node can tell a failed IfMatch condition apart from other errors
+func writeErrorStatus(err error) int {
+	var errPrecondition objects.ErrPreconditionFailed
+	if errors.As(err, &errPrecondition) {
+		return http.StatusPreconditionFailed
+	}
+	return http.StatusInternalServerError
+}
+
 func (i *indices) postObjectBatch(w http.ResponseWriter, r *http.Request,
 	index, shard string,
 ) {
@@ -435,6 +478,75 @@ func (i *indices) getObject() http.Handler {
 	})
 }
 
+// getObjectVersions returns the version history of an object or, if the
+// asOf param is set, the version which was current at that time
+func (i *indices) getObjectVersions() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectVersions.FindStringSubmatch(r.URL.Path)
+		if len(args) != 4 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard, id := args[1], args[2], args[3]
+
+		defer r.Body.Close()
+
+		if !i.db.StartupComplete() {
+			http.Error(w, "startup is not complete", http.StatusServiceUnavailable)
+			return
+		}
+
+		if asOfParam := r.URL.Query().Get("asOf"); asOfParam != "" {
+			asOf, err := strconv.ParseInt(asOfParam, 10, 64)
+			if err != nil {
+				http.Error(w, "parse 'asOf' param: "+err.Error(),
+					http.StatusBadRequest)
+				return
+			}
+
+			obj, err := i.shards.ObjectAsOf(r.Context(), index, shard,
+				strfmt.UUID(id), asOf)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			if obj == nil {
+				// the object did not exist at that time
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			objBytes, err := IndicesPayloads.SingleObject.Marshal(obj)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			IndicesPayloads.SingleObject.SetContentTypeHeader(w)
+			w.Write(objBytes)
+			return
+		}
+
+		versions, err := i.shards.ObjectVersions(r.Context(), index, shard,
+			strfmt.UUID(id))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		versionsBytes, err := IndicesPayloads.ObjectVersionList.Marshal(versions)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.ObjectVersionList.SetContentTypeHeader(w)
+		w.Write(versionsBytes)
+	})
+}
+
 func (i *indices) checkExists(w http.ResponseWriter, r *http.Request,
 	index, shard, id string,
 ) {
@@ -502,7 +614,7 @@ func (i *indices) mergeObject() http.Handler {
 		}
 
 		if err := i.shards.MergeObject(r.Context(), index, shard, mergeDoc); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), writeErrorStatus(err))
 			return
 		}
 
@@ -559,6 +671,50 @@ func (i *indices) getObjectsMulti() http.Handler {
 	})
 }
 
+func (i *indices) getObjectsByDocID() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjects.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, fmt.Sprintf("invalid URI: %s", r.URL.Path),
+				http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+
+		docIDsBytes, err := base64.StdEncoding.DecodeString(r.URL.Query().Get("docIDs"))
+		if err != nil {
+			http.Error(w, "base64 decode 'docIDs' param: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		var docIDs []uint64
+		if err := json.Unmarshal(docIDsBytes, &docIDs); err != nil {
+			http.Error(w, "unmarshal 'docIDs' param from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		objs, err := i.shards.ObjectsByDocID(r.Context(), index, shard, docIDs)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		objsBytes, err := IndicesPayloads.ObjectList.Marshal(objs)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.ObjectList.SetContentTypeHeader(w)
+		w.Write(objsBytes)
+	})
+}
+
 func (i *indices) postSearchObjects() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpObjectsSearch.FindStringSubmatch(r.URL.Path)
@@ -38,6 +38,7 @@ type indicesPayloads struct {
 	MergeDoc                  mergeDocPayload
 	ObjectList                objectListPayload
 	VersionedObjectList       versionedObjectListPayload
+	ObjectVersionList         objectVersionListPayload
 	SearchResults             searchResultsPayload
 	SearchParams              searchParamsPayload
 	ReferenceList             referenceListPayload
@@ -306,6 +307,102 @@ func (p versionedObjectListPayload) Unmarshal(in []byte) ([]*objects.VObject, er
 	return out, nil
 }
 
+// objectVersionListPayload encodes the version history of an object. Each
+// version is written as its version number, a deleted flag and the length
+// prefixed object, which is empty for deletions.
+type objectVersionListPayload struct{}
+
+func (p objectVersionListPayload) MIME() string {
+	return "application/vnd.weaviate.objectversion.list+octet-stream"
+}
+
+func (p objectVersionListPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p objectVersionListPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p objectVersionListPayload) Marshal(in []objects.StoredObjectVersion) ([]byte, error) {
+	// NOTE: This implementation is not optimized for allocation efficiency,
+	// reserve 1024 byte per object which is rather arbitrary
+	out := make([]byte, 0, 1024*len(in))
+
+	reusableBuf := make([]byte, 8)
+	for _, version := range in {
+		binary.LittleEndian.PutUint64(reusableBuf, uint64(version.Version))
+		out = append(out, reusableBuf...)
+
+		deleted := byte(0)
+		if version.Deleted {
+			deleted = 1
+		}
+		out = append(out, deleted)
+
+		var objBytes []byte
+		if version.Object != nil {
+			var err error
+			objBytes, err = version.Object.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		binary.LittleEndian.PutUint64(reusableBuf, uint64(len(objBytes)))
+		out = append(out, reusableBuf...)
+		out = append(out, objBytes...)
+	}
+
+	return out, nil
+}
+
+func (p objectVersionListPayload) Unmarshal(in []byte) ([]objects.StoredObjectVersion, error) {
+	var out []objects.StoredObjectVersion
+
+	reusableBuf := make([]byte, 8)
+	r := bytes.NewReader(in)
+
+	for {
+		_, err := io.ReadFull(r, reusableBuf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		version := objects.StoredObjectVersion{
+			Version: int64(binary.LittleEndian.Uint64(reusableBuf)),
+		}
+
+		deleted, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		version.Deleted = deleted == 1
+
+		if _, err := io.ReadFull(r, reusableBuf); err != nil {
+			return nil, err
+		}
+		if ln := binary.LittleEndian.Uint64(reusableBuf); ln > 0 {
+			payloadBytes := make([]byte, ln)
+			if _, err := io.ReadFull(r, payloadBytes); err != nil {
+				return nil, err
+			}
+
+			version.Object, err = storobj.FromBinary(payloadBytes)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		out = append(out, version)
+	}
+
+	return out, nil
+}
+
 type mergeDocPayload struct{}
 
 func (p mergeDocPayload) MIME() string {
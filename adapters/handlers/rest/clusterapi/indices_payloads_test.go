@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
 )
 
 func Test_objectListPayload_Marshal(t *testing.T) {
@@ -94,3 +95,42 @@ func Test_objectListPayload_Marshal(t *testing.T) {
 	assert.EqualValues(t, objs[2].Object, received[1].Object)
 	assert.EqualValues(t, objs[2].ID(), received[1].ID())
 }
+
+func Test_objectVersionListPayload_Marshal(t *testing.T) {
+	id := strfmt.UUID("c6f85bf5-c3b7-4c1d-bd51-e899f9605336")
+	obj := &storobj.Object{
+		MarshallerVersion: 1,
+		Object: models.Object{
+			ID:                 id,
+			Class:              "SomeClass",
+			CreationTimeUnix:   1000,
+			LastUpdateTimeUnix: 2000,
+			Properties: map[string]interface{}{
+				"propA": "this is prop A",
+			},
+		},
+		Vector:    []float32{1, 2, 3},
+		VectorLen: 3,
+	}
+
+	versions := []objects.StoredObjectVersion{
+		{Version: 3000, Deleted: true},
+		{Version: 2000, Object: obj},
+	}
+
+	payload := objectVersionListPayload{}
+	b, err := payload.Marshal(versions)
+	require.Nil(t, err)
+
+	received, err := payload.Unmarshal(b)
+	require.Nil(t, err)
+	require.Len(t, received, 2)
+	assert.Equal(t, int64(3000), received[0].Version)
+	assert.True(t, received[0].Deleted)
+	assert.Nil(t, received[0].Object)
+	assert.Equal(t, int64(2000), received[1].Version)
+	assert.False(t, received[1].Deleted)
+	require.NotNil(t, received[1].Object)
+	assert.EqualValues(t, obj.Object, received[1].Object.Object)
+	assert.Equal(t, obj.Vector, received[1].Object.Vector)
+}
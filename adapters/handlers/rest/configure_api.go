@@ -55,6 +55,7 @@ import (
 	modqnaopenai "github.com/weaviate/weaviate/modules/qna-openai"
 	modqna "github.com/weaviate/weaviate/modules/qna-transformers"
 	modcentroid "github.com/weaviate/weaviate/modules/ref2vec-centroid"
+	modrerankertransformers "github.com/weaviate/weaviate/modules/reranker-transformers"
 	modsum "github.com/weaviate/weaviate/modules/sum-transformers"
 	modspellcheck "github.com/weaviate/weaviate/modules/text-spellcheck"
 	modcohere "github.com/weaviate/weaviate/modules/text2vec-cohere"
@@ -68,6 +69,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/export"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -77,6 +79,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/traverser"
+	"github.com/weaviate/weaviate/usecases/traverser/highlighter"
 )
 
 const MinimumRequiredContextionaryVersion = "1.0.2"
@@ -103,6 +106,7 @@ type explorer interface {
 	GetClass(ctx context.Context, params dto.GetParams) ([]interface{}, error)
 	CrossClassVectorSearch(ctx context.Context, params traverser.ExploreParams) ([]search.Result, error)
 	SetSchemaGetter(schemaUC.SchemaGetter)
+	SetHighlightAnalyzers(highlighter.Analyzers)
 }
 
 func configureAPI(api *operations.WeaviateAPI) http.Handler {
@@ -183,6 +187,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		MaxImportGoroutinesFactor: appState.ServerConfig.Config.MaxImportGoroutinesFactor,
 		TrackVectorDimensions:     appState.ServerConfig.Config.TrackVectorDimensions,
 		ResourceUsage:             appState.ServerConfig.Config.ResourceUsage,
+		ChangeDataCapture:         appState.ServerConfig.Config.ChangeDataCapture,
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, replicationClient, appState.Metrics) // TODO client
 	if err != nil {
 		appState.Logger.
@@ -254,10 +259,14 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		schemaManager, repo, appState.Modules)
 	appState.BackupManager = backupManager
 
+	exportManager := export.NewManager(appState.Logger, appState.Authorizer,
+		schemaManager, repo, appState.Modules)
+
 	go clusterapi.Serve(appState)
 
 	vectorRepo.SetSchemaGetter(schemaManager)
 	explorer.SetSchemaGetter(schemaManager)
+	explorer.SetHighlightAnalyzers(inverted.HighlightAnalyzers{})
 	appState.Modules.SetSchemaGetter(schemaManager)
 
 	err = vectorRepo.WaitForStartup(ctx)
@@ -296,6 +305,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	setupMiscHandlers(api, appState.ServerConfig, schemaManager, appState.Modules)
 	setupClassificationHandlers(api, classifier)
 	setupBackupHandlers(api, backupScheduler)
+	setupExportHandlers(api, exportManager)
 	setupNodesHandlers(api, schemaManager, repo, appState)
 
 	err = migrator.AdjustFilterablePropSettings(ctx)
@@ -341,6 +351,12 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
+		// stop a running export, its shards are read from the repo
+		if err := exportManager.Shutdown(ctx); err != nil {
+			appState.Logger.WithError(err).WithField("action", "export").
+				Error("stop running export")
+		}
+
 		if err := repo.Shutdown(ctx); err != nil {
 			panic(err)
 		}
@@ -537,6 +553,14 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules["reranker-transformers"]; ok {
+		appState.Modules.Register(modrerankertransformers.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", "reranker-transformers").
+			Debug("enabled module")
+	}
+
 	if _, ok := enabledModules["img2vec-neural"]; ok {
 		appState.Modules.Register(modimage.New())
 		appState.Logger.
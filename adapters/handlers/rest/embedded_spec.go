@@ -411,6 +411,10 @@ func init() {
             "schema": {
               "type": "object",
               "properties": {
+                "conditional": {
+                  "description": "If true, each object is only written if its lastUpdateTimeUnix matches the stored object. Objects without a lastUpdateTimeUnix are only created if they do not exist yet.",
+                  "type": "boolean"
+                },
                 "fields": {
                   "description": "Define which fields need to be returned. Default value is ALL",
                   "type": "array",
@@ -544,6 +548,68 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
+      },
+      "patch": {
+        "description": "Update Objects in bulk that match a certain filter. The given properties are merged into every matching object, vectors are recomputed if needed.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Updates Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.update",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchUpdate"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchUpdateResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
     "/batch/references": {
@@ -718,6 +784,127 @@ func init() {
         ]
       }
     },
+    "/exports/{backend}": {
+      "post": {
+        "description": "Starts a process of exporting the objects of a class to JSONL or Parquet files",
+        "tags": [
+          "exports"
+        ],
+        "operationId": "exports.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ExportCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Export process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/ExportCreateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid export attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/exports/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of an export, including the progress of every shard",
+        "tags": [
+          "exports"
+        ],
+        "operationId": "exports.create.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of an export. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Export status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/ExportCreateStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Export does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid export status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
     "/graphql": {
       "post": {
         "description": "Get an object based on GraphQL",
@@ -1138,6 +1325,13 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonNodeNameParameterQuery"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Return the version of the object which was current at this time (in ms since epoch). Requires objectVersioningConfig to be enabled for the class",
+            "name": "asOf",
+            "in": "query"
           }
         ],
         "responses": {
@@ -1210,6 +1404,9 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
           }
         ],
         "responses": {
@@ -1231,6 +1428,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The stored object does not match the If-Match condition.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -1391,7 +1594,7 @@ func init() {
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object. Instead of a value, a property can be set to an operator which is applied to the stored value: $append, $remove or $addToSet with a value or an array of values for array properties, or $inc with a number for int and number properties.",
             "name": "body",
             "in": "body",
             "schema": {
@@ -1400,6 +1603,9 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
           }
         ],
         "responses": {
@@ -1421,6 +1627,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The stored object does not match the If-Match condition.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
@@ -1695,6 +1907,72 @@ func init() {
         ]
       }
     },
+    "/objects/{className}/{id}/versions": {
+      "get": {
+        "description": "List the stored versions of a data object, newest first.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get the version history of an Object based on its class and UUID.",
+        "operationId": "objects.class.versions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ObjectVersionsResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      }
+    },
     "/objects/{id}": {
       "get": {
         "description": "Lists Objects.",
@@ -1937,7 +2215,7 @@ func init() {
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object. Instead of a value, a property can be set to an operator which is applied to the stored value: $append, $remove or $addToSet with a value or an array of values for array properties, or $inc with a number for int and number properties.",
             "name": "body",
             "in": "body",
             "schema": {
@@ -3046,20 +3324,141 @@ func init() {
         }
       ]
     },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+    "BatchUpdate": {
+      "type": "object",
       "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
-        },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
-          "type": "string"
+        "dryRun": {
+          "description": "If true, objects will not be updated yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
         },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+        "match": {
+          "description": "Outlines how to find the objects to be updated.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be updated.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be updated.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "properties": {
+          "description": "The properties to set on every matching object. Properties set to null are removed from the objects.",
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "BatchUpdateResponse": {
+      "description": "Update Objects response.",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be updated yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be updated.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be updated.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be updated.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "properties": {
+          "description": "The properties to set on every matching object. Properties set to null are removed from the objects.",
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been updated but could not be updated.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully updated objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific Object.",
+                "format": "object",
+                "properties": {
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  },
+                  "id": {
+                    "description": "ID of the Object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  }
+                }
+              }
+            },
+            "successful": {
+              "description": "How many objects were successfully updated in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+      "properties": {
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
+        },
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
           "type": "number",
           "format": "float"
         }
@@ -3203,6 +3602,12 @@ func init() {
         "multiTenancyConfig": {
           "$ref": "#/definitions/MultiTenancyConfig"
         },
+        "objectTtlConfig": {
+          "$ref": "#/definitions/ObjectTtlConfig"
+        },
+        "objectVersioningConfig": {
+          "$ref": "#/definitions/ObjectVersioningConfig"
+        },
         "properties": {
           "description": "The properties of the class.",
           "type": "array",
@@ -3423,6 +3828,183 @@ func init() {
         }
       }
     },
+    "ExportCreateRequest": {
+      "description": "Request body for exporting the objects of a class",
+      "properties": {
+        "class": {
+          "description": "The class whose objects are exported",
+          "type": "string"
+        },
+        "format": {
+          "description": "Format of the exported files",
+          "type": "string",
+          "default": "jsonl",
+          "enum": [
+            "jsonl",
+            "parquet"
+          ]
+        },
+        "id": {
+          "description": "The ID of the export. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "includeVector": {
+          "description": "If true, the vector of every object is exported as well. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "where": {
+          "description": "Filter to limit the objects to be exported. The filter is evaluated once per shard when its export starts, the export is not a point-in-time snapshot: objects which are updated while the shard is exported may be left out.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "ExportCreateResponse": {
+      "description": "The definition of an export create response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The class whose objects are exported",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if the export failed",
+          "type": "string"
+        },
+        "format": {
+          "description": "Format of the exported files",
+          "type": "string",
+          "default": "jsonl",
+          "enum": [
+            "jsonl",
+            "parquet"
+          ]
+        },
+        "id": {
+          "description": "The ID of the export. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of export files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of export process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "ExportCreateStatusResponse": {
+      "description": "The definition of an export status response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The class whose objects are exported",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "Time at which the export ended",
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "description": "error message if the export failed",
+          "type": "string"
+        },
+        "format": {
+          "description": "Format of the exported files",
+          "type": "string",
+          "default": "jsonl",
+          "enum": [
+            "jsonl",
+            "parquet"
+          ]
+        },
+        "id": {
+          "description": "The ID of the export. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "objects": {
+          "description": "The number of objects exported so far",
+          "type": "integer",
+          "format": "int64"
+        },
+        "path": {
+          "description": "destination path of export files proper to selected backend",
+          "type": "string"
+        },
+        "shards": {
+          "description": "The progress of every shard of the class",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExportShardStatus"
+          }
+        },
+        "startedAt": {
+          "description": "Time at which the export was started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "phase of export process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "ExportShardStatus": {
+      "description": "The export status of a single shard, which is written to its own file",
+      "properties": {
+        "error": {
+          "description": "error message if the export failed",
+          "type": "string"
+        },
+        "file": {
+          "description": "The name of the file the objects of the shard are written to",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the shard",
+          "type": "string"
+        },
+        "objects": {
+          "description": "The number of objects of the shard exported so far",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "phase of the export of the shard",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
     "GeoCoordinates": {
       "properties": {
         "latitude": {
@@ -3525,6 +4107,14 @@ func init() {
       "description": "Configure the inverted index built into Weaviate",
       "type": "object",
       "properties": {
+        "analyzers": {
+          "description": "Named text analyzers, which can be referenced by the text properties of the class through their ` + "`" + `analyzer` + "`" + ` setting",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TextAnalyzerConfig"
+          },
+          "x-omitempty": true
+        },
         "bm25": {
           "$ref": "#/definitions/BM25Config"
         },
@@ -3537,6 +4127,10 @@ func init() {
           "description": "Index each object with the null state",
           "type": "boolean"
         },
+        "indexPositions": {
+          "description": "Index the positions of terms in searchable properties, which enables phrase and proximity queries in BM25 (e.g. '\"new york\"~2')",
+          "type": "boolean"
+        },
         "indexPropertyLength": {
           "description": "Index length of properties",
           "type": "boolean"
@@ -3547,6 +4141,9 @@ func init() {
         },
         "stopwords": {
           "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "$ref": "#/definitions/SynonymConfig"
         }
       }
     },
@@ -3614,13 +4211,63 @@ func init() {
         "$ref": "#/definitions/SingleRef"
       }
     },
-    "NodeShardStatus": {
-      "description": "The definition of a node shard status response body",
+    "NestedProperty": {
+      "type": "object",
       "properties": {
-        "class": {
-          "description": "The name of shard's class.",
-          "type": "string",
-          "x-omitempty": false
+        "dataType": {
+          "description": "Data type of the nested property. Can be any primitive data type, \"object\" or \"object[]\", but not a reference to another class.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the nested property.",
+          "type": "string"
+        },
+        "indexFilterable": {
+          "description": "Optional. Should this nested property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use its path in where filters",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this nested property be indexed in the inverted index. Defaults to true. Applicable only to nested properties of data type text and text[]. If you choose false, you will not be able to use its path in bm25 or hybrid search",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the nested property. Must not start with an uppercase letter, as such a segment of a filter path is read as the class of a reference.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The nested properties of a nested property of data type \"object\" or \"object[]\".",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the nested property. Optional. Applies to text and text[] data types. Allowed values are the same as for the tokenization of properties",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "trigram",
+            "cjk"
+          ]
+        }
+      }
+    },
+    "NodeShardStatus": {
+      "description": "The definition of a node shard status response body",
+      "properties": {
+        "class": {
+          "description": "The name of shard's class.",
+          "type": "string",
+          "x-omitempty": false
         },
         "name": {
           "description": "The name of the shard.",
@@ -3740,6 +4387,75 @@ func init() {
         }
       }
     },
+    "ObjectTtlConfig": {
+      "description": "Configure the automatic deletion of expired objects of a class",
+      "type": "object",
+      "properties": {
+        "defaultTtl": {
+          "description": "Time to live of an object in seconds, counted from the time set in deleteOn. Can be 0 if deleteOn is a date property which marks the expiry itself",
+          "type": "integer",
+          "format": "int64"
+        },
+        "deleteOn": {
+          "description": "The time an object's time to live is counted from. Either '_creationTimeUnix' (default) or the name of a property of data type date",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Whether or not expired objects are deleted automatically",
+          "type": "boolean"
+        }
+      }
+    },
+    "ObjectVersion": {
+      "description": "A stored version of an object",
+      "type": "object",
+      "properties": {
+        "deleted": {
+          "description": "Marks the deletion of the object. Deleted versions carry no object",
+          "type": "boolean"
+        },
+        "object": {
+          "$ref": "#/definitions/Object"
+        },
+        "version": {
+          "description": "The time the version was written (in ms since epoch). For objects this equals their lastUpdateTimeUnix",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ObjectVersioningConfig": {
+      "description": "Configure how many previous versions of the objects of a class are kept",
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not previous versions of objects are kept",
+          "type": "boolean"
+        },
+        "maxAgeHours": {
+          "description": "The number of hours a version is kept after it was replaced by a newer one. 0 means no limit",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxVersions": {
+          "description": "The maximum number of versions kept per object, including the current one. 0 means no limit",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ObjectVersionsResponse": {
+      "description": "The stored versions of an object, newest first",
+      "type": "object",
+      "properties": {
+        "versions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ObjectVersion"
+          }
+        }
+      }
+    },
     "ObjectsGetResponse": {
       "type": "object",
       "allOf": [
@@ -3958,6 +4674,10 @@ func init() {
     "Property": {
       "type": "object",
       "properties": {
+        "analyzer": {
+          "description": "Name of a text analyzer defined in the invertedIndexConfig of the class. Optional. Applies to text and text[] data types. If set, the analyzer is used instead of the tokenization of the property, both at import and at query time",
+          "type": "string"
+        },
         "dataType": {
           "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
           "type": "array",
@@ -3992,14 +4712,28 @@ func init() {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "nestedProperties": {
+          "description": "The nested properties of a property of data type \"object\" or \"object[]\". Required for these data types, not allowed for any other.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "stopwordsPreset": {
+          "description": "Name of a stopword preset which replaces the preset of the invertedIndexConfig for this property, e.g. for a property in a different language than the rest of the class. Optional. Applies to text and text[] data types. The additions and removals of the class still apply",
+          "type": "string"
+        },
         "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims), ` + "`" + `trigram` + "`" + ` (splits into lowercased trigrams of each word, speeds up infix ` + "`" + `Like` + "`" + ` filters), ` + "`" + `cjk` + "`" + ` (splits chinese, japanese and korean text into overlapping bigrams and all other text into lowercased words). Not supported for remaining data types",
           "type": "string",
           "enum": [
             "word",
             "lowercase",
             "whitespace",
-            "field"
+            "field",
+            "trigram",
+            "cjk"
           ]
         }
       }
@@ -4171,7 +4905,7 @@ func init() {
           }
         },
         "preset": {
-          "description": "pre-existing list of common words by language",
+          "description": "pre-existing list of common words by language, one of 'en' (default), 'de', 'fr', 'es', 'nl', 'it', 'pt' or 'none'",
           "type": "string"
         },
         "removals": {
@@ -4183,6 +4917,49 @@ func init() {
         }
       }
     },
+    "SynonymConfig": {
+      "description": "Synonyms which expand the terms of BM25 queries and of text equality filters at query time, they can be updated without reindexing",
+      "type": "object",
+      "properties": {
+        "equivalents": {
+          "description": "Sets of interchangeable terms, e.g. [\"car\", \"automobile\"]. A query term from a set also matches all other terms of the set",
+          "type": "array",
+          "items": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
+        },
+        "mappings": {
+          "description": "One-way mappings, which expand query terms to synonyms without expanding the synonyms back",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SynonymMapping"
+          }
+        }
+      }
+    },
+    "SynonymMapping": {
+      "description": "A one-way synonym mapping, e.g. from [\"ipod\"] to [\"mp3 player\"]",
+      "type": "object",
+      "properties": {
+        "synonyms": {
+          "description": "Synonyms the terms are expanded to, a synonym may consist of multiple words",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "terms": {
+          "description": "Query terms which are expanded",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "Tenant": {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
@@ -4193,6 +4970,27 @@ func init() {
         }
       }
     },
+    "TextAnalyzerConfig": {
+      "description": "A named chain of a tokenization and token filters which are applied to the text of a property at import and at query time",
+      "type": "object",
+      "properties": {
+        "filters": {
+          "description": "Token filters applied in order to the terms produced by the tokenization. Allowed values are ` + "`" + `lowercase` + "`" + `, ` + "`" + `asciifolding` + "`" + ` (removes diacritics, e.g. ` + "`" + `café` + "`" + ` becomes ` + "`" + `cafe` + "`" + `), ` + "`" + `nfkc` + "`" + ` (unicode normalization), ` + "`" + `elision` + "`" + ` (removes elided articles, e.g. ` + "`" + `l'avion` + "`" + ` becomes ` + "`" + `avion` + "`" + `) and the snowball stemmers ` + "`" + `snowball_en` + "`" + `, ` + "`" + `snowball_de` + "`" + `, ` + "`" + `snowball_fr` + "`" + `, ` + "`" + `snowball_es` + "`" + ` and ` + "`" + `snowball_nl` + "`" + `",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the analyzer, used to reference it from the analyzer setting of a property",
+          "type": "string"
+        },
+        "tokenization": {
+          "description": "Tokenization used to split the text into terms. Allowed values are the same as for the tokenization of a property. Defaults to ` + "`" + `word` + "`" + `",
+          "type": "string"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
@@ -4223,7 +5021,11 @@ func init() {
             "LessThan",
             "LessThanEqual",
             "WithinGeoRange",
-            "IsNull"
+            "IsNull",
+            "WithinGeoPolygon",
+            "WithinGeoBoundingBox",
+            "ContainsAny",
+            "ContainsAll"
           ],
           "example": "GreaterThanEqual"
         },
@@ -4245,12 +5047,47 @@ func init() {
           "x-nullable": true,
           "example": false
         },
+        "valueBooleanArray": {
+          "description": "value as an array of booleans, to be used with the ContainsAny and ContainsAll operators",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-omitempty": true,
+          "example": [
+            true,
+            false
+          ]
+        },
         "valueDate": {
           "description": "value as date (as string)",
           "type": "string",
           "x-nullable": true,
           "example": "TODO"
         },
+        "valueDateArray": {
+          "description": "value as an array of dates (as strings), to be used with the ContainsAny and ContainsAll operators",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true,
+          "example": [
+            "2006-01-02T15:04:05Z"
+          ]
+        },
+        "valueGeoBoundingBox": {
+          "description": "value as a bounding box of geo coordinates",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoBoundingBox"
+        },
+        "valueGeoPolygon": {
+          "description": "value as a polygon of geo coordinates",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoPolygon"
+        },
         "valueGeoRange": {
           "description": "value as geo coordinates and distance",
           "type": "object",
@@ -4264,6 +5101,19 @@ func init() {
           "x-nullable": true,
           "example": 2000
         },
+        "valueIntArray": {
+          "description": "value as an array of integers, to be used with the ContainsAny and ContainsAll operators",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-omitempty": true,
+          "example": [
+            100,
+            200
+          ]
+        },
         "valueNumber": {
           "description": "value as number/float",
           "type": "number",
@@ -4271,6 +5121,19 @@ func init() {
           "x-nullable": true,
           "example": 3.14
         },
+        "valueNumberArray": {
+          "description": "value as an array of numbers/floats, to be used with the ContainsAny and ContainsAll operators",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-omitempty": true,
+          "example": [
+            3.14,
+            2.71
+          ]
+        },
         "valueString": {
           "description": "value as text (deprecated as of v1.19; alias for valueText)",
           "type": "string",
@@ -4282,6 +5145,45 @@ func init() {
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
+        },
+        "valueTextArray": {
+          "description": "value as an array of texts, to be used with the ContainsAny and ContainsAll operators",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true,
+          "example": [
+            "red",
+            "blue"
+          ]
+        }
+      }
+    },
+    "WhereFilterGeoBoundingBox": {
+      "description": "filter within a rectangle of geo coordinates",
+      "type": "object",
+      "properties": {
+        "bottomRight": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        },
+        "topLeft": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    },
+    "WhereFilterGeoPolygon": {
+      "description": "filter within a polygon of geo coordinates",
+      "type": "object",
+      "properties": {
+        "coordinates": {
+          "description": "the corners of the polygon in order, the polygon is closed automatically",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GeoCoordinates"
+          }
         }
       }
     },
@@ -4324,6 +5226,12 @@ func init() {
       "name": "consistency_level",
       "in": "query"
     },
+    "CommonIfMatchParameterHeader": {
+      "type": "string",
+      "description": "Only apply the write if the lastUpdateTimeUnix of the stored object equals this value. Use 0 to only write if the object does not exist yet.",
+      "name": "If-Match",
+      "in": "header"
+    },
     "CommonIncludeParameterQuery": {
       "type": "string",
       "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
@@ -4791,6 +5699,10 @@ func init() {
             "schema": {
               "type": "object",
               "properties": {
+                "conditional": {
+                  "description": "If true, each object is only written if its lastUpdateTimeUnix matches the stored object. Objects without a lastUpdateTimeUnix are only created if they do not exist yet.",
+                  "type": "boolean"
+                },
                 "fields": {
                   "description": "Define which fields need to be returned. Default value is ALL",
                   "type": "array",
@@ -4930,28 +5842,22 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/batch/references": {
-      "post": {
-        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+      },
+      "patch": {
+        "description": "Update Objects in bulk that match a certain filter. The given properties are merged into every matching object, vectors are recomputed if needed.",
         "tags": [
           "batch",
-          "references"
+          "objects"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+        "summary": "Updates Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.update",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
+              "$ref": "#/definitions/BatchUpdate"
             }
           },
           {
@@ -4963,12 +5869,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
+              "$ref": "#/definitions/BatchUpdateResponse"
             }
           },
           "400": {
@@ -5002,33 +5905,107 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/classifications/": {
+    "/batch/references": {
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "description": "Register cross-references between any class items (objects or objects) in bulk.",
         "tags": [
-          "classifications"
+          "batch",
+          "references"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
+          "200": {
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
+        "parameters": [
+          {
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
               "$ref": "#/definitions/Classification"
             }
           },
@@ -5107,6 +6084,127 @@ func init() {
         ]
       }
     },
+    "/exports/{backend}": {
+      "post": {
+        "description": "Starts a process of exporting the objects of a class to JSONL or Parquet files",
+        "tags": [
+          "exports"
+        ],
+        "operationId": "exports.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ExportCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Export process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/ExportCreateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid export attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/exports/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of an export, including the progress of every shard",
+        "tags": [
+          "exports"
+        ],
+        "operationId": "exports.create.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of an export. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Export status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/ExportCreateStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Export does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid export status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
     "/graphql": {
       "post": {
         "description": "Get an object based on GraphQL",
@@ -5563,6 +6661,13 @@ func init() {
             "description": "The target node which should fulfill the request",
             "name": "node_name",
             "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Return the version of the object which was current at this time (in ms since epoch). Requires objectVersioningConfig to be enabled for the class",
+            "name": "asOf",
+            "in": "query"
           }
         ],
         "responses": {
@@ -5638,6 +6743,12 @@ func init() {
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
             "name": "consistency_level",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only apply the write if the lastUpdateTimeUnix of the stored object equals this value. Use 0 to only write if the object does not exist yet.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
@@ -5659,6 +6770,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The stored object does not match the If-Match condition.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -5825,7 +6942,7 @@ func init() {
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object. Instead of a value, a property can be set to an operator which is applied to the stored value: $append, $remove or $addToSet with a value or an array of values for array properties, or $inc with a number for int and number properties.",
             "name": "body",
             "in": "body",
             "schema": {
@@ -5837,6 +6954,12 @@ func init() {
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
             "name": "consistency_level",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only apply the write if the lastUpdateTimeUnix of the stored object equals this value. Use 0 to only write if the object does not exist yet.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
@@ -5858,6 +6981,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The stored object does not match the If-Match condition.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
@@ -6141,6 +7270,75 @@ func init() {
         ]
       }
     },
+    "/objects/{className}/{id}/versions": {
+      "get": {
+        "description": "List the stored versions of a data object, newest first.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get the version history of an Object based on its class and UUID.",
+        "operationId": "objects.class.versions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ObjectVersionsResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      }
+    },
     "/objects/{id}": {
       "get": {
         "description": "Lists Objects.",
@@ -6392,7 +7590,7 @@ func init() {
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object. Instead of a value, a property can be set to an operator which is applied to the stored value: $append, $remove or $addToSet with a value or an array of values for array properties, or $inc with a number for int and number properties.",
             "name": "body",
             "in": "body",
             "schema": {
@@ -7309,55 +8507,309 @@ func init() {
     "BackupRestoreStatusResponse": {
       "description": "The definition of a backup restore metadata",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backup backend",
-          "type": "string"
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backup backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BatchDelete": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        }
+      }
+    },
+    "BatchDeleteMatch": {
+      "description": "Outlines how to find the objects to be deleted.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class (name) which objects will be deleted.",
+          "type": "string",
+          "example": "City"
+        },
+        "where": {
+          "description": "Filter to limit the objects to be deleted.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
+              }
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
+    "BatchDeleteResponseMatch": {
+      "description": "Outlines how to find the objects to be deleted.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class (name) which objects will be deleted.",
+          "type": "string",
+          "example": "City"
+        },
+        "where": {
+          "description": "Filter to limit the objects to be deleted.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchDeleteResponseResults": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "description": "How many objects should have been deleted but could not be deleted.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "limit": {
+          "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "matches": {
+          "description": "How many objects were matched by the filter.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objects": {
+          "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
+          }
+        },
+        "successful": {
+          "description": "How many objects were successfully deleted in this round.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "BatchDeleteResponseResultsObjectsItems0": {
+      "description": "Results for this specific Object.",
+      "format": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "type": "string",
+          "default": "SUCCESS",
+          "enum": [
+            "SUCCESS",
+            "DRYRUN",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BatchReference": {
+      "properties": {
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        },
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "BatchReferenceResponseAO1Result": {
+      "description": "Results for this specific reference.",
+      "format": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
         },
         "status": {
-          "description": "phase of backup restoration process",
           "type": "string",
-          "default": "STARTED",
+          "default": "SUCCESS",
           "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
             "SUCCESS",
+            "PENDING",
             "FAILED"
           ]
         }
       }
     },
-    "BatchDelete": {
+    "BatchUpdate": {
       "type": "object",
       "properties": {
         "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "description": "If true, objects will not be updated yet, but merely listed. Defaults to false.",
           "type": "boolean",
           "default": false
         },
         "match": {
-          "description": "Outlines how to find the objects to be deleted.",
+          "description": "Outlines how to find the objects to be updated.",
           "type": "object",
           "properties": {
             "class": {
-              "description": "Class (name) which objects will be deleted.",
+              "description": "Class (name) which objects will be updated.",
               "type": "string",
               "example": "City"
             },
             "where": {
-              "description": "Filter to limit the objects to be deleted.",
+              "description": "Filter to limit the objects to be updated.",
               "type": "object",
               "$ref": "#/definitions/WhereFilter"
             }
@@ -7367,45 +8819,49 @@ func init() {
           "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
           "type": "string",
           "default": "minimal"
+        },
+        "properties": {
+          "description": "The properties to set on every matching object. Properties set to null are removed from the objects.",
+          "$ref": "#/definitions/PropertySchema"
         }
       }
     },
-    "BatchDeleteMatch": {
-      "description": "Outlines how to find the objects to be deleted.",
+    "BatchUpdateMatch": {
+      "description": "Outlines how to find the objects to be updated.",
       "type": "object",
       "properties": {
         "class": {
-          "description": "Class (name) which objects will be deleted.",
+          "description": "Class (name) which objects will be updated.",
           "type": "string",
           "example": "City"
         },
         "where": {
-          "description": "Filter to limit the objects to be deleted.",
+          "description": "Filter to limit the objects to be updated.",
           "type": "object",
           "$ref": "#/definitions/WhereFilter"
         }
       }
     },
-    "BatchDeleteResponse": {
-      "description": "Delete Objects response.",
+    "BatchUpdateResponse": {
+      "description": "Update Objects response.",
       "type": "object",
       "properties": {
         "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "description": "If true, objects will not be updated yet, but merely listed. Defaults to false.",
           "type": "boolean",
           "default": false
         },
         "match": {
-          "description": "Outlines how to find the objects to be deleted.",
+          "description": "Outlines how to find the objects to be updated.",
           "type": "object",
           "properties": {
             "class": {
-              "description": "Class (name) which objects will be deleted.",
+              "description": "Class (name) which objects will be updated.",
               "type": "string",
               "example": "City"
             },
             "where": {
-              "description": "Filter to limit the objects to be deleted.",
+              "description": "Filter to limit the objects to be updated.",
               "type": "object",
               "$ref": "#/definitions/WhereFilter"
             }
@@ -7416,17 +8872,15 @@ func init() {
           "type": "string",
           "default": "minimal"
         },
+        "properties": {
+          "description": "The properties to set on every matching object. Properties set to null are removed from the objects.",
+          "$ref": "#/definitions/PropertySchema"
+        },
         "results": {
           "type": "object",
           "properties": {
             "failed": {
-              "description": "How many objects should have been deleted but could not be deleted.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "limit": {
-              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "description": "How many objects should have been updated but could not be updated.",
               "type": "number",
               "format": "int64",
               "x-omitempty": false
@@ -7438,14 +8892,14 @@ func init() {
               "x-omitempty": false
             },
             "objects": {
-              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully updated objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
               "type": "array",
               "items": {
-                "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
+                "$ref": "#/definitions/BatchUpdateResponseResultsObjectsItems0"
               }
             },
             "successful": {
-              "description": "How many objects were successfully deleted in this round.",
+              "description": "How many objects were successfully updated in this round.",
               "type": "number",
               "format": "int64",
               "x-omitempty": false
@@ -7454,33 +8908,27 @@ func init() {
         }
       }
     },
-    "BatchDeleteResponseMatch": {
-      "description": "Outlines how to find the objects to be deleted.",
+    "BatchUpdateResponseMatch": {
+      "description": "Outlines how to find the objects to be updated.",
       "type": "object",
       "properties": {
         "class": {
-          "description": "Class (name) which objects will be deleted.",
+          "description": "Class (name) which objects will be updated.",
           "type": "string",
           "example": "City"
         },
         "where": {
-          "description": "Filter to limit the objects to be deleted.",
+          "description": "Filter to limit the objects to be updated.",
           "type": "object",
           "$ref": "#/definitions/WhereFilter"
         }
       }
     },
-    "BatchDeleteResponseResults": {
+    "BatchUpdateResponseResults": {
       "type": "object",
       "properties": {
         "failed": {
-          "description": "How many objects should have been deleted but could not be deleted.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        },
-        "limit": {
-          "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+          "description": "How many objects should have been updated but could not be updated.",
           "type": "number",
           "format": "int64",
           "x-omitempty": false
@@ -7492,21 +8940,21 @@ func init() {
           "x-omitempty": false
         },
         "objects": {
-          "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+          "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully updated objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
+            "$ref": "#/definitions/BatchUpdateResponseResultsObjectsItems0"
           }
         },
         "successful": {
-          "description": "How many objects were successfully deleted in this round.",
+          "description": "How many objects were successfully updated in this round.",
           "type": "number",
           "format": "int64",
           "x-omitempty": false
         }
       }
     },
-    "BatchDeleteResponseResultsObjectsItems0": {
+    "BatchUpdateResponseResultsObjectsItems0": {
       "description": "Results for this specific Object.",
       "format": "object",
       "properties": {
@@ -7529,70 +8977,6 @@ func init() {
         }
       }
     },
-    "BatchReference": {
-      "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
-        },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
-        }
-      }
-    },
-    "BatchReferenceResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
-          }
-        }
-      ]
-    },
-    "BatchReferenceResponseAO1Result": {
-      "description": "Results for this specific reference.",
-      "format": "object",
-      "properties": {
-        "errors": {
-          "$ref": "#/definitions/ErrorResponse"
-        },
-        "status": {
-          "type": "string",
-          "default": "SUCCESS",
-          "enum": [
-            "SUCCESS",
-            "PENDING",
-            "FAILED"
-          ]
-        }
-      }
-    },
     "C11yExtension": {
       "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
       "properties": {
@@ -7794,6 +9178,12 @@ func init() {
         "multiTenancyConfig": {
           "$ref": "#/definitions/MultiTenancyConfig"
         },
+        "objectTtlConfig": {
+          "$ref": "#/definitions/ObjectTtlConfig"
+        },
+        "objectVersioningConfig": {
+          "$ref": "#/definitions/ObjectVersioningConfig"
+        },
         "properties": {
           "description": "The properties of the class.",
           "type": "array",
@@ -8002,38 +9392,215 @@ func init() {
           "format": "date-time",
           "x-nullable": true
         },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
+        },
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+          "type": "string"
+        }
+      }
+    },
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ErrorResponseErrorItems0"
+          }
+        }
+      }
+    },
+    "ErrorResponseErrorItems0": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "ExportCreateRequest": {
+      "description": "Request body for exporting the objects of a class",
+      "properties": {
+        "class": {
+          "description": "The class whose objects are exported",
+          "type": "string"
+        },
+        "format": {
+          "description": "Format of the exported files",
+          "type": "string",
+          "default": "jsonl",
+          "enum": [
+            "jsonl",
+            "parquet"
+          ]
+        },
+        "id": {
+          "description": "The ID of the export. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "includeVector": {
+          "description": "If true, the vector of every object is exported as well. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "where": {
+          "description": "Filter to limit the objects to be exported. The filter is evaluated once per shard when its export starts, the export is not a point-in-time snapshot: objects which are updated while the shard is exported may be left out.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "ExportCreateResponse": {
+      "description": "The definition of an export create response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The class whose objects are exported",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if the export failed",
+          "type": "string"
+        },
+        "format": {
+          "description": "Format of the exported files",
+          "type": "string",
+          "default": "jsonl",
+          "enum": [
+            "jsonl",
+            "parquet"
+          ]
+        },
+        "id": {
+          "description": "The ID of the export. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of export files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of export process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "ExportCreateStatusResponse": {
+      "description": "The definition of an export status response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The class whose objects are exported",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "Time at which the export ended",
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "description": "error message if the export failed",
+          "type": "string"
+        },
+        "format": {
+          "description": "Format of the exported files",
+          "type": "string",
+          "default": "jsonl",
+          "enum": [
+            "jsonl",
+            "parquet"
+          ]
+        },
+        "id": {
+          "description": "The ID of the export. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "objects": {
+          "description": "The number of objects exported so far",
+          "type": "integer",
+          "format": "int64"
+        },
+        "path": {
+          "description": "destination path of export files proper to selected backend",
+          "type": "string"
+        },
+        "shards": {
+          "description": "The progress of every shard of the class",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExportShardStatus"
+          }
+        },
+        "startedAt": {
+          "description": "Time at which the export was started",
           "type": "string",
           "format": "date-time"
         },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string"
-        },
         "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
-          "type": "string"
+          "description": "phase of export process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "SUCCESS",
+            "FAILED"
+          ]
         }
       }
     },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
-      "type": "object",
+    "ExportShardStatus": {
+      "description": "The export status of a single shard, which is written to its own file",
       "properties": {
         "error": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ErrorResponseErrorItems0"
-          }
-        }
-      }
-    },
-    "ErrorResponseErrorItems0": {
-      "type": "object",
-      "properties": {
-        "message": {
+          "description": "error message if the export failed",
           "type": "string"
+        },
+        "file": {
+          "description": "The name of the file the objects of the shard are written to",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the shard",
+          "type": "string"
+        },
+        "objects": {
+          "description": "The number of objects of the shard exported so far",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "phase of the export of the shard",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "SUCCESS",
+            "FAILED"
+          ]
         }
       }
     },
@@ -8142,6 +9709,14 @@ func init() {
       "description": "Configure the inverted index built into Weaviate",
       "type": "object",
       "properties": {
+        "analyzers": {
+          "description": "Named text analyzers, which can be referenced by the text properties of the class through their ` + "`" + `analyzer` + "`" + ` setting",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TextAnalyzerConfig"
+          },
+          "x-omitempty": true
+        },
         "bm25": {
           "$ref": "#/definitions/BM25Config"
         },
@@ -8154,6 +9729,10 @@ func init() {
           "description": "Index each object with the null state",
           "type": "boolean"
         },
+        "indexPositions": {
+          "description": "Index the positions of terms in searchable properties, which enables phrase and proximity queries in BM25 (e.g. '\"new york\"~2')",
+          "type": "boolean"
+        },
         "indexPropertyLength": {
           "description": "Index length of properties",
           "type": "boolean"
@@ -8164,6 +9743,9 @@ func init() {
         },
         "stopwords": {
           "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "$ref": "#/definitions/SynonymConfig"
         }
       }
     },
@@ -8231,6 +9813,56 @@ func init() {
         "$ref": "#/definitions/SingleRef"
       }
     },
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "description": "Data type of the nested property. Can be any primitive data type, \"object\" or \"object[]\", but not a reference to another class.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the nested property.",
+          "type": "string"
+        },
+        "indexFilterable": {
+          "description": "Optional. Should this nested property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use its path in where filters",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this nested property be indexed in the inverted index. Defaults to true. Applicable only to nested properties of data type text and text[]. If you choose false, you will not be able to use its path in bm25 or hybrid search",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the nested property. Must not start with an uppercase letter, as such a segment of a filter path is read as the class of a reference.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The nested properties of a nested property of data type \"object\" or \"object[]\".",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the nested property. Optional. Applies to text and text[] data types. Allowed values are the same as for the tokenization of properties",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "trigram",
+            "cjk"
+          ]
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -8357,6 +9989,75 @@ func init() {
         }
       }
     },
+    "ObjectTtlConfig": {
+      "description": "Configure the automatic deletion of expired objects of a class",
+      "type": "object",
+      "properties": {
+        "defaultTtl": {
+          "description": "Time to live of an object in seconds, counted from the time set in deleteOn. Can be 0 if deleteOn is a date property which marks the expiry itself",
+          "type": "integer",
+          "format": "int64"
+        },
+        "deleteOn": {
+          "description": "The time an object's time to live is counted from. Either '_creationTimeUnix' (default) or the name of a property of data type date",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Whether or not expired objects are deleted automatically",
+          "type": "boolean"
+        }
+      }
+    },
+    "ObjectVersion": {
+      "description": "A stored version of an object",
+      "type": "object",
+      "properties": {
+        "deleted": {
+          "description": "Marks the deletion of the object. Deleted versions carry no object",
+          "type": "boolean"
+        },
+        "object": {
+          "$ref": "#/definitions/Object"
+        },
+        "version": {
+          "description": "The time the version was written (in ms since epoch). For objects this equals their lastUpdateTimeUnix",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ObjectVersioningConfig": {
+      "description": "Configure how many previous versions of the objects of a class are kept",
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not previous versions of objects are kept",
+          "type": "boolean"
+        },
+        "maxAgeHours": {
+          "description": "The number of hours a version is kept after it was replaced by a newer one. 0 means no limit",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxVersions": {
+          "description": "The maximum number of versions kept per object, including the current one. 0 means no limit",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ObjectVersionsResponse": {
+      "description": "The stored versions of an object, newest first",
+      "type": "object",
+      "properties": {
+        "versions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ObjectVersion"
+          }
+        }
+      }
+    },
     "ObjectsGetResponse": {
       "type": "object",
       "allOf": [
@@ -8593,6 +10294,10 @@ func init() {
     "Property": {
       "type": "object",
       "properties": {
+        "analyzer": {
+          "description": "Name of a text analyzer defined in the invertedIndexConfig of the class. Optional. Applies to text and text[] data types. If set, the analyzer is used instead of the tokenization of the property, both at import and at query time",
+          "type": "string"
+        },
         "dataType": {
           "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
           "type": "array",
@@ -8627,14 +10332,28 @@ func init() {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "nestedProperties": {
+          "description": "The nested properties of a property of data type \"object\" or \"object[]\". Required for these data types, not allowed for any other.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "stopwordsPreset": {
+          "description": "Name of a stopword preset which replaces the preset of the invertedIndexConfig for this property, e.g. for a property in a different language than the rest of the class. Optional. Applies to text and text[] data types. The additions and removals of the class still apply",
+          "type": "string"
+        },
         "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims), ` + "`" + `trigram` + "`" + ` (splits into lowercased trigrams of each word, speeds up infix ` + "`" + `Like` + "`" + ` filters), ` + "`" + `cjk` + "`" + ` (splits chinese, japanese and korean text into overlapping bigrams and all other text into lowercased words). Not supported for remaining data types",
           "type": "string",
           "enum": [
             "word",
             "lowercase",
             "whitespace",
-            "field"
+            "field",
+            "trigram",
+            "cjk"
           ]
         }
       }
@@ -8806,7 +10525,7 @@ func init() {
           }
         },
         "preset": {
-          "description": "pre-existing list of common words by language",
+          "description": "pre-existing list of common words by language, one of 'en' (default), 'de', 'fr', 'es', 'nl', 'it', 'pt' or 'none'",
           "type": "string"
         },
         "removals": {
@@ -8818,6 +10537,49 @@ func init() {
         }
       }
     },
+    "SynonymConfig": {
+      "description": "Synonyms which expand the terms of BM25 queries and of text equality filters at query time, they can be updated without reindexing",
+      "type": "object",
+      "properties": {
+        "equivalents": {
+          "description": "Sets of interchangeable terms, e.g. [\"car\", \"automobile\"]. A query term from a set also matches all other terms of the set",
+          "type": "array",
+          "items": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
+        },
+        "mappings": {
+          "description": "One-way mappings, which expand query terms to synonyms without expanding the synonyms back",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SynonymMapping"
+          }
+        }
+      }
+    },
+    "SynonymMapping": {
+      "description": "A one-way synonym mapping, e.g. from [\"ipod\"] to [\"mp3 player\"]",
+      "type": "object",
+      "properties": {
+        "synonyms": {
+          "description": "Synonyms the terms are expanded to, a synonym may consist of multiple words",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "terms": {
+          "description": "Query terms which are expanded",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "Tenant": {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
@@ -8828,6 +10590,27 @@ func init() {
         }
       }
     },
+    "TextAnalyzerConfig": {
+      "description": "A named chain of a tokenization and token filters which are applied to the text of a property at import and at query time",
+      "type": "object",
+      "properties": {
+        "filters": {
+          "description": "Token filters applied in order to the terms produced by the tokenization. Allowed values are ` + "`" + `lowercase` + "`" + `, ` + "`" + `asciifolding` + "`" + ` (removes diacritics, e.g. ` + "`" + `café` + "`" + ` becomes ` + "`" + `cafe` + "`" + `), ` + "`" + `nfkc` + "`" + ` (unicode normalization), ` + "`" + `elision` + "`" + ` (removes elided articles, e.g. ` + "`" + `l'avion` + "`" + ` becomes ` + "`" + `avion` + "`" + `) and the snowball stemmers ` + "`" + `snowball_en` + "`" + `, ` + "`" + `snowball_de` + "`" + `, ` + "`" + `snowball_fr` + "`" + `, ` + "`" + `snowball_es` + "`" + ` and ` + "`" + `snowball_nl` + "`" + `",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the analyzer, used to reference it from the analyzer setting of a property",
+          "type": "string"
+        },
+        "tokenization": {
+          "description": "Tokenization used to split the text into terms. Allowed values are the same as for the tokenization of a property. Defaults to ` + "`" + `word` + "`" + `",
+          "type": "string"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
@@ -8858,7 +10641,11 @@ func init() {
             "LessThan",
             "LessThanEqual",
             "WithinGeoRange",
-            "IsNull"
+            "IsNull",
+            "WithinGeoPolygon",
+            "WithinGeoBoundingBox",
+            "ContainsAny",
+            "ContainsAll"
           ],
           "example": "GreaterThanEqual"
         },
@@ -8880,12 +10667,47 @@ func init() {
           "x-nullable": true,
           "example": false
         },
+        "valueBooleanArray": {
+          "description": "value as an array of booleans, to be used with the ContainsAny and ContainsAll operators",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-omitempty": true,
+          "example": [
+            true,
+            false
+          ]
+        },
         "valueDate": {
           "description": "value as date (as string)",
           "type": "string",
           "x-nullable": true,
           "example": "TODO"
         },
+        "valueDateArray": {
+          "description": "value as an array of dates (as strings), to be used with the ContainsAny and ContainsAll operators",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true,
+          "example": [
+            "2006-01-02T15:04:05Z"
+          ]
+        },
+        "valueGeoBoundingBox": {
+          "description": "value as a bounding box of geo coordinates",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoBoundingBox"
+        },
+        "valueGeoPolygon": {
+          "description": "value as a polygon of geo coordinates",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoPolygon"
+        },
         "valueGeoRange": {
           "description": "value as geo coordinates and distance",
           "type": "object",
@@ -8899,6 +10721,19 @@ func init() {
           "x-nullable": true,
           "example": 2000
         },
+        "valueIntArray": {
+          "description": "value as an array of integers, to be used with the ContainsAny and ContainsAll operators",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-omitempty": true,
+          "example": [
+            100,
+            200
+          ]
+        },
         "valueNumber": {
           "description": "value as number/float",
           "type": "number",
@@ -8906,6 +10741,19 @@ func init() {
           "x-nullable": true,
           "example": 3.14
         },
+        "valueNumberArray": {
+          "description": "value as an array of numbers/floats, to be used with the ContainsAny and ContainsAll operators",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-omitempty": true,
+          "example": [
+            3.14,
+            2.71
+          ]
+        },
         "valueString": {
           "description": "value as text (deprecated as of v1.19; alias for valueText)",
           "type": "string",
@@ -8917,6 +10765,45 @@ func init() {
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
+        },
+        "valueTextArray": {
+          "description": "value as an array of texts, to be used with the ContainsAny and ContainsAll operators",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true,
+          "example": [
+            "red",
+            "blue"
+          ]
+        }
+      }
+    },
+    "WhereFilterGeoBoundingBox": {
+      "description": "filter within a rectangle of geo coordinates",
+      "type": "object",
+      "properties": {
+        "bottomRight": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        },
+        "topLeft": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    },
+    "WhereFilterGeoPolygon": {
+      "description": "filter within a polygon of geo coordinates",
+      "type": "object",
+      "properties": {
+        "coordinates": {
+          "description": "the corners of the polygon in order, the polygon is closed automatically",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GeoCoordinates"
+          }
         }
       }
     },
@@ -8968,6 +10855,12 @@ func init() {
       "name": "consistency_level",
       "in": "query"
     },
+    "CommonIfMatchParameterHeader": {
+      "type": "string",
+      "description": "Only apply the write if the lastUpdateTimeUnix of the stored object equals this value. Use 0 to only write if the object does not exist yet.",
+      "name": "If-Match",
+      "in": "header"
+    },
     "CommonIncludeParameterQuery": {
       "type": "string",
       "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
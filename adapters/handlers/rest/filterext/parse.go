@@ -143,6 +143,14 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorNot, nil
 	case models.WhereFilterOperatorIsNull:
 		return filters.OperatorIsNull, nil
+	case models.WhereFilterOperatorWithinGeoPolygon:
+		return filters.OperatorWithinGeoPolygon, nil
+	case models.WhereFilterOperatorWithinGeoBoundingBox:
+		return filters.OperatorWithinGeoBoundingBox, nil
+	case models.WhereFilterOperatorContainsAny:
+		return filters.OperatorContainsAny, nil
+	case models.WhereFilterOperatorContainsAll:
+		return filters.OperatorContainsAll, nil
 	default:
 		return -1, fmt.Errorf("unrecognized operator: %s", in)
 	}
@@ -168,5 +176,12 @@ func allValuesNil(in *models.WhereFilter) bool {
 		in.ValueText == nil &&
 		in.ValueInt == nil &&
 		in.ValueNumber == nil &&
-		in.ValueGeoRange == nil
+		in.ValueGeoRange == nil &&
+		in.ValueGeoPolygon == nil &&
+		in.ValueGeoBoundingBox == nil &&
+		in.ValueTextArray == nil &&
+		in.ValueIntArray == nil &&
+		in.ValueNumberArray == nil &&
+		in.ValueBooleanArray == nil &&
+		in.ValueDateArray == nil
 }
@@ -156,6 +156,153 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				}},
 			},
+			{
+				name: "valid geo polygon filter",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoPolygon",
+					ValueGeoPolygon: &models.WhereFilterGeoPolygon{
+						Coordinates: []*models.GeoCoordinates{
+							geoCoordinates(0, 0), geoCoordinates(1, 0), geoCoordinates(0, 1),
+						},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorWithinGeoPolygon,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("geoField"),
+					},
+					Value: &filters.Value{
+						Value: filters.GeoPolygon{
+							Coordinates: []*models.GeoCoordinates{
+								geoCoordinates(0, 0), geoCoordinates(1, 0), geoCoordinates(0, 1),
+							},
+						},
+						Type: schema.DataTypeGeoCoordinates,
+					},
+				}},
+			},
+			{
+				name: "valid geo bounding box filter",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoBoundingBox",
+					ValueGeoBoundingBox: &models.WhereFilterGeoBoundingBox{
+						TopLeft:     geoCoordinates(1, 0),
+						BottomRight: geoCoordinates(0, 1),
+					},
+					Path: []string{"geoField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorWithinGeoBoundingBox,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("geoField"),
+					},
+					Value: &filters.Value{
+						Value: filters.GeoBoundingBox{
+							TopLeft:     geoCoordinates(1, 0),
+							BottomRight: geoCoordinates(0, 1),
+						},
+						Type: schema.DataTypeGeoCoordinates,
+					},
+				}},
+			},
+			{
+				name: "valid text array filter",
+				input: &models.WhereFilter{
+					Operator:       "ContainsAny",
+					ValueTextArray: []string{"foo", "bar"},
+					Path:           []string{"textArrayField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("textArrayField"),
+					},
+					Value: &filters.Value{
+						Value: []string{"foo", "bar"},
+						Type:  schema.DataTypeTextArray,
+					},
+				}},
+			},
+			{
+				name: "valid int array filter",
+				input: &models.WhereFilter{
+					Operator:      "ContainsAll",
+					ValueIntArray: []int64{1, 2},
+					Path:          []string{"intArrayField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAll,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("intArrayField"),
+					},
+					Value: &filters.Value{
+						Value: []int{1, 2},
+						Type:  schema.DataTypeIntArray,
+					},
+				}},
+			},
+			{
+				name: "valid number array filter",
+				input: &models.WhereFilter{
+					Operator:         "ContainsAny",
+					ValueNumberArray: []float64{1.5},
+					Path:             []string{"numberArrayField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("numberArrayField"),
+					},
+					Value: &filters.Value{
+						Value: []float64{1.5},
+						Type:  schema.DataTypeNumberArray,
+					},
+				}},
+			},
+			{
+				name: "valid boolean array filter",
+				input: &models.WhereFilter{
+					Operator:          "ContainsAny",
+					ValueBooleanArray: []bool{true},
+					Path:              []string{"booleanArrayField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("booleanArrayField"),
+					},
+					Value: &filters.Value{
+						Value: []bool{true},
+						Type:  schema.DataTypeBooleanArray,
+					},
+				}},
+			},
+			{
+				name: "valid date array filter",
+				input: &models.WhereFilter{
+					Operator:       "ContainsAll",
+					ValueDateArray: []string{"2006-01-02T15:04:05Z"},
+					Path:           []string{"dateArrayField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAll,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("dateArrayField"),
+					},
+					Value: &filters.Value{
+						Value: []string{"2006-01-02T15:04:05Z"},
+						Type:  schema.DataTypeDateArray,
+					},
+				}},
+			},
 			{
 				name: "[deprected string] valid string filter",
 				input: &models.WhereFilter{
@@ -235,6 +382,53 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				expectedErr: fmt.Errorf("invalid where filter: valueGeoRange: " +
 					"field 'distance.max' must be a positive number"),
 			},
+			{
+				name: "geo polygon with too few coordinates",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoPolygon",
+					ValueGeoPolygon: &models.WhereFilterGeoPolygon{
+						Coordinates: []*models.GeoCoordinates{
+							geoCoordinates(0, 0), geoCoordinates(1, 0),
+						},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueGeoPolygon: " +
+					"a polygon needs at least 3 coordinates, got 2"),
+			},
+			{
+				name: "geo bounding box missing corner",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoBoundingBox",
+					ValueGeoBoundingBox: &models.WhereFilterGeoBoundingBox{
+						TopLeft: geoCoordinates(1, 0),
+					},
+					Path: []string{"geoField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueGeoBoundingBox: " +
+					"bottomRight: latitude and longitude must be set"),
+			},
+			{
+				name: "empty text array",
+				input: &models.WhereFilter{
+					Operator:       "ContainsAny",
+					ValueTextArray: []string{},
+					Path:           []string{"textArrayField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueTextArray: " +
+					"at least one value must be set"),
+			},
+			{
+				name: "and operator and array value set",
+				input: &models.WhereFilter{
+					Operator:      "And",
+					ValueIntArray: []int64{1},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: " +
+					"operator 'And' not compatible with field 'value<Type>', " +
+					"remove value field or switch to compare operator " +
+					"(eg. Equal, NotEqual, etc.)"),
+			},
 			{
 				name: "and operator and path set",
 				input: &models.WhereFilter{
@@ -515,6 +709,10 @@ func inputGeoRangeFilter(lat, lon, max float64) *models.WhereFilterGeoRange {
 	}
 }
 
+func geoCoordinates(lat, lon float32) *models.GeoCoordinates {
+	return &models.GeoCoordinates{Latitude: &lat, Longitude: &lon}
+}
+
 func ptFloat32(in float32) *float32 {
 	return &in
 }
@@ -116,6 +116,107 @@ var valueExtractors = []valueExtractorFunc{
 			},
 		}, schema.DataTypeGeoCoordinates), nil
 	},
+	// geo polygon
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueGeoPolygon == nil {
+			return nil, nil
+		}
+
+		polygon := filters.GeoPolygon{
+			Coordinates: make([]*models.GeoCoordinates, len(in.ValueGeoPolygon.Coordinates)),
+		}
+		for i, c := range in.ValueGeoPolygon.Coordinates {
+			polygon.Coordinates[i] = copyGeoCoordinates(c)
+		}
+
+		if err := polygon.Validate(); err != nil {
+			return nil, fmt.Errorf("valueGeoPolygon: %v", err)
+		}
+
+		return valueFilter(polygon, schema.DataTypeGeoCoordinates), nil
+	},
+	// geo bounding box
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueGeoBoundingBox == nil {
+			return nil, nil
+		}
+
+		box := filters.GeoBoundingBox{
+			TopLeft:     copyGeoCoordinates(in.ValueGeoBoundingBox.TopLeft),
+			BottomRight: copyGeoCoordinates(in.ValueGeoBoundingBox.BottomRight),
+		}
+
+		if err := box.Validate(); err != nil {
+			return nil, fmt.Errorf("valueGeoBoundingBox: %v", err)
+		}
+
+		return valueFilter(box, schema.DataTypeGeoCoordinates), nil
+	},
+	// text array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueTextArray == nil {
+			return nil, nil
+		}
+
+		if len(in.ValueTextArray) == 0 {
+			return nil, fmt.Errorf("valueTextArray: at least one value must be set")
+		}
+
+		return valueFilter(in.ValueTextArray, schema.DataTypeTextArray), nil
+	},
+	// int array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueIntArray == nil {
+			return nil, nil
+		}
+
+		if len(in.ValueIntArray) == 0 {
+			return nil, fmt.Errorf("valueIntArray: at least one value must be set")
+		}
+
+		values := make([]int, len(in.ValueIntArray))
+		for i, v := range in.ValueIntArray {
+			values[i] = int(v)
+		}
+
+		return valueFilter(values, schema.DataTypeIntArray), nil
+	},
+	// number array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueNumberArray == nil {
+			return nil, nil
+		}
+
+		if len(in.ValueNumberArray) == 0 {
+			return nil, fmt.Errorf("valueNumberArray: at least one value must be set")
+		}
+
+		return valueFilter(in.ValueNumberArray, schema.DataTypeNumberArray), nil
+	},
+	// boolean array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueBooleanArray == nil {
+			return nil, nil
+		}
+
+		if len(in.ValueBooleanArray) == 0 {
+			return nil, fmt.Errorf("valueBooleanArray: at least one value must be set")
+		}
+
+		return valueFilter(in.ValueBooleanArray, schema.DataTypeBooleanArray), nil
+	},
+	// date array (as strings)
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueDateArray == nil {
+			return nil, nil
+		}
+
+		if len(in.ValueDateArray) == 0 {
+			return nil, fmt.Errorf("valueDateArray: at least one value must be set")
+		}
+
+		return valueFilter(in.ValueDateArray, schema.DataTypeDateArray), nil
+	},
 	// deprecated string
 	func(in *models.WhereFilter) (*filters.Value, error) {
 		if in.ValueString == nil {
@@ -133,6 +234,17 @@ func valueFilter(value interface{}, dt schema.DataType) *filters.Value {
 	}
 }
 
+func copyGeoCoordinates(in *models.GeoCoordinates) *models.GeoCoordinates {
+	if in == nil {
+		return nil
+	}
+
+	return &models.GeoCoordinates{
+		Latitude:  in.Latitude,
+		Longitude: in.Longitude,
+	}
+}
+
 // Small utility function used in printing error messages.
 func jsonify(stuff interface{}) string {
 	j, _ := json.Marshal(stuff)
@@ -35,7 +35,7 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 	}
 
 	objs, err := h.manager.AddObjects(params.HTTPRequest.Context(),
-		principal, params.Body.Objects, params.Body.Fields, repl)
+		principal, params.Body.Objects, params.Body.Fields, params.Body.Conditional, repl)
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -208,6 +208,88 @@ func (h *batchObjectHandlers) objectsDeleteResponse(input *objects.BatchDeleteRe
 	return response
 }
 
+func (h *batchObjectHandlers) updateObjects(params batch.BatchObjectsUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		return batch.NewBatchObjectsUpdateBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	res, err := h.manager.UpdateObjects(params.HTTPRequest.Context(),
+		principal, params.Body.Match, params.Body.Properties, params.Body.DryRun,
+		params.Body.Output, repl)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batch.NewBatchObjectsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrInvalidUserInput:
+			return batch.NewBatchObjectsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batch.NewBatchObjectsUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return batch.NewBatchObjectsUpdateOK().
+		WithPayload(h.objectsUpdateResponse(params.Body.Properties, res))
+}
+
+func (h *batchObjectHandlers) objectsUpdateResponse(properties models.PropertySchema,
+	input *objects.BatchUpdateResponse,
+) *models.BatchUpdateResponse {
+	var successful, failed int64
+	output := input.Output
+	var objects []*models.BatchUpdateResponseResultsObjectsItems0
+	for _, obj := range input.Result.Objects {
+		var errorResponse *models.ErrorResponse
+
+		status := models.BatchUpdateResponseResultsObjectsItems0StatusSUCCESS
+		if input.DryRun {
+			status = models.BatchUpdateResponseResultsObjectsItems0StatusDRYRUN
+		} else if obj.Err != nil {
+			status = models.BatchUpdateResponseResultsObjectsItems0StatusFAILED
+			errorResponse = errPayloadFromSingleErr(obj.Err)
+			failed += 1
+		} else {
+			successful += 1
+		}
+
+		if output == "minimal" &&
+			(status == models.BatchUpdateResponseResultsObjectsItems0StatusSUCCESS ||
+				status == models.BatchUpdateResponseResultsObjectsItems0StatusDRYRUN) {
+			// only add SUCCESS and DRYRUN results if output is "verbose"
+			continue
+		}
+
+		objects = append(objects, &models.BatchUpdateResponseResultsObjectsItems0{
+			ID:     obj.UUID,
+			Status: &status,
+			Errors: errorResponse,
+		})
+	}
+
+	response := &models.BatchUpdateResponse{
+		Match: &models.BatchUpdateResponseMatch{
+			Class: input.Match.Class,
+			Where: input.Match.Where,
+		},
+		DryRun:     &input.DryRun,
+		Output:     &output,
+		Properties: properties,
+		Results: &models.BatchUpdateResponseResults{
+			Matches:    input.Result.Matches,
+			Successful: successful,
+			Failed:     failed,
+			Objects:    objects,
+		},
+	}
+	return response
+}
+
 func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.BatchManager) {
 	h := &batchObjectHandlers{manager}
 
@@ -217,4 +299,6 @@ func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.Batc
 		BatchReferencesCreateHandlerFunc(h.addReferences)
 	api.BatchBatchObjectsDeleteHandler = batch.
 		BatchObjectsDeleteHandlerFunc(h.deleteObjects)
+	api.BatchBatchObjectsUpdateHandler = batch.
+		BatchObjectsUpdateHandlerFunc(h.updateObjects)
 }
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/exports"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	uexport "github.com/weaviate/weaviate/usecases/export"
+)
+
+type exportHandlers struct {
+	manager *uexport.Manager
+}
+
+func (s *exportHandlers) createExport(params exports.ExportsCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	req := uexport.Request{
+		ID:      params.Body.ID,
+		Backend: params.Backend,
+		Class:   params.Body.Class,
+		Where:   params.Body.Where,
+	}
+	if params.Body.Format != nil {
+		req.Format = *params.Body.Format
+	}
+	if params.Body.IncludeVector != nil {
+		req.IncludeVector = *params.Body.IncludeVector
+	}
+
+	desc, err := s.manager.Export(params.HTTPRequest.Context(), principal, &req)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return exports.NewExportsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return exports.NewExportsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return exports.NewExportsCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	status := string(desc.Status)
+	format := string(desc.Format)
+	return exports.NewExportsCreateOK().WithPayload(&models.ExportCreateResponse{
+		ID:      desc.ID,
+		Backend: desc.Backend,
+		Class:   desc.Class,
+		Format:  &format,
+		Path:    desc.Path,
+		Status:  &status,
+	})
+}
+
+func (s *exportHandlers) createExportStatus(params exports.ExportsCreateStatusParams,
+	principal *models.Principal,
+) middleware.Responder {
+	desc, err := s.manager.Status(params.HTTPRequest.Context(), principal, params.Backend, params.ID)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return exports.NewExportsCreateStatusForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return exports.NewExportsCreateStatusUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return exports.NewExportsCreateStatusNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return exports.NewExportsCreateStatusInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return exports.NewExportsCreateStatusOK().WithPayload(exportStatusPayload(desc))
+}
+
+func exportStatusPayload(desc *uexport.Descriptor) *models.ExportCreateStatusResponse {
+	status := string(desc.Status)
+	format := string(desc.Format)
+	payload := &models.ExportCreateStatusResponse{
+		ID:        desc.ID,
+		Backend:   desc.Backend,
+		Class:     desc.Class,
+		Format:    &format,
+		Path:      desc.Path,
+		Status:    &status,
+		Error:     desc.Error,
+		Objects:   desc.Objects(),
+		StartedAt: strfmt.DateTime(desc.StartedAt),
+		Shards:    make([]*models.ExportShardStatus, len(desc.Shards)),
+	}
+	if !desc.CompletedAt.IsZero() {
+		payload.CompletedAt = strfmt.DateTime(desc.CompletedAt)
+	}
+	for i, shard := range desc.Shards {
+		shardStatus := string(shard.Status)
+		payload.Shards[i] = &models.ExportShardStatus{
+			Name:    shard.Name,
+			File:    shard.File,
+			Status:  &shardStatus,
+			Objects: shard.Objects,
+			Error:   shard.Error,
+		}
+	}
+	return payload
+}
+
+func setupExportHandlers(api *operations.WeaviateAPI,
+	manager *uexport.Manager,
+) {
+	h := &exportHandlers{manager}
+	api.ExportsExportsCreateHandler = exports.
+		ExportsCreateHandlerFunc(h.createExport)
+	api.ExportsExportsCreateStatusHandler = exports.
+		ExportsCreateStatusHandlerFunc(h.createExportStatus)
+}
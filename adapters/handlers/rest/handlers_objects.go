@@ -14,6 +14,7 @@ package rest
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	middleware "github.com/go-openapi/runtime/middleware"
@@ -52,18 +53,23 @@ type objectsManager interface {
 	DeleteObject(_ context.Context, _ *models.Principal,
 		class string, _ strfmt.UUID, _ *additional.ReplicationProperties) error
 	UpdateObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID,
-		_ *models.Object, _ *additional.ReplicationProperties) (*models.Object, error)
+		_ *models.Object, ifMatch *int64, _ *additional.ReplicationProperties) (*models.Object, error)
 	HeadObject(ctx context.Context, principal *models.Principal, class string,
 		id strfmt.UUID, repl *additional.ReplicationProperties) (bool, *uco.Error)
 	GetObjects(context.Context, *models.Principal, *int64, *int64, *string, *string, *string, additional.Properties) ([]*models.Object, error)
 	Query(ctx context.Context, principal *models.Principal, params *uco.QueryParams) ([]*models.Object, *uco.Error)
-	MergeObject(context.Context, *models.Principal, *models.Object, *additional.ReplicationProperties) *uco.Error
+	MergeObject(_ context.Context, _ *models.Principal, _ *models.Object,
+		ifMatch *int64, _ *additional.ReplicationProperties) *uco.Error
 	AddObjectReference(context.Context, *models.Principal, *uco.AddReferenceInput, *additional.ReplicationProperties) *uco.Error
 	UpdateObjectReferences(context.Context, *models.Principal,
 		*uco.PutReferenceInput, *additional.ReplicationProperties) *uco.Error
 	DeleteObjectReference(context.Context, *models.Principal,
 		*uco.DeleteReferenceInput, *additional.ReplicationProperties) *uco.Error
 	GetObjectsClass(ctx context.Context, principal *models.Principal, id strfmt.UUID) (*models.Class, error)
+	GetObjectVersions(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID,
+		_ additional.Properties) ([]*models.ObjectVersion, error)
+	GetObjectAsOf(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID,
+		asOf int64, _ additional.Properties) (*models.Object, error)
 }
 
 func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
@@ -151,8 +157,14 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	object, err := h.manager.GetObject(params.HTTPRequest.Context(), principal,
-		params.ClassName, params.ID, additional, replProps)
+	var object *models.Object
+	if params.AsOf != nil {
+		object, err = h.manager.GetObjectAsOf(params.HTTPRequest.Context(), principal,
+			params.ClassName, params.ID, *params.AsOf, additional)
+	} else {
+		object, err = h.manager.GetObject(params.HTTPRequest.Context(), principal,
+			params.ClassName, params.ID, additional, replProps)
+	}
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -174,6 +186,46 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 	return objects.NewObjectsClassGetOK().WithPayload(object)
 }
 
+func (h *objectHandlers) getObjectVersions(params objects.ObjectsClassVersionsParams,
+	principal *models.Principal,
+) middleware.Responder {
+	// module specific additional params are not supported, as the class of an
+	// object which has been deleted can not be determined from its id
+	additional, err := parseIncludeParam(params.Include, h.modulesProvider, false, nil)
+	if err != nil {
+		return objects.NewObjectsClassVersionsBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	versions, err := h.manager.GetObjectVersions(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID, additional)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return objects.NewObjectsClassVersionsForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrNotFound:
+			return objects.NewObjectsClassVersionsNotFound()
+		default:
+			return objects.NewObjectsClassVersionsInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	for _, version := range versions {
+		if version.Object == nil {
+			continue
+		}
+		propertiesMap, ok := version.Object.Properties.(map[string]interface{})
+		if ok {
+			version.Object.Properties = h.extendPropertiesWithAPILinks(propertiesMap)
+		}
+	}
+
+	return objects.NewObjectsClassVersionsOK().
+		WithPayload(&models.ObjectVersionsResponse{Versions: versions})
+}
+
 func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -302,9 +354,15 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return objects.NewObjectsClassPutUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
 	object, err := h.manager.UpdateObject(
 		params.HTTPRequest.Context(), principal,
-		params.ClassName, params.ID, params.Body, repl)
+		params.ClassName, params.ID, params.Body, ifMatch, repl)
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -313,6 +371,9 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 		case uco.ErrInvalidUserInput:
 			return objects.NewObjectsClassPutUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrPreconditionFailed:
+			return objects.NewObjectsClassPutPreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(err))
 		case uco.ErrNotFound:
 			return objects.NewObjectsClassDeleteNotFound()
 		default:
@@ -368,11 +429,20 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	objErr := h.manager.MergeObject(params.HTTPRequest.Context(), principal, updates, repl)
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return objects.NewObjectsClassPatchUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	objErr := h.manager.MergeObject(params.HTTPRequest.Context(), principal, updates, ifMatch, repl)
 	if objErr != nil {
 		switch {
 		case objErr.NotFound():
 			return objects.NewObjectsClassPatchNotFound()
+		case objErr.PreconditionFailed():
+			return objects.NewObjectsClassPatchPreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(objErr))
 		case objErr.Forbidden():
 			return objects.NewObjectsClassPatchForbidden().
 				WithPayload(errPayloadFromSingleErr(objErr))
@@ -509,6 +579,8 @@ func setupObjectHandlers(api *operations.WeaviateAPI,
 		ObjectsValidateHandlerFunc(h.validateObject)
 	api.ObjectsObjectsClassGetHandler = objects.
 		ObjectsClassGetHandlerFunc(h.getObject)
+	api.ObjectsObjectsClassVersionsHandler = objects.
+		ObjectsClassVersionsHandlerFunc(h.getObjectVersions)
 	api.ObjectsObjectsClassHeadHandler = objects.
 		ObjectsClassHeadHandlerFunc(h.headObject)
 	api.ObjectsObjectsClassDeleteHandler = objects.
@@ -754,6 +826,23 @@ func getReplicationProperties(consistencyLvl, nodeName *string) (*additional.Rep
 	return &repl, nil
 }
 
+// parseIfMatch parses the If-Match header, which holds the expected
+// lastUpdateTimeUnix of the object. Quoted and weak ETags are accepted too.
+func parseIfMatch(header *string) (*int64, error) {
+	if header == nil {
+		return nil, nil
+	}
+
+	raw := strings.TrimPrefix(strings.TrimSpace(*header), "W/")
+	raw = strings.Trim(raw, `"`)
+	ifMatch, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || ifMatch < 0 {
+		return nil, fmt.Errorf("invalid If-Match header %q: "+
+			"expected the lastUpdateTimeUnix of the object", *header)
+	}
+	return &ifMatch, nil
+}
+
 func getConsistencyLevel(lvl *string) (string, error) {
 	if lvl != nil {
 		switch replica.ConsistencyLevel(*lvl) {
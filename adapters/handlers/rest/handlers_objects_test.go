@@ -226,6 +226,61 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 		}
 	})
 
+	t.Run("get object as of a point in time", func(t *testing.T) {
+		asOf := int64(1690000000000)
+		fakeManager := &fakeManager{
+			getObjectReturn:     &models.Object{Class: "Foo"},
+			getObjectAsOfReturn: &models.Object{Class: "Foo", LastUpdateTimeUnix: asOf},
+		}
+		h := &objectHandlers{manager: fakeManager, logger: &logrus.Logger{}}
+		res := h.getObject(objects.ObjectsClassGetParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/objects/Foo/id", nil),
+			ClassName:   "Foo",
+			AsOf:        &asOf,
+		}, nil)
+		parsed, ok := res.(*objects.ObjectsClassGetOK)
+		require.True(t, ok)
+		assert.Equal(t, fakeManager.getObjectAsOfReturn, parsed.Payload)
+	})
+
+	t.Run("get object versions", func(t *testing.T) {
+		fakeManager := &fakeManager{
+			getObjectVersionsReturn: []*models.ObjectVersion{
+				{Version: 3, Deleted: true},
+				{Version: 2, Object: &models.Object{Class: "Foo", Properties: map[string]interface{}{
+					"someRef": models.MultipleRef{
+						&models.SingleRef{
+							Beacon: "weaviate://localhost/85f78e29-5937-4390-a121-5379f262b4e5",
+						},
+					},
+				}}},
+			},
+		}
+		h := &objectHandlers{manager: fakeManager, logger: &logrus.Logger{}}
+		res := h.getObjectVersions(objects.ObjectsClassVersionsParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/objects/Foo/id/versions", nil),
+			ClassName:   "Foo",
+		}, nil)
+		parsed, ok := res.(*objects.ObjectsClassVersionsOK)
+		require.True(t, ok)
+		require.Len(t, parsed.Payload.Versions, 2)
+		assert.Equal(t, &models.ObjectVersion{Version: 3, Deleted: true}, parsed.Payload.Versions[0])
+		ref := parsed.Payload.Versions[1].Object.Properties.(map[string]interface{})["someRef"]
+		assert.Equal(t, strfmt.URI("/v1/objects/85f78e29-5937-4390-a121-5379f262b4e5"),
+			ref.(models.MultipleRef)[0].Href)
+	})
+
+	t.Run("get versions of non-existing object", func(t *testing.T) {
+		fakeManager := &fakeManager{getObjectErr: uco.NewErrNotFound("not found")}
+		h := &objectHandlers{manager: fakeManager, logger: &logrus.Logger{}}
+		res := h.getObjectVersions(objects.ObjectsClassVersionsParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/objects/Foo/id/versions", nil),
+			ClassName:   "Foo",
+		}, nil)
+		_, ok := res.(*objects.ObjectsClassVersionsNotFound)
+		assert.True(t, ok)
+	})
+
 	t.Run("get objects", func(t *testing.T) {
 		type test struct {
 			name           string
@@ -589,6 +644,10 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 				name: "not found",
 				err:  uco.ErrNotFound{},
 			},
+			{
+				name: "precondition failed",
+				err:  uco.ErrPreconditionFailed{},
+			},
 			{
 				name: "unknown error",
 				err:  stderrors.New("any error"),
@@ -644,6 +703,19 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 		if _, ok := res.(*objects.ObjectsClassPatchNotFound); !ok {
 			t.Errorf("expected: %T got: %T", objects.ObjectsClassPatchNotFound{}, res)
 		}
+		fakeManager.patchObjectReturn = &uco.Error{Code: uco.StatusPreconditionFailed}
+		res = h.patchObject(req, nil)
+		if _, ok := res.(*objects.ObjectsClassPatchPreconditionFailed); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassPatchPreconditionFailed{}, res)
+		}
+		invalidIfMatch := "yesterday"
+		fakeManager.patchObjectReturn = nil
+		req.IfMatch = &invalidIfMatch
+		res = h.patchObject(req, nil)
+		if _, ok := res.(*objects.ObjectsClassPatchUnprocessableEntity); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassPatchUnprocessableEntity{}, res)
+		}
+		req.IfMatch = nil
 		fakeManager.patchObjectReturn = &uco.Error{Code: uco.StatusForbidden}
 		res = h.patchObject(req, nil)
 		if _, ok := res.(*objects.ObjectsClassPatchForbidden); !ok {
@@ -1029,10 +1101,42 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 	})
 }
 
+func TestParseIfMatch(t *testing.T) {
+	str := func(s string) *string { return &s }
+	ptInt64 := func(i int64) *int64 { return &i }
+
+	tests := []struct {
+		header   *string
+		expected *int64
+		wantErr  bool
+	}{
+		{header: nil, expected: nil},
+		{header: str("1690000000000"), expected: ptInt64(1690000000000)},
+		{header: str(`"1690000000000"`), expected: ptInt64(1690000000000)},
+		{header: str(`W/"1690000000000"`), expected: ptInt64(1690000000000)},
+		{header: str("0"), expected: ptInt64(0)},
+		{header: str("-1"), wantErr: true},
+		{header: str("*"), wantErr: true},
+	}
+
+	for _, test := range tests {
+		ifMatch, err := parseIfMatch(test.header)
+		if test.wantErr {
+			assert.NotNil(t, err)
+			continue
+		}
+		require.Nil(t, err)
+		assert.Equal(t, test.expected, ifMatch)
+	}
+}
+
 type fakeManager struct {
 	getObjectReturn *models.Object
 	getObjectErr    error
 
+	getObjectVersionsReturn []*models.ObjectVersion
+	getObjectAsOfReturn     *models.Object
+
 	addObjectReturn    *models.Object
 	queryResult        []*models.Object
 	queryErr           *uco.Error
@@ -1071,6 +1175,18 @@ func (f *fakeManager) GetObject(_ context.Context, _ *models.Principal, class st
 	return f.getObjectReturn, f.getObjectErr
 }
 
+func (f *fakeManager) GetObjectVersions(_ context.Context, _ *models.Principal,
+	class string, _ strfmt.UUID, _ additional.Properties,
+) ([]*models.ObjectVersion, error) {
+	return f.getObjectVersionsReturn, f.getObjectErr
+}
+
+func (f *fakeManager) GetObjectAsOf(_ context.Context, _ *models.Principal,
+	class string, _ strfmt.UUID, _ int64, _ additional.Properties,
+) (*models.Object, error) {
+	return f.getObjectAsOfReturn, f.getObjectErr
+}
+
 func (f *fakeManager) GetObjectsClass(ctx context.Context,
 	principal *models.Principal, id strfmt.UUID,
 ) (*models.Class, error) {
@@ -1092,13 +1208,13 @@ func (f *fakeManager) Query(_ context.Context,
 }
 
 func (f *fakeManager) UpdateObject(_ context.Context, _ *models.Principal, _ string,
-	_ strfmt.UUID, updates *models.Object, _ *additional.ReplicationProperties,
+	_ strfmt.UUID, updates *models.Object, _ *int64, _ *additional.ReplicationProperties,
 ) (*models.Object, error) {
 	return updates, f.updateObjectErr
 }
 
 func (f *fakeManager) MergeObject(_ context.Context, _ *models.Principal,
-	_ *models.Object, _ *additional.ReplicationProperties,
+	_ *models.Object, _ *int64, _ *additional.ReplicationProperties,
 ) *uco.Error {
 	return f.patchObjectReturn
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
@@ -43,6 +44,10 @@ type ObjectsClassGetParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Return the version of the object which was current at this time (in ms since epoch). Requires objectVersioningConfig to be enabled for the class
+	  In: query
+	*/
+	AsOf *int64
 	/*
 	  Required: true
 	  In: path
@@ -78,6 +83,11 @@ func (o *ObjectsClassGetParams) BindRequest(r *http.Request, route *middleware.M
 
 	qs := runtime.Values(r.URL.Query())
 
+	qAsOf, qhkAsOf, _ := qs.GetOK("asOf")
+	if err := o.bindAsOf(qAsOf, qhkAsOf, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rClassName, rhkClassName, _ := route.Params.GetOK("className")
 	if err := o.bindClassName(rClassName, rhkClassName, route.Formats); err != nil {
 		res = append(res, err)
@@ -108,6 +118,29 @@ func (o *ObjectsClassGetParams) BindRequest(r *http.Request, route *middleware.M
 	return nil
 }
 
+// bindAsOf binds and validates parameter AsOf from query.
+func (o *ObjectsClassGetParams) bindAsOf(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("asOf", "query", "int64", raw)
+	}
+	o.AsOf = &value
+
+	return nil
+}
+
 // bindClassName binds and validates parameter ClassName from path.
 func (o *ObjectsClassGetParams) bindClassName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
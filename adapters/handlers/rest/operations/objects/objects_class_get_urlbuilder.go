@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // ObjectsClassGetURL generates an URL for the objects class get operation
@@ -30,6 +31,7 @@ type ObjectsClassGetURL struct {
 	ClassName string
 	ID        strfmt.UUID
 
+	AsOf             *int64
 	ConsistencyLevel *string
 	Include          *string
 	NodeName         *string
@@ -82,6 +84,14 @@ func (o *ObjectsClassGetURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var asOfQ string
+	if o.AsOf != nil {
+		asOfQ = swag.FormatInt64(*o.AsOf)
+	}
+	if asOfQ != "" {
+		qs.Set("asOf", asOfQ)
+	}
+
 	var consistencyLevelQ string
 	if o.ConsistencyLevel != nil {
 		consistencyLevelQ = *o.ConsistencyLevel
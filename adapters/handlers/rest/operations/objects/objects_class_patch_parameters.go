@@ -45,7 +45,7 @@ type ObjectsClassPatchParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
-	/*RFC 7396-style patch, the body contains the object to merge into the existing object.
+	/*RFC 7396-style patch, the body contains the object to merge into the existing object. Instead of a value, a property can be set to an operator which is applied to the stored value: $append, $remove or $addToSet with a value or an array of values for array properties, or $inc with a number for int and number properties.
 	  In: body
 	*/
 	Body *models.Object
@@ -63,6 +63,10 @@ type ObjectsClassPatchParams struct {
 	  In: path
 	*/
 	ID strfmt.UUID
+	/*Only apply the write if the lastUpdateTimeUnix of the stored object equals this value. Use 0 to only write if the object does not exist yet.
+	  In: header
+	*/
+	IfMatch *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -112,6 +116,10 @@ func (o *ObjectsClassPatchParams) BindRequest(r *http.Request, route *middleware
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -182,3 +190,20 @@ func (o *ObjectsClassPatchParams) validateID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *ObjectsClassPatchParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
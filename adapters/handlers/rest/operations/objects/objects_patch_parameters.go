@@ -45,7 +45,7 @@ type ObjectsPatchParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
-	/*RFC 7396-style patch, the body contains the object to merge into the existing object.
+	/*RFC 7396-style patch, the body contains the object to merge into the existing object. Instead of a value, a property can be set to an operator which is applied to the stored value: $append, $remove or $addToSet with a value or an array of values for array properties, or $inc with a number for int and number properties.
 	  In: body
 	*/
 	Body *models.Object
@@ -34,6 +34,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/backups"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/classifications"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/exports"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/meta"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
@@ -87,6 +88,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BatchBatchObjectsDeleteHandler: batch.BatchObjectsDeleteHandlerFunc(func(params batch.BatchObjectsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsDelete has not yet been implemented")
 		}),
+		BatchBatchObjectsUpdateHandler: batch.BatchObjectsUpdateHandlerFunc(func(params batch.BatchObjectsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchObjectsUpdate has not yet been implemented")
+		}),
 		BatchBatchReferencesCreateHandler: batch.BatchReferencesCreateHandlerFunc(func(params batch.BatchReferencesCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchReferencesCreate has not yet been implemented")
 		}),
@@ -96,6 +100,12 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ClassificationsClassificationsPostHandler: classifications.ClassificationsPostHandlerFunc(func(params classifications.ClassificationsPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation classifications.ClassificationsPost has not yet been implemented")
 		}),
+		ExportsExportsCreateHandler: exports.ExportsCreateHandlerFunc(func(params exports.ExportsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation exports.ExportsCreate has not yet been implemented")
+		}),
+		ExportsExportsCreateStatusHandler: exports.ExportsCreateStatusHandlerFunc(func(params exports.ExportsCreateStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation exports.ExportsCreateStatus has not yet been implemented")
+		}),
 		GraphqlGraphqlBatchHandler: graphql.GraphqlBatchHandlerFunc(func(params graphql.GraphqlBatchParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlBatch has not yet been implemented")
 		}),
@@ -132,6 +142,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsClassReferencesPutHandler: objects.ObjectsClassReferencesPutHandlerFunc(func(params objects.ObjectsClassReferencesPutParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassReferencesPut has not yet been implemented")
 		}),
+		ObjectsObjectsClassVersionsHandler: objects.ObjectsClassVersionsHandlerFunc(func(params objects.ObjectsClassVersionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsClassVersions has not yet been implemented")
+		}),
 		ObjectsObjectsCreateHandler: objects.ObjectsCreateHandlerFunc(func(params objects.ObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsCreate has not yet been implemented")
 		}),
@@ -267,12 +280,18 @@ type WeaviateAPI struct {
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
 	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
 	BatchBatchObjectsDeleteHandler batch.BatchObjectsDeleteHandler
+	// BatchBatchObjectsUpdateHandler sets the operation handler for the batch objects update operation
+	BatchBatchObjectsUpdateHandler batch.BatchObjectsUpdateHandler
 	// BatchBatchReferencesCreateHandler sets the operation handler for the batch references create operation
 	BatchBatchReferencesCreateHandler batch.BatchReferencesCreateHandler
 	// ClassificationsClassificationsGetHandler sets the operation handler for the classifications get operation
 	ClassificationsClassificationsGetHandler classifications.ClassificationsGetHandler
 	// ClassificationsClassificationsPostHandler sets the operation handler for the classifications post operation
 	ClassificationsClassificationsPostHandler classifications.ClassificationsPostHandler
+	// ExportsExportsCreateHandler sets the operation handler for the exports create operation
+	ExportsExportsCreateHandler exports.ExportsCreateHandler
+	// ExportsExportsCreateStatusHandler sets the operation handler for the exports create status operation
+	ExportsExportsCreateStatusHandler exports.ExportsCreateStatusHandler
 	// GraphqlGraphqlBatchHandler sets the operation handler for the graphql batch operation
 	GraphqlGraphqlBatchHandler graphql.GraphqlBatchHandler
 	// GraphqlGraphqlPostHandler sets the operation handler for the graphql post operation
@@ -297,6 +316,8 @@ type WeaviateAPI struct {
 	ObjectsObjectsClassReferencesDeleteHandler objects.ObjectsClassReferencesDeleteHandler
 	// ObjectsObjectsClassReferencesPutHandler sets the operation handler for the objects class references put operation
 	ObjectsObjectsClassReferencesPutHandler objects.ObjectsClassReferencesPutHandler
+	// ObjectsObjectsClassVersionsHandler sets the operation handler for the objects class versions operation
+	ObjectsObjectsClassVersionsHandler objects.ObjectsClassVersionsHandler
 	// ObjectsObjectsCreateHandler sets the operation handler for the objects create operation
 	ObjectsObjectsCreateHandler objects.ObjectsCreateHandler
 	// ObjectsObjectsDeleteHandler sets the operation handler for the objects delete operation
@@ -448,6 +469,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BatchBatchObjectsDeleteHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsDeleteHandler")
 	}
+	if o.BatchBatchObjectsUpdateHandler == nil {
+		unregistered = append(unregistered, "batch.BatchObjectsUpdateHandler")
+	}
 	if o.BatchBatchReferencesCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchReferencesCreateHandler")
 	}
@@ -457,6 +481,12 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ClassificationsClassificationsPostHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsPostHandler")
 	}
+	if o.ExportsExportsCreateHandler == nil {
+		unregistered = append(unregistered, "exports.ExportsCreateHandler")
+	}
+	if o.ExportsExportsCreateStatusHandler == nil {
+		unregistered = append(unregistered, "exports.ExportsCreateStatusHandler")
+	}
 	if o.GraphqlGraphqlBatchHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlBatchHandler")
 	}
@@ -493,6 +523,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsClassReferencesPutHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassReferencesPutHandler")
 	}
+	if o.ObjectsObjectsClassVersionsHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsClassVersionsHandler")
+	}
 	if o.ObjectsObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsCreateHandler")
 	}
@@ -690,6 +723,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/batch/objects"] = batch.NewBatchObjectsDelete(o.context, o.BatchBatchObjectsDeleteHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/batch/objects"] = batch.NewBatchObjectsUpdate(o.context, o.BatchBatchObjectsUpdateHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -705,6 +742,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/exports/{backend}"] = exports.NewExportsCreate(o.context, o.ExportsExportsCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/exports/{backend}/{id}"] = exports.NewExportsCreateStatus(o.context, o.ExportsExportsCreateStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/graphql/batch"] = graphql.NewGraphqlBatch(o.context, o.GraphqlGraphqlBatchHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -750,6 +795,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/objects/{className}/{id}/references/{propertyName}"] = objects.NewObjectsClassReferencesPut(o.context, o.ObjectsObjectsClassReferencesPutHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/objects/{className}/{id}/versions"] = objects.NewObjectsClassVersions(o.context, o.ObjectsObjectsClassVersionsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -235,6 +235,12 @@ func (i *Index) resetBackupState() {
 	i.backupState = BackupState{InProgress: false}
 }
 
+func (i *Index) isBackupInProgress() bool {
+	i.backupStateLock.RLock()
+	defer i.backupStateLock.RUnlock()
+	return i.backupState.InProgress
+}
+
 func (i *Index) resumeMaintenanceCycles(ctx context.Context) error {
 	var g errgroup.Group
 	i.ForEachShard(func(_ string, shard *Shard) error {
@@ -39,7 +39,9 @@ func (db *DB) BatchPutObjects(ctx context.Context, objs objects.BatchObjects,
 			continue
 		}
 		queue := objectByClass[item.Object.Class]
-		queue.objects = append(queue.objects, storobj.FromObject(item.Object, item.Vector))
+		object := storobj.FromObject(item.Object, item.Vector)
+		object.IfMatch = item.IfMatch
+		queue.objects = append(queue.objects, object)
 		queue.originalIndex = append(queue.originalIndex, item.OriginalIndex)
 		objectByClass[item.Object.Class] = queue
 	}
@@ -163,3 +165,28 @@ func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDelete
 	}
 	return result, nil
 }
+
+// BatchUpdateObjects merges into all objects of the given class which match
+// the filters. The filter is resolved per shard and the matching objects are
+// read and merged in pages, so that they are never held at once.
+func (db *DB) BatchUpdateObjects(ctx context.Context, params objects.BatchUpdateParams,
+	merge objects.BatchUpdateMergeFunc, repl *additional.ReplicationProperties,
+) (objects.BatchUpdateResult, error) {
+	idx := db.GetIndex(params.ClassName)
+	if idx == nil {
+		return objects.BatchUpdateResult{}, errors.Errorf("cannot find index for class %v", params.ClassName)
+	}
+
+	updatedObjects, matches, err := idx.batchUpdateObjects(ctx, params.Filters,
+		params.DryRun, merge, repl)
+	if err != nil {
+		return objects.BatchUpdateResult{}, errors.Wrapf(err, "cannot update objects")
+	}
+
+	result := objects.BatchUpdateResult{
+		Matches: matches,
+		DryRun:  params.DryRun,
+		Objects: updatedObjects,
+	}
+	return result, nil
+}
@@ -16,6 +16,7 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"sort"
@@ -314,6 +315,112 @@ func TestBatchDeleteObjects_Journey(t *testing.T) {
 	t.Run("batch delete journey things", testBatchDeleteObjectsJourney(repo, queryMaximumResults))
 }
 
+func TestBatchUpdateObjects(t *testing.T) {
+	className := "ThingForBatching"
+	dirName := t.TempDir()
+
+	queryMaximumResults := int64(20)
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       queryMaximumResults,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the thing class", testAddBatchObjectClass(repo, migrator, schemaGetter))
+
+	// more objects than fit into a single page and than QueryMaximumResults
+	simpleInsertObjects(t, repo, className, 103)
+
+	params := func(value string, dryRun bool) objects.BatchUpdateParams {
+		return objects.BatchUpdateParams{
+			ClassName: schema.ClassName(className),
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(className),
+						Property: schema.PropertyName("stringProp"),
+					},
+					Value: &filters.Value{
+						Value: value,
+						Type:  schema.DataTypeText,
+					},
+				},
+			},
+			DryRun: dryRun,
+		}
+	}
+
+	failedID := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506007")
+	merge := func(ctx context.Context, obj *search.Result) (*objects.MergeDocument, error) {
+		if obj.ID == failedID {
+			return nil, errors.New("merge failed")
+		}
+		assert.Equal(t, []float32{1, 2, 3}, obj.Vector)
+		return &objects.MergeDocument{
+			Class:           className,
+			ID:              obj.ID,
+			PrimitiveSchema: map[string]interface{}{"stringProp": "updated"},
+			Vector:          obj.Vector,
+			UpdateTime:      obj.Updated + 1,
+		}, nil
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		noMerge := func(ctx context.Context, obj *search.Result) (*objects.MergeDocument, error) {
+			t.Fatal("merge must not be called in a dry run")
+			return nil, nil
+		}
+
+		res, err := repo.BatchUpdateObjects(context.Background(), params("element", true),
+			noMerge, nil)
+		require.Nil(t, err)
+		assert.True(t, res.DryRun)
+		assert.Equal(t, int64(103), res.Matches)
+		require.Len(t, res.Objects, 103)
+	})
+
+	t.Run("update all matches", func(t *testing.T) {
+		res, err := repo.BatchUpdateObjects(context.Background(), params("element", false),
+			merge, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(103), res.Matches)
+		require.Len(t, res.Objects, 103)
+
+		failed := 0
+		for _, obj := range res.Objects {
+			if obj.Err != nil {
+				assert.Equal(t, failedID, obj.UUID)
+				assert.EqualError(t, obj.Err, "merge failed")
+				failed++
+			}
+		}
+		assert.Equal(t, 1, failed)
+	})
+
+	t.Run("updated objects match the new value", func(t *testing.T) {
+		res, err := repo.BatchUpdateObjects(context.Background(), params("updated", true),
+			merge, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(102), res.Matches)
+
+		res, err = repo.BatchUpdateObjects(context.Background(), params("element", true),
+			merge, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), res.Matches)
+		require.Len(t, res.Objects, 1)
+		assert.Equal(t, failedID, res.Objects[0].UUID)
+	})
+}
+
 func testAddBatchObjectClass(repo *DB, migrator *Migrator,
 	schemaGetter *fakeSchemaGetter,
 ) func(t *testing.T) {
@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -999,3 +1000,585 @@ func TestBM25F_SortMultiProp(t *testing.T) {
 		require.Equal(t, uint64(1), res[0].DocID())
 	})
 }
+
+func TestBM25FPhrases(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "none")
+	invertedConfig.IndexPositions = true
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               "PhraseClass",
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "multiTitles",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []map[string]interface{}{
+		{"title": "new york city guide"},
+		{"title": "york is new to me"},
+		{"title": "a new hotel in york"},
+		{"multiTitles": []string{"visiting new", "york pass"}},
+		{"title": "New-York nights"},
+	}
+	for i, data := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: data}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []uint64
+	}{
+		{
+			name:        "without phrase",
+			query:       "new york",
+			expectedIDs: []uint64{0, 1, 2, 3, 4},
+		},
+		{
+			name:        "exact phrase",
+			query:       `"new york"`,
+			expectedIDs: []uint64{0, 4},
+		},
+		{
+			name:        "proximity",
+			query:       `"new york"~2`,
+			expectedIDs: []uint64{0, 2, 4},
+		},
+		{
+			name:        "proximity across array elements",
+			query:       `"new york"~100`,
+			expectedIDs: []uint64{0, 2, 3, 4},
+		},
+		{
+			name:        "multiple phrases",
+			query:       `"new york" "city guide"`,
+			expectedIDs: []uint64{0},
+		},
+		{
+			name:        "phrase without matches",
+			query:       `"york new"`,
+			expectedIDs: []uint64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kwr := &searchparams.KeywordRanking{
+				Type:       "bm25",
+				Properties: []string{"title", "multiTitles"},
+				Query:      tt.query,
+			}
+			res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil)
+			require.Nil(t, err)
+
+			ids := make([]uint64, len(res))
+			for i := range res {
+				ids[i] = res[i].DocID()
+			}
+			assert.ElementsMatch(t, tt.expectedIDs, ids)
+		})
+	}
+
+	t.Run("phrase and additional term", func(t *testing.T) {
+		kwr := &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Properties: []string{"title", "multiTitles"},
+			Query:      `"new york"~2 hotel`,
+		}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+
+		// the additional term does not restrict the results, but ranks the
+		// object containing it first
+		require.Len(t, res, 3)
+		assert.Equal(t, uint64(2), res[0].DocID())
+	})
+}
+
+func TestBM25FTextAnalyzers(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "en")
+	invertedConfig.Analyzers = []*models.TextAnalyzerConfig{
+		{Name: "english", Filters: []string{"asciifolding", "snowball_en"}},
+	}
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               "AnalyzerClass",
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:     "stemmedTitle",
+				DataType: schema.DataTypeText.PropString(),
+				Analyzer: "english",
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []string{
+		"we run to the cafe",
+		"running between cafés",
+		"the runner drinks tea",
+	}
+	for i, text := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: map[string]interface{}{
+			"title":        text,
+			"stemmedTitle": text,
+		}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	tests := []struct {
+		name        string
+		property    string
+		query       string
+		expectedIDs []uint64
+	}{
+		{
+			name:        "without analyzer",
+			property:    "title",
+			query:       "runs cafe",
+			expectedIDs: []uint64{0},
+		},
+		{
+			name:        "with stemming and ascii folding",
+			property:    "stemmedTitle",
+			query:       "runs cafe",
+			expectedIDs: []uint64{0, 1},
+		},
+		{
+			name:        "stopwords are removed before stemming",
+			property:    "stemmedTitle",
+			query:       "the",
+			expectedIDs: []uint64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kwr := &searchparams.KeywordRanking{
+				Type:       "bm25",
+				Properties: []string{tt.property},
+				Query:      tt.query,
+			}
+			res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil)
+			require.Nil(t, err)
+
+			ids := make([]uint64, len(res))
+			for i := range res {
+				ids[i] = res[i].DocID()
+			}
+			assert.ElementsMatch(t, tt.expectedIDs, ids)
+		})
+	}
+
+	t.Run("filter on analyzed property", func(t *testing.T) {
+		filter := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    schema.ClassName(class.Class),
+					Property: schema.PropertyName("stemmedTitle"),
+				},
+				Value: &filters.Value{
+					Value: "Café",
+					Type:  schema.DataTypeText,
+				},
+			},
+		}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, filter, nil, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID()
+		}
+		assert.ElementsMatch(t, []uint64{0, 1}, ids)
+	})
+}
+
+func TestBM25FFuzzy(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "en"),
+		Class:               "FuzzyClass",
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []string{
+		"the colour of the moon",
+		"color theory",
+		"a journey to the moon",
+	}
+	for i, text := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: map[string]interface{}{"title": text}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, kwr *searchparams.KeywordRanking) ([]uint64, []float32) {
+		kwr.Type = "bm25"
+		kwr.Properties = []string{"title"}
+		res, scores, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID()
+		}
+		return ids, scores
+	}
+
+	t.Run("typo without fuzziness", func(t *testing.T) {
+		ids, _ := search(t, &searchparams.KeywordRanking{Query: "colr"})
+		assert.Empty(t, ids)
+	})
+
+	t.Run("typo with fuzziness of one", func(t *testing.T) {
+		ids, _ := search(t, &searchparams.KeywordRanking{Query: "colr~1"})
+		assert.Equal(t, []uint64{1}, ids)
+	})
+
+	t.Run("typo with fuzziness of two ranks closer terms first", func(t *testing.T) {
+		ids, scores := search(t, &searchparams.KeywordRanking{Query: "colr~2"})
+		require.Equal(t, []uint64{1, 0}, ids)
+		assert.Greater(t, scores[0], scores[1])
+	})
+
+	t.Run("automatic fuzziness", func(t *testing.T) {
+		ids, _ := search(t, &searchparams.KeywordRanking{Query: "jorney~"})
+		assert.Equal(t, []uint64{2}, ids)
+	})
+
+	t.Run("default fuzziness for all terms", func(t *testing.T) {
+		ids, _ := search(t, &searchparams.KeywordRanking{Query: "jorney mon", Fuzziness: 1})
+		assert.ElementsMatch(t, []uint64{0, 2}, ids)
+	})
+
+	t.Run("without penalty fuzzy matches score like exact matches", func(t *testing.T) {
+		penalty := 0.0
+		_, fuzzyScores := search(t, &searchparams.KeywordRanking{Query: "colr~1", FuzzyPenalty: &penalty})
+		_, exactScores := search(t, &searchparams.KeywordRanking{Query: "color"})
+		require.Len(t, fuzzyScores, 1)
+		require.Len(t, exactScores, 1)
+		assert.Equal(t, exactScores[0], fuzzyScores[0])
+	})
+
+	t.Run("invalid penalty", func(t *testing.T) {
+		penalty := 2.0
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"title"}, Query: "colr~1", FuzzyPenalty: &penalty}
+		_, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil)
+		assert.ErrorContains(t, err, "fuzzyPenalty must be between 0 and 1")
+	})
+}
+
+func TestBM25FSynonyms(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "en")
+	invertedConfig.Synonyms = &models.SynonymConfig{
+		Equivalents: [][]string{{"car", "automobile"}},
+		Mappings: []*models.SynonymMapping{
+			{Terms: []string{"ipod"}, Synonyms: []string{"mp3 player"}},
+		},
+	}
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               "SynonymClass",
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []string{
+		"a fast car",
+		"the automobile industry",
+		"an old mp3 player",
+		"a player of the game",
+		"my first ipod",
+	}
+	for i, text := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: map[string]interface{}{"title": text}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	docIDs := func(res []*storobj.Object) []uint64 {
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID()
+		}
+		return ids
+	}
+
+	search := func(t *testing.T, query string) []uint64 {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"title"}, Query: query}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		return docIDs(res)
+	}
+
+	filter := func(t *testing.T, value string) []uint64 {
+		res, _, err := idx.objectSearch(context.TODO(), 1000, &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: schema.ClassName(class.Class), Property: "title"},
+				Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+			},
+		}, nil, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		return docIDs(res)
+	}
+
+	t.Run("equivalent terms are matched both ways", func(t *testing.T) {
+		assert.ElementsMatch(t, []uint64{0, 1}, search(t, "car"))
+		assert.ElementsMatch(t, []uint64{0, 1}, search(t, "automobile"))
+	})
+
+	t.Run("mapped terms are matched one way", func(t *testing.T) {
+		ids := search(t, "ipod")
+		assert.ElementsMatch(t, []uint64{2, 4}, ids)
+		// "a player of the game" doesn't contain the whole synonym "mp3 player"
+		assert.NotContains(t, ids, uint64(3))
+		assert.Equal(t, []uint64{2}, search(t, "mp3"))
+	})
+
+	t.Run("equality filters match synonyms", func(t *testing.T) {
+		assert.ElementsMatch(t, []uint64{0, 1}, filter(t, "car"))
+		assert.ElementsMatch(t, []uint64{2, 4}, filter(t, "ipod"))
+	})
+
+	t.Run("updated synonyms apply without reindexing", func(t *testing.T) {
+		class.InvertedIndexConfig.Synonyms = &models.SynonymConfig{
+			Equivalents: [][]string{{"car", "game"}},
+		}
+		defer func() { class.InvertedIndexConfig.Synonyms = nil }()
+
+		assert.ElementsMatch(t, []uint64{0, 3}, search(t, "car"))
+		assert.ElementsMatch(t, []uint64{0, 3}, filter(t, "car"))
+	})
+
+	t.Run("removed synonyms", func(t *testing.T) {
+		assert.ElementsMatch(t, []uint64{0}, search(t, "car"))
+		assert.ElementsMatch(t, []uint64{0}, filter(t, "car"))
+	})
+}
+
+func TestBM25FPropertyStopwordsPreset(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "en"),
+		Class:               "StopwordsPresetClass",
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:            "titleDe",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				StopwordsPreset: "de",
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []map[string]interface{}{
+		{"title": "the cat is sleeping", "titleDe": "die katze schläft"},
+		{"title": "die hard", "titleDe": "die maus"},
+	}
+	for i, props := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: props}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	docIDs := func(res []*storobj.Object) []uint64 {
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID()
+		}
+		return ids
+	}
+
+	search := func(t *testing.T, query string, properties ...string) []uint64 {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: properties, Query: query}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		return docIDs(res)
+	}
+
+	filter := func(value string, property string) ([]uint64, error) {
+		res, _, err := idx.objectSearch(context.TODO(), 1000, &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: schema.ClassName(class.Class), Property: schema.PropertyName(property)},
+				Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+			},
+		}, nil, nil, nil, additional.Properties{}, nil)
+		return docIDs(res), err
+	}
+
+	t.Run("bm25 removes the stopwords of the property preset", func(t *testing.T) {
+		assert.Equal(t, []uint64{0}, search(t, "die katze", "titleDe"))
+		assert.Equal(t, []uint64{1}, search(t, "die katze", "title"))
+	})
+
+	t.Run("bm25 across properties with different presets", func(t *testing.T) {
+		assert.ElementsMatch(t, []uint64{0, 1}, search(t, "die katze", "title", "titleDe"))
+		assert.Equal(t, []uint64{0}, search(t, "the katze", "title", "titleDe"))
+	})
+
+	t.Run("filters remove the stopwords of the property preset", func(t *testing.T) {
+		ids, err := filter("die katze", "titleDe")
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0}, ids)
+
+		ids, err = filter("die", "title")
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1}, ids)
+
+		_, err = filter("die", "titleDe")
+		assert.ErrorContains(t, err, "only stopwords provided")
+	})
+}
@@ -0,0 +1,264 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"golang.org/x/sync/errgroup"
+)
+
+// bulkLoadPageSize is the number of vectors which are read from the objects
+// bucket at once when the vector index of a shard is built
+const bulkLoadPageSize = 10000
+
+// BulkLoad writes a batch of objects of a single class directly into the
+// local shards of the class. It is meant for offline imports into a data
+// directory which is not served yet, so unlike BatchPutObjects it never
+// forwards objects to other nodes or replicas. Objects which belong to a
+// shard that is not local are skipped, their number is returned.
+//
+// BulkLoad requires a DB with Config.BulkLoad set. The objects are written to
+// the object and inverted buckets only, the vector index is built by
+// FinishBulkLoad once all objects are written.
+func (db *DB) BulkLoad(ctx context.Context, className string,
+	objs []*storobj.Object,
+) (int, error) {
+	idx, err := db.bulkLoadIndex(className)
+	if err != nil {
+		return 0, err
+	}
+
+	return idx.bulkLoad(ctx, objs)
+}
+
+// FinishBulkLoad builds the vector index of every local shard of the class
+// from the objects written by BulkLoad and writes it to disk
+func (db *DB) FinishBulkLoad(ctx context.Context, className string) error {
+	idx, err := db.bulkLoadIndex(className)
+	if err != nil {
+		return err
+	}
+
+	return idx.finishBulkLoad(ctx)
+}
+
+func (db *DB) bulkLoadIndex(className string) (*Index, error) {
+	if !db.config.BulkLoad {
+		return nil, fmt.Errorf("bulk load requires a db in bulk load mode")
+	}
+
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, fmt.Errorf("class %q not found", className)
+	}
+	return idx, nil
+}
+
+func (i *Index) bulkLoad(ctx context.Context, objs []*storobj.Object) (int, error) {
+	skipped := 0
+	byShard := map[*Shard][]*storobj.Object{}
+	for _, obj := range objs {
+		shardName, err := i.shardFromUUID(obj.ID())
+		if err != nil {
+			return 0, errors.Wrapf(err, "object %s", obj.ID())
+		}
+
+		shard := i.shards.Load(shardName)
+		if shard == nil {
+			skipped++
+			continue
+		}
+		byShard[shard] = append(byShard[shard], obj)
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for shard, objs := range byShard {
+		shard, objs := shard, objs
+		eg.Go(func() error {
+			return shard.bulkPutObjects(ctx, objs)
+		})
+	}
+
+	return skipped, eg.Wait()
+}
+
+func (i *Index) finishBulkLoad(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	i.ForEachShard(func(name string, shard *Shard) error {
+		eg.Go(func() error {
+			return shard.bulkBuildVectorIndex(ctx)
+		})
+		return nil
+	})
+
+	return eg.Wait()
+}
+
+// bulkVectorIndex is implemented by a vector index which can be built without
+// a commit log and be written to disk at once, see hnsw.WriteGraph
+type bulkVectorIndex interface {
+	Add(id uint64, vector []float32) error
+	ContainsNode(id uint64) bool
+	WriteGraph() error
+}
+
+// bulkPutObjects writes objects into the object and inverted buckets of the
+// shard. Unlike putObjectBatch it does not add them to the vector index, which
+// is built in a single pass by bulkBuildVectorIndex. As the vector index is
+// built from the objects bucket, vectors of replaced objects never end up in
+// it.
+func (s *Shard) bulkPutObjects(ctx context.Context, objs []*storobj.Object) error {
+	duplicates := findDuplicatesInBatchObjects(objs)
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(runtime.GOMAXPROCS(0))
+	for j, obj := range objs {
+		if _, ok := duplicates[j]; ok {
+			continue
+		}
+
+		obj := obj
+		eg.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			uuidParsed, err := uuid.Parse(obj.ID().String())
+			if err != nil {
+				return errors.Wrapf(err, "shard %s: object %s: invalid id", s.ID(), obj.ID())
+			}
+			idBytes, err := uuidParsed.MarshalBinary()
+			if err != nil {
+				return err
+			}
+
+			status, err := s.putObjectLSM(obj, idBytes)
+			if err != nil {
+				return errors.Wrapf(err, "shard %s: object %s", s.ID(), obj.ID())
+			}
+			if err := s.updatePropertySpecificIndices(obj, status); err != nil {
+				return errors.Wrapf(err, "shard %s: object %s: update prop-specific indices",
+					s.ID(), obj.ID())
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	return s.propLengths.Flush(false)
+}
+
+// bulkBuildVectorIndex adds the vectors of all objects of the shard which are
+// not part of the vector index yet and writes the index to disk. The objects
+// bucket is read one page at a time, so that the cursor is not held while the
+// vectors are added.
+func (s *Shard) bulkBuildVectorIndex(ctx context.Context) error {
+	vectorIndex, ok := s.vectorIndex.(bulkVectorIndex)
+	if !ok {
+		// the vector index is skipped
+		return nil
+	}
+
+	var after []byte
+	for {
+		docIDs, vectors, last, err := s.bulkReadVectors(after, vectorIndex)
+		if err != nil {
+			return errors.Wrapf(err, "shard %s: read vectors", s.ID())
+		}
+
+		eg, ctx := errgroup.WithContext(ctx)
+		eg.SetLimit(runtime.GOMAXPROCS(0))
+		for j := range docIDs {
+			docID, vector := docIDs[j], vectors[j]
+			eg.Go(func() error {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				if err := vectorIndex.Add(docID, vector); err != nil {
+					return errors.Wrapf(err, "shard %s: insert doc id %d to vector index",
+						s.ID(), docID)
+				}
+				return nil
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+
+		if last == nil {
+			break
+		}
+		after = last
+	}
+
+	if err := vectorIndex.WriteGraph(); err != nil {
+		return errors.Wrapf(err, "shard %s: write vector index", s.ID())
+	}
+	return nil
+}
+
+// bulkReadVectors reads up to bulkLoadPageSize vectors of objects after the
+// key after, or from the start if it is nil. It returns the key of the last
+// object which was read, or nil if there are no more objects.
+func (s *Shard) bulkReadVectors(after []byte, vectorIndex bulkVectorIndex,
+) ([]uint64, [][]float32, []byte, error) {
+	c := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer c.Close()
+
+	var k, v []byte
+	if after == nil {
+		k, v = c.First()
+	} else if k, v = c.Seek(after); bytes.Equal(k, after) {
+		k, v = c.Next()
+	}
+
+	docIDs := make([]uint64, 0, bulkLoadPageSize)
+	vectors := make([][]float32, 0, bulkLoadPageSize)
+	for n := 0; k != nil; k, v = c.Next() {
+		if n == bulkLoadPageSize {
+			return docIDs, vectors, after, nil
+		}
+		n++
+		after = append(after[:0], k...)
+
+		docID, err := storobj.DocIDFromBinary(v)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if vectorIndex.ContainsNode(docID) {
+			continue
+		}
+		vector, err := storobj.VectorFromBinary(v)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if len(vector) == 0 {
+			continue
+		}
+
+		docIDs = append(docIDs, docID)
+		vectors = append(vectors, vector)
+	}
+
+	return docIDs, vectors, nil, nil
+}
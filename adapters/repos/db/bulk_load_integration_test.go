@@ -0,0 +1,271 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestBulkLoad(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               "BulkLoad",
+		Properties: []*models.Property{
+			{
+				Name:         "description",
+				DataType:     []string{string(schema.DataTypeText)},
+				Tokenization: "word",
+			},
+		},
+	}
+	shardState := singleShardState()
+	schemaGetter := &fakeSchemaGetter{
+		shardState: shardState,
+		schema: schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{class}},
+		},
+	}
+
+	newRepo := func(bulkLoad bool) *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+			BulkLoad:                  bulkLoad,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	ids := make([]strfmt.UUID, 50)
+	objs := make([]*storobj.Object, len(ids))
+	for i := range objs {
+		ids[i] = strfmt.UUID(uuid.NewString())
+		objs[i] = storobj.FromObject(&models.Object{
+			Class: class.Class,
+			ID:    ids[i],
+			Properties: map[string]interface{}{
+				"description": fmt.Sprintf("object number %d", i),
+			},
+			CreationTimeUnix:   1000,
+			LastUpdateTimeUnix: 1000,
+		}, []float32{float32(i), 1, 1})
+	}
+
+	// the class exists in the schema from the start, so the index is created
+	// on startup just like for a node which joins a cluster
+	repo := newRepo(true)
+	shard := func() *Shard {
+		var shard *Shard
+		repo.GetIndex(schema.ClassName(class.Class)).ForEachShard(
+			func(name string, s *Shard) error {
+				shard = s
+				return nil
+			})
+		return shard
+	}
+
+	t.Run("load in batches", func(t *testing.T) {
+		skipped, err := repo.BulkLoad(context.Background(), class.Class, objs[:20])
+		require.Nil(t, err)
+		assert.Equal(t, 0, skipped)
+
+		// the first objects are written again and replace their previous
+		// version
+		skipped, err = repo.BulkLoad(context.Background(), class.Class,
+			append(objs[20:], objs[:5]...))
+		require.Nil(t, err)
+		assert.Equal(t, 0, skipped)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := repo.BulkLoad(context.Background(), "Unknown", objs)
+		require.NotNil(t, err)
+	})
+
+	t.Run("nothing is written to the WALs", func(t *testing.T) {
+		wals, err := filepath.Glob(filepath.Join(shard().DBPathLSM(), "*", "*.wal"))
+		require.Nil(t, err)
+		require.NotEmpty(t, wals)
+		for _, wal := range wals {
+			info, err := os.Stat(wal)
+			require.Nil(t, err)
+			assert.Zero(t, info.Size(), wal)
+		}
+	})
+
+	t.Run("build the vector index", func(t *testing.T) {
+		require.Nil(t, repo.FinishBulkLoad(context.Background(), class.Class))
+
+		// only the current version of every object is part of the graph
+		vectorIndex := shard().vectorIndex.(bulkVectorIndex)
+		docIDs := map[uint64]struct{}{}
+		for _, id := range ids {
+			obj, err := shard().objectByID(context.Background(), id, nil, additional.Properties{})
+			require.Nil(t, err)
+			docIDs[obj.DocID()] = struct{}{}
+			assert.True(t, vectorIndex.ContainsNode(obj.DocID()))
+		}
+		for docID := uint64(0); docID < uint64(len(objs)+5); docID++ {
+			if _, ok := docIDs[docID]; !ok {
+				assert.False(t, vectorIndex.ContainsNode(docID), docID)
+			}
+		}
+
+		graphs, err := filepath.Glob(filepath.Join(dirName, "*.hnsw.commitlog.d", "*.condensed"))
+		require.Nil(t, err)
+		assert.Len(t, graphs, 1)
+	})
+
+	t.Run("shutdown and restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(context.Background()))
+		repo = newRepo(false)
+	})
+
+	t.Run("bulk load requires bulk load mode", func(t *testing.T) {
+		_, err := repo.BulkLoad(context.Background(), class.Class, objs)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "bulk load mode")
+	})
+
+	t.Run("verify object by id", func(t *testing.T) {
+		for i, id := range ids {
+			res, err := repo.ObjectByID(context.Background(), id, nil, additional.Properties{})
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			assert.Equal(t, fmt.Sprintf("object number %d", i),
+				res.Schema.(map[string]interface{})["description"])
+		}
+	})
+
+	t.Run("find object through inverted index", func(t *testing.T) {
+		res, err := repo.ObjectSearch(context.Background(), 0, 10,
+			&filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					Value: &filters.Value{
+						Value: "7",
+						Type:  schema.DataTypeText,
+					},
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: "description",
+					},
+				},
+			}, nil, additional.Properties{})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[7], res[0].ID)
+	})
+
+	t.Run("find object through vector index", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(),
+			dto.GetParams{
+				ClassName:    class.Class,
+				SearchVector: []float32{42, 1, 1},
+				Pagination: &filters.Pagination{
+					Limit: 1,
+				},
+			})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[42], res[0].ID)
+	})
+
+	require.Nil(t, repo.Shutdown(context.Background()))
+}
+
+func TestBulkLoadSkipsRemoteShards(t *testing.T) {
+	config, err := sharding.ParseConfig(map[string]interface{}{
+		"desiredCount": json.Number("4"),
+	}, 2)
+	require.Nil(t, err)
+	state, err := sharding.InitState("bulk-load-test-index", config,
+		fakeNodes{[]string{"node1", "node2"}}, 1, false)
+	require.Nil(t, err)
+	state.SetLocalName("node1")
+
+	ctx := testCtx()
+	shd, idx := testShard(t, ctx, "BulkLoadRemote", func(i *Index) {
+		i.getSchema = &fakeSchemaGetter{
+			shardState: state,
+			schema: schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{{Class: "BulkLoadRemote"}},
+			}},
+		}
+	})
+	defer shd.shutdown(ctx)
+
+	// serve the test shard as the local shard of the sharding state
+	var localShard string
+	for _, name := range state.AllPhysicalShards() {
+		if state.IsShardLocal(name) {
+			localShard = name
+			break
+		}
+	}
+	require.NotEmpty(t, localShard)
+	idx.shards.Store(localShard, shd)
+
+	objs := make([]*storobj.Object, 100)
+	var local []*storobj.Object
+	for i := range objs {
+		objs[i] = testObject("BulkLoadRemote")
+		shardName, err := idx.shardFromUUID(objs[i].ID())
+		require.Nil(t, err)
+		if shardName == localShard {
+			local = append(local, objs[i])
+		}
+	}
+	require.NotEmpty(t, local)
+	require.Less(t, len(local), len(objs))
+
+	skipped, err := idx.bulkLoad(ctx, objs)
+	require.Nil(t, err)
+	assert.Equal(t, len(objs)-len(local), skipped)
+
+	assert.Equal(t, len(local), shd.objectCount())
+	for _, obj := range local {
+		res, err := shd.objectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+	}
+}
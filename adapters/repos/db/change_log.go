@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/schema"
+	"golang.org/x/sync/errgroup"
+)
+
+// SubscribeChanges calls fn for every change recorded in the change logs of
+// the local shards of a class, starting at fromOffset. If shardName is set,
+// only the changes of this shard are read. As offsets are assigned per shard,
+// consumers resuming a subscription of a class with several shards should
+// subscribe to each shard separately.
+//
+// Once all stored changes have been read, SubscribeChanges waits for new ones
+// until the context is cancelled, a shard is shut down or fn returns an
+// error. Changes of the same shard are passed to fn in order, calls to fn are
+// never concurrent.
+func (db *DB) SubscribeChanges(ctx context.Context, className, shardName string,
+	fromOffset uint64, fn func(changelog.Change) error,
+) error {
+	if !db.config.ChangeDataCapture.Enabled {
+		return fmt.Errorf("change data capture is not enabled")
+	}
+
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("class %q not found", className)
+	}
+
+	return idx.subscribeChanges(ctx, shardName, fromOffset, fn)
+}
+
+func (i *Index) subscribeChanges(ctx context.Context, shardName string,
+	fromOffset uint64, fn func(changelog.Change) error,
+) error {
+	var logs []*changeLog
+	i.ForEachShard(func(name string, shard *Shard) error {
+		if shard.changeLog != nil && (shardName == "" || shardName == name) {
+			logs = append(logs, shard.changeLog)
+		}
+		return nil
+	})
+
+	if len(logs) == 0 {
+		if shardName != "" {
+			return fmt.Errorf("shard %q of class %q not found on this node",
+				shardName, i.Config.ClassName)
+		}
+		return fmt.Errorf("no shards of class %q on this node", i.Config.ClassName)
+	}
+
+	var fnLock sync.Mutex
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, l := range logs {
+		l := l
+		eg.Go(func() error {
+			return l.subscribe(ctx, fromOffset, func(change changelog.Change) error {
+				fnLock.Lock()
+				defer fnLock.Unlock()
+				return fn(change)
+			})
+		})
+	}
+
+	return eg.Wait()
+}
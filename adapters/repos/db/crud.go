@@ -31,8 +31,23 @@ import (
 
 func (db *DB) PutObject(ctx context.Context, obj *models.Object,
 	vector []float32, repl *additional.ReplicationProperties,
+) error {
+	return db.putObject(ctx, storobj.FromObject(obj, vector), repl)
+}
+
+// PutObjectIfMatch puts the object only if the stored object has the
+// lastUpdateTimeUnix ifMatch, or does not exist yet if ifMatch is 0
+func (db *DB) PutObjectIfMatch(ctx context.Context, obj *models.Object,
+	vector []float32, ifMatch int64, repl *additional.ReplicationProperties,
 ) error {
 	object := storobj.FromObject(obj, vector)
+	object.IfMatch = &ifMatch
+	return db.putObject(ctx, object, repl)
+}
+
+func (db *DB) putObject(ctx context.Context, object *storobj.Object,
+	repl *additional.ReplicationProperties,
+) error {
 	idx := db.GetIndex(object.Class())
 	if idx == nil {
 		return fmt.Errorf("import into non-existing index for %s", object.Class())
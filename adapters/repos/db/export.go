@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// exportPageSize is the number of objects read from a shard at once
+const exportPageSize = 1000
+
+// ExportShards returns the names of all physical shards of a class, local
+// and remote
+func (db *DB) ExportShards(className string) ([]string, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, fmt.Errorf("class %q not found", className)
+	}
+
+	return idx.getSchema.ShardingState(className).AllPhysicalShards(), nil
+}
+
+// ExportShard reads all objects of a shard in pages and passes them to fn.
+// If filters are set, only the matching objects are passed. The shard does
+// not need to be local, remote shards are read through the owning node.
+//
+// A filtered export is not a point-in-time snapshot: the filter is evaluated
+// once upfront, an object which is updated while the shard is read gets a
+// new doc id and is left out, even if it still matches.
+func (db *DB) ExportShard(ctx context.Context, className, shardName string,
+	filters *filters.LocalFilter, addl additional.Properties,
+	fn func(search.Results) error,
+) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return fmt.Errorf("class %q not found", className)
+	}
+
+	return idx.exportShard(ctx, shardName, filters, addl, fn)
+}
+
+func (i *Index) exportShard(ctx context.Context, shardName string,
+	where *filters.LocalFilter, addl additional.Properties,
+	fn func(search.Results) error,
+) error {
+	var shard *Shard
+	if i.isLocalShard(shardName) {
+		if shard = i.shards.Load(shardName); shard == nil {
+			return errors.Errorf("shard %q does not exist locally", shardName)
+		}
+	}
+
+	// a filter is resolved to the matching doc ids upfront, the objects are
+	// then read in id order like without a filter, skipping all others
+	var allow helpers.AllowList
+	if where != nil {
+		if shard != nil {
+			var err error
+			allow, err = shard.buildAllowList(ctx, where, additional.Properties{})
+			if err != nil {
+				return errors.Wrapf(err, "shard %s: find doc ids", shardName)
+			}
+		} else {
+			docIDs, err := i.remote.FindDocIDs(ctx, shardName, where)
+			if err != nil {
+				return errors.Wrapf(err, "shard %s: find doc ids", shardName)
+			}
+			allow = helpers.NewAllowList(docIDs...)
+		}
+		if allow.IsEmpty() {
+			return nil
+		}
+	}
+
+	cursor := &filters.Cursor{Limit: exportPageSize}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var objs []*storobj.Object
+		var err error
+		if shard != nil {
+			objs, err = shard.cursorObjectList(ctx, cursor, addl, i.Config.ClassName)
+		} else {
+			objs, _, err = i.remote.SearchShard(ctx, shardName, nil, cursor.Limit,
+				nil, nil, nil, cursor, nil, addl, false)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "shard %s: list objects", shardName)
+		}
+		if len(objs) == 0 {
+			return nil
+		}
+		cursor.After = objs[len(objs)-1].ID().String()
+
+		page := objs
+		if allow != nil {
+			page = make([]*storobj.Object, 0, len(objs))
+			for _, obj := range objs {
+				if allow.Contains(obj.DocID()) {
+					page = append(page, obj)
+				}
+			}
+		}
+		if len(page) > 0 {
+			if err := fn(storobj.SearchResults(page, addl)); err != nil {
+				return err
+			}
+		}
+
+		if len(objs) < cursor.Limit {
+			return nil
+		}
+	}
+}
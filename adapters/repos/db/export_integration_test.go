@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestExportShard(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	defer shd.shutdown(ctx)
+
+	// more objects than fit on a single page
+	objs := make([]*storobj.Object, exportPageSize+50)
+	for i := range objs {
+		objs[i] = testObject(className)
+	}
+	for _, err := range shd.putObjectBatch(ctx, objs) {
+		require.Nil(t, err)
+	}
+
+	export := func(t *testing.T, where *filters.LocalFilter,
+		addl additional.Properties,
+	) search.Results {
+		var out search.Results
+		pages := 0
+		err := idx.exportShard(ctx, shd.name, where, addl, func(res search.Results) error {
+			pages++
+			out = append(out, res...)
+			return nil
+		})
+		require.Nil(t, err)
+		if where == nil {
+			assert.Equal(t, 2, pages)
+		}
+		return out
+	}
+
+	t.Run("all objects", func(t *testing.T) {
+		res := export(t, nil, additional.Properties{Vector: true})
+		require.Len(t, res, len(objs))
+
+		seen := map[string]struct{}{}
+		for _, r := range res {
+			seen[r.ID.String()] = struct{}{}
+			assert.Equal(t, []float32{1, 2, 3}, r.Vector)
+		}
+		assert.Len(t, seen, len(objs))
+	})
+
+	t.Run("with filter", func(t *testing.T) {
+		id := objs[exportPageSize+10].ID()
+		where := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				Value: &filters.Value{
+					Value: id.String(),
+					Type:  schema.DataTypeText,
+				},
+				On: &filters.Path{
+					Class:    schema.ClassName(className),
+					Property: "id",
+				},
+			},
+		}
+
+		res := export(t, where, additional.Properties{})
+		require.Len(t, res, 1)
+		assert.Equal(t, id, res[0].ID)
+	})
+}
@@ -164,6 +164,24 @@ func (f *fakeRemoteClient) MultiGetObjects(ctx context.Context, hostName, indexN
 	return nil, nil
 }
 
+func (f *fakeRemoteClient) ObjectsByDocID(ctx context.Context, hostName, indexName,
+	shardName string, docIDs []uint64,
+) ([]*storobj.Object, error) {
+	return nil, nil
+}
+
+func (f *fakeRemoteClient) ObjectVersions(ctx context.Context, hostName, indexName,
+	shardName string, id strfmt.UUID,
+) ([]objects.StoredObjectVersion, error) {
+	return nil, nil
+}
+
+func (f *fakeRemoteClient) ObjectAsOf(ctx context.Context, hostName, indexName,
+	shardName string, id strfmt.UUID, asOf int64,
+) (*storobj.Object, error) {
+	return nil, nil
+}
+
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
 	shardName string, vector []float32, limit int,
 	filters *filters.LocalFilter, _ *searchparams.KeywordRanking, sort []filters.Sort,
@@ -77,8 +77,12 @@ var (
 	gt   = filters.OperatorGreaterThan
 	gte  = filters.OperatorGreaterThanEqual
 	wgr  = filters.OperatorWithinGeoRange
+	wgp  = filters.OperatorWithinGeoPolygon
+	wgb  = filters.OperatorWithinGeoBoundingBox
 	and  = filters.OperatorAnd
 	null = filters.OperatorIsNull
+	cany = filters.OperatorContainsAny
+	call = filters.OperatorContainsAll
 
 	// datatypes
 	dtInt            = schema.DataTypeInt
@@ -87,6 +91,8 @@ var (
 	dtText           = schema.DataTypeText
 	dtDate           = schema.DataTypeDate
 	dtGeoCoordinates = schema.DataTypeGeoCoordinates
+	dtTextArray      = schema.DataTypeTextArray
+	dtIntArray       = schema.DataTypeIntArray
 )
 
 func prepareCarTestSchemaAndData(repo *DB,
@@ -314,6 +320,46 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				}, wgr, dtGeoCoordinates),
 				expectedIDs: []strfmt.UUID{carSprinterID},
 			},
+			{
+				name: "within a bounding box around California",
+				filter: buildFilter("parkedAt", filters.GeoBoundingBox{
+					TopLeft: &models.GeoCoordinates{
+						Latitude:  ptFloat32(42),
+						Longitude: ptFloat32(-125),
+					},
+					BottomRight: &models.GeoCoordinates{
+						Latitude:  ptFloat32(32),
+						Longitude: ptFloat32(-114),
+					},
+				}, wgb, dtGeoCoordinates),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name: "within a bounding box around the contiguous US",
+				filter: buildFilter("parkedAt", filters.GeoBoundingBox{
+					TopLeft: &models.GeoCoordinates{
+						Latitude:  ptFloat32(49),
+						Longitude: ptFloat32(-125),
+					},
+					BottomRight: &models.GeoCoordinates{
+						Latitude:  ptFloat32(24),
+						Longitude: ptFloat32(-66),
+					},
+				}, wgb, dtGeoCoordinates),
+				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID},
+			},
+			{
+				name: "within a polygon around New York City",
+				filter: buildFilter("parkedAt", filters.GeoPolygon{
+					Coordinates: []*models.GeoCoordinates{
+						{Latitude: ptFloat32(40.9), Longitude: ptFloat32(-74.3)},
+						{Latitude: ptFloat32(40.5), Longitude: ptFloat32(-74.3)},
+						{Latitude: ptFloat32(40.5), Longitude: ptFloat32(-73.7)},
+						{Latitude: ptFloat32(40.9), Longitude: ptFloat32(-73.7)},
+					},
+				}, wgp, dtGeoCoordinates),
+				expectedIDs: []strfmt.UUID{carE63sID},
+			},
 			// {
 			// 	name:        "by id like",
 			// 	filter:      buildFilter("id", carPoloID.String(), like, dtText),
@@ -389,6 +435,48 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				filter:      buildFilter("colorArrayField", false, null, dtBool),
 				expectedIDs: []strfmt.UUID{carE63sID, carPoloID, carSprinterID},
 			},
+			{
+				name:        "by color array containing any of the values",
+				filter:      buildFilter("colorArrayField", []string{"dark", "light grey"}, cany, dtTextArray),
+				expectedIDs: []strfmt.UUID{carSprinterID, carPoloID},
+			},
+			{
+				name:        "by color array containing all of the values",
+				filter:      buildFilter("colorArrayField", []string{"very light", "grey"}, call, dtTextArray),
+				expectedIDs: []strfmt.UUID{carE63sID},
+			},
+			{
+				name:        "by color array containing all of the values, no match",
+				filter:      buildFilter("colorArrayField", []string{"dark", "light grey"}, call, dtTextArray),
+				expectedIDs: []strfmt.UUID{},
+			},
+			{
+				name:        "by color array with word tokenization containing all of the values",
+				filter:      buildFilter("colorArrayWhitespace", []string{"light", "grey"}, call, dtTextArray),
+				expectedIDs: []strfmt.UUID{carE63sID, carSprinterID},
+			},
+			{
+				name:        "by color containing any of the values",
+				filter:      buildFilter("colorField", []string{"dark grey", "light grey"}, cany, dtTextArray),
+				expectedIDs: []strfmt.UUID{carSprinterID, carPoloID},
+			},
+			{
+				name:        "horsepower containing any of the values",
+				filter:      buildFilter("horsepower", []int{130, 612, 1}, cany, dtIntArray),
+				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID},
+			},
+			{
+				name: "by dealerships containing all of the values",
+				filter: buildFilter("availableAtDealerships",
+					[]string{dealershipNorth.String(), dealershipSouth.String()}, call, dtTextArray),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name: "by dealerships containing any of the values",
+				filter: buildFilter("availableAtDealerships",
+					[]string{dealershipNorth.String(), dealershipSouth.String()}, cany, dtTextArray),
+				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID, carPoloID},
+			},
 			{
 				name:        "by string length",
 				filter:      buildFilter("len(colorField)", 10, eq, dtInt),
@@ -655,6 +743,14 @@ func buildSortFilter(path []string, order string) filters.Sort {
 	return filters.Sort{Path: path, Order: order}
 }
 
+func buildSortNearFilter(path []string, order string, lat, lon float32) filters.Sort {
+	return filters.Sort{
+		Path:  path,
+		Order: order,
+		Near:  &models.GeoCoordinates{Latitude: &lat, Longitude: &lon},
+	}
+}
+
 func compoundFilter(operator filters.Operator,
 	operands ...*filters.LocalFilter,
 ) *filters.LocalFilter {
@@ -1196,6 +1292,187 @@ func TestCasingOfOperatorCombinations(t *testing.T) {
 	})
 }
 
+func TestNGramTokenizationFilters(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:               "NGramTokenization",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{
+				Name:         "textPropTrigram",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationTrigram,
+			},
+			{
+				Name:         "textArrayPropTrigram",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationTrigram,
+			},
+			{
+				Name:         "textPropCJK",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationCjk,
+			},
+		},
+	}
+
+	objects := []*models.Object{
+		{
+			Class: class.Class,
+			ID:    strfmt.UUID(uuid.New().String()),
+			Properties: map[string]interface{}{
+				"name":                 "carpet",
+				"textPropTrigram":      "The Persian carpet",
+				"textArrayPropTrigram": []string{"living room", "carpet"},
+				"textPropCJK":          "北京大学的图书馆",
+			},
+			Vector: []float32{0.1},
+		},
+		{
+			Class: class.Class,
+			ID:    strfmt.UUID(uuid.New().String()),
+			Properties: map[string]interface{}{
+				"name":                 "scarf",
+				"textPropTrigram":      "a red scarf and a pet",
+				"textArrayPropTrigram": []string{"wardrobe"},
+				"textPropCJK":          "東京タワーの夜景",
+			},
+			Vector: []float32{0.1},
+		},
+		{
+			Class: class.Class,
+			ID:    strfmt.UUID(uuid.New().String()),
+			Properties: map[string]interface{}{
+				"name":                 "theater",
+				"textPropTrigram":      "the theater",
+				"textArrayPropTrigram": []string{"stage", "living room"},
+				"textPropCJK":          "大学生 students",
+			},
+			Vector: []float32{0.1},
+		},
+	}
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{
+				class,
+			},
+		}
+	})
+
+	t.Run("importing the objects", func(t *testing.T) {
+		for i, obj := range objects {
+			t.Run(fmt.Sprintf("importing object %d", i), func(t *testing.T) {
+				require.Nil(t,
+					repo.PutObject(context.Background(), obj, obj.Vector, nil))
+			})
+		}
+	})
+
+	t.Run("verifying filters", func(t *testing.T) {
+		type test struct {
+			name          string
+			filter        *filters.LocalFilter
+			expectedNames []string
+		}
+
+		tests := []test{
+			{
+				name:          "trigram, infix like",
+				filter:        buildFilter("textPropTrigram", "*arpe*", like, dtText),
+				expectedNames: []string{"carpet"},
+			},
+			{
+				name:          "trigram, infix like with trigrams of different words",
+				filter:        buildFilter("textPropTrigram", "*carpet*", like, dtText),
+				expectedNames: []string{"carpet"},
+			},
+			{
+				name:          "trigram, suffix like",
+				filter:        buildFilter("textPropTrigram", "*ater", like, dtText),
+				expectedNames: []string{"theater"},
+			},
+			{
+				name:          "trigram, like with short fragments",
+				filter:        buildFilter("textPropTrigram", "c?r*", like, dtText),
+				expectedNames: []string{"carpet"},
+			},
+			{
+				name:          "trigram, like matching whole words only",
+				filter:        buildFilter("textPropTrigram", "pet", like, dtText),
+				expectedNames: []string{"scarf"},
+			},
+			{
+				name:          "trigram, equal does not drop stopword trigrams",
+				filter:        buildFilter("textPropTrigram", "the", eq, dtText),
+				expectedNames: []string{"carpet", "theater"},
+			},
+			{
+				name:          "trigram array, infix like",
+				filter:        buildFilter("textArrayPropTrigram", "*vin*", like, dtText),
+				expectedNames: []string{"carpet", "theater"},
+			},
+			{
+				name:          "cjk, bigrams",
+				filter:        buildFilter("textPropCJK", "大学", eq, dtText),
+				expectedNames: []string{"carpet", "theater"},
+			},
+			{
+				name:          "cjk, multiple bigrams",
+				filter:        buildFilter("textPropCJK", "東京タワー", eq, dtText),
+				expectedNames: []string{"scarf"},
+			},
+			{
+				name:          "cjk, latin words",
+				filter:        buildFilter("textPropCJK", "Students", eq, dtText),
+				expectedNames: []string{"theater"},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				params := dto.GetParams{
+					ClassName:  class.Class,
+					Pagination: &filters.Pagination{Limit: 100},
+					Filters:    test.filter,
+				}
+				res, err := repo.ClassSearch(context.Background(), params)
+				require.Nil(t, err)
+
+				names := make([]string, len(res))
+				for pos, obj := range res {
+					names[pos] = obj.Schema.(map[string]interface{})["name"].(string)
+				}
+				assert.ElementsMatch(t, test.expectedNames, names)
+			})
+		}
+	})
+}
+
 func testSortProperties(repo *DB) func(t *testing.T) {
 	return func(t *testing.T) {
 		type test struct {
@@ -1276,6 +1553,30 @@ func testSortProperties(repo *DB) func(t *testing.T) {
 				},
 				expectedIDs: []strfmt.UUID{carE63sID, carSprinterID, carPoloID, carNilID, carEmpty},
 			},
+			{
+				name: "parkedAt near New York City asc",
+				sort: []filters.Sort{
+					buildSortNearFilter([]string{"parkedAt"}, "asc", 40.730610, -73.935242),
+				},
+				expectedIDs: []strfmt.UUID{carPoloID, carNilID, carEmpty, carE63sID, carSprinterID},
+			},
+			{
+				name: "parkedAt near Las Vegas desc",
+				sort: []filters.Sort{
+					buildSortNearFilter([]string{"parkedAt"}, "desc", 36.169941, -115.139832),
+				},
+				expectedIDs: []strfmt.UUID{carE63sID, carSprinterID, carPoloID, carNilID, carEmpty},
+			},
+			{
+				name: "parkedAt near a point without longitude",
+				sort: []filters.Sort{{
+					Path:  []string{"parkedAt"},
+					Order: "asc",
+					Near:  &models.GeoCoordinates{Latitude: ptFloat32(40.730610)},
+				}},
+				wantErr:    true,
+				errMessage: "invalid near parameter: latitude and longitude must be set",
+			},
 			{
 				name: "contact asc",
 				sort: []filters.Sort{
@@ -22,6 +22,8 @@ var (
 	ObjectsBucketLSM           = "objects"
 	CompressedObjectsBucketLSM = "compressed_objects"
 	DimensionsBucketLSM        = "dimensions"
+	ChangeLogBucketLSM         = "changelog"
+	ObjectVersionsBucketLSM    = "object_versions"
 	DocIDBucket                = []byte("doc_ids")
 )
 
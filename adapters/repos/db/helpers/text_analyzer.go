@@ -0,0 +1,209 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stemmers"
+	"github.com/weaviate/weaviate/entities/models"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	TokenFilterLowercase    = "lowercase"
+	TokenFilterASCIIFolding = "asciifolding"
+	TokenFilterNFKC         = "nfkc"
+	TokenFilterElision      = "elision"
+	// TokenFilterSnowballPrefix is followed by the language of the stemmer,
+	// e.g. snowball_en
+	TokenFilterSnowballPrefix = "snowball_"
+)
+
+type tokenFilter struct {
+	apply func(term string) string
+	// stemmers are not applied to terms containing wildcards, as the result
+	// would no longer match the stemmed terms in the index
+	isStemmer bool
+}
+
+// TextAnalyzer splits text into terms according to one of the Tokenizations
+// and passes each term through a chain of token filters
+type TextAnalyzer struct {
+	tokenization string
+	filters      []tokenFilter
+}
+
+// NewTokenizationAnalyzer creates an analyzer which only applies the given
+// tokenization, which is equivalent to calling Tokenize
+func NewTokenizationAnalyzer(tokenization string) *TextAnalyzer {
+	return &TextAnalyzer{tokenization: tokenization}
+}
+
+// NewTextAnalyzer creates an analyzer from a tokenization (defaults to word)
+// and a list of token filter names, which are applied in the given order
+func NewTextAnalyzer(tokenization string, filterNames []string) (*TextAnalyzer, error) {
+	if tokenization == "" {
+		tokenization = models.PropertyTokenizationWord
+	}
+	if !isTokenization(tokenization) {
+		return nil, fmt.Errorf("tokenization '%s' does not exist", tokenization)
+	}
+
+	filters := make([]tokenFilter, len(filterNames))
+	for i, name := range filterNames {
+		filter, err := newTokenFilter(name)
+		if err != nil {
+			return nil, err
+		}
+		filters[i] = filter
+	}
+
+	return &TextAnalyzer{tokenization: tokenization, filters: filters}, nil
+}
+
+func isTokenization(tokenization string) bool {
+	for _, t := range Tokenizations {
+		if t == tokenization {
+			return true
+		}
+	}
+	return false
+}
+
+func newTokenFilter(name string) (tokenFilter, error) {
+	switch name {
+	case TokenFilterLowercase:
+		return tokenFilter{apply: strings.ToLower}, nil
+	case TokenFilterASCIIFolding:
+		return tokenFilter{apply: foldToASCII}, nil
+	case TokenFilterNFKC:
+		return tokenFilter{apply: norm.NFKC.String}, nil
+	case TokenFilterElision:
+		return tokenFilter{apply: removeElision}, nil
+	}
+
+	if lang := strings.TrimPrefix(name, TokenFilterSnowballPrefix); lang != name {
+		if stem, ok := stemmers.Stemmers[lang]; ok {
+			return tokenFilter{apply: stem, isStemmer: true}, nil
+		}
+	}
+
+	return tokenFilter{}, fmt.Errorf("token filter '%s' does not exist", name)
+}
+
+func (a *TextAnalyzer) Tokenization() string {
+	return a.tokenization
+}
+
+// Tokenize splits the input into terms and applies all token filters
+func (a *TextAnalyzer) Tokenize(in string) []string {
+	return a.Filter(Tokenize(a.tokenization, in))
+}
+
+// TokenizeWithWildcards splits the input into terms keeping the wildcard
+// symbols, then applies all token filters. Stemmers are skipped for terms
+// which contain wildcards.
+func (a *TextAnalyzer) TokenizeWithWildcards(in string) []string {
+	terms := TokenizeWithWildcards(a.tokenization, in)
+	if len(a.filters) == 0 {
+		return terms
+	}
+
+	out := terms[:0]
+	for _, term := range terms {
+		hasWildcards := strings.ContainsAny(term, "*?")
+		for _, filter := range a.filters {
+			if filter.isStemmer && hasWildcards {
+				continue
+			}
+			term = filter.apply(term)
+		}
+		if term != "" {
+			out = append(out, term)
+		}
+	}
+	return out
+}
+
+// Filter applies all token filters to already tokenized terms. Terms which
+// end up empty are removed.
+func (a *TextAnalyzer) Filter(terms []string) []string {
+	if len(a.filters) == 0 {
+		return terms
+	}
+
+	out := terms[:0]
+	for _, term := range terms {
+		for _, filter := range a.filters {
+			term = filter.apply(term)
+		}
+		if term != "" {
+			out = append(out, term)
+		}
+	}
+	return out
+}
+
+// asciiFoldingReplacements are letters which are not decomposed into a base
+// letter and diacritics by the unicode normalization
+var asciiFoldingReplacements = strings.NewReplacer(
+	"ß", "ss", "æ", "ae", "Æ", "AE", "œ", "oe", "Œ", "OE", "ø", "o", "Ø", "O",
+	"đ", "d", "Đ", "D", "ł", "l", "Ł", "L", "þ", "th", "Þ", "TH", "ı", "i",
+)
+
+// foldToASCII removes diacritics from letters, e.g. café becomes cafe
+func foldToASCII(term string) string {
+	if isASCII(term) {
+		return term
+	}
+
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, asciiFoldingReplacements.Replace(term))
+	if err != nil {
+		return term
+	}
+	return folded
+}
+
+func isASCII(term string) bool {
+	for i := 0; i < len(term); i++ {
+		if term[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// elidedArticles are removed together with the following apostrophe from the
+// start of a term, e.g. l'avion becomes avion
+var elidedArticles = map[string]struct{}{
+	"l": {}, "m": {}, "t": {}, "qu": {}, "n": {}, "s": {}, "j": {}, "d": {},
+	"c": {}, "jusqu": {}, "quoiqu": {}, "lorsqu": {}, "puisqu": {},
+}
+
+func removeElision(term string) string {
+	i := strings.IndexAny(term, "'’")
+	if i <= 0 {
+		return term
+	}
+	if _, ok := elidedArticles[strings.ToLower(term[:i])]; !ok {
+		return term
+	}
+	_, size := utf8.DecodeRuneInString(term[i:])
+	return term[i+size:]
+}
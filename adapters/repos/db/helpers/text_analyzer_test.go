@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestTextAnalyzer(t *testing.T) {
+	type testCase struct {
+		name         string
+		tokenization string
+		filters      []string
+		input        string
+		expected     []string
+	}
+
+	testCases := []testCase{
+		{
+			name:         "tokenization only",
+			tokenization: models.PropertyTokenizationWhitespace,
+			input:        "Running Cafés",
+			expected:     []string{"Running", "Cafés"},
+		},
+		{
+			name:     "default tokenization",
+			filters:  []string{TokenFilterASCIIFolding},
+			input:    "Crème brûlée, s'il vous plaît",
+			expected: []string{"creme", "brulee", "s", "il", "vous", "plait"},
+		},
+		{
+			name:         "lowercase and asciifolding",
+			tokenization: models.PropertyTokenizationWhitespace,
+			filters:      []string{TokenFilterLowercase, TokenFilterASCIIFolding},
+			input:        "Straße Ærø Łódź",
+			expected:     []string{"strasse", "aero", "lodz"},
+		},
+		{
+			name:         "nfkc",
+			tokenization: models.PropertyTokenizationWhitespace,
+			filters:      []string{TokenFilterNFKC},
+			input:        "ｗｅａｖｉａｔｅ ﬁle",
+			expected:     []string{"weaviate", "file"},
+		},
+		{
+			name:         "elision and french stemmer",
+			tokenization: models.PropertyTokenizationLowercase,
+			filters:      []string{TokenFilterElision, "snowball_fr"},
+			input:        "L'avion d’abord qu'ils marchés",
+			expected:     []string{"avion", "abord", "il", "march"},
+		},
+		{
+			name:         "english stemmer",
+			tokenization: models.PropertyTokenizationWord,
+			filters:      []string{"snowball_en"},
+			input:        "The runners were running happily",
+			expected:     []string{"the", "runner", "were", "run", "happili"},
+		},
+		{
+			name:         "filters are applied in order",
+			tokenization: models.PropertyTokenizationWhitespace,
+			filters:      []string{"snowball_en", TokenFilterLowercase},
+			input:        "RUNNING running",
+			expected:     []string{"running", "run"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			analyzer, err := NewTextAnalyzer(tc.tokenization, tc.filters)
+			require.Nil(t, err)
+			assert.Equal(t, tc.expected, analyzer.Tokenize(tc.input))
+		})
+	}
+
+	t.Run("wildcards are not stemmed", func(t *testing.T) {
+		analyzer, err := NewTextAnalyzer(models.PropertyTokenizationWord,
+			[]string{TokenFilterASCIIFolding, "snowball_en"})
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"runn*", "cafe", "happili"},
+			analyzer.TokenizeWithWildcards("runn* café happily"))
+	})
+
+	t.Run("tokenization analyzer", func(t *testing.T) {
+		for _, tokenization := range Tokenizations {
+			input := " Hello You*-beautiful_world?!"
+			assert.Equal(t, Tokenize(tokenization, input),
+				NewTokenizationAnalyzer(tokenization).Tokenize(input))
+			assert.Equal(t, TokenizeWithWildcards(tokenization, input),
+				NewTokenizationAnalyzer(tokenization).TokenizeWithWildcards(input))
+		}
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewTextAnalyzer("non_existing", nil)
+		assert.EqualError(t, err, "tokenization 'non_existing' does not exist")
+
+		_, err = NewTextAnalyzer(models.PropertyTokenizationWord, []string{"snowball_xx"})
+		assert.EqualError(t, err, "token filter 'snowball_xx' does not exist")
+
+		_, err = NewTextAnalyzer(models.PropertyTokenizationWord, []string{"uppercase"})
+		assert.EqualError(t, err, "token filter 'uppercase' does not exist")
+	})
+}
@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"unicode"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// TokenSpan is a part of a text, given as rune offsets, together with the
+// terms which the tokenization creates from it
+type TokenSpan struct {
+	Start int
+	End   int
+	Terms []string
+}
+
+// TokenSpans splits the text into the parts from which the tokenization
+// creates its terms, which allows to locate the terms in the original text,
+// e.g. to highlight them. The bigrams of CJK text overlap each other.
+func TokenSpans(tokenization string, text []rune) []TokenSpan {
+	isAlphanumeric := func(r rune) bool { return unicode.IsLetter(r) || unicode.IsNumber(r) }
+	isNotSpace := func(r rune) bool { return !unicode.IsSpace(r) }
+
+	switch tokenization {
+	case models.PropertyTokenizationWord, models.PropertyTokenizationTrigram:
+		return tokenSpans(tokenization, text, isAlphanumeric)
+	case models.PropertyTokenizationLowercase, models.PropertyTokenizationWhitespace:
+		return tokenSpans(tokenization, text, isNotSpace)
+	case models.PropertyTokenizationField:
+		start, end := 0, len(text)
+		for start < end && unicode.IsSpace(text[start]) {
+			start++
+		}
+		for end > start && unicode.IsSpace(text[end-1]) {
+			end--
+		}
+		if start == end {
+			return nil
+		}
+		return []TokenSpan{{Start: start, End: end, Terms: tokenizeField(string(text[start:end]))}}
+	case models.PropertyTokenizationCjk:
+		var spans []TokenSpan
+		for _, word := range tokenSpans(models.PropertyTokenizationWord, text, isAlphanumeric) {
+			spans = appendCJKSpans(spans, text, word.Start, word.End)
+		}
+		return spans
+	default:
+		return nil
+	}
+}
+
+func tokenSpans(tokenization string, text []rune, isPartOfToken func(rune) bool) []TokenSpan {
+	var spans []TokenSpan
+	for start := 0; start < len(text); {
+		if !isPartOfToken(text[start]) {
+			start++
+			continue
+		}
+		end := start + 1
+		for end < len(text) && isPartOfToken(text[end]) {
+			end++
+		}
+		spans = append(spans, TokenSpan{
+			Start: start,
+			End:   end,
+			Terms: Tokenize(tokenization, string(text[start:end])),
+		})
+		start = end
+	}
+	return spans
+}
+
+// appendCJKSpans mirrors appendCJKTerms, each bigram of the CJK parts of the
+// word becomes a span of its own
+func appendCJKSpans(spans []TokenSpan, text []rune, wordStart, wordEnd int) []TokenSpan {
+	for start := wordStart; start < wordEnd; {
+		cjk := isCJK(text[start])
+		end := start + 1
+		for end < wordEnd && isCJK(text[end]) == cjk {
+			end++
+		}
+
+		if !cjk || end-start <= 2 {
+			spans = append(spans, TokenSpan{
+				Start: start,
+				End:   end,
+				Terms: lowercase([]string{string(text[start:end])}),
+			})
+		} else {
+			for i := start; i+2 <= end; i++ {
+				spans = append(spans, TokenSpan{
+					Start: i,
+					End:   i + 2,
+					Terms: []string{string(text[i : i+2])},
+				})
+			}
+		}
+		start = end
+	}
+	return spans
+}
@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestTokenSpans(t *testing.T) {
+	testCases := []struct {
+		tokenization string
+		input        string
+		expected     []TokenSpan
+	}{
+		{
+			tokenization: models.PropertyTokenizationWord,
+			input:        " Hello, World!",
+			expected: []TokenSpan{
+				{Start: 1, End: 6, Terms: []string{"hello"}},
+				{Start: 8, End: 13, Terms: []string{"world"}},
+			},
+		},
+		{
+			tokenization: models.PropertyTokenizationLowercase,
+			input:        " Hello, World!",
+			expected: []TokenSpan{
+				{Start: 1, End: 7, Terms: []string{"hello,"}},
+				{Start: 8, End: 14, Terms: []string{"world!"}},
+			},
+		},
+		{
+			tokenization: models.PropertyTokenizationField,
+			input:        " Hello, World! ",
+			expected: []TokenSpan{
+				{Start: 1, End: 14, Terms: []string{"Hello, World!"}},
+			},
+		},
+		{
+			tokenization: models.PropertyTokenizationTrigram,
+			input:        "Hello",
+			expected: []TokenSpan{
+				{Start: 0, End: 5, Terms: []string{"hel", "ell", "llo"}},
+			},
+		},
+		{
+			tokenization: models.PropertyTokenizationCjk,
+			input:        "東京タワー Tower",
+			expected: []TokenSpan{
+				{Start: 0, End: 2, Terms: []string{"東京"}},
+				{Start: 1, End: 3, Terms: []string{"京タ"}},
+				{Start: 2, End: 4, Terms: []string{"タワ"}},
+				{Start: 3, End: 5, Terms: []string{"ワー"}},
+				{Start: 6, End: 11, Terms: []string{"tower"}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.tokenization, func(t *testing.T) {
+			assert.Equal(t, tc.expected, TokenSpans(tc.tokenization, []rune(tc.input)))
+		})
+	}
+}
@@ -23,6 +23,8 @@ var Tokenizations []string = []string{
 	models.PropertyTokenizationLowercase,
 	models.PropertyTokenizationWhitespace,
 	models.PropertyTokenizationField,
+	models.PropertyTokenizationTrigram,
+	models.PropertyTokenizationCjk,
 }
 
 func Tokenize(tokenization string, in string) []string {
@@ -35,6 +37,10 @@ func Tokenize(tokenization string, in string) []string {
 		return tokenizeWhitespace(in)
 	case models.PropertyTokenizationField:
 		return tokenizeField(in)
+	case models.PropertyTokenizationTrigram:
+		return tokenizeTrigram(in)
+	case models.PropertyTokenizationCjk:
+		return tokenizeCJK(in)
 	default:
 		return []string{}
 	}
@@ -50,11 +56,24 @@ func TokenizeWithWildcards(tokenization string, in string) []string {
 		return tokenizeWhitespace(in)
 	case models.PropertyTokenizationField:
 		return tokenizeField(in)
+	case models.PropertyTokenizationTrigram:
+		// like patterns are matched against the trigrams of each of their
+		// literal parts, therefore they are not split into trigrams here
+		return tokenizeWordWithWildcards(in)
+	case models.PropertyTokenizationCjk:
+		return tokenizeCJKWithWildcards(in)
 	default:
 		return []string{}
 	}
 }
 
+// IsNGramTokenization indicates whether the tokenization splits words into
+// n-grams, which are not meaningful as stopwords
+func IsNGramTokenization(tokenization string) bool {
+	return tokenization == models.PropertyTokenizationTrigram ||
+		tokenization == models.PropertyTokenizationCjk
+}
+
 // tokenizeField trims white spaces
 // (former DataTypeString/Field)
 func tokenizeField(in string) []string {
@@ -91,6 +110,85 @@ func tokenizeWordWithWildcards(in string) []string {
 	return lowercase(terms)
 }
 
+// tokenizeTrigram splits on any non-alphanumerical, lowercases the words and
+// splits each of them into overlapping trigrams. Words shorter than three
+// characters are kept as they are.
+func tokenizeTrigram(in string) []string {
+	words := tokenizeWord(in)
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		terms = append(terms, ngrams([]rune(word), 3)...)
+	}
+	return terms
+}
+
+// tokenizeCJK splits on any non-alphanumerical and lowercases the words like
+// tokenizeWord. As chinese, japanese and korean text does not separate words
+// by white spaces, runs of CJK characters are split into overlapping bigrams.
+func tokenizeCJK(in string) []string {
+	words := tokenizeWord(in)
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		terms = appendCJKTerms(terms, word)
+	}
+	return terms
+}
+
+// tokenizeCJKWithWildcards keeps words containing wildcards as they are, so
+// that patterns of up to two CJK characters can be matched against the
+// bigrams
+func tokenizeCJKWithWildcards(in string) []string {
+	words := tokenizeWordWithWildcards(in)
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if strings.ContainsAny(word, "*?") {
+			terms = append(terms, word)
+			continue
+		}
+		terms = appendCJKTerms(terms, word)
+	}
+	return terms
+}
+
+func appendCJKTerms(terms []string, word string) []string {
+	runes := []rune(word)
+	for start := 0; start < len(runes); {
+		cjk := isCJK(runes[start])
+		end := start + 1
+		for end < len(runes) && isCJK(runes[end]) == cjk {
+			end++
+		}
+
+		if cjk {
+			terms = append(terms, ngrams(runes[start:end], 2)...)
+		} else {
+			terms = append(terms, string(runes[start:end]))
+		}
+		start = end
+	}
+	return terms
+}
+
+func isCJK(r rune) bool {
+	// the prolonged sound mark is used within katakana words, but belongs to
+	// the common script
+	return r == 'ー' || unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// ngrams splits the runes into overlapping n-grams. If there are no more than
+// n runes, they are returned as a single term.
+func ngrams(runes []rune, n int) []string {
+	if len(runes) <= n {
+		return []string{string(runes)}
+	}
+
+	out := make([]string, 0, len(runes)-n+1)
+	for i := 0; i+n <= len(runes); i++ {
+		out = append(out, string(runes[i:i+n]))
+	}
+	return out
+}
+
 func lowercase(terms []string) []string {
 	for i := range terms {
 		terms[i] = strings.ToLower(terms[i])
@@ -99,8 +197,13 @@ func lowercase(terms []string) []string {
 }
 
 func TokenizeAndCountDuplicates(tokenization string, in string) ([]string, []int) {
+	return CountDuplicates(Tokenize(tokenization, in))
+}
+
+// CountDuplicates returns the unique terms and how often each of them occurs
+func CountDuplicates(terms []string) ([]string, []int) {
 	counts := map[string]int{}
-	for _, term := range Tokenize(tokenization, in) {
+	for _, term := range terms {
 		counts[term]++
 	}
 
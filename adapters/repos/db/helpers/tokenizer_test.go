@@ -44,6 +44,17 @@ func TestTokenize(t *testing.T) {
 				tokenization: models.PropertyTokenizationWord,
 				expected:     []string{"hello", "you", "beautiful", "world"},
 			},
+			{
+				tokenization: models.PropertyTokenizationTrigram,
+				expected: []string{
+					"hel", "ell", "llo", "you", "bea", "eau", "aut", "uti", "tif",
+					"ifu", "ful", "wor", "orl", "rld",
+				},
+			},
+			{
+				tokenization: models.PropertyTokenizationCjk,
+				expected:     []string{"hello", "you", "beautiful", "world"},
+			},
 		}
 
 		for _, tc := range testCases {
@@ -70,6 +81,14 @@ func TestTokenize(t *testing.T) {
 				tokenization: models.PropertyTokenizationWord,
 				expected:     []string{"hello", "you*", "beautiful", "world?"},
 			},
+			{
+				tokenization: models.PropertyTokenizationTrigram,
+				expected:     []string{"hello", "you*", "beautiful", "world?"},
+			},
+			{
+				tokenization: models.PropertyTokenizationCjk,
+				expected:     []string{"hello", "you*", "beautiful", "world?"},
+			},
 		}
 
 		for _, tc := range testCases {
@@ -79,6 +98,46 @@ func TestTokenize(t *testing.T) {
 	})
 }
 
+func TestTokenizeNGrams(t *testing.T) {
+	testCases := []struct {
+		name         string
+		tokenization string
+		input        string
+		expected     []string
+	}{
+		{
+			name:         "trigrams of short words",
+			tokenization: models.PropertyTokenizationTrigram,
+			input:        "A new Café",
+			expected:     []string{"a", "new", "caf", "afé"},
+		},
+		{
+			name:         "chinese bigrams",
+			tokenization: models.PropertyTokenizationCjk,
+			input:        "北京大学",
+			expected:     []string{"北京", "京大", "大学"},
+		},
+		{
+			name:         "japanese mixed with latin",
+			tokenization: models.PropertyTokenizationCjk,
+			input:        "東京タワーはTOKYOの名所",
+			expected:     []string{"東京", "京タ", "タワ", "ワー", "ーは", "tokyo", "の名", "名所"},
+		},
+		{
+			name:         "single cjk character",
+			tokenization: models.PropertyTokenizationCjk,
+			input:        "猫 cat",
+			expected:     []string{"猫", "cat"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Tokenize(tc.tokenization, tc.input))
+		})
+	}
+}
+
 func TestTokenizeAndCountDuplicates(t *testing.T) {
 	input := "Hello You Beautiful World! hello you beautiful world!"
 
@@ -284,8 +284,10 @@ type IndexConfig struct {
 	MemtablesMinActiveSeconds int
 	MemtablesMaxActiveSeconds int
 	ReplicationFactor         int64
+	ChangeDataCapture         config.ChangeDataCapture
 
 	TrackVectorDimensions bool
+	BulkLoad              bool
 }
 
 func indexID(class schema.ClassName) string {
@@ -319,6 +321,9 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 	}
 
 	if i.replicationEnabled() {
+		if object.IfMatch != nil {
+			return errConditionalWriteReplicated
+		}
 		if replProps == nil {
 			replProps = defaultConsistency()
 		}
@@ -497,10 +502,24 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 				if replProps == nil {
 					replProps = defaultConsistency()
 				}
-				errs = i.replicator.PutObjects(ctx, shardName, group.objects,
-					replica.ConsistencyLevel(replProps.ConsistencyLevel))
+				errs = putUnconditionalObjects(group.objects,
+					func(objs []*storobj.Object) []error {
+						return i.replicator.PutObjects(ctx, shardName, objs,
+							replica.ConsistencyLevel(replProps.ConsistencyLevel))
+					},
+					func(*storobj.Object) error {
+						return errConditionalWriteReplicated
+					})
 			} else if !i.isLocalShard(shardName) {
-				errs = i.remote.BatchPutObjects(ctx, shardName, group.objects)
+				// the payload of remote batches has no room for the condition of
+				// conditional writes, so those objects are sent one by one
+				errs = putUnconditionalObjects(group.objects,
+					func(objs []*storobj.Object) []error {
+						return i.remote.BatchPutObjects(ctx, shardName, objs)
+					},
+					func(obj *storobj.Object) error {
+						return i.remote.PutObject(ctx, shardName, obj)
+					})
 			} else {
 				shard := i.shards.Load(shardName)
 				errs = shard.putObjectBatch(ctx, group.objects)
@@ -517,6 +536,37 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 	return out
 }
 
+var errConditionalWriteReplicated = objects.NewErrInvalidUserInput(
+	"conditional writes are not supported for classes with replication enabled")
+
+// putUnconditionalObjects puts all objects without an IfMatch condition as
+// one batch and hands the conditional ones to putConditional one by one. The
+// returned errors are in the order of the objects.
+func putUnconditionalObjects(objs []*storobj.Object,
+	putBatch func([]*storobj.Object) []error,
+	putConditional func(*storobj.Object) error,
+) []error {
+	out := make([]error, len(objs))
+	batch := make([]*storobj.Object, 0, len(objs))
+	batchPos := make([]int, 0, len(objs))
+	for pos, obj := range objs {
+		if obj.IfMatch != nil {
+			out[pos] = putConditional(obj)
+			continue
+		}
+		batch = append(batch, obj)
+		batchPos = append(batchPos, pos)
+	}
+
+	if len(batch) == 0 {
+		return out
+	}
+	for j, err := range putBatch(batch) {
+		out[batchPos[j]] = err
+	}
+	return out
+}
+
 func duplicateErr(in error, count int) []error {
 	out := make([]error, count)
 	for i := range out {
@@ -691,6 +741,22 @@ func (i *Index) IncomingMultiGetObjects(ctx context.Context, shardName string,
 	return objs, nil
 }
 
+func (i *Index) IncomingObjectsByDocID(ctx context.Context, shardName string,
+	docIDs []uint64,
+) ([]*storobj.Object, error) {
+	shard := i.shards.Load(shardName)
+	if shard == nil {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	objs, err := shard.objectsByDocID(docIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return objs, nil
+}
+
 func (i *Index) multiObjectByID(ctx context.Context,
 	query []multi.Identifier,
 ) ([]*storobj.Object, error) {
@@ -1205,6 +1271,9 @@ func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 	}
 
 	if i.replicationEnabled() {
+		if merge.IfMatch != nil {
+			return errConditionalWriteReplicated
+		}
 		if replProps == nil {
 			replProps = defaultConsistency()
 		}
@@ -1503,6 +1572,81 @@ func (i *Index) IncomingDeleteObjectBatch(ctx context.Context, shardName string,
 	return shard.deleteObjectBatch(ctx, docIDs, dryRun)
 }
 
+// batchUpdatePageSize is the number of matching objects read at once by an
+// update-by-query
+const batchUpdatePageSize = 100
+
+// batchUpdateObjects resolves the filter shard by shard and merges into the
+// matching objects page by page. Each merge goes through mergeObject, so it is
+// applied by the shard which owns the object, or by all of its replicas. It
+// returns the results of the merges and the total number of matches.
+func (i *Index) batchUpdateObjects(ctx context.Context, filters *filters.LocalFilter,
+	dryRun bool, merge objects.BatchUpdateMergeFunc,
+	replProps *additional.ReplicationProperties,
+) (objects.BatchSimpleObjects, int64, error) {
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+
+	var (
+		out     objects.BatchSimpleObjects
+		matches int64
+	)
+	for _, shardName := range shardState.AllPhysicalShards() {
+		local := shardState.IsShardLocal(shardName)
+
+		var docIDs []uint64
+		var err error
+		if local {
+			docIDs, err = i.shards.Load(shardName).findDocIDs(ctx, filters)
+		} else {
+			docIDs, err = i.remote.FindDocIDs(ctx, shardName, filters)
+		}
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "shard %s", shardName)
+		}
+		matches += int64(len(docIDs))
+
+		for start := 0; start < len(docIDs); start += batchUpdatePageSize {
+			end := start + batchUpdatePageSize
+			if end > len(docIDs) {
+				end = len(docIDs)
+			}
+
+			var objs []*storobj.Object
+			if local {
+				objs, err = i.shards.Load(shardName).objectsByDocID(docIDs[start:end])
+			} else {
+				objs, err = i.remote.ObjectsByDocID(ctx, shardName, docIDs[start:end])
+			}
+			if err != nil {
+				return nil, 0, errors.Wrapf(err, "shard %s", shardName)
+			}
+
+			// objects which were deleted or updated since the filter was
+			// resolved are not part of the page
+			for _, obj := range objs {
+				res := objects.BatchSimpleObject{UUID: obj.ID()}
+				if !dryRun {
+					res.Err = i.batchUpdateObject(ctx, obj, merge, replProps)
+				}
+				out = append(out, res)
+			}
+		}
+	}
+
+	return out, matches, nil
+}
+
+func (i *Index) batchUpdateObject(ctx context.Context, obj *storobj.Object,
+	merge objects.BatchUpdateMergeFunc, replProps *additional.ReplicationProperties,
+) error {
+	mergeDoc, err := merge(ctx, obj.SearchResult(additional.Properties{Vector: true}))
+	if err != nil {
+		return err
+	}
+
+	return i.mergeObject(ctx, *mergeDoc, replProps)
+}
+
 func defaultConsistency(l ...replica.ConsistencyLevel) *additional.ReplicationProperties {
 	rp := &additional.ReplicationProperties{}
 	if len(l) != 0 {
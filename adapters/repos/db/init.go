@@ -65,7 +65,9 @@ func (db *DB) init(ctx context.Context) error {
 				MemtablesMinActiveSeconds: db.config.MemtablesMinActiveSeconds,
 				MemtablesMaxActiveSeconds: db.config.MemtablesMaxActiveSeconds,
 				TrackVectorDimensions:     db.config.TrackVectorDimensions,
+				BulkLoad:                  db.config.BulkLoad,
 				ReplicationFactor:         class.ReplicationConfig.Factor,
+				ChangeDataCapture:         db.config.ChangeDataCapture,
 			}, db.schemaGetter.ShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
 				class.VectorIndexConfig.(schema.VectorIndexConfig),
@@ -25,8 +25,16 @@ type IsFallbackToSearchable func() bool
 type Countable struct {
 	Data          []byte
 	TermFrequency float32
+	// Positions of the term within the (tokenized) property value, only set
+	// if the analyzer was created with NewPositionalAnalyzer
+	Positions []uint32
 }
 
+// positionGapBetweenArrayElements is added to the term positions between the
+// elements of a text array, so that phrase and proximity queries do not match
+// across elements
+const positionGapBetweenArrayElements = 100
+
 type Property struct {
 	Name               string
 	Items              []Countable
@@ -37,6 +45,8 @@ type Property struct {
 
 type Analyzer struct {
 	isFallbackToSearchable IsFallbackToSearchable
+	withPositions          bool
+	textAnalyzers          map[string]*helpers.TextAnalyzer
 }
 
 // Text tokenizes given input according to selected tokenization,
@@ -48,9 +58,19 @@ func (a *Analyzer) Text(tokenization, in string) []Countable {
 // TextArray tokenizes given input according to selected tokenization,
 // then aggregates duplicates
 func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
+	return a.TextArrayWithAnalyzer(helpers.NewTokenizationAnalyzer(tokenization), inArr)
+}
+
+// TextArrayWithAnalyzer tokenizes given input with the text analyzer, then
+// aggregates duplicates
+func (a *Analyzer) TextArrayWithAnalyzer(textAnalyzer *helpers.TextAnalyzer, inArr []string) []Countable {
+	if a.withPositions {
+		return a.textArrayWithPositions(textAnalyzer, inArr)
+	}
+
 	var terms []string
 	for _, in := range inArr {
-		terms = append(terms, helpers.Tokenize(tokenization, in)...)
+		terms = append(terms, textAnalyzer.Tokenize(in)...)
 	}
 
 	counts := map[string]uint64{}
@@ -70,6 +90,45 @@ func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
 	return countable
 }
 
+func (a *Analyzer) textArrayWithPositions(textAnalyzer *helpers.TextAnalyzer, inArr []string) []Countable {
+	positions := map[string][]uint32{}
+	var order []string
+
+	position := uint32(0)
+	for i, in := range inArr {
+		if i > 0 {
+			position += positionGapBetweenArrayElements
+		}
+		for _, term := range textAnalyzer.Tokenize(in) {
+			if _, ok := positions[term]; !ok {
+				order = append(order, term)
+			}
+			positions[term] = append(positions[term], position)
+			position++
+		}
+	}
+
+	countable := make([]Countable, len(order))
+	for i, term := range order {
+		countable[i] = Countable{
+			Data:          []byte(term),
+			TermFrequency: float32(len(positions[term])),
+			Positions:     positions[term],
+		}
+	}
+	return countable
+}
+
+// textArrayForProp analyzes the text of the property with the text analyzer
+// it references or with its tokenization
+func (a *Analyzer) textArrayForProp(prop *models.Property, inArr []string) ([]Countable, error) {
+	textAnalyzer, err := TextAnalyzerForProperty(prop, a.textAnalyzers)
+	if err != nil {
+		return nil, err
+	}
+	return a.TextArrayWithAnalyzer(textAnalyzer, inArr), nil
+}
+
 // Int requires no analysis, so it's actually just a simple conversion to a
 // string-formatted byte slice of the int
 func (a *Analyzer) Int(in int64) ([]Countable, error) {
@@ -219,3 +278,19 @@ func NewAnalyzer(isFallbackToSearchable IsFallbackToSearchable) *Analyzer {
 	}
 	return &Analyzer{isFallbackToSearchable: isFallbackToSearchable}
 }
+
+// NewPositionalAnalyzer creates an analyzer which additionally records the
+// positions of each term of a text or text array, as required for phrase and
+// proximity queries
+func NewPositionalAnalyzer(isFallbackToSearchable IsFallbackToSearchable) *Analyzer {
+	a := NewAnalyzer(isFallbackToSearchable)
+	a.withPositions = true
+	return a
+}
+
+// WithTextAnalyzers sets the named text analyzers of the class, which are
+// used for the properties that reference them
+func (a *Analyzer) WithTextAnalyzers(textAnalyzers map[string]*helpers.TextAnalyzer) *Analyzer {
+	a.textAnalyzers = textAnalyzers
+	return a
+}
@@ -456,3 +456,25 @@ type fakeStopwordDetector struct{}
 func (fsd fakeStopwordDetector) IsStopword(word string) bool {
 	return false
 }
+
+func TestPositionalAnalyzer(t *testing.T) {
+	a := NewPositionalAnalyzer(nil)
+
+	t.Run("with text", func(t *testing.T) {
+		countable := a.Text(models.PropertyTokenizationWord, "New York, new ideas")
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("new"), TermFrequency: 2, Positions: []uint32{0, 2}},
+			{Data: []byte("york"), TermFrequency: 1, Positions: []uint32{1}},
+			{Data: []byte("ideas"), TermFrequency: 1, Positions: []uint32{3}},
+		}, countable)
+	})
+
+	t.Run("with text array", func(t *testing.T) {
+		countable := a.TextArray(models.PropertyTokenizationWord, []string{"new york", "york pass"})
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("new"), TermFrequency: 1, Positions: []uint32{0}},
+			{Data: []byte("york"), TermFrequency: 2, Positions: []uint32{1, 2 + positionGapBetweenArrayElements}},
+			{Data: []byte("pass"), TermFrequency: 1, Positions: []uint32{3 + positionGapBetweenArrayElements}},
+		}, countable)
+	})
+}
@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+const (
+	// fuzzinessAuto derives the maximum edit distance from the length of the
+	// term, it is set by a tilde without a number, e.g. colour~
+	fuzzinessAuto = -1
+	maxFuzziness  = 2
+	// defaultFuzzyPenalty halves the weight of a matched term for each edit
+	defaultFuzzyPenalty = 0.5
+)
+
+var bm25FuzzyTermRegexp = regexp.MustCompile(`^([^"~]+)~(\d*)$`)
+
+// bm25FuzzyTerm is a part of a BM25 query, such as colour~1, which also
+// matches all terms within the given edit distance
+type bm25FuzzyTerm struct {
+	text        string
+	maxDistance int
+}
+
+// maxDistanceFor resolves the automatic fuzziness for a term, which allows
+// no edits for terms of up to two characters, one edit for up to five
+// characters and two edits for longer terms
+func (t bm25FuzzyTerm) maxDistanceFor(term string) int {
+	if t.maxDistance != fuzzinessAuto {
+		return t.maxDistance
+	}
+
+	switch length := utf8.RuneCountInString(term); {
+	case length <= 2:
+		return 0
+	case length <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// parseBM25FuzzyTerms extracts all terms with a fuzziness from the query.
+// If a default fuzziness is set, it applies to all terms of the query which
+// have no explicit fuzziness. Parts of phrases are left in the query.
+func parseBM25FuzzyTerms(query string, defaultFuzziness int) (string, []bm25FuzzyTerm, error) {
+	if defaultFuzziness < 0 || defaultFuzziness > maxFuzziness {
+		return "", nil, fmt.Errorf("fuzziness must be between 0 and %d, got %d",
+			maxFuzziness, defaultFuzziness)
+	}
+
+	var fuzzyTerms []bm25FuzzyTerm
+	rest := make([]string, 0)
+	for _, field := range strings.Fields(query) {
+		match := bm25FuzzyTermRegexp.FindStringSubmatch(field)
+		if match == nil {
+			if defaultFuzziness > 0 && !strings.ContainsAny(field, `"~`) {
+				fuzzyTerms = append(fuzzyTerms, bm25FuzzyTerm{text: field, maxDistance: defaultFuzziness})
+			} else {
+				rest = append(rest, field)
+			}
+			continue
+		}
+
+		maxDistance := fuzzinessAuto
+		if match[2] != "" {
+			maxDistance, _ = strconv.Atoi(match[2])
+			if maxDistance > maxFuzziness {
+				return "", nil, fmt.Errorf("fuzziness of term '%s' must not exceed %d",
+					match[1], maxFuzziness)
+			}
+		}
+		fuzzyTerms = append(fuzzyTerms, bm25FuzzyTerm{text: match[1], maxDistance: maxDistance})
+	}
+
+	return strings.Join(rest, " "), fuzzyTerms, nil
+}
+
+// expandFuzzyTerms looks up the terms in the searchable indexes of the
+// properties which are within the edit distance of the fuzzy terms. The
+// weight of a matched term is reduced by the penalty for each edit. Terms
+// which are already part of the query are skipped, as they are scored anyway.
+func (b *BM25Searcher) expandFuzzyTerms(fuzzyTerms []bm25FuzzyTerm,
+	tokenize func(string) []string, propNames []string, queryTerms []string,
+	penalty float64,
+) ([]string, []float64, error) {
+	weights := map[string]float64{}
+	for _, fuzzyTerm := range fuzzyTerms {
+		for _, term := range tokenize(fuzzyTerm.text) {
+			maxDistance := fuzzyTerm.maxDistanceFor(term)
+			for _, propName := range propNames {
+				bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+				if bucket == nil {
+					return nil, nil, fmt.Errorf("could not find bucket for property %v", propName)
+				}
+
+				for match, distance := range fuzzyMatches(bucket, term, maxDistance) {
+					if weight := math.Pow(1-penalty, float64(distance)); weight > weights[match] {
+						weights[match] = weight
+					}
+				}
+			}
+		}
+	}
+
+	for _, queryTerm := range queryTerms {
+		delete(weights, queryTerm)
+	}
+
+	terms := make([]string, 0, len(weights))
+	for term := range weights {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+
+	termWeights := make([]float64, len(terms))
+	for i, term := range terms {
+		termWeights[i] = weights[term]
+	}
+	return terms, termWeights, nil
+}
+
+// fuzzyMatches returns all keys of the bucket within the maximum edit
+// distance of the term together with their distance. The keys are visited in
+// sorted order, which allows to skip all keys sharing a prefix as soon as the
+// automaton rejects the prefix.
+func fuzzyMatches(b *lsmkv.Bucket, term string, maxDistance int) map[string]int {
+	automaton := newLevenshteinAutomaton(term, maxDistance)
+	c := b.MapCursorKeyOnly()
+	defer c.Close()
+
+	out := map[string]int{}
+	for k, _ := c.First(); k != nil; {
+		state := automaton.start()
+		rejectedPrefix := -1
+		for pos := 0; pos < len(k); {
+			r, size := utf8.DecodeRune(k[pos:])
+			state = automaton.step(state, r)
+			pos += size
+			if !automaton.canMatch(state) {
+				rejectedPrefix = pos
+				break
+			}
+		}
+
+		if rejectedPrefix < 0 {
+			if automaton.isMatch(state) {
+				out[string(k)] = automaton.distance(state)
+			}
+			k, _ = c.Next()
+			continue
+		}
+
+		next, ok := prefixSuccessor(k[:rejectedPrefix])
+		if !ok {
+			break
+		}
+		k, _ = c.Seek(next)
+	}
+
+	return out
+}
+
+// prefixSuccessor returns the smallest key which is greater than all keys
+// starting with the prefix
+func prefixSuccessor(prefix []byte) ([]byte, bool) {
+	next := make([]byte, len(prefix))
+	copy(next, prefix)
+	for i := len(next) - 1; i >= 0; i-- {
+		if next[i] < 0xff {
+			next[i]++
+			return next[:i+1], true
+		}
+	}
+	return nil, false
+}
+
+// levenshteinAutomaton accepts all words within the maximum edit distance of
+// the term. Its state is the row of the edit distance matrix of the term and
+// the input consumed so far.
+type levenshteinAutomaton struct {
+	term        []rune
+	maxDistance int
+}
+
+func newLevenshteinAutomaton(term string, maxDistance int) *levenshteinAutomaton {
+	return &levenshteinAutomaton{term: []rune(term), maxDistance: maxDistance}
+}
+
+func (a *levenshteinAutomaton) start() []int {
+	state := make([]int, len(a.term)+1)
+	for i := range state {
+		state[i] = i
+	}
+	return state
+}
+
+func (a *levenshteinAutomaton) step(state []int, r rune) []int {
+	next := make([]int, len(state))
+	next[0] = state[0] + 1
+	for i := 1; i < len(state); i++ {
+		substitution := state[i-1]
+		if a.term[i-1] != r {
+			substitution++
+		}
+		next[i] = minInt(substitution, minInt(state[i]+1, next[i-1]+1))
+	}
+	return next
+}
+
+func (a *levenshteinAutomaton) distance(state []int) int {
+	return state[len(state)-1]
+}
+
+func (a *levenshteinAutomaton) isMatch(state []int) bool {
+	return a.distance(state) <= a.maxDistance
+}
+
+// canMatch indicates whether any word starting with the consumed input can
+// still be accepted
+func (a *levenshteinAutomaton) canMatch(state []int) bool {
+	for _, distance := range state {
+		if distance <= a.maxDistance {
+			return true
+		}
+	}
+	return false
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
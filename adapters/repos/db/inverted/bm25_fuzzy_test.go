@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBM25FuzzyTerms(t *testing.T) {
+	tests := []struct {
+		name               string
+		query              string
+		fuzziness          int
+		expectedQuery      string
+		expectedFuzzyTerms []bm25FuzzyTerm
+		expectedErr        string
+	}{
+		{
+			name:          "no fuzzy terms",
+			query:         "journey to  the moon",
+			expectedQuery: "journey to the moon",
+		},
+		{
+			name:               "explicit fuzziness",
+			query:              "colour~1 of the moon~2",
+			expectedQuery:      "of the",
+			expectedFuzzyTerms: []bm25FuzzyTerm{{text: "colour", maxDistance: 1}, {text: "moon", maxDistance: 2}},
+		},
+		{
+			name:               "automatic fuzziness",
+			query:              "colour~ moon",
+			expectedQuery:      "moon",
+			expectedFuzzyTerms: []bm25FuzzyTerm{{text: "colour", maxDistance: fuzzinessAuto}},
+		},
+		{
+			name:               "default fuzziness",
+			query:              "colour moon~0",
+			fuzziness:          1,
+			expectedQuery:      "",
+			expectedFuzzyTerms: []bm25FuzzyTerm{{text: "colour", maxDistance: 1}, {text: "moon", maxDistance: 0}},
+		},
+		{
+			name:          "proximity of a phrase",
+			query:         `"new york"~2`,
+			fuzziness:     1,
+			expectedQuery: `"new york"~2`,
+		},
+		{
+			name:        "fuzziness of term too high",
+			query:       "colour~3",
+			expectedErr: "fuzziness of term 'colour' must not exceed 2",
+		},
+		{
+			name:        "default fuzziness too high",
+			query:       "colour",
+			fuzziness:   3,
+			expectedErr: "fuzziness must be between 0 and 2, got 3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, fuzzyTerms, err := parseBM25FuzzyTerms(tt.query, tt.fuzziness)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedQuery, query)
+			assert.Equal(t, tt.expectedFuzzyTerms, fuzzyTerms)
+		})
+	}
+}
+
+func TestFuzzyTermMaxDistance(t *testing.T) {
+	auto := bm25FuzzyTerm{maxDistance: fuzzinessAuto}
+	assert.Equal(t, 0, auto.maxDistanceFor("ab"))
+	assert.Equal(t, 1, auto.maxDistanceFor("moon"))
+	assert.Equal(t, 2, auto.maxDistanceFor("journey"))
+	assert.Equal(t, 1, bm25FuzzyTerm{maxDistance: 1}.maxDistanceFor("journey"))
+}
+
+func TestLevenshteinAutomaton(t *testing.T) {
+	tests := []struct {
+		word             string
+		expectedDistance int
+	}{
+		{word: "colour", expectedDistance: 0},
+		{word: "color", expectedDistance: 1},
+		{word: "colours", expectedDistance: 1},
+		{word: "cloour", expectedDistance: 2},
+		{word: "dolor", expectedDistance: 2},
+		{word: "col", expectedDistance: 3},
+		{word: "côlour", expectedDistance: 1},
+	}
+
+	automaton := newLevenshteinAutomaton("colour", 2)
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			state := automaton.start()
+			for _, r := range tt.word {
+				state = automaton.step(state, r)
+			}
+			assert.Equal(t, tt.expectedDistance, automaton.distance(state))
+			assert.Equal(t, tt.expectedDistance <= 2, automaton.isMatch(state))
+		})
+	}
+
+	t.Run("rejects prefixes", func(t *testing.T) {
+		state := automaton.start()
+		for _, r := range "xyz" {
+			state = automaton.step(state, r)
+		}
+		assert.False(t, automaton.canMatch(state))
+	})
+}
+
+func TestPrefixSuccessor(t *testing.T) {
+	next, ok := prefixSuccessor([]byte("col"))
+	require.True(t, ok)
+	assert.Equal(t, []byte("com"), next)
+
+	next, ok = prefixSuccessor([]byte{'a', 0xff})
+	require.True(t, ok)
+	assert.Equal(t, []byte("b"), next)
+
+	_, ok = prefixSuccessor([]byte{0xff, 0xff})
+	assert.False(t, ok)
+}
@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+)
+
+// bm25Phrase is a quoted part of a BM25 query, such as "new york" or
+// "new york"~2. All terms of the phrase need to appear in the given order
+// with at most slop other terms in between.
+type bm25Phrase struct {
+	text string
+	slop int
+}
+
+// parseBM25Phrases extracts all phrases from the query. The returned query
+// contains the text of the phrases without the quotes and slop, so that the
+// terms of a phrase still contribute to the BM25F score.
+func parseBM25Phrases(query string) (string, []bm25Phrase) {
+	var (
+		phrases []bm25Phrase
+		out     strings.Builder
+	)
+
+	rest := query
+	for {
+		start := strings.IndexByte(rest, '"')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(rest[start+1:], '"')
+		if end < 0 {
+			// unterminated quote, treat it as regular text
+			break
+		}
+		end += start + 1
+
+		phrase := bm25Phrase{text: rest[start+1 : end]}
+		out.WriteString(rest[:start])
+		out.WriteString(" ")
+		out.WriteString(phrase.text)
+		out.WriteString(" ")
+		rest = rest[end+1:]
+
+		if strings.HasPrefix(rest, "~") {
+			digits := 0
+			for digits+1 < len(rest) && rest[digits+1] >= '0' && rest[digits+1] <= '9' {
+				digits++
+			}
+			if digits > 0 {
+				phrase.slop, _ = strconv.Atoi(rest[1 : digits+1])
+				rest = rest[digits+1:]
+			}
+		}
+
+		if strings.TrimSpace(phrase.text) != "" {
+			phrases = append(phrases, phrase)
+		}
+	}
+	out.WriteString(rest)
+
+	return out.String(), phrases
+}
+
+// phraseDocIDs returns the ids of all documents which contain the phrase in
+// at least one of the given properties. The properties need to be indexed
+// with positions.
+func (b *BM25Searcher) phraseDocIDs(phrase bm25Phrase,
+	propNames []string, textAnalyzers map[string]*helpers.TextAnalyzer,
+) (*sroar.Bitmap, error) {
+	out := sroar.NewBitmap()
+
+	for _, propName := range propNames {
+		queryTerms := textAnalyzers[propName].Tokenize(phrase.text)
+		if len(queryTerms) == 0 {
+			continue
+		}
+
+		if err := b.addDocIDsContainingTerms(out, propName, queryTerms, true, phrase.slop); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// addDocIDsContainingTerms adds the ids of the documents which contain all of
+// the already analyzed terms in the property to out. With matchPositions the
+// terms additionally need to appear in order with at most slop other terms in
+// between.
+func (b *BM25Searcher) addDocIDsContainingTerms(out *sroar.Bitmap, propName string,
+	terms []string, matchPositions bool, slop int,
+) error {
+	bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+	if bucket == nil {
+		return fmt.Errorf("could not find bucket for property %v", propName)
+	}
+
+	var candidates map[uint64][][]uint32
+	for i, term := range terms {
+		pairs, err := bucket.MapList([]byte(term))
+		if err != nil {
+			return err
+		}
+
+		next := make(map[uint64][][]uint32, len(pairs))
+		for _, pair := range pairs {
+			docID := binary.BigEndian.Uint64(pair.Key)
+			if i == 0 {
+				next[docID] = [][]uint32{positionsFromValue(pair.Value)}
+			} else if positions, ok := candidates[docID]; ok {
+				next[docID] = append(positions, positionsFromValue(pair.Value))
+			}
+		}
+		candidates = next
+
+		if len(candidates) == 0 {
+			return nil
+		}
+	}
+
+	for docID, positions := range candidates {
+		if !matchPositions || positionsMatchPhrase(positions, slop) {
+			out.Set(docID)
+		}
+	}
+
+	return nil
+}
+
+// positionsFromValue parses the term positions that follow the frequency and
+// the property length in the value of a searchable bucket
+func positionsFromValue(value []byte) []uint32 {
+	if len(value) <= 8 {
+		return nil
+	}
+
+	positions := make([]uint32, (len(value)-8)/4)
+	for i := range positions {
+		positions[i] = binary.LittleEndian.Uint32(value[8+4*i : 12+4*i])
+	}
+	return positions
+}
+
+// positionsMatchPhrase checks whether there is an occurrence of each term (in
+// order) such that no more than slop other terms are in between. The
+// positions of each term are expected to be sorted in ascending order.
+func positionsMatchPhrase(positions [][]uint32, slop int) bool {
+	if len(positions) == 0 {
+		return false
+	}
+
+	for _, start := range positions[0] {
+		// for a given start, picking the earliest possible position for each
+		// following term results in the shortest span
+		prev := start
+		for _, termPositions := range positions[1:] {
+			i := sort.Search(len(termPositions), func(i int) bool {
+				return termPositions[i] > prev
+			})
+			if i == len(termPositions) {
+				// if there is no match for this start, any later start can't
+				// have a match either
+				return false
+			}
+			prev = termPositions[i]
+		}
+
+		if int(prev-start)-(len(positions)-1) <= slop {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBM25Phrases(t *testing.T) {
+	tests := []struct {
+		name            string
+		query           string
+		expectedQuery   string
+		expectedPhrases []bm25Phrase
+	}{
+		{
+			name:          "no phrases",
+			query:         "new york",
+			expectedQuery: "new york",
+		},
+		{
+			name:            "single phrase",
+			query:           `"new york"`,
+			expectedQuery:   "new york",
+			expectedPhrases: []bm25Phrase{{text: "new york"}},
+		},
+		{
+			name:            "phrase with slop and additional terms",
+			query:           `hotels in "new york"~3 cheap`,
+			expectedQuery:   "hotels in new york cheap",
+			expectedPhrases: []bm25Phrase{{text: "new york", slop: 3}},
+		},
+		{
+			name:          "tilde without a number",
+			query:         `"new york"~ city`,
+			expectedQuery: "new york ~ city",
+			expectedPhrases: []bm25Phrase{
+				{text: "new york"},
+			},
+		},
+		{
+			name:          "multiple phrases",
+			query:         `"new york" or "los angeles"~1`,
+			expectedQuery: "new york or los angeles",
+			expectedPhrases: []bm25Phrase{
+				{text: "new york"},
+				{text: "los angeles", slop: 1},
+			},
+		},
+		{
+			name:          "unterminated quote",
+			query:         `"new york" "city`,
+			expectedQuery: `new york "city`,
+			expectedPhrases: []bm25Phrase{
+				{text: "new york"},
+			},
+		},
+		{
+			name:          "empty phrase",
+			query:         `"" york`,
+			expectedQuery: "york",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, phrases := parseBM25Phrases(tt.query)
+			assert.Equal(t, tt.expectedQuery, strings.Join(strings.Fields(query), " "))
+			assert.Equal(t, tt.expectedPhrases, phrases)
+		})
+	}
+}
+
+func TestPositionsMatchPhrase(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions [][]uint32
+		slop      int
+		expected  bool
+	}{
+		{
+			name:      "single term",
+			positions: [][]uint32{{4}},
+			expected:  true,
+		},
+		{
+			name:      "adjacent terms",
+			positions: [][]uint32{{0, 7}, {8}},
+			expected:  true,
+		},
+		{
+			name:      "terms in wrong order",
+			positions: [][]uint32{{3}, {2}},
+			slop:      5,
+			expected:  false,
+		},
+		{
+			name:      "terms too far apart",
+			positions: [][]uint32{{0}, {3}},
+			slop:      1,
+			expected:  false,
+		},
+		{
+			name:      "terms within slop",
+			positions: [][]uint32{{0}, {3}},
+			slop:      2,
+			expected:  true,
+		},
+		{
+			name:      "later occurrence matches",
+			positions: [][]uint32{{0, 10}, {5, 11}, {12}},
+			expected:  true,
+		},
+		{
+			name:      "repeated term",
+			positions: [][]uint32{{1, 2}, {1, 2}},
+			expected:  true,
+		},
+		{
+			name:      "repeated term only once",
+			positions: [][]uint32{{1}, {1}},
+			slop:      3,
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, positionsMatchPhrase(tt.positions, tt.slop))
+		})
+	}
+}
+
+func TestPositionsFromValue(t *testing.T) {
+	value := make([]byte, 16)
+	binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(2))
+	binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(10))
+	binary.LittleEndian.PutUint32(value[8:12], 3)
+	binary.LittleEndian.PutUint32(value[12:16], 7)
+
+	assert.Equal(t, []uint32{3, 7}, positionsFromValue(value))
+	assert.Nil(t, positionsFromValue(value[:8]))
+}
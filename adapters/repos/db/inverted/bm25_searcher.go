@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/weaviate/sroar"
@@ -111,8 +112,14 @@ func (b *BM25Searcher) wand(
 		}
 	}
 
+	textAnalyzers, err := TextAnalyzersFromConfig(class.InvertedIndexConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// There are currently cases, for different tokenization:
-	// word, lowercase, whitespace and field.
+	// word, lowercase, whitespace, field, trigram and cjk.
+	// Properties with a text analyzer are handled as an additional case per analyzer.
 	// Query is tokenized and respective properties are then searched for the search terms,
 	// results at the end are combined using WAND
 	tokenizationsOrdered := []string{
@@ -120,15 +127,42 @@ func (b *BM25Searcher) wand(
 		models.PropertyTokenizationLowercase,
 		models.PropertyTokenizationWhitespace,
 		models.PropertyTokenizationField,
+		models.PropertyTokenizationTrigram,
+		models.PropertyTokenizationCjk,
+	}
+
+	// phrases ("new york") and proximity ("new york"~2) can only be served if
+	// term positions are indexed, otherwise quotes are ignored as before
+	query := params.Query
+	var phrases []bm25Phrase
+	if class.InvertedIndexConfig != nil && class.InvertedIndexConfig.IndexPositions {
+		query, phrases = parseBM25Phrases(params.Query)
+	}
+
+	// terms with a fuzziness (colour~1) are expanded to the similar terms of
+	// the indexes once the properties of each tokenization are known
+	query, fuzzyTerms, err := parseBM25FuzzyTerms(query, params.Fuzziness)
+	if err != nil {
+		return nil, nil, err
+	}
+	fuzzyPenalty := defaultFuzzyPenalty
+	if params.FuzzyPenalty != nil {
+		fuzzyPenalty = *params.FuzzyPenalty
+		if fuzzyPenalty < 0 || fuzzyPenalty > 1 {
+			return nil, nil, fmt.Errorf("fuzzyPenalty must be between 0 and 1, got %v", fuzzyPenalty)
+		}
 	}
 
 	queryTermsByTokenization := map[string][]string{}
 	duplicateBoostsByTokenization := map[string][]int{}
 	propNamesByTokenization := map[string][]string{}
 	propertyBoosts := make(map[string]float32, len(params.Properties))
+	textAnalyzerByPropName := make(map[string]*helpers.TextAnalyzer, len(params.Properties))
+	tokenizeByTokenization := map[string]func(string) []string{}
 
 	for _, tokenization := range tokenizationsOrdered {
-		queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
+		tokenizeByTokenization[tokenization] = queryTokenizer(helpers.NewTokenizationAnalyzer(tokenization), stopWordDetector)
+		queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = helpers.TokenizeAndCountDuplicates(tokenization, query)
 
 		// stopword filtering for word tokenization
 		if tokenization == models.PropertyTokenizationWord {
@@ -138,6 +172,21 @@ func (b *BM25Searcher) wand(
 		propNamesByTokenization[tokenization] = make([]string, 0)
 	}
 
+	if class.InvertedIndexConfig != nil {
+		for _, analyzerConf := range class.InvertedIndexConfig.Analyzers {
+			if analyzerConf == nil {
+				continue
+			}
+			group := textAnalyzerGroup(analyzerConf.Name)
+			tokenize := queryTokenizer(textAnalyzers[analyzerConf.Name], stopWordDetector)
+
+			queryTermsByTokenization[group], duplicateBoostsByTokenization[group] = helpers.CountDuplicates(tokenize(query))
+			tokenizeByTokenization[group] = tokenize
+			propNamesByTokenization[group] = make([]string, 0)
+			tokenizationsOrdered = append(tokenizationsOrdered, group)
+		}
+	}
+
 	averagePropLength := 0.
 	for _, propertyWithBoost := range params.Properties {
 		property := propertyWithBoost
@@ -162,11 +211,41 @@ func (b *BM25Searcher) wand(
 
 		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
 		case schema.DataTypeText, schema.DataTypeTextArray:
-			if _, exists := propNamesByTokenization[prop.Tokenization]; !exists {
+			textAnalyzer, err := TextAnalyzerForProperty(prop, textAnalyzers)
+			if err != nil {
+				return nil, nil, err
+			}
+			group := prop.Tokenization
+			if prop.Analyzer != "" {
+				group = textAnalyzerGroup(prop.Analyzer)
+			}
+			if _, exists := propNamesByTokenization[group]; !exists {
 				return nil, nil, fmt.Errorf("cannot handle tokenization '%v' of property '%s'",
 					prop.Tokenization, prop.Name)
 			}
-			propNamesByTokenization[prop.Tokenization] = append(propNamesByTokenization[prop.Tokenization], property)
+			if prop.StopwordsPreset != "" {
+				// properties with their own stopword preset need their own
+				// query terms, as different stopwords are removed from the query
+				group = stopwordsPresetGroup(group, prop.StopwordsPreset)
+				if _, exists := propNamesByTokenization[group]; !exists {
+					var stopwordConfig *models.StopwordConfig
+					if class.InvertedIndexConfig != nil {
+						stopwordConfig = class.InvertedIndexConfig.Stopwords
+					}
+					detector, err := stopwords.NewDetectorForProperty(stopwordConfig, prop)
+					if err != nil {
+						return nil, nil, err
+					}
+					tokenize := queryTokenizer(textAnalyzer, detector)
+
+					queryTermsByTokenization[group], duplicateBoostsByTokenization[group] = helpers.CountDuplicates(tokenize(query))
+					tokenizeByTokenization[group] = tokenize
+					propNamesByTokenization[group] = make([]string, 0)
+					tokenizationsOrdered = append(tokenizationsOrdered, group)
+				}
+			}
+			propNamesByTokenization[group] = append(propNamesByTokenization[group], property)
+			textAnalyzerByPropName[property] = textAnalyzer
 		default:
 			return nil, nil, fmt.Errorf("cannot handle datatype '%v' of property '%s'", dt, prop.Name)
 		}
@@ -174,6 +253,86 @@ func (b *BM25Searcher) wand(
 
 	averagePropLength = averagePropLength / float64(len(params.Properties))
 
+	if len(phrases) > 0 {
+		filterDocIds, err = b.filterByPhrases(filterDocIds, phrases, textAnalyzerByPropName)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "phrases")
+		}
+	}
+
+	queryTermWeightsByTokenization := make(map[string][]float64, len(duplicateBoostsByTokenization))
+	for tokenization, duplicateBoosts := range duplicateBoostsByTokenization {
+		weights := make([]float64, len(duplicateBoosts))
+		for i := range duplicateBoosts {
+			weights[i] = float64(duplicateBoosts[i])
+		}
+		queryTermWeightsByTokenization[tokenization] = weights
+	}
+
+	// synonyms are looked up per tokenization, as the configured terms have to
+	// be tokenized like the query to match its terms. The terms of synonyms
+	// consisting of several terms are only searched in the documents which
+	// contain the whole synonym, their allow lists are kept by term index.
+	termAllowListsByTokenization := map[string]map[int]helpers.AllowList{}
+	if class.InvertedIndexConfig != nil && class.InvertedIndexConfig.Synonyms != nil {
+		for _, tokenization := range tokenizationsOrdered {
+			propNames := propNamesByTokenization[tokenization]
+			if len(propNames) == 0 {
+				continue
+			}
+
+			expander := synonyms.NewExpanderFromConfig(class.InvertedIndexConfig.Synonyms,
+				tokenizeByTokenization[tokenization])
+			queryTerms := queryTermsByTokenization[tokenization]
+			expanded, weights, multiTermSynonyms := expandSynonyms(expander, queryTerms,
+				queryTermWeightsByTokenization[tokenization])
+			queryTermsByTokenization[tokenization] = append(queryTerms, expanded...)
+			queryTermWeightsByTokenization[tokenization] = append(queryTermWeightsByTokenization[tokenization], weights...)
+
+			isQueryTerm := make(map[string]struct{}, len(queryTerms))
+			for _, term := range queryTerms {
+				isQueryTerm[term] = struct{}{}
+			}
+			for _, synonym := range multiTermSynonyms {
+				allowList, err := b.synonymAllowList(filterDocIds, synonym, propNames,
+					class.InvertedIndexConfig.IndexPositions)
+				if err != nil {
+					return nil, nil, errors.Wrap(err, "synonyms")
+				}
+				for _, term := range synonym.terms {
+					// terms which are part of the query already contribute to
+					// the score on their own
+					if _, ok := isQueryTerm[term]; ok {
+						continue
+					}
+					if termAllowListsByTokenization[tokenization] == nil {
+						termAllowListsByTokenization[tokenization] = map[int]helpers.AllowList{}
+					}
+					termAllowListsByTokenization[tokenization][len(queryTermsByTokenization[tokenization])] = allowList
+					queryTermsByTokenization[tokenization] = append(queryTermsByTokenization[tokenization], term)
+					queryTermWeightsByTokenization[tokenization] = append(queryTermWeightsByTokenization[tokenization], synonym.weight)
+				}
+			}
+		}
+	}
+
+	if len(fuzzyTerms) > 0 {
+		for _, tokenization := range tokenizationsOrdered {
+			propNames := propNamesByTokenization[tokenization]
+			if len(propNames) == 0 {
+				continue
+			}
+
+			expanded, weights, err := b.expandFuzzyTerms(fuzzyTerms, tokenizeByTokenization[tokenization],
+				propNames, queryTermsByTokenization[tokenization], fuzzyPenalty)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "fuzzy terms")
+			}
+			queryTermsByTokenization[tokenization] = append(queryTermsByTokenization[tokenization], expanded...)
+			queryTermWeightsByTokenization[tokenization] = append(queryTermWeightsByTokenization[tokenization], weights...)
+		}
+	}
+
 	// preallocate the results
 	lengthAllResults := 0
 	for tokenization, propNames := range propNamesByTokenization {
@@ -191,15 +350,21 @@ func (b *BM25Searcher) wand(
 		propNames := propNamesByTokenization[tokenization]
 		if len(propNames) > 0 {
 			queryTerms := queryTermsByTokenization[tokenization]
-			duplicateBoosts := duplicateBoostsByTokenization[tokenization]
+			queryTermWeights := queryTermWeightsByTokenization[tokenization]
+			termAllowLists := termAllowListsByTokenization[tokenization]
 
 			for i := range queryTerms {
 				j := i
 				k := i + offset
 
+				allowList := filterDocIds
+				if termAllowList, ok := termAllowLists[j]; ok {
+					allowList = termAllowList
+				}
+
 				eg.Go(func() error {
-					termResult, docIndices, err := b.createTerm(N, filterDocIds, queryTerms[j], propNames,
-						propertyBoosts, duplicateBoosts[j], params.AdditionalExplanations)
+					termResult, docIndices, err := b.createTerm(N, allowList, queryTerms[j], propNames,
+						propertyBoosts, queryTermWeights[j], params.AdditionalExplanations)
 					if err != nil {
 						return err
 					}
@@ -230,6 +395,74 @@ func (b *BM25Searcher) wand(
 	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
 }
 
+// filterByPhrases restricts the allow list to the documents which contain
+// all phrases in at least one of the searched properties
+func (b *BM25Searcher) filterByPhrases(filterDocIds helpers.AllowList,
+	phrases []bm25Phrase, textAnalyzerByPropName map[string]*helpers.TextAnalyzer,
+) (helpers.AllowList, error) {
+	propNames := make([]string, 0, len(textAnalyzerByPropName))
+	for propName := range textAnalyzerByPropName {
+		propNames = append(propNames, propName)
+	}
+
+	var matches *sroar.Bitmap
+	for _, phrase := range phrases {
+		docIDs, err := b.phraseDocIDs(phrase, propNames, textAnalyzerByPropName)
+		if err != nil {
+			return nil, err
+		}
+		if matches == nil {
+			matches = docIDs
+		} else {
+			matches.And(docIDs)
+		}
+	}
+
+	if filterDocIds != nil {
+		filtered := sroar.NewBitmap()
+		for _, docID := range matches.ToArray() {
+			if filterDocIds.Contains(docID) {
+				filtered.Set(docID)
+			}
+		}
+		matches = filtered
+	}
+
+	return helpers.NewAllowListFromBitmap(matches), nil
+}
+
+// queryTokenizer splits query text into the terms of the analyzer. For word
+// tokenization stopwords are removed before the terms are altered by the
+// token filters of the analyzer.
+func queryTokenizer(textAnalyzer *helpers.TextAnalyzer, detector *stopwords.Detector) func(string) []string {
+	return func(text string) []string {
+		terms := helpers.Tokenize(textAnalyzer.Tokenization(), text)
+		if textAnalyzer.Tokenization() == models.PropertyTokenizationWord && detector != nil {
+			withoutStopwords := terms[:0]
+			for _, term := range terms {
+				if !detector.IsStopword(term) {
+					withoutStopwords = append(withoutStopwords, term)
+				}
+			}
+			terms = withoutStopwords
+		}
+		return textAnalyzer.Filter(terms)
+	}
+}
+
+// textAnalyzerGroup is the key under which the query terms and properties
+// of a text analyzer are grouped, it can't collide with a tokenization
+func textAnalyzerGroup(analyzerName string) string {
+	return "analyzer:" + analyzerName
+}
+
+// stopwordsPresetGroup is the key under which the query terms and properties
+// of a tokenization or text analyzer with a property specific stopword preset
+// are grouped
+func stopwordsPresetGroup(group, preset string) string {
+	return group + "|stopwords:" + preset
+}
+
 func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string, duplicateBoost []int, detector *stopwords.Detector) ([]string, []int) {
 	if detector == nil || len(queryTerms) == 0 {
 		return queryTerms, duplicateBoost
@@ -328,7 +561,7 @@ func (b *BM25Searcher) getTopKHeap(limit int, results terms, averagePropLength f
 	}
 }
 
-func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string, propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int, additionalExplanations bool) (term, map[uint64]int, error) {
+func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string, propertyNames []string, propertyBoosts map[string]float32, queryTermWeight float64, additionalExplanations bool) (term, map[uint64]int, error) {
 	termResult := term{queryTerm: query}
 	filteredDocIDs := sroar.NewBitmap() // to build the global n if there is a filter
 
@@ -443,7 +676,7 @@ func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, que
 	if filterDocIds != nil {
 		n += float64(filteredDocIDs.GetCardinality())
 	}
-	termResult.idf = math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * float64(duplicateTextBoost)
+	termResult.idf = math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * queryTermWeight
 
 	termResult.posPointer = 0
 	termResult.idPointer = termResult.data[0].id
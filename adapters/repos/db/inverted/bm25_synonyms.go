@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
+)
+
+// bm25Synonym is a synonym consisting of several terms, such as "mp3 player".
+// Its terms only contribute to the score of documents which contain all of
+// them, so that "player" on its own is not a match.
+type bm25Synonym struct {
+	terms  []string
+	weight float64
+}
+
+// expandSynonyms returns the synonyms of the query terms which are not query
+// terms themselves. Each synonym is weighted like the query term it was
+// derived from. Synonyms consisting of a single term are returned as
+// additional query terms, the others are returned separately, as they need to
+// be matched as a whole.
+func expandSynonyms(expander *synonyms.Expander, queryTerms []string,
+	queryTermWeights []float64,
+) ([]string, []float64, []bm25Synonym) {
+	if expander.IsEmpty() {
+		return nil, nil, nil
+	}
+
+	weights := map[string]float64{}
+	multiTermSynonyms := map[string]*bm25Synonym{}
+	for i, queryTerm := range queryTerms {
+		for _, synonym := range expander.Synonyms(queryTerm) {
+			if len(synonym) == 1 {
+				if queryTermWeights[i] > weights[synonym[0]] {
+					weights[synonym[0]] = queryTermWeights[i]
+				}
+				continue
+			}
+
+			key := strings.Join(synonym, "\x00")
+			if existing, ok := multiTermSynonyms[key]; ok {
+				if queryTermWeights[i] > existing.weight {
+					existing.weight = queryTermWeights[i]
+				}
+				continue
+			}
+			multiTermSynonyms[key] = &bm25Synonym{terms: synonym, weight: queryTermWeights[i]}
+		}
+	}
+
+	for _, queryTerm := range queryTerms {
+		delete(weights, queryTerm)
+	}
+
+	terms := make([]string, 0, len(weights))
+	for term := range weights {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+
+	termWeights := make([]float64, len(terms))
+	for i, term := range terms {
+		termWeights[i] = weights[term]
+	}
+
+	keys := make([]string, 0, len(multiTermSynonyms))
+	for key := range multiTermSynonyms {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	synonymsOut := make([]bm25Synonym, len(keys))
+	for i, key := range keys {
+		synonymsOut[i] = *multiTermSynonyms[key]
+	}
+
+	return terms, termWeights, synonymsOut
+}
+
+// synonymAllowList restricts the allow list to the documents which contain
+// all terms of the synonym in at least one of the properties. If term
+// positions are indexed, the terms need to appear as a phrase.
+func (b *BM25Searcher) synonymAllowList(filterDocIds helpers.AllowList,
+	synonym bm25Synonym, propNames []string, matchPositions bool,
+) (helpers.AllowList, error) {
+	matches := sroar.NewBitmap()
+	for _, propName := range propNames {
+		if err := b.addDocIDsContainingTerms(matches, propName, synonym.terms,
+			matchPositions, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	if filterDocIds != nil {
+		filtered := sroar.NewBitmap()
+		for _, docID := range matches.ToArray() {
+			if filterDocIds.Contains(docID) {
+				filtered.Set(docID)
+			}
+		}
+		matches = filtered
+	}
+
+	return helpers.NewAllowListFromBitmap(matches), nil
+}
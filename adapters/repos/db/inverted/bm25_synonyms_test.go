@@ -0,0 +1,41 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestExpandSynonyms(t *testing.T) {
+	expander := synonyms.NewExpanderFromConfig(&models.SynonymConfig{
+		Equivalents: [][]string{{"car", "automobile", "auto"}},
+		Mappings: []*models.SynonymMapping{
+			{Terms: []string{"ipod"}, Synonyms: []string{"mp3 player"}},
+		},
+	}, strings.Fields)
+
+	terms, weights, multiTermSynonyms := expandSynonyms(expander,
+		[]string{"car", "auto", "ipod"}, []float64{2, 1, 1})
+	assert.Equal(t, []string{"automobile"}, terms)
+	assert.Equal(t, []float64{2}, weights)
+	assert.Equal(t, []bm25Synonym{{terms: []string{"mp3", "player"}, weight: 1}}, multiTermSynonyms)
+
+	terms, weights, multiTermSynonyms = expandSynonyms(nil, []string{"car"}, []float64{1})
+	assert.Empty(t, terms)
+	assert.Empty(t, weights)
+	assert.Empty(t, multiTermSynonyms)
+}
@@ -12,6 +12,7 @@
 package inverted
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -36,6 +37,16 @@ func ValidateConfig(conf *models.InvertedIndexConfig) error {
 		return err
 	}
 
+	err = validateAnalyzersConfig(conf.Analyzers)
+	if err != nil {
+		return err
+	}
+
+	err = validateSynonymConfig(conf.Synonyms)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -45,6 +56,7 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 	conf.IndexTimestamps = iicm.IndexTimestamps
 	conf.IndexNullState = iicm.IndexNullState
 	conf.IndexPropertyLength = iicm.IndexPropertyLength
+	conf.IndexPositions = iicm.IndexPositions
 
 	if iicm.Bm25 == nil {
 		conf.BM25.K1 = float64(config.DefaultBM25k1)
@@ -135,6 +147,44 @@ func validateStopwordAdditionsRemovals(conf *models.StopwordConfig) error {
 	return nil
 }
 
+func validateSynonymConfig(conf *models.SynonymConfig) error {
+	if conf == nil {
+		return nil
+	}
+
+	for i, set := range conf.Equivalents {
+		if len(set) < 2 {
+			return errors.Errorf("synonyms.equivalents[%d] must contain at least two terms", i)
+		}
+		if err := validateSynonymEntries(set, fmt.Sprintf("synonyms.equivalents[%d]", i)); err != nil {
+			return err
+		}
+	}
+
+	for i, mapping := range conf.Mappings {
+		if mapping == nil || len(mapping.Terms) == 0 || len(mapping.Synonyms) == 0 {
+			return errors.Errorf("synonyms.mappings[%d] must contain at least one term and one synonym", i)
+		}
+		if err := validateSynonymEntries(mapping.Terms, fmt.Sprintf("synonyms.mappings[%d].terms", i)); err != nil {
+			return err
+		}
+		if err := validateSynonymEntries(mapping.Synonyms, fmt.Sprintf("synonyms.mappings[%d].synonyms", i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateSynonymEntries(entries []string, path string) error {
+	for _, entry := range entries {
+		if strings.TrimSpace(entry) == "" {
+			return errors.Errorf("cannot use whitespace in %s", path)
+		}
+	}
+	return nil
+}
+
 func removeStopwordAdditionsIfInPreset(conf *models.StopwordConfig, foundAdditions map[string]int) {
 	presets := stopwords.Presets[conf.Preset]
 
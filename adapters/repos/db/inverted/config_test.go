@@ -163,6 +163,101 @@ func TestValidateConfig(t *testing.T) {
 			assert.Equal(t, test.expectedLength, len(in.Stopwords.Additions))
 		}
 	})
+
+	t.Run("with valid analyzers", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Analyzers: []*models.TextAnalyzerConfig{
+				{Name: "english", Filters: []string{"asciifolding", "snowball_en"}},
+				{Name: "french", Tokenization: "lowercase", Filters: []string{"elision", "snowball_fr"}},
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.Nil(t, err)
+	})
+
+	t.Run("with invalid analyzers", func(t *testing.T) {
+		tests := []struct {
+			analyzers   []*models.TextAnalyzerConfig
+			expectedErr string
+		}{
+			{
+				analyzers:   []*models.TextAnalyzerConfig{{Filters: []string{"lowercase"}}},
+				expectedErr: "analyzers must have a name",
+			},
+			{
+				analyzers: []*models.TextAnalyzerConfig{
+					{Name: "english", Filters: []string{"snowball_en"}},
+					{Name: "english", Filters: []string{"lowercase"}},
+				},
+				expectedErr: "analyzer 'english' is defined more than once",
+			},
+			{
+				analyzers:   []*models.TextAnalyzerConfig{{Name: "english", Tokenization: "DNE"}},
+				expectedErr: "analyzer 'english': tokenization 'DNE' does not exist",
+			},
+			{
+				analyzers:   []*models.TextAnalyzerConfig{{Name: "english", Filters: []string{"snowball_DNE"}}},
+				expectedErr: "analyzer 'english': token filter 'snowball_DNE' does not exist",
+			},
+		}
+
+		for _, test := range tests {
+			in := &models.InvertedIndexConfig{Analyzers: test.analyzers}
+
+			err := ValidateConfig(in)
+			assert.EqualError(t, err, test.expectedErr)
+		}
+	})
+
+	t.Run("with valid synonyms", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Synonyms: &models.SynonymConfig{
+				Equivalents: [][]string{{"car", "automobile"}},
+				Mappings: []*models.SynonymMapping{
+					{Terms: []string{"ipod"}, Synonyms: []string{"mp3 player"}},
+				},
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.Nil(t, err)
+	})
+
+	t.Run("with invalid synonyms", func(t *testing.T) {
+		tests := []struct {
+			synonyms    *models.SynonymConfig
+			expectedErr string
+		}{
+			{
+				synonyms:    &models.SynonymConfig{Equivalents: [][]string{{"car"}}},
+				expectedErr: "synonyms.equivalents[0] must contain at least two terms",
+			},
+			{
+				synonyms:    &models.SynonymConfig{Equivalents: [][]string{{"car", " "}}},
+				expectedErr: "cannot use whitespace in synonyms.equivalents[0]",
+			},
+			{
+				synonyms: &models.SynonymConfig{Mappings: []*models.SynonymMapping{
+					{Terms: []string{"ipod"}},
+				}},
+				expectedErr: "synonyms.mappings[0] must contain at least one term and one synonym",
+			},
+			{
+				synonyms: &models.SynonymConfig{Mappings: []*models.SynonymMapping{
+					{Terms: []string{"ipod"}, Synonyms: []string{""}},
+				}},
+				expectedErr: "cannot use whitespace in synonyms.mappings[0].synonyms",
+			},
+		}
+
+		for _, test := range tests {
+			in := &models.InvertedIndexConfig{Synonyms: test.synonyms}
+
+			err := ValidateConfig(in)
+			assert.EqualError(t, err, test.expectedErr)
+		}
+	})
 }
 
 func TestConfigFromModel(t *testing.T) {
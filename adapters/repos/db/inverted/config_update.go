@@ -36,6 +36,16 @@ func ValidateUserConfigUpdate(initial, updated *models.InvertedIndexConfig) erro
 		return err
 	}
 
+	err = validateAnalyzersConfigUpdate(initial, updated)
+	if err != nil {
+		return err
+	}
+
+	err = validateSynonymsConfigUpdate(initial, updated)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -65,6 +75,10 @@ func validateInvertedIndexConfigUpdate(initial, updated *models.InvertedIndexCon
 		return errors.New("IndexNullState cannot be changed when updating a schema")
 	}
 
+	if updated.IndexPositions != initial.IndexPositions {
+		return errors.New("IndexPositions cannot be changed when updating a schema")
+	}
+
 	return nil
 }
 
@@ -85,3 +99,50 @@ func validateStopwordsConfigUpdate(initial, updated *models.InvertedIndexConfig)
 
 	return nil
 }
+
+// validateSynonymsConfigUpdate allows any change of the synonyms, as they
+// are only applied at query time
+func validateSynonymsConfigUpdate(initial, updated *models.InvertedIndexConfig) error {
+	if updated.Synonyms == nil {
+		updated.Synonyms = initial.Synonyms
+		return nil
+	}
+
+	return validateSynonymConfig(updated.Synonyms)
+}
+
+// validateAnalyzersConfigUpdate allows to add analyzers, but existing ones
+// can't be changed or removed, as the terms in the index were produced by them
+func validateAnalyzersConfigUpdate(initial, updated *models.InvertedIndexConfig) error {
+	updatedByName := make(map[string]*models.TextAnalyzerConfig, len(updated.Analyzers))
+	for _, analyzer := range updated.Analyzers {
+		if analyzer != nil {
+			updatedByName[analyzer.Name] = analyzer
+		}
+	}
+
+	for _, analyzer := range initial.Analyzers {
+		if analyzer == nil {
+			continue
+		}
+		other, ok := updatedByName[analyzer.Name]
+		if !ok || !analyzersEqual(analyzer, other) {
+			return errors.Errorf("analyzer '%s' cannot be changed or removed when updating a schema",
+				analyzer.Name)
+		}
+	}
+
+	return validateAnalyzersConfig(updated.Analyzers)
+}
+
+func analyzersEqual(a, b *models.TextAnalyzerConfig) bool {
+	if a.Tokenization != b.Tokenization || len(a.Filters) != len(b.Filters) {
+		return false
+	}
+	for i := range a.Filters {
+		if a.Filters[i] != b.Filters[i] {
+			return false
+		}
+	}
+	return true
+}
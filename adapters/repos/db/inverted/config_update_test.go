@@ -138,4 +138,93 @@ func TestValidateUserConfigUpdate(t *testing.T) {
 		err := ValidateUserConfigUpdate(validInitial, updated)
 		require.EqualError(t, err, "IndexPropertyLength cannot be changed when updating a schema")
 	})
+
+	t.Run("with invalid updated inverted index positions change", func(t *testing.T) {
+		updated := &models.InvertedIndexConfig{
+			IndexPositions: true,
+		}
+
+		err := ValidateUserConfigUpdate(validInitial, updated)
+		require.EqualError(t, err, "IndexPositions cannot be changed when updating a schema")
+	})
+
+	t.Run("with analyzers", func(t *testing.T) {
+		initial := &models.InvertedIndexConfig{
+			Bm25:      validInitial.Bm25,
+			Stopwords: validInitial.Stopwords,
+			Analyzers: []*models.TextAnalyzerConfig{
+				{Name: "english", Filters: []string{"snowball_en"}},
+			},
+		}
+
+		t.Run("added analyzer", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Analyzers: []*models.TextAnalyzerConfig{
+					{Name: "english", Filters: []string{"snowball_en"}},
+					{Name: "german", Filters: []string{"snowball_de"}},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+		})
+
+		t.Run("changed analyzer", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Analyzers: []*models.TextAnalyzerConfig{
+					{Name: "english", Filters: []string{"lowercase", "snowball_en"}},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, "analyzer 'english' cannot be changed or removed when updating a schema")
+		})
+
+		t.Run("removed analyzer", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, "analyzer 'english' cannot be changed or removed when updating a schema")
+		})
+	})
+
+	t.Run("with synonyms", func(t *testing.T) {
+		initial := &models.InvertedIndexConfig{
+			Bm25:      validInitial.Bm25,
+			Stopwords: validInitial.Stopwords,
+			Synonyms: &models.SynonymConfig{
+				Equivalents: [][]string{{"car", "automobile"}},
+			},
+		}
+
+		t.Run("changed synonyms", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Synonyms: &models.SynonymConfig{
+					Equivalents: [][]string{{"car", "automobile", "auto"}},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+		})
+
+		t.Run("omitted synonyms are kept", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+			assert.Equal(t, initial.Synonyms, updated.Synonyms)
+		})
+
+		t.Run("invalid synonyms", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Synonyms: &models.SynonymConfig{
+					Equivalents: [][]string{{"car"}},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, "synonyms.equivalents[0] must contain at least two terms")
+		})
+	})
 }
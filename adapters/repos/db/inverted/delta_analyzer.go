@@ -82,7 +82,8 @@ func countableDelta(prev, next []Countable) ([]Countable, []Countable) {
 
 	for _, nextItem := range next {
 		prev, ok := seenInPrev[string(nextItem.Data)]
-		if ok && prev.TermFrequency == nextItem.TermFrequency {
+		if ok && prev.TermFrequency == nextItem.TermFrequency &&
+			positionsIdentical(prev.Positions, nextItem.Positions) {
 			// we have an identical overlap, delete from old list
 			delete(seenInPrev, string(nextItem.Data))
 			// don't add to new list
@@ -111,7 +112,8 @@ func listsIdentical(a []Countable, b []Countable) bool {
 
 	for i := range a {
 		if !bytes.Equal(a[i].Data, b[i].Data) ||
-			a[i].TermFrequency != b[i].TermFrequency {
+			a[i].TermFrequency != b[i].TermFrequency ||
+			!positionsIdentical(a[i].Positions, b[i].Positions) {
 			// return as soon as an item didn't match
 			return false
 		}
@@ -122,3 +124,17 @@ func listsIdentical(a []Countable, b []Countable) bool {
 	// considerably more expensive merge
 	return true
 }
+
+func positionsIdentical(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/traverser/highlighter"
+)
+
+// HighlightAnalyzers provide the highlighter with the text analyzers and
+// stopwords which bm25 uses, so that the scored terms are highlighted
+type HighlightAnalyzers struct{}
+
+func (HighlightAnalyzers) Analyzer(class *models.Class,
+	prop *models.Property,
+) (highlighter.Analyzer, error) {
+	var textAnalyzers map[string]*helpers.TextAnalyzer
+	var stopwordConfig *models.StopwordConfig
+	if class.InvertedIndexConfig != nil {
+		var err error
+		textAnalyzers, err = TextAnalyzersFromConfig(class.InvertedIndexConfig)
+		if err != nil {
+			return nil, err
+		}
+		stopwordConfig = class.InvertedIndexConfig.Stopwords
+	}
+
+	textAnalyzer, err := TextAnalyzerForProperty(prop, textAnalyzers)
+	if err != nil {
+		return nil, err
+	}
+	detector, err := stopwords.NewDetectorForProperty(stopwordConfig, prop)
+	if err != nil {
+		return nil, err
+	}
+
+	return &highlightAnalyzer{
+		textAnalyzer: textAnalyzer,
+		tokenize:     queryTokenizer(textAnalyzer, detector),
+	}, nil
+}
+
+type highlightAnalyzer struct {
+	textAnalyzer *helpers.TextAnalyzer
+	tokenize     func(string) []string
+}
+
+func (a *highlightAnalyzer) QueryTerms(query string) []string {
+	return a.tokenize(query)
+}
+
+func (a *highlightAnalyzer) TermSpans(text []rune) []highlighter.TermSpan {
+	tokens := helpers.TokenSpans(a.textAnalyzer.Tokenization(), text)
+	out := make([]highlighter.TermSpan, len(tokens))
+	for i, token := range tokens {
+		out[i] = highlighter.TermSpan{
+			Start: token.Start,
+			End:   token.End,
+			Terms: a.textAnalyzer.Filter(token.Terms),
+		}
+	}
+	return out
+}
@@ -59,3 +59,11 @@ func optimizable(in []byte) ([]byte, bool) {
 func isWildcardCharacter(in byte) bool {
 	return in == '?' || in == '*'
 }
+
+// likeFragments returns the literal parts of a like pattern, which are
+// separated by wildcards
+func likeFragments(in []byte) [][]byte {
+	return bytes.FieldsFunc(in, func(r rune) bool {
+		return r == '?' || r == '*'
+	})
+}
@@ -125,3 +125,26 @@ func TestLikeRegexp_ForOptimizability(t *testing.T) {
 
 	run(t, tests)
 }
+
+func TestLikeFragments(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{input: "car", expected: []string{"car"}},
+		{input: "*car*", expected: []string{"car"}},
+		{input: "c?r*pet", expected: []string{"c", "r", "pet"}},
+		{input: "**", expected: []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			fragments := likeFragments([]byte(test.input))
+			actual := make([]string, len(fragments))
+			for i := range fragments {
+				actual[i] = string(fragments[i])
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -14,6 +14,7 @@ package inverted
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -76,7 +77,11 @@ func (a *Analyzer) analyzeProps(propsMap map[string]*models.Property,
 			continue
 		}
 
-		if schema.IsRefDataType(prop.DataType) {
+		if _, ok := schema.AsNested(prop.DataType); ok {
+			if err := a.extendPropertiesWithNested(&out, prop, input, key); err != nil {
+				return nil, err
+			}
+		} else if schema.IsRefDataType(prop.DataType) {
 			if err := a.extendPropertiesWithReference(&out, prop, input, key); err != nil {
 				return nil, err
 			}
@@ -178,6 +183,76 @@ func (a *Analyzer) extendPropertiesWithArrayType(properties *[]Property,
 	return nil
 }
 
+// extendPropertiesWithNested extends the properties with one property per
+// path of the nested properties of an object or object[] property, e.g.
+// "address.city". Values within object arrays are collected into a single
+// array property per path.
+func (a *Analyzer) extendPropertiesWithNested(properties *[]Property,
+	prop *models.Property, input map[string]any, propName string,
+) error {
+	value, ok := input[propName]
+	if !ok {
+		// skip any nested prop that's not set
+		return nil
+	}
+
+	for _, pathProp := range schema.NestedPropertyPaths(prop) {
+		if !HasInvertedIndex(pathProp) {
+			continue
+		}
+
+		segments := strings.Split(pathProp.Name, schema.NestedPathSeparator)[1:]
+		values := nestedPathValues(value, segments)
+		if len(values) == 0 {
+			continue
+		}
+
+		var property *Property
+		var err error
+		if schema.IsArrayDataType(pathProp.DataType) {
+			property, err = a.analyzeArrayProp(pathProp, values)
+		} else {
+			property, err = a.analyzePrimitiveProp(pathProp, values[0])
+		}
+		if err != nil {
+			return fmt.Errorf("analyze nested prop %s: %w", pathProp.Name, err)
+		}
+		if property == nil {
+			continue
+		}
+
+		*properties = append(*properties, *property)
+	}
+	return nil
+}
+
+// nestedPathValues collects the values at the path within a nested value,
+// descending into all elements of object arrays and flattening array values
+func nestedPathValues(value any, segments []string) []any {
+	if len(segments) == 0 {
+		if value == nil {
+			return nil
+		}
+		if values, err := typedSliceToUntyped(value); err == nil {
+			return values
+		}
+		return []any{value}
+	}
+
+	switch typed := value.(type) {
+	case map[string]any:
+		return nestedPathValues(typed[segments[0]], segments[1:])
+	case []any:
+		var values []any
+		for _, elem := range typed {
+			values = append(values, nestedPathValues(elem, segments)...)
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
 // extendPropertiesWithPrimitive mutates the passed in properties, by extending
 // it with an additional property - if applicable
 func (a *Analyzer) extendPropertiesWithPrimitive(properties *[]Property,
@@ -215,7 +290,10 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 		if err != nil {
 			return nil, err
 		}
-		items = a.TextArray(prop.Tokenization, in)
+		items, err = a.textArrayForProp(prop, in)
+		if err != nil {
+			return nil, err
+		}
 	case schema.DataTypeIntArray:
 		in := make([]int64, len(values))
 		for i, value := range values {
@@ -355,7 +433,11 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.Text(prop.Tokenization, asString)
+		var err error
+		items, err = a.textArrayForProp(prop, []string{asString})
+		if err != nil {
+			return nil, err
+		}
 		propertyLength = utf8.RuneCountInString(asString)
 	case schema.DataTypeInt:
 		if asFloat, ok := value.(float64); ok {
@@ -715,6 +715,98 @@ func TestAnalyzeObject(t *testing.T) {
 	})
 }
 
+func TestAnalyzeObject_NestedProperties(t *testing.T) {
+	a := NewAnalyzer(nil)
+	vFalse := false
+
+	props := []*models.Property{
+		{
+			Name:     "address",
+			DataType: schema.DataTypeObject.PropString(),
+			NestedProperties: []*models.NestedProperty{
+				{Name: "city", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationField},
+				{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+				{Name: "note", DataType: schema.DataTypeText.PropString(), IndexFilterable: &vFalse, IndexSearchable: &vFalse},
+			},
+		},
+		{
+			Name:     "pets",
+			DataType: schema.DataTypeObjectArray.PropString(),
+			NestedProperties: []*models.NestedProperty{
+				{Name: "name", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationField},
+				{Name: "tags", DataType: schema.DataTypeTextArray.PropString(), Tokenization: models.PropertyTokenizationField},
+			},
+		},
+	}
+	sch := map[string]interface{}{
+		"address": map[string]interface{}{
+			"city": "Amsterdam",
+			"zip":  int64(1011),
+			"note": "ring twice",
+		},
+		"pets": []interface{}{
+			map[string]interface{}{"name": "Rex", "tags": []interface{}{"dog", "old"}},
+			map[string]interface{}{"name": "Tom"},
+			map[string]interface{}{"tags": []interface{}{"cat"}},
+		},
+	}
+
+	res, err := a.Object(sch, props, strfmt.UUID("2609f1bc-7693-48f3-b531-6ddc52cd2501"))
+	require.Nil(t, err)
+
+	byName := map[string]Property{}
+	for _, prop := range res {
+		byName[prop.Name] = prop
+	}
+	assert.NotContains(t, byName, "address")
+	assert.NotContains(t, byName, "address.note")
+
+	expectedZip, err := a.Int(1011)
+	require.Nil(t, err)
+
+	tests := []struct {
+		name     string
+		expected []Countable
+		length   int
+	}{
+		{
+			name:     "address.city",
+			expected: []Countable{{Data: []byte("Amsterdam"), TermFrequency: 1}},
+			length:   9,
+		},
+		{
+			name:     "address.zip",
+			expected: expectedZip,
+			length:   -1,
+		},
+		{
+			name: "pets.name",
+			expected: []Countable{
+				{Data: []byte("Rex"), TermFrequency: 1},
+				{Data: []byte("Tom"), TermFrequency: 1},
+			},
+			length: 2,
+		},
+		{
+			name: "pets.tags",
+			expected: []Countable{
+				{Data: []byte("dog"), TermFrequency: 1},
+				{Data: []byte("old"), TermFrequency: 1},
+				{Data: []byte("cat"), TermFrequency: 1},
+			},
+			length: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Contains(t, byName, test.name)
+			assert.ElementsMatch(t, test.expected, byName[test.name].Items)
+			assert.Equal(t, test.length, byName[test.name].Length)
+		})
+	}
+}
+
 func TestConvertSliceToUntyped(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -33,7 +33,20 @@ type propValuePair struct {
 
 	// only set if operator=OperatorWithinGeoRange, as that cannot be served by a
 	// byte value from an inverted index
-	valueGeoRange      *filters.GeoRange
+	valueGeoRange *filters.GeoRange
+	// same as valueGeoRange for the OperatorWithinGeoPolygon and
+	// OperatorWithinGeoBoundingBox operators
+	valueGeoPolygon     *filters.GeoPolygon
+	valueGeoBoundingBox *filters.GeoBoundingBox
+
+	// only set if operator=OperatorContainsAny or OperatorContainsAll, holds
+	// the rows of each value. A value matches if all of its rows are present.
+	containsRows [][][]byte
+
+	// only set for text properties, used to serve OperatorLike on properties
+	// with trigram tokenization
+	textAnalyzer *helpers.TextAnalyzer
+
 	docIDs             docBitmap
 	children           []*propValuePair
 	hasFilterableIndex bool
@@ -45,7 +58,7 @@ func newPropValuePair() propValuePair {
 }
 
 func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
-	if pv.operator.OnValue() {
+	if pv.operator.OnValue() || pv.operator.IsContains() {
 		var bucketName string
 		if pv.hasFilterableIndex {
 			bucketName = helpers.BucketFromPropNameLSM(pv.prop)
@@ -75,15 +88,21 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 				"add `indexTimestamps: true` to the invertedIndexConfig")
 		}
 
-		if b == nil && pv.operator != filters.OperatorWithinGeoRange {
-			// a nil bucket is ok for a WithinGeoRange filter, as this query is not
+		if b == nil && !pv.operator.IsGeo() {
+			// a nil bucket is ok for a geo filter, as this query is not
 			// served by the inverted index, but propagated to a secondary index in
 			// .docPointers()
 			return errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
 		}
 
 		ctx := context.TODO() // TODO: pass through instead of spawning new
-		dbm, err := s.docBitmap(ctx, b, limit, pv)
+		var dbm docBitmap
+		var err error
+		if pv.operator.IsContains() {
+			dbm, err = s.docBitmapContains(ctx, b, pv)
+		} else {
+			dbm, err = s.docBitmap(ctx, b, limit, pv)
+		}
 		if err != nil {
 			return err
 		}
@@ -114,7 +133,7 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 }
 
 func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
-	if pv.operator.OnValue() {
+	if pv.operator.OnValue() || pv.operator.IsContains() {
 		return &pv.docIDs, nil
 	}
 
@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
@@ -240,6 +241,10 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 		return s.extractReferenceFilter(property, filter)
 	}
 
+	if filter.Operator.IsContains() {
+		return s.extractContains(className, property, filter)
+	}
+
 	if s.onRefProp(property) && filter.Value.Type == schema.DataTypeInt {
 		// ref prop and int type is a special case, the user is looking for the
 		// reference count as opposed to the content
@@ -257,14 +262,151 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 	}
 
 	if s.onTokenizableProp(property) {
-		return s.extractTokenizableProp(property, filter.Value.Type, filter.Value.Value,
-			filter.Operator)
+		return s.extractTokenizableProp(className, property, filter.Value.Type,
+			filter.Value.Value, filter.Operator)
 	}
 
 	return s.extractPrimitiveProp(property, filter.Value.Type, filter.Value.Value,
 		filter.Operator)
 }
 
+// extractContains resolves the rows of each value of a ContainsAny or
+// ContainsAll filter. Their bitmaps are read and combined directly into the
+// union (ContainsAny) or intersection (ContainsAll) once the doc ids are
+// fetched.
+func (s *Searcher) extractContains(className schema.ClassName,
+	prop *models.Property, filter *filters.Clause,
+) (*propValuePair, error) {
+	baseType, ok := schema.IsArrayType(filter.Value.Type)
+	if !ok {
+		return nil, fmt.Errorf("operator %s requires an array value, got %q",
+			filter.Operator.Name(), filter.Value.Type)
+	}
+
+	values, err := containsValues(filter.Value.Value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "operator %s", filter.Operator.Name())
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("operator %s requires at least one value",
+			filter.Operator.Name())
+	}
+
+	out := &propValuePair{
+		prop:         prop.Name,
+		operator:     filter.Operator,
+		containsRows: make([][][]byte, len(values)),
+		docIDs:       newDocBitmap(),
+	}
+
+	for i, value := range values {
+		var pv *propValuePair
+		switch {
+		case s.onTokenizableProp(prop):
+			pv, err = s.extractContainsTokenizableValue(className, prop, baseType, value)
+		case s.onUUIDProp(prop):
+			pv, err = s.extractUUIDFilter(prop, value, baseType, filters.OperatorEqual)
+			if err == nil {
+				pv.containsRows = [][][]byte{{pv.value}}
+			}
+		default:
+			pv, err = s.extractPrimitiveProp(prop, baseType, value, filters.OperatorEqual)
+			if err == nil {
+				pv.containsRows = [][][]byte{{pv.value}}
+			}
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "value at pos %d", i)
+		}
+
+		out.containsRows[i] = pv.containsRows[0]
+		out.textAnalyzer = pv.textAnalyzer
+		out.hasFilterableIndex = pv.hasFilterableIndex
+		out.hasSearchableIndex = pv.hasSearchableIndex
+	}
+
+	return out, nil
+}
+
+// extractContainsTokenizableValue tokenizes a single value of a contains
+// filter. All of its terms, except for stopwords, need to be present for the
+// value to match.
+func (s *Searcher) extractContainsTokenizableValue(className schema.ClassName,
+	prop *models.Property, propType schema.DataType, value interface{},
+) (*propValuePair, error) {
+	if propType != schema.DataTypeText {
+		return nil, fmt.Errorf("expected value type to be text, got %v", propType)
+	}
+	asStr, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected value to be string, got %T", value)
+	}
+
+	textAnalyzer, err := s.textAnalyzerForProperty(className, prop)
+	if err != nil {
+		return nil, err
+	}
+
+	hasFilterableIndex := HasFilterableIndex(prop) && !s.isFallbackToSearchable()
+	hasSearchableIndex := HasSearchableIndex(prop)
+
+	if !hasFilterableIndex && !hasSearchableIndex {
+		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
+	}
+
+	removeStopwords := !helpers.IsNGramTokenization(textAnalyzer.Tokenization())
+	stopwordDetector, err := s.stopwordDetectorForProperty(className, prop)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows [][]byte
+	for _, term := range textAnalyzer.Tokenize(asStr) {
+		if removeStopwords && stopwordDetector.IsStopword(term) {
+			continue
+		}
+		rows = append(rows, []byte(term))
+	}
+	if len(rows) == 0 {
+		return nil, errors.Errorf("invalid search term, only stopwords provided. Stopwords can be configured in class.invertedIndexConfig.stopwords")
+	}
+
+	return &propValuePair{
+		prop:               prop.Name,
+		containsRows:       [][][]byte{rows},
+		textAnalyzer:       textAnalyzer,
+		hasFilterableIndex: hasFilterableIndex,
+		hasSearchableIndex: hasSearchableIndex,
+	}, nil
+}
+
+func containsValues(in interface{}) ([]interface{}, error) {
+	var out []interface{}
+	switch values := in.(type) {
+	case []string:
+		for _, v := range values {
+			out = append(out, v)
+		}
+	case []int:
+		for _, v := range values {
+			out = append(out, v)
+		}
+	case []float64:
+		for _, v := range values {
+			out = append(out, v)
+		}
+	case []bool:
+		for _, v := range values {
+			out = append(out, v)
+		}
+	case []interface{}:
+		out = values
+	default:
+		return nil, fmt.Errorf("expected an array value, got %T", in)
+	}
+	return out, nil
+}
+
 func (s *Searcher) extractReferenceFilter(prop *models.Property,
 	filter *filters.Clause,
 ) (*propValuePair, error) {
@@ -345,16 +487,36 @@ func (s *Searcher) extractGeoFilter(prop *models.Property, value interface{},
 			"be used with geoRange filters", prop.Name)
 	}
 
-	parsed := value.(filters.GeoRange)
-
-	return &propValuePair{
+	pv := &propValuePair{
 		value:              nil, // not going to be served by an inverted index
-		valueGeoRange:      &parsed,
 		prop:               prop.Name,
 		operator:           operator,
 		hasFilterableIndex: HasFilterableIndex(prop),
 		hasSearchableIndex: HasSearchableIndex(prop),
-	}, nil
+	}
+
+	var expectedOperator filters.Operator
+	switch parsed := value.(type) {
+	case filters.GeoRange:
+		pv.valueGeoRange = &parsed
+		expectedOperator = filters.OperatorWithinGeoRange
+	case filters.GeoPolygon:
+		pv.valueGeoPolygon = &parsed
+		expectedOperator = filters.OperatorWithinGeoPolygon
+	case filters.GeoBoundingBox:
+		pv.valueGeoBoundingBox = &parsed
+		expectedOperator = filters.OperatorWithinGeoBoundingBox
+	default:
+		return nil, fmt.Errorf("unsupported geo filter value %T", value)
+	}
+
+	if operator != expectedOperator {
+		return nil, fmt.Errorf("prop %q: operator %s cannot be used with a "+
+			"value of type %T, use %s instead", prop.Name, operator.Name(), value,
+			expectedOperator.Name())
+	}
+
+	return pv, nil
 }
 
 func (s *Searcher) extractUUIDFilter(prop *models.Property, value interface{},
@@ -473,19 +635,25 @@ func (s *Searcher) extractTimestampProp(propName string, propType schema.DataTyp
 	}, nil
 }
 
-func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema.DataType,
-	value interface{}, operator filters.Operator,
+func (s *Searcher) extractTokenizableProp(className schema.ClassName,
+	prop *models.Property, propType schema.DataType, value interface{},
+	operator filters.Operator,
 ) (*propValuePair, error) {
 	var terms []string
 
+	textAnalyzer, err := s.textAnalyzerForProperty(className, prop)
+	if err != nil {
+		return nil, err
+	}
+
 	switch propType {
 	case schema.DataTypeText:
 		// if the operator is like, we cannot apply the regular text-splitting
 		// logic as it would remove all wildcard symbols
 		if operator == filters.OperatorLike {
-			terms = helpers.TokenizeWithWildcards(prop.Tokenization, value.(string))
+			terms = textAnalyzer.TokenizeWithWildcards(value.(string))
 		} else {
-			terms = helpers.Tokenize(prop.Tokenization, value.(string))
+			terms = textAnalyzer.Tokenize(value.(string))
 		}
 	default:
 		return nil, fmt.Errorf("expected value type to be text, got %v", propType)
@@ -498,18 +666,58 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
 	}
 
-	propValuePairs := make([]*propValuePair, 0, len(terms))
-	for _, term := range terms {
-		if s.stopwords.IsStopword(term) {
-			continue
-		}
-		propValuePairs = append(propValuePairs, &propValuePair{
+	// n-grams, e.g. the trigram "the" of "theater", must not be mistaken for
+	// stopwords
+	removeStopwords := !helpers.IsNGramTokenization(textAnalyzer.Tokenization())
+	stopwordDetector, err := s.stopwordDetectorForProperty(className, prop)
+	if err != nil {
+		return nil, err
+	}
+
+	// equality filters also match the synonyms of a term, each synonym
+	// consisting of several terms requires all of them to be present
+	var expander *synonyms.Expander
+	if operator == filters.OperatorEqual {
+		expander = s.synonymExpander(className, textAnalyzer)
+	}
+
+	termPropValuePair := func(term string) *propValuePair {
+		return &propValuePair{
 			value:              []byte(term),
 			prop:               prop.Name,
 			operator:           operator,
+			textAnalyzer:       textAnalyzer,
 			hasFilterableIndex: hasFilterableIndex,
 			hasSearchableIndex: hasSearchableIndex,
-		})
+		}
+	}
+
+	propValuePairs := make([]*propValuePair, 0, len(terms))
+	for _, term := range terms {
+		if removeStopwords && stopwordDetector.IsStopword(term) {
+			continue
+		}
+
+		termSynonyms := expander.Synonyms(term)
+		if len(termSynonyms) == 0 {
+			propValuePairs = append(propValuePairs, termPropValuePair(term))
+			continue
+		}
+
+		alternatives := make([]*propValuePair, 0, len(termSynonyms)+1)
+		alternatives = append(alternatives, termPropValuePair(term))
+		for _, synonym := range termSynonyms {
+			if len(synonym) == 1 {
+				alternatives = append(alternatives, termPropValuePair(synonym[0]))
+				continue
+			}
+			children := make([]*propValuePair, len(synonym))
+			for i := range synonym {
+				children[i] = termPropValuePair(synonym[i])
+			}
+			alternatives = append(alternatives, &propValuePair{operator: filters.OperatorAnd, children: children})
+		}
+		propValuePairs = append(propValuePairs, &propValuePair{operator: filters.OperatorOr, children: alternatives})
 	}
 
 	if len(propValuePairs) > 1 {
@@ -695,3 +903,53 @@ type docPointerWithScore struct {
 	frequency  float32
 	propLength float32
 }
+
+// stopwordDetectorForProperty prefers the property's stopword preset over the class's
+func (s *Searcher) stopwordDetectorForProperty(className schema.ClassName,
+	prop *models.Property,
+) (stopwords.StopwordDetector, error) {
+	if prop.StopwordsPreset == "" {
+		return s.stopwords, nil
+	}
+
+	class := s.schema.GetClass(className)
+	if class == nil {
+		return nil, fmt.Errorf("class %q not found", className)
+	}
+	var stopwordConfig *models.StopwordConfig
+	if class.InvertedIndexConfig != nil {
+		stopwordConfig = class.InvertedIndexConfig.Stopwords
+	}
+	return stopwords.NewDetectorForProperty(stopwordConfig, prop)
+}
+
+// synonymExpander is nil if the class has no synonyms configured
+func (s *Searcher) synonymExpander(className schema.ClassName,
+	textAnalyzer *helpers.TextAnalyzer,
+) *synonyms.Expander {
+	class := s.schema.GetClass(className)
+	if class == nil || class.InvertedIndexConfig == nil || class.InvertedIndexConfig.Synonyms == nil {
+		return nil
+	}
+	return synonyms.NewExpanderFromConfig(class.InvertedIndexConfig.Synonyms, textAnalyzer.Tokenize)
+}
+
+// textAnalyzerForProperty returns the text analyzer used to index the
+// property, so that filter values are analyzed the same way
+func (s *Searcher) textAnalyzerForProperty(className schema.ClassName,
+	prop *models.Property,
+) (*helpers.TextAnalyzer, error) {
+	if prop.Analyzer == "" {
+		return helpers.NewTokenizationAnalyzer(prop.Tokenization), nil
+	}
+
+	class := s.schema.GetClass(className)
+	if class == nil {
+		return nil, fmt.Errorf("class %q not found", className)
+	}
+	textAnalyzers, err := TextAnalyzersFromConfig(class.InvertedIndexConfig)
+	if err != nil {
+		return nil, err
+	}
+	return TextAnalyzerForProperty(prop, textAnalyzers)
+}
@@ -19,7 +19,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
@@ -28,9 +30,15 @@ func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
 	// geo props cannot be served by the inverted index and they require an
 	// external index. So, instead of trying to serve this chunk of the filter
 	// request internally, we can pass it to an external geo index
-	if pv.operator == filters.OperatorWithinGeoRange {
+	if pv.operator.IsGeo() {
 		return s.docBitmapGeo(ctx, pv)
 	}
+	// properties with trigram tokenization do not contain whole words in the
+	// inverted index, so like patterns need to be resolved by their trigrams
+	if pv.operator == filters.OperatorLike && pv.textAnalyzer != nil &&
+		pv.textAnalyzer.Tokenization() == models.PropertyTokenizationTrigram {
+		return s.docBitmapTrigramLike(ctx, b, limit, pv)
+	}
 	// all other operators perform operations on the inverted index which we
 	// can serve directly
 
@@ -143,11 +151,67 @@ func (s *Searcher) docBitmapGeo(ctx context.Context, pv *propValuePair) (docBitm
 		return out, nil
 	}
 
-	res, err := propIndex.GeoIndex.WithinRange(ctx, *pv.valueGeoRange)
+	var res []uint64
+	var err error
+	switch pv.operator {
+	case filters.OperatorWithinGeoPolygon:
+		res, err = propIndex.GeoIndex.WithinPolygon(ctx, *pv.valueGeoPolygon)
+	case filters.OperatorWithinGeoBoundingBox:
+		res, err = propIndex.GeoIndex.WithinBoundingBox(ctx, *pv.valueGeoBoundingBox)
+	default:
+		res, err = propIndex.GeoIndex.WithinRange(ctx, *pv.valueGeoRange)
+	}
 	if err != nil {
-		return out, errors.Wrapf(err, "geo index range search on prop %q", pv.prop)
+		return out, errors.Wrapf(err, "geo index %s search on prop %q",
+			pv.operator.Name(), pv.prop)
 	}
 
 	out.docIDs.SetMany(res)
 	return out, nil
 }
+
+// docBitmapContains reads the bitmap of every row of the contains values.
+// The rows of a single value are intersected, the values themselves are
+// combined into their union (ContainsAny) or intersection (ContainsAll).
+func (s *Searcher) docBitmapContains(ctx context.Context, b *lsmkv.Bucket,
+	pv *propValuePair,
+) (docBitmap, error) {
+	var out *sroar.Bitmap
+	for _, rows := range pv.containsRows {
+		var valueDocIDs *sroar.Bitmap
+		for _, row := range rows {
+			rowDocIDs, err := s.docBitmap(ctx, b, 0, &propValuePair{
+				value:              row,
+				prop:               pv.prop,
+				operator:           filters.OperatorEqual,
+				textAnalyzer:       pv.textAnalyzer,
+				hasFilterableIndex: pv.hasFilterableIndex,
+				hasSearchableIndex: pv.hasSearchableIndex,
+			})
+			if err != nil {
+				return docBitmap{}, err
+			}
+			if valueDocIDs == nil {
+				valueDocIDs = rowDocIDs.docIDs.Clone()
+			} else {
+				valueDocIDs.And(rowDocIDs.docIDs)
+			}
+		}
+
+		switch {
+		case out == nil:
+			out = valueDocIDs
+		case pv.operator == filters.OperatorContainsAll:
+			out.And(valueDocIDs)
+		default:
+			out.Or(valueDocIDs)
+		}
+
+		// no further value can add to an empty intersection
+		if pv.operator == filters.OperatorContainsAll && out.IsEmpty() {
+			break
+		}
+	}
+
+	return docBitmap{docIDs: roaringset.Condense(out)}, nil
+}
@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// docBitmapTrigramLike serves like filters on properties with trigram
+// tokenization. The candidates are the documents which contain all trigrams
+// of the literal parts of the pattern, e.g. "car" and "arp" for *carp*. As
+// the trigrams may stem from different words, the candidates are verified
+// against the pattern afterwards.
+func (s *Searcher) docBitmapTrigramLike(ctx context.Context, b *lsmkv.Bucket,
+	limit int, pv *propValuePair,
+) (docBitmap, error) {
+	like, err := parseLikeRegexp(pv.value)
+	if err != nil {
+		return docBitmap{}, errors.Wrapf(err, "parse like value")
+	}
+
+	candidates, err := s.trigramLikeCandidates(ctx, b, pv)
+	if err != nil {
+		return docBitmap{}, err
+	}
+
+	objs, err := s.objectsByDocID(newSliceDocIDsIterator(candidates.IDs()), additional.Properties{})
+	if err != nil {
+		return docBitmap{}, errors.Wrap(err, "resolve like candidates")
+	}
+
+	out := newDocBitmap()
+	for _, obj := range objs {
+		props, ok := obj.Properties().(map[string]interface{})
+		if !ok || !likeMatchesValue(like, pv.textAnalyzer, props[pv.prop]) {
+			continue
+		}
+
+		out.docIDs.Set(obj.DocID())
+		if limit > 0 && out.docIDs.GetCardinality() >= limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+// trigramLikeCandidates intersects the postings of all trigrams of the
+// literal parts of the pattern. Parts shorter than a trigram are matched
+// against all trigrams containing them.
+func (s *Searcher) trigramLikeCandidates(ctx context.Context, b *lsmkv.Bucket,
+	pv *propValuePair,
+) (docBitmap, error) {
+	fragments := likeFragments(pv.value)
+	if len(fragments) == 0 {
+		// a pattern of wildcards only matches any document containing a term
+		fragments = [][]byte{{}}
+	}
+
+	children := []*propValuePair{}
+	for _, fragment := range fragments {
+		if utf8.RuneCount(fragment) < 3 {
+			children = append(children, &propValuePair{
+				value:              append(append([]byte("*"), fragment...), '*'),
+				prop:               pv.prop,
+				operator:           filters.OperatorLike,
+				hasFilterableIndex: pv.hasFilterableIndex,
+				hasSearchableIndex: pv.hasSearchableIndex,
+			})
+			continue
+		}
+
+		for _, trigram := range pv.textAnalyzer.Tokenize(string(fragment)) {
+			children = append(children, &propValuePair{
+				value:              []byte(trigram),
+				prop:               pv.prop,
+				operator:           filters.OperatorEqual,
+				hasFilterableIndex: pv.hasFilterableIndex,
+				hasSearchableIndex: pv.hasSearchableIndex,
+			})
+		}
+	}
+
+	var out docBitmap
+	for i, child := range children {
+		dbm, err := s.docBitmap(ctx, b, 0, child)
+		if err != nil {
+			return docBitmap{}, errors.Wrapf(err, "trigram %q", child.value)
+		}
+		if i == 0 {
+			out = newDocBitmap()
+			out.docIDs.Or(dbm.docIDs)
+		} else {
+			out.docIDs.And(dbm.docIDs)
+		}
+		if out.count() == 0 {
+			break
+		}
+	}
+	return out, nil
+}
+
+// likeMatchesValue checks whether any word of a text or text[] value matches
+// the like pattern. The words are altered by the same token filters as the
+// pattern.
+func likeMatchesValue(like *likeRegexp, textAnalyzer *helpers.TextAnalyzer,
+	value interface{},
+) bool {
+	var texts []string
+	switch typed := value.(type) {
+	case string:
+		texts = []string{typed}
+	case []string:
+		texts = typed
+	case []interface{}:
+		for _, elem := range typed {
+			if text, ok := elem.(string); ok {
+				texts = append(texts, text)
+			}
+		}
+	default:
+		return false
+	}
+
+	for _, text := range texts {
+		words := textAnalyzer.Filter(helpers.Tokenize(models.PropertyTokenizationWord, text))
+		for _, word := range words {
+			if like.regexp.MatchString(word) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmers
+
+import "strings"
+
+var dutchAccents = strings.NewReplacer(
+	"ä", "a", "ë", "e", "ï", "i", "ö", "o", "ü", "u",
+	"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u",
+)
+
+func isDutchVowel(r rune) bool {
+	return runeIn(r, "aeiouyè")
+}
+
+// stemDutch implements the dutch snowball stemmer
+func stemDutch(in string) string {
+	w := newWord(dutchAccents.Replace(in), isDutchVowel)
+	for i, r := range w.runes {
+		switch {
+		case r == 'y' && (i == 0 || w.vowelAt(i-1)):
+			w.runes[i] = 'Y'
+		case r == 'i' && w.vowelAt(i-1) && w.vowelAt(i+1):
+			w.runes[i] = 'I'
+		}
+	}
+
+	w.markRegions()
+	// the region before R1 needs to contain at least 3 letters
+	if w.r1 < 3 {
+		w.r1 = 3
+	}
+
+	dutchStep1(w)
+	eFound := dutchStep2(w)
+	dutchStep3a(w)
+	dutchStep3b(w, eFound)
+	dutchStep4(w)
+
+	w.replaceAll('I', 'i')
+	w.replaceAll('Y', 'y')
+	return w.String()
+}
+
+func dutchStep1(w *word) {
+	suffix := w.longestSuffix("heden", "en", "ene", "s", "se")
+	if suffix == "" || !w.hasSuffixIn(w.r1, suffix) {
+		return
+	}
+
+	switch suffix {
+	case "heden":
+		w.replaceSuffix(suffix, "heid")
+	case "en", "ene":
+		dutchRemoveEnEnding(w, suffix)
+	case "s", "se":
+		if start := w.suffixStart(suffix); w.consonantAt(start-1) && w.runes[start-1] != 'j' {
+			w.removeSuffix(suffix)
+		}
+	}
+}
+
+// dutchRemoveEnEnding removes the suffix if it is preceded by a non-vowel
+// which is not part of "gem", then undoubles the ending
+func dutchRemoveEnEnding(w *word, suffix string) {
+	start := w.suffixStart(suffix)
+	if !w.consonantAt(start-1) || w.hasSuffix("gem"+suffix) {
+		return
+	}
+	w.removeSuffix(suffix)
+	dutchUndouble(w)
+}
+
+func dutchUndouble(w *word) {
+	if w.hasSuffix("kk") || w.hasSuffix("dd") || w.hasSuffix("tt") {
+		w.runes = w.runes[:len(w.runes)-1]
+	}
+}
+
+func dutchStep2(w *word) bool {
+	if !w.hasSuffixIn(w.r1, "e") || !w.consonantAt(len(w.runes)-2) {
+		return false
+	}
+	w.removeSuffix("e")
+	dutchUndouble(w)
+	return true
+}
+
+func dutchStep3a(w *word) {
+	if !w.hasSuffixIn(w.r2, "heid") || w.hasSuffix("cheid") {
+		return
+	}
+	w.removeSuffix("heid")
+	if w.hasSuffixIn(w.r1, "en") {
+		dutchRemoveEnEnding(w, "en")
+	}
+}
+
+func dutchStep3b(w *word, eFound bool) {
+	suffix := w.longestSuffix("end", "ing", "ig", "lijk", "baar", "bar")
+	if suffix == "" || !w.hasSuffixIn(w.r2, suffix) {
+		return
+	}
+
+	switch suffix {
+	case "end", "ing":
+		w.removeSuffix(suffix)
+		if w.hasSuffixIn(w.r2, "ig") && !w.hasSuffix("eig") {
+			w.removeSuffix("ig")
+		} else {
+			dutchUndouble(w)
+		}
+	case "ig":
+		if !w.hasSuffix("eig") {
+			w.removeSuffix(suffix)
+		}
+	case "lijk":
+		w.removeSuffix(suffix)
+		dutchStep2(w)
+	case "baar":
+		w.removeSuffix(suffix)
+	case "bar":
+		if eFound {
+			w.removeSuffix(suffix)
+		}
+	}
+}
+
+// dutchStep4 undoubles the vowel if the word ends with a non-vowel, a double
+// a, e, o or u and a non-vowel other than I
+func dutchStep4(w *word) {
+	n := len(w.runes)
+	if n < 4 || !w.consonantAt(n-4) || !w.consonantAt(n-1) || w.runes[n-1] == 'I' {
+		return
+	}
+	if v := w.runes[n-2]; w.runes[n-3] == v && runeIn(v, "aeou") {
+		w.runes = append(w.runes[:n-2], w.runes[n-1])
+	}
+}
@@ -0,0 +1,252 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmers
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// englishExceptions are stemmed irregularly or not at all
+var englishExceptions = map[string]string{
+	"skies":  "sky",
+	"dying":  "die",
+	"lying":  "lie",
+	"tying":  "tie",
+	"idly":   "idl",
+	"gently": "gentl",
+	"ugly":   "ugli",
+	"early":  "earli",
+	"only":   "onli",
+	"singly": "singl",
+	"sky":    "sky",
+	"news":   "news",
+	"howe":   "howe",
+	"atlas":  "atlas",
+	"cosmos": "cosmos",
+	"bias":   "bias",
+	"andes":  "andes",
+}
+
+// englishExceptionsAfterStep1a are left as they are once the plural has
+// been removed
+var englishExceptionsAfterStep1a = map[string]struct{}{
+	"inning": {}, "outing": {}, "canning": {}, "herring": {},
+	"earring": {}, "proceed": {}, "exceed": {}, "succeed": {},
+}
+
+func isEnglishVowel(r rune) bool {
+	return runeIn(r, "aeiouy")
+}
+
+// stemEnglish implements the english (porter2) snowball stemmer
+func stemEnglish(in string) string {
+	if utf8.RuneCountInString(in) <= 2 {
+		return in
+	}
+	if exception, ok := englishExceptions[in]; ok {
+		return exception
+	}
+
+	w := newWord(strings.TrimPrefix(in, "'"), isEnglishVowel)
+	for i, r := range w.runes {
+		if r == 'y' && (i == 0 || w.vowelAt(i-1)) {
+			w.runes[i] = 'Y'
+		}
+	}
+
+	w.markRegions()
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if strings.HasPrefix(w.String(), prefix) {
+			w.r1 = len(prefix)
+			w.r2 = w.regionAfter(w.r1)
+		}
+	}
+
+	englishStep0(w)
+	englishStep1a(w)
+	if _, ok := englishExceptionsAfterStep1a[w.String()]; ok {
+		return w.String()
+	}
+	englishStep1b(w)
+	englishStep1c(w)
+	englishStep2(w)
+	englishStep3(w)
+	englishStep4(w)
+	englishStep5(w)
+
+	w.replaceAll('Y', 'y')
+	return w.String()
+}
+
+func englishStep0(w *word) {
+	if suffix := w.longestSuffix("'", "'s", "'s'"); suffix != "" {
+		w.removeSuffix(suffix)
+	}
+}
+
+func englishStep1a(w *word) {
+	switch suffix := w.longestSuffix("sses", "ied", "ies", "s", "us", "ss"); suffix {
+	case "sses":
+		w.replaceSuffix(suffix, "ss")
+	case "ied", "ies":
+		if w.suffixStart(suffix) > 1 {
+			w.replaceSuffix(suffix, "i")
+		} else {
+			w.replaceSuffix(suffix, "ie")
+		}
+	case "s":
+		if w.hasVowel(0, len(w.runes)-2) {
+			w.removeSuffix(suffix)
+		}
+	}
+}
+
+func englishStep1b(w *word) {
+	switch suffix := w.longestSuffix("eed", "eedly", "ed", "edly", "ing", "ingly"); suffix {
+	case "eed", "eedly":
+		if w.hasSuffixIn(w.r1, suffix) {
+			w.replaceSuffix(suffix, "ee")
+		}
+	case "ed", "edly", "ing", "ingly":
+		if !w.hasVowel(0, w.suffixStart(suffix)) {
+			return
+		}
+		w.removeSuffix(suffix)
+		switch {
+		case w.hasSuffix("at"), w.hasSuffix("bl"), w.hasSuffix("iz"):
+			w.runes = append(w.runes, 'e')
+		case englishEndsWithDouble(w):
+			w.runes = w.runes[:len(w.runes)-1]
+		case englishIsShort(w):
+			w.runes = append(w.runes, 'e')
+		}
+	}
+}
+
+func englishStep1c(w *word) {
+	n := len(w.runes)
+	if n > 2 && (w.runes[n-1] == 'y' || w.runes[n-1] == 'Y') && w.consonantAt(n-2) {
+		w.runes[n-1] = 'i'
+	}
+}
+
+var englishStep2Suffixes = map[string]string{
+	"tional": "tion", "enci": "ence", "anci": "ance", "abli": "able",
+	"entli": "ent", "izer": "ize", "ization": "ize", "ational": "ate",
+	"ation": "ate", "ator": "ate", "alism": "al", "aliti": "al",
+	"alli": "al", "fulness": "ful", "ousli": "ous", "ousness": "ous",
+	"iveness": "ive", "iviti": "ive", "biliti": "ble", "bli": "ble",
+	"ogi": "og", "fulli": "ful", "lessli": "less", "li": "",
+}
+
+func englishStep2(w *word) {
+	suffix := longestSuffixOf(w, englishStep2Suffixes)
+	if suffix == "" || !w.hasSuffixIn(w.r1, suffix) {
+		return
+	}
+
+	start := w.suffixStart(suffix)
+	switch suffix {
+	case "ogi":
+		if start == 0 || w.runes[start-1] != 'l' {
+			return
+		}
+	case "li":
+		if start == 0 || !runeIn(w.runes[start-1], "cdeghkmnrt") {
+			return
+		}
+	}
+	w.replaceSuffix(suffix, englishStep2Suffixes[suffix])
+}
+
+var englishStep3Suffixes = map[string]string{
+	"tional": "tion", "ational": "ate", "alize": "al", "icate": "ic",
+	"iciti": "ic", "ical": "ic", "ful": "", "ness": "", "ative": "",
+}
+
+func englishStep3(w *word) {
+	suffix := longestSuffixOf(w, englishStep3Suffixes)
+	if suffix == "" || !w.hasSuffixIn(w.r1, suffix) {
+		return
+	}
+	if suffix == "ative" && !w.hasSuffixIn(w.r2, suffix) {
+		return
+	}
+	w.replaceSuffix(suffix, englishStep3Suffixes[suffix])
+}
+
+func englishStep4(w *word) {
+	suffix := w.longestSuffix("al", "ance", "ence", "er", "ic", "able", "ible",
+		"ant", "ement", "ment", "ent", "ism", "ate", "iti", "ous", "ive", "ize", "ion")
+	if suffix == "" || !w.hasSuffixIn(w.r2, suffix) {
+		return
+	}
+	if suffix == "ion" {
+		start := w.suffixStart(suffix)
+		if start == 0 || (w.runes[start-1] != 's' && w.runes[start-1] != 't') {
+			return
+		}
+	}
+	w.removeSuffix(suffix)
+}
+
+func englishStep5(w *word) {
+	n := len(w.runes)
+	switch {
+	case w.hasSuffix("e"):
+		if n-1 >= w.r2 || (n-1 >= w.r1 && !englishEndsInShortSyllable(w.runes[:n-1])) {
+			w.removeSuffix("e")
+		}
+	case w.hasSuffix("l"):
+		if n-1 >= w.r2 && n >= 2 && w.runes[n-2] == 'l' {
+			w.removeSuffix("l")
+		}
+	}
+}
+
+func englishEndsWithDouble(w *word) bool {
+	n := len(w.runes)
+	return n >= 2 && w.runes[n-1] == w.runes[n-2] && runeIn(w.runes[n-1], "bdfgmnprt")
+}
+
+// englishIsShort indicates whether the word ends in a short syllable and R1
+// is empty
+func englishIsShort(w *word) bool {
+	return w.r1 >= len(w.runes) && englishEndsInShortSyllable(w.runes)
+}
+
+// englishEndsInShortSyllable indicates whether the runes end with a vowel
+// followed by a non-vowel other than w, x or Y which is preceded by a
+// non-vowel, or consist of a vowel followed by a non-vowel
+func englishEndsInShortSyllable(runes []rune) bool {
+	n := len(runes)
+	switch {
+	case n == 2:
+		return isEnglishVowel(runes[0]) && !isEnglishVowel(runes[1])
+	case n > 2:
+		return !isEnglishVowel(runes[n-3]) && isEnglishVowel(runes[n-2]) &&
+			!isEnglishVowel(runes[n-1]) && !runeIn(runes[n-1], "wxY")
+	default:
+		return false
+	}
+}
+
+func longestSuffixOf(w *word, suffixes map[string]string) string {
+	longest, longestLen := "", 0
+	for suffix := range suffixes {
+		if l := utf8.RuneCountInString(suffix); l > longestLen && w.hasSuffix(suffix) {
+			longest, longestLen = suffix, l
+		}
+	}
+	return longest
+}
@@ -0,0 +1,328 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmers
+
+import (
+	"strings"
+	"unicode"
+)
+
+func isFrenchVowel(r rune) bool {
+	return runeIn(r, "aeiouyâàëéêèïîôûù")
+}
+
+// stemFrench implements the french snowball stemmer
+func stemFrench(in string) string {
+	w := newWord(in, isFrenchVowel)
+	for i, r := range w.runes {
+		before, after := w.vowelAt(i-1), w.vowelAt(i+1)
+		switch {
+		case (r == 'u' || r == 'i') && before && after:
+			w.runes[i] = unicode.ToUpper(r)
+		case r == 'y' && (before || after):
+			w.runes[i] = 'Y'
+		case r == 'u' && i > 0 && w.runes[i-1] == 'q':
+			w.runes[i] = 'U'
+		}
+	}
+
+	w.markRegions()
+	w.rv = frenchRV(w)
+
+	// the -ment suffixes alter the word in step 1 without counting as a
+	// successful step 1, so that the verb suffixes are removed as well
+	if frenchStep1(w) || frenchStep2a(w) || frenchStep2b(w) {
+		frenchStep3(w)
+	} else {
+		frenchStep4(w)
+	}
+	frenchStep5(w)
+	frenchStep6(w)
+
+	w.replaceAll('I', 'i')
+	w.replaceAll('U', 'u')
+	w.replaceAll('Y', 'y')
+	return w.String()
+}
+
+// frenchRV is the region after the third letter if the word starts with two
+// vowels or one of par, col and tap, otherwise it's the region after the
+// first vowel that is not at the beginning of the word
+func frenchRV(w *word) int {
+	n := len(w.runes)
+	if n >= 3 && w.vowelAt(0) && w.vowelAt(1) {
+		return 3
+	}
+	for _, prefix := range []string{"par", "col", "tap"} {
+		if strings.HasPrefix(w.String(), prefix) {
+			return 3
+		}
+	}
+	for i := 1; i < n; i++ {
+		if w.vowelAt(i) {
+			return i + 1
+		}
+	}
+	return n
+}
+
+// frenchStep1 removes standard suffixes and indicates whether the step
+// succeeded
+func frenchStep1(w *word) bool {
+	suffix := w.longestSuffix("ance", "iqUe", "isme", "able", "iste", "eux",
+		"ances", "iqUes", "ismes", "ables", "istes", "atrice", "ateur", "ation",
+		"atrices", "ateurs", "ations", "logie", "logies", "usion", "ution",
+		"usions", "utions", "ence", "ences", "ement", "ements", "ité", "ités",
+		"if", "ive", "ifs", "ives", "eaux", "aux", "euse", "euses", "issement",
+		"issements", "amment", "emment", "ment", "ments")
+
+	switch suffix {
+	case "":
+		return false
+	case "ance", "iqUe", "isme", "able", "iste", "eux", "ances", "iqUes",
+		"ismes", "ables", "istes":
+		if !w.hasSuffixIn(w.r2, suffix) {
+			return false
+		}
+		w.removeSuffix(suffix)
+	case "atrice", "ateur", "ation", "atrices", "ateurs", "ations":
+		if !w.hasSuffixIn(w.r2, suffix) {
+			return false
+		}
+		w.removeSuffix(suffix)
+		frenchRemoveOrReplace(w, "ic", "iqU")
+	case "logie", "logies":
+		if !w.hasSuffixIn(w.r2, suffix) {
+			return false
+		}
+		w.replaceSuffix(suffix, "log")
+	case "usion", "ution", "usions", "utions":
+		if !w.hasSuffixIn(w.r2, suffix) {
+			return false
+		}
+		w.replaceSuffix(suffix, "u")
+	case "ence", "ences":
+		if !w.hasSuffixIn(w.r2, suffix) {
+			return false
+		}
+		w.replaceSuffix(suffix, "ent")
+	case "ement", "ements":
+		if !w.hasSuffixIn(w.rv, suffix) {
+			return false
+		}
+		w.removeSuffix(suffix)
+		switch other := w.longestSuffix("iv", "eus", "abl", "iqU", "ièr", "Ièr"); other {
+		case "iv":
+			if w.hasSuffixIn(w.r2, other) {
+				w.removeSuffix(other)
+				if w.hasSuffixIn(w.r2, "at") {
+					w.removeSuffix("at")
+				}
+			}
+		case "eus":
+			if w.hasSuffixIn(w.r2, other) {
+				w.removeSuffix(other)
+			} else if w.hasSuffixIn(w.r1, other) {
+				w.replaceSuffix(other, "eux")
+			}
+		case "abl", "iqU":
+			if w.hasSuffixIn(w.r2, other) {
+				w.removeSuffix(other)
+			}
+		case "ièr", "Ièr":
+			if w.hasSuffixIn(w.rv, other) {
+				w.replaceSuffix(other, "i")
+			}
+		}
+	case "ité", "ités":
+		if !w.hasSuffixIn(w.r2, suffix) {
+			return false
+		}
+		w.removeSuffix(suffix)
+		switch other := w.longestSuffix("abil", "ic", "iv"); other {
+		case "abil":
+			frenchRemoveOrReplace(w, other, "abl")
+		case "ic":
+			frenchRemoveOrReplace(w, other, "iqU")
+		case "iv":
+			if w.hasSuffixIn(w.r2, other) {
+				w.removeSuffix(other)
+			}
+		}
+	case "if", "ive", "ifs", "ives":
+		if !w.hasSuffixIn(w.r2, suffix) {
+			return false
+		}
+		w.removeSuffix(suffix)
+		if w.hasSuffixIn(w.r2, "at") {
+			w.removeSuffix("at")
+			frenchRemoveOrReplace(w, "ic", "iqU")
+		}
+	case "eaux":
+		w.replaceSuffix(suffix, "eau")
+	case "aux":
+		if !w.hasSuffixIn(w.r1, suffix) {
+			return false
+		}
+		w.replaceSuffix(suffix, "al")
+	case "euse", "euses":
+		if w.hasSuffixIn(w.r2, suffix) {
+			w.removeSuffix(suffix)
+		} else if w.hasSuffixIn(w.r1, suffix) {
+			w.replaceSuffix(suffix, "eux")
+		} else {
+			return false
+		}
+	case "issement", "issements":
+		if !w.hasSuffixIn(w.r1, suffix) || !w.consonantAt(w.suffixStart(suffix)-1) {
+			return false
+		}
+		w.removeSuffix(suffix)
+	case "amment":
+		if w.hasSuffixIn(w.rv, suffix) {
+			w.replaceSuffix(suffix, "ant")
+		}
+		return false
+	case "emment":
+		if w.hasSuffixIn(w.rv, suffix) {
+			w.replaceSuffix(suffix, "ent")
+		}
+		return false
+	case "ment", "ments":
+		if start := w.suffixStart(suffix); start-1 >= w.rv && w.vowelAt(start-1) {
+			w.removeSuffix(suffix)
+		}
+		return false
+	}
+
+	return true
+}
+
+// frenchRemoveOrReplace removes the suffix if it is in R2, otherwise it
+// replaces it
+func frenchRemoveOrReplace(w *word, suffix, replacement string) {
+	if !w.hasSuffix(suffix) {
+		return
+	}
+	if w.hasSuffixIn(w.r2, suffix) {
+		w.removeSuffix(suffix)
+	} else {
+		w.replaceSuffix(suffix, replacement)
+	}
+}
+
+// frenchStep2a removes verb suffixes beginning with i and indicates whether a
+// suffix was removed
+func frenchStep2a(w *word) bool {
+	suffix := w.longestSuffix("îmes", "ît", "îtes", "i", "ie", "ies", "ir",
+		"ira", "irai", "iraIent", "irais", "irait", "iras", "irent", "irez",
+		"iriez", "irions", "irons", "iront", "is", "issaIent", "issais", "issait",
+		"issant", "issante", "issantes", "issants", "isse", "issent", "isses",
+		"issez", "issiez", "issions", "issons", "it")
+	if suffix == "" || !w.hasSuffixIn(w.rv, suffix) {
+		return false
+	}
+	if start := w.suffixStart(suffix); start-1 < w.rv || !w.consonantAt(start-1) {
+		return false
+	}
+	w.removeSuffix(suffix)
+	return true
+}
+
+// frenchStep2b removes all other verb suffixes and indicates whether a suffix
+// was removed
+func frenchStep2b(w *word) bool {
+	suffix := w.longestSuffix("ions", "é", "ée", "ées", "és", "èrent", "er",
+		"era", "erai", "eraIent", "erais", "erait", "eras", "erez", "eriez",
+		"erions", "erons", "eront", "ez", "iez", "âmes", "ât", "âtes", "a", "ai",
+		"aIent", "ais", "ait", "ant", "ante", "antes", "ants", "as", "asse",
+		"assent", "asses", "assiez", "assions")
+	if suffix == "" || !w.hasSuffixIn(w.rv, suffix) {
+		return false
+	}
+
+	switch suffix {
+	case "ions":
+		if !w.hasSuffixIn(w.r2, suffix) {
+			return false
+		}
+		w.removeSuffix(suffix)
+	case "âmes", "ât", "âtes", "a", "ai", "aIent", "ais", "ait", "ant", "ante",
+		"antes", "ants", "as", "asse", "assent", "asses", "assiez", "assions":
+		w.removeSuffix(suffix)
+		if w.hasSuffixIn(w.rv, "e") {
+			w.removeSuffix("e")
+		}
+	default:
+		w.removeSuffix(suffix)
+	}
+	return true
+}
+
+func frenchStep3(w *word) {
+	switch {
+	case w.hasSuffix("Y"):
+		w.replaceSuffix("Y", "i")
+	case w.hasSuffix("ç"):
+		w.replaceSuffix("ç", "c")
+	}
+}
+
+// frenchStep4 removes residual suffixes
+func frenchStep4(w *word) {
+	if n := len(w.runes); n > 1 && w.runes[n-1] == 's' && !runeIn(w.runes[n-2], "aiouès") {
+		w.removeSuffix("s")
+	}
+
+	suffix := w.longestSuffix("ion", "ier", "ière", "Ier", "Ière", "e", "ë")
+	if suffix == "" || !w.hasSuffixIn(w.rv, suffix) {
+		return
+	}
+
+	switch suffix {
+	case "ion":
+		if start := w.suffixStart(suffix); w.hasSuffixIn(w.r2, suffix) &&
+			start-1 >= w.rv && runeIn(w.runes[start-1], "st") {
+			w.removeSuffix(suffix)
+		}
+	case "ier", "ière", "Ier", "Ière":
+		w.replaceSuffix(suffix, "i")
+	case "e":
+		w.removeSuffix(suffix)
+	case "ë":
+		if w.hasSuffix("guë") {
+			w.removeSuffix(suffix)
+		}
+	}
+}
+
+// frenchStep5 undoubles the final consonant of enn, onn, ett, ell and eill
+func frenchStep5(w *word) {
+	if w.longestSuffix("enn", "onn", "ett", "ell", "eill") != "" {
+		w.runes = w.runes[:len(w.runes)-1]
+	}
+}
+
+// frenchStep6 removes the accent of an é or è that is followed by at least
+// one non-vowel at the end of the word
+func frenchStep6(w *word) {
+	i := len(w.runes) - 1
+	for i >= 0 && w.consonantAt(i) {
+		i--
+	}
+	if i < 0 || i == len(w.runes)-1 {
+		return
+	}
+	if w.runes[i] == 'é' || w.runes[i] == 'è' {
+		w.runes[i] = 'e'
+	}
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmers
+
+import "strings"
+
+func isGermanVowel(r rune) bool {
+	return runeIn(r, "aeiouyäöü")
+}
+
+// stemGerman implements the german snowball stemmer
+func stemGerman(in string) string {
+	w := newWord(strings.ReplaceAll(in, "ß", "ss"), isGermanVowel)
+	for i := 1; i < len(w.runes)-1; i++ {
+		if w.vowelAt(i-1) && w.vowelAt(i+1) {
+			switch w.runes[i] {
+			case 'u':
+				w.runes[i] = 'U'
+			case 'y':
+				w.runes[i] = 'Y'
+			}
+		}
+	}
+
+	w.markRegions()
+	// the region before R1 needs to contain at least 3 letters
+	if w.r1 < 3 {
+		w.r1 = 3
+	}
+
+	germanStep1(w)
+	germanStep2(w)
+	germanStep3(w)
+
+	w.replaceAll('U', 'u')
+	w.replaceAll('Y', 'y')
+	w.replaceAll('ä', 'a')
+	w.replaceAll('ö', 'o')
+	w.replaceAll('ü', 'u')
+	return w.String()
+}
+
+func germanStep1(w *word) {
+	suffix := w.longestSuffix("em", "ern", "er", "e", "en", "es", "s")
+	if suffix == "" || !w.hasSuffixIn(w.r1, suffix) {
+		return
+	}
+
+	switch suffix {
+	case "em", "ern", "er":
+		w.removeSuffix(suffix)
+	case "e", "en", "es":
+		w.removeSuffix(suffix)
+		if w.hasSuffix("niss") {
+			w.removeSuffix("s")
+		}
+	case "s":
+		if start := w.suffixStart(suffix); start > 0 && runeIn(w.runes[start-1], "bdfghklmnrt") {
+			w.removeSuffix(suffix)
+		}
+	}
+}
+
+func germanStep2(w *word) {
+	suffix := w.longestSuffix("en", "er", "est", "st")
+	if suffix == "" || !w.hasSuffixIn(w.r1, suffix) {
+		return
+	}
+
+	switch suffix {
+	case "en", "er", "est":
+		w.removeSuffix(suffix)
+	case "st":
+		// the st-ending needs to be preceded by at least 3 letters
+		if start := w.suffixStart(suffix); start > 3 && runeIn(w.runes[start-1], "bdfghklmnt") {
+			w.removeSuffix(suffix)
+		}
+	}
+}
+
+func germanStep3(w *word) {
+	suffix := w.longestSuffix("end", "ung", "ig", "ik", "isch", "lich", "heit", "keit")
+	if suffix == "" || !w.hasSuffixIn(w.r2, suffix) {
+		return
+	}
+
+	switch suffix {
+	case "end", "ung":
+		w.removeSuffix(suffix)
+		if w.hasSuffixIn(w.r2, "ig") && !w.hasSuffix("eig") {
+			w.removeSuffix("ig")
+		}
+	case "ig", "ik", "isch":
+		if !w.hasSuffix("e" + suffix) {
+			w.removeSuffix(suffix)
+		}
+	case "lich", "heit":
+		w.removeSuffix(suffix)
+		if other := w.longestSuffix("er", "en"); other != "" && w.hasSuffixIn(w.r1, other) {
+			w.removeSuffix(other)
+		}
+	case "keit":
+		w.removeSuffix(suffix)
+		if other := w.longestSuffix("lich", "ig"); other != "" && w.hasSuffixIn(w.r2, other) {
+			w.removeSuffix(other)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmers
+
+import "strings"
+
+var spanishAccents = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u")
+
+func isSpanishVowel(r rune) bool {
+	return runeIn(r, "aeiouáéíóúü")
+}
+
+var spanishVerbSuffixes = []string{
+	"en", "es", "éis", "emos", "arían", "arías", "arán", "arás", "aríais",
+	"aría", "aréis", "aríamos", "aremos", "ará", "aré", "erían", "erías",
+	"erán", "erás", "eríais", "ería", "eréis", "eríamos", "eremos", "erá",
+	"eré", "irían", "irías", "irán", "irás", "iríais", "iría", "iréis",
+	"iríamos", "iremos", "irá", "iré", "aba", "ada", "ida", "ía", "ara",
+	"iera", "ad", "ed", "id", "ase", "iese", "aste", "iste", "an", "aban",
+	"ían", "aran", "ieran", "asen", "iesen", "aron", "ieron", "ado", "ido",
+	"ando", "iendo", "ió", "ar", "er", "ir", "as", "abas", "adas", "idas",
+	"ías", "aras", "ieras", "ases", "ieses", "ís", "áis", "abais", "íais",
+	"arais", "ierais", "aseis", "ieseis", "asteis", "isteis", "ados", "idos",
+	"amos", "ábamos", "íamos", "imos", "áramos", "iéramos", "iésemos",
+	"ásemos",
+}
+
+// stemSpanish implements the spanish snowball stemmer
+func stemSpanish(in string) string {
+	w := newWord(in, isSpanishVowel)
+	w.markRegions()
+	w.rv = spanishRV(w)
+
+	spanishStep0(w)
+	if !spanishStep1(w) && !spanishStep2a(w) {
+		spanishStep2b(w)
+	}
+	spanishStep3(w)
+
+	return spanishAccents.Replace(w.String())
+}
+
+// spanishRV is the region after the next vowel if the second letter is a
+// consonant, the region after the next consonant if the word starts with
+// two vowels and the region after the third letter otherwise
+func spanishRV(w *word) int {
+	n := len(w.runes)
+	if n < 2 {
+		return n
+	}
+
+	switch {
+	case w.consonantAt(1):
+		for i := 2; i < n; i++ {
+			if w.vowelAt(i) {
+				return i + 1
+			}
+		}
+		return n
+	case w.vowelAt(0):
+		for i := 2; i < n; i++ {
+			if w.consonantAt(i) {
+				return i + 1
+			}
+		}
+		return n
+	default:
+		if n < 3 {
+			return n
+		}
+		return 3
+	}
+}
+
+// spanishStep0 removes attached pronouns
+func spanishStep0(w *word) {
+	pronoun := w.longestSuffix("me", "se", "sela", "selo", "selas", "selos",
+		"la", "le", "lo", "las", "les", "los", "nos")
+	if pronoun == "" {
+		return
+	}
+
+	stem := &word{runes: w.runes[:w.suffixStart(pronoun)], isVowel: w.isVowel}
+	switch ending := stem.longestSuffix("iéndo", "ándo", "ár", "ér", "ír",
+		"ando", "iendo", "ar", "er", "ir", "yendo"); ending {
+	case "":
+		return
+	case "iéndo", "ándo", "ár", "ér", "ír":
+		if stem.hasSuffixIn(w.rv, ending) {
+			w.removeSuffix(pronoun)
+			w.replaceSuffix(ending, spanishAccents.Replace(ending))
+		}
+	case "yendo":
+		if stem.hasSuffixIn(w.rv, ending) && stem.hasSuffix("uyendo") {
+			w.removeSuffix(pronoun)
+		}
+	default:
+		if stem.hasSuffixIn(w.rv, ending) {
+			w.removeSuffix(pronoun)
+		}
+	}
+}
+
+// spanishStep1 removes standard suffixes and indicates whether a suffix was
+// removed
+func spanishStep1(w *word) bool {
+	suffix := w.longestSuffix("anza", "anzas", "ico", "ica", "icos", "icas",
+		"ismo", "ismos", "able", "ables", "ible", "ibles", "ista", "istas", "oso",
+		"osa", "osos", "osas", "amiento", "amientos", "imiento", "imientos",
+		"adora", "ador", "ación", "adoras", "adores", "aciones", "ante", "antes",
+		"ancia", "ancias", "logía", "logías", "ución", "uciones", "encia",
+		"encias", "amente", "mente", "idad", "idades", "iva", "ivo", "ivas", "ivos")
+	if suffix == "" {
+		return false
+	}
+
+	if suffix == "amente" {
+		if !w.hasSuffixIn(w.r1, suffix) {
+			return false
+		}
+		w.removeSuffix(suffix)
+		switch other := w.longestSuffix("iv", "os", "ic", "ad"); other {
+		case "iv":
+			if w.hasSuffixIn(w.r2, other) {
+				w.removeSuffix(other)
+				if w.hasSuffixIn(w.r2, "at") {
+					w.removeSuffix("at")
+				}
+			}
+		case "os", "ic", "ad":
+			if w.hasSuffixIn(w.r2, other) {
+				w.removeSuffix(other)
+			}
+		}
+		return true
+	}
+
+	if !w.hasSuffixIn(w.r2, suffix) {
+		return false
+	}
+
+	switch suffix {
+	case "adora", "ador", "ación", "adoras", "adores", "aciones", "ante",
+		"antes", "ancia", "ancias":
+		w.removeSuffix(suffix)
+		if w.hasSuffixIn(w.r2, "ic") {
+			w.removeSuffix("ic")
+		}
+	case "logía", "logías":
+		w.replaceSuffix(suffix, "log")
+	case "ución", "uciones":
+		w.replaceSuffix(suffix, "u")
+	case "encia", "encias":
+		w.replaceSuffix(suffix, "ente")
+	case "mente":
+		w.removeSuffix(suffix)
+		if other := w.longestSuffix("ante", "able", "ible"); other != "" && w.hasSuffixIn(w.r2, other) {
+			w.removeSuffix(other)
+		}
+	case "idad", "idades":
+		w.removeSuffix(suffix)
+		if other := w.longestSuffix("abil", "ic", "iv"); other != "" && w.hasSuffixIn(w.r2, other) {
+			w.removeSuffix(other)
+		}
+	case "iva", "ivo", "ivas", "ivos":
+		w.removeSuffix(suffix)
+		if w.hasSuffixIn(w.r2, "at") {
+			w.removeSuffix("at")
+		}
+	default:
+		w.removeSuffix(suffix)
+	}
+	return true
+}
+
+// spanishStep2a removes verb suffixes beginning with y and indicates whether
+// a suffix was removed
+func spanishStep2a(w *word) bool {
+	suffix := w.longestSuffix("ya", "ye", "yan", "yen", "yeron", "yendo", "yo",
+		"yó", "yas", "yes", "yais", "yamos")
+	if suffix == "" || !w.hasSuffixIn(w.rv, suffix) || !w.hasSuffix("u"+suffix) {
+		return false
+	}
+	w.removeSuffix(suffix)
+	return true
+}
+
+// spanishStep2b removes all other verb suffixes
+func spanishStep2b(w *word) {
+	switch suffix := w.longestSuffix(spanishVerbSuffixes...); suffix {
+	case "":
+	case "en", "es", "éis", "emos":
+		if w.hasSuffixIn(w.rv, suffix) {
+			w.removeSuffix(suffix)
+			if w.hasSuffix("gu") {
+				w.removeSuffix("u")
+			}
+		}
+	default:
+		if w.hasSuffixIn(w.rv, suffix) {
+			w.removeSuffix(suffix)
+		}
+	}
+}
+
+// spanishStep3 removes residual suffixes
+func spanishStep3(w *word) {
+	switch suffix := w.longestSuffix("os", "a", "o", "á", "í", "ó", "e", "é"); suffix {
+	case "":
+	case "e", "é":
+		if w.hasSuffixIn(w.rv, suffix) {
+			w.removeSuffix(suffix)
+			if w.hasSuffixIn(w.rv, "u") && w.hasSuffix("gu") {
+				w.removeSuffix("u")
+			}
+		}
+	default:
+		if w.hasSuffixIn(w.rv, suffix) {
+			w.removeSuffix(suffix)
+		}
+	}
+}
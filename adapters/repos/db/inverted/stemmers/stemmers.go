@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package stemmers contains implementations of the snowball stemming
+// algorithms (https://snowballstem.org/algorithms/). The stemmers expect a
+// single lowercased word as input.
+package stemmers
+
+const (
+	English = "en"
+	German  = "de"
+	French  = "fr"
+	Spanish = "es"
+	Dutch   = "nl"
+)
+
+type Stemmer func(word string) string
+
+var Stemmers = map[string]Stemmer{
+	English: stemEnglish,
+	German:  stemGerman,
+	French:  stemFrench,
+	Spanish: stemSpanish,
+	Dutch:   stemDutch,
+}
@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStemmers(t *testing.T) {
+	tests := map[string]map[string]string{
+		English: {
+			"caresses":       "caress",
+			"ponies":         "poni",
+			"ties":           "tie",
+			"cats":           "cat",
+			"agreed":         "agre",
+			"hopping":        "hop",
+			"filing":         "file",
+			"sized":          "size",
+			"happy":          "happi",
+			"relational":     "relat",
+			"conditional":    "condit",
+			"vietnamization": "vietnam",
+			"hopefulness":    "hope",
+			"sensibiliti":    "sensibl",
+			"generously":     "generous",
+			"running":        "run",
+			"consistently":   "consist",
+			"skies":          "sky",
+			"dying":          "die",
+			"news":           "news",
+			"by":             "by",
+		},
+		German: {
+			"häuser":               "haus",
+			"laufen":               "lauf",
+			"katzen":               "katz",
+			"freundlichkeit":       "freundlich",
+			"aufeinanderfolgenden": "aufeinanderfolg",
+			"kategorisch":          "kategor",
+			"ergebnisse":           "ergebnis",
+			"zeitungen":            "zeitung",
+			"straße":               "strass",
+		},
+		French: {
+			"continuellement": "continuel",
+			"majestueusement": "majestu",
+			"chevaux":         "cheval",
+			"marchés":         "march",
+			"nationalité":     "national",
+			"finissons":       "fin",
+			"heureusement":    "heureux",
+			"évidemment":      "évident",
+			"abondamment":     "abond",
+			"parlerions":      "parl",
+		},
+		Spanish: {
+			"corriendo":    "corr",
+			"canciones":    "cancion",
+			"cantaba":      "cant",
+			"rápidamente":  "rapid",
+			"nacionalidad": "nacional",
+			"chicas":       "chic",
+			"comiéndolo":   "com",
+			"averiguen":    "averig",
+			"computadoras": "comput",
+		},
+		Dutch: {
+			"lichamelijk":     "licham",
+			"huizen":          "huiz",
+			"kinderen":        "kinder",
+			"boeken":          "boek",
+			"vriendelijkheid": "vriendelijk",
+			"mogelijkheden":   "mogelijk",
+			"gelukkig":        "gelukk",
+			"maan":            "man",
+			"opheffen":        "opheff",
+			"maandelijks":     "maandelijk",
+		},
+	}
+
+	for language, words := range tests {
+		stem, ok := Stemmers[language]
+		if !ok {
+			t.Fatalf("no stemmer for language %s", language)
+		}
+		t.Run(language, func(t *testing.T) {
+			for word, expected := range words {
+				assert.Equal(t, expected, stem(word), word)
+			}
+		})
+	}
+}
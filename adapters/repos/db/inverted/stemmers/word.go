@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmers
+
+import "unicode/utf8"
+
+// word is the state of a word while it is being stemmed. The regions are
+// rune offsets as defined by the snowball algorithms, they are determined
+// once before any suffix is removed and don't change afterwards.
+type word struct {
+	runes   []rune
+	isVowel func(r rune) bool
+	r1      int
+	r2      int
+	rv      int
+}
+
+func newWord(in string, isVowel func(r rune) bool) *word {
+	return &word{runes: []rune(in), isVowel: isVowel}
+}
+
+func (w *word) String() string {
+	return string(w.runes)
+}
+
+// markRegions sets R1 to the region after the first non-vowel following a
+// vowel and R2 to the same region within R1
+func (w *word) markRegions() {
+	w.r1 = w.regionAfter(0)
+	w.r2 = w.regionAfter(w.r1)
+}
+
+func (w *word) regionAfter(start int) int {
+	for i := start + 1; i < len(w.runes); i++ {
+		if w.isVowel(w.runes[i-1]) && !w.isVowel(w.runes[i]) {
+			return i + 1
+		}
+	}
+	return len(w.runes)
+}
+
+func (w *word) vowelAt(i int) bool {
+	return i >= 0 && i < len(w.runes) && w.isVowel(w.runes[i])
+}
+
+func (w *word) consonantAt(i int) bool {
+	return i >= 0 && i < len(w.runes) && !w.isVowel(w.runes[i])
+}
+
+func (w *word) hasVowel(from, to int) bool {
+	for i := from; i < to; i++ {
+		if w.isVowel(w.runes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *word) hasSuffix(suffix string) bool {
+	start := len(w.runes) - utf8.RuneCountInString(suffix)
+	if start < 0 {
+		return false
+	}
+	i := start
+	for _, r := range suffix {
+		if w.runes[i] != r {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+// suffixStart returns the offset at which the given suffix starts, it must
+// only be called for suffixes the word actually ends with
+func (w *word) suffixStart(suffix string) int {
+	return len(w.runes) - utf8.RuneCountInString(suffix)
+}
+
+// hasSuffixIn indicates whether the word ends with the suffix and the suffix
+// lies entirely within the region starting at the given offset
+func (w *word) hasSuffixIn(region int, suffix string) bool {
+	return w.hasSuffix(suffix) && w.suffixStart(suffix) >= region
+}
+
+// longestSuffix returns the longest of the given suffixes the word ends with
+// or an empty string if there is none
+func (w *word) longestSuffix(suffixes ...string) string {
+	longest, longestLen := "", 0
+	for _, suffix := range suffixes {
+		if l := utf8.RuneCountInString(suffix); l > longestLen && w.hasSuffix(suffix) {
+			longest, longestLen = suffix, l
+		}
+	}
+	return longest
+}
+
+func (w *word) removeSuffix(suffix string) {
+	w.runes = w.runes[:w.suffixStart(suffix)]
+}
+
+func (w *word) replaceSuffix(suffix, replacement string) {
+	w.removeSuffix(suffix)
+	w.runes = append(w.runes, []rune(replacement)...)
+}
+
+func (w *word) replaceAll(from, to rune) {
+	for i := range w.runes {
+		if w.runes[i] == from {
+			w.runes[i] = to
+		}
+	}
+}
+
+func runeIn(r rune, set string) bool {
+	for _, s := range set {
+		if r == s {
+			return true
+		}
+	}
+	return false
+}
@@ -87,3 +87,16 @@ func (d *Detector) IsStopword(word string) bool {
 	_, ok := d.stopwords[word]
 	return ok
 }
+
+// NewDetectorForProperty creates a detector from the stopword config of the
+// class, the stopword preset of the property replaces the preset of the class
+func NewDetectorForProperty(config *models.StopwordConfig, prop *models.Property) (*Detector, error) {
+	var propConfig models.StopwordConfig
+	if config != nil {
+		propConfig = *config
+	}
+	if prop.StopwordsPreset != "" {
+		propConfig.Preset = prop.StopwordsPreset
+	}
+	return NewDetectorFromConfig(propConfig)
+}
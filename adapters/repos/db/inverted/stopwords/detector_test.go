@@ -156,4 +156,66 @@ func TestStopwordDetector(t *testing.T) {
 
 		runTest(t, tests)
 	})
+
+	t.Run("with language presets", func(t *testing.T) {
+		tests := []testcase{
+			{
+				cfg:               models.StopwordConfig{Preset: "de"},
+				input:             []string{"der", "hund", "ist", "der", "beste"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "fr"},
+				input:             []string{"l", "avion", "est", "très", "rapide"},
+				expectedCountable: 3,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "es"},
+				input:             []string{"el", "perro", "es", "el", "mejor"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "nl"},
+				input:             []string{"de", "hond", "is", "de", "beste"},
+				expectedCountable: 2,
+			},
+		}
+
+		runTest(t, tests)
+	})
+}
+
+func TestNewDetectorForProperty(t *testing.T) {
+	classConfig := &models.StopwordConfig{
+		Preset:    "en",
+		Additions: []string{"hund"},
+		Removals:  []string{"the"},
+	}
+
+	t.Run("without preset of the property", func(t *testing.T) {
+		sd, err := NewDetectorForProperty(classConfig, &models.Property{Name: "title"})
+		require.Nil(t, err)
+		require.True(t, sd.IsStopword("a"))
+		require.False(t, sd.IsStopword("der"))
+	})
+
+	t.Run("with preset of the property", func(t *testing.T) {
+		sd, err := NewDetectorForProperty(classConfig, &models.Property{Name: "title", StopwordsPreset: "de"})
+		require.Nil(t, err)
+		require.False(t, sd.IsStopword("a"))
+		require.True(t, sd.IsStopword("der"))
+		require.True(t, sd.IsStopword("hund"))
+		require.Equal(t, "en", classConfig.Preset)
+	})
+
+	t.Run("without config of the class", func(t *testing.T) {
+		sd, err := NewDetectorForProperty(nil, &models.Property{Name: "title", StopwordsPreset: "fr"})
+		require.Nil(t, err)
+		require.True(t, sd.IsStopword("le"))
+	})
+
+	t.Run("with unknown preset", func(t *testing.T) {
+		_, err := NewDetectorForProperty(classConfig, &models.Property{Name: "title", StopwordsPreset: "xx"})
+		require.NotNil(t, err)
+	})
 }
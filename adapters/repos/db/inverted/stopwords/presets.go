@@ -12,10 +12,20 @@
 package stopwords
 
 const (
-	EnglishPreset = "en"
-	NoPreset      = "none"
+	EnglishPreset    = "en"
+	GermanPreset     = "de"
+	FrenchPreset     = "fr"
+	SpanishPreset    = "es"
+	DutchPreset      = "nl"
+	ItalianPreset    = "it"
+	PortuguesePreset = "pt"
+	NoPreset         = "none"
 )
 
+// Presets are lists of common words per language. The words are lowercase
+// and contain no punctuation, as they are compared to the terms of the word
+// tokenization. Elided articles such as the l' of l'avion are split off by
+// the tokenization and are therefore part of the lists as single letters.
 var Presets = map[string][]string{
 	EnglishPreset: {
 		"a", "an", "and", "are", "as", "at", "be", "but", "by", "for",
@@ -23,5 +33,81 @@ var Presets = map[string][]string{
 		"the", "their", "then", "there", "these", "they", "this", "to", "was", "will",
 		"with",
 	},
+	GermanPreset: {
+		"aber", "als", "am", "an", "auch", "auf", "aus", "bei", "bin", "bis", "bist",
+		"da", "dadurch", "daher", "darum", "das", "dass", "daß", "dein", "deine", "dem",
+		"den", "der", "des", "dessen", "deshalb", "die", "dies", "dieser", "dieses",
+		"doch", "dort", "du", "durch", "ein", "eine", "einem", "einen", "einer",
+		"eines", "er", "es", "euer", "eure", "für", "hatte", "hatten", "hattest",
+		"hattet", "hier", "hinter", "ich", "ihr", "ihre", "im", "in", "ist", "ja",
+		"jede", "jedem", "jeden", "jeder", "jedes", "jener", "jenes", "jetzt", "kann",
+		"kannst", "können", "könnt", "machen", "mein", "meine", "mit", "muß", "muss",
+		"mußt", "musst", "müssen", "müßt", "müsst", "nach", "nachdem", "nein", "nicht",
+		"nun", "oder", "seid", "sein", "seine", "sich", "sie", "sind", "soll", "sollen",
+		"sollst", "sollt", "sonst", "soweit", "sowie", "und", "unser", "unsere",
+		"unter", "vom", "von", "vor", "wann", "warum", "was", "weiter", "weitere",
+		"wenn", "wer", "werde", "werden", "werdet", "weshalb", "wie", "wieder",
+		"wieso", "wir", "wird", "wirst", "wo", "woher", "wohin", "zu", "zum", "zur",
+		"über",
+	},
+	FrenchPreset: {
+		"a", "afin", "ai", "ainsi", "après", "au", "aucun", "aucune", "auprès",
+		"auquel", "aussi", "autre", "autres", "aux", "avec", "c", "car", "ce", "ceci",
+		"cela", "celle", "celles", "celui", "ces", "cet", "cette", "ceux", "chez",
+		"comme", "comment", "d", "dans", "de", "des", "donc", "dont", "du", "elle",
+		"elles", "en", "entre", "est", "et", "étaient", "était", "été", "être", "eu",
+		"eux", "il", "ils", "j", "je", "l", "la", "le", "les", "leur", "leurs", "lui",
+		"m", "ma", "mais", "me", "même", "mes", "moi", "mon", "n", "ne", "ni", "nos",
+		"notre", "nous", "on", "ont", "or", "ou", "où", "par", "pas", "pour", "qu",
+		"que", "quel", "quelle", "quelles", "quels", "qui", "s", "sa", "sans", "se",
+		"ses", "si", "son", "sont", "sous", "sur", "t", "ta", "te", "tes", "toi", "ton",
+		"tous", "tout", "toute", "toutes", "tu", "un", "une", "vos", "votre", "vous",
+		"y",
+	},
+	SpanishPreset: {
+		"a", "al", "algo", "algunas", "algunos", "ante", "antes", "como", "con",
+		"contra", "cual", "cuando", "de", "del", "desde", "donde", "durante", "e",
+		"el", "él", "ella", "ellas", "ellos", "en", "entre", "era", "es", "esa",
+		"esas", "ese", "eso", "esos", "esta", "está", "están", "estas", "este",
+		"esto", "estos", "fue", "fueron", "ha", "han", "hasta", "hay", "la", "las",
+		"le", "les", "lo", "los", "más", "me", "mi", "mis", "mucho", "muy", "nada",
+		"ni", "no", "nos", "nosotros", "o", "otra", "otras", "otro", "otros", "para",
+		"pero", "poco", "por", "porque", "que", "qué", "quien", "se", "ser", "si",
+		"sí", "sin", "sobre", "son", "su", "sus", "también", "te", "tiene", "todo",
+		"todos", "tu", "tus", "un", "una", "uno", "unos", "y", "ya", "yo",
+	},
+	DutchPreset: {
+		"aan", "al", "alles", "als", "altijd", "andere", "ben", "bij", "daar", "dan",
+		"dat", "de", "der", "deze", "die", "dit", "doch", "doen", "door", "dus", "een",
+		"eens", "en", "er", "ge", "geen", "geweest", "haar", "had", "heb", "hebben",
+		"heeft", "hem", "het", "hier", "hij", "hoe", "hun", "iemand", "iets", "ik",
+		"in", "is", "ja", "je", "kan", "kon", "kunnen", "maar", "me", "meer", "men",
+		"met", "mij", "mijn", "moet", "na", "naar", "niet", "niets", "nog", "nu", "of",
+		"om", "omdat", "onder", "ons", "ook", "op", "over", "reeds", "te", "tegen",
+		"toch", "toen", "tot", "u", "uit", "uw", "van", "veel", "voor", "want",
+		"waren", "was", "wat", "werd", "wezen", "wie", "wil", "worden", "wordt",
+		"zal", "ze", "zelf", "zich", "zij", "zijn", "zo", "zonder", "zou",
+	},
+	ItalianPreset: {
+		"a", "ad", "agli", "ai", "al", "alla", "alle", "allo", "anche", "c", "che",
+		"chi", "ci", "come", "con", "contro", "cui", "d", "da", "dagli", "dai", "dal",
+		"dalla", "dalle", "dallo", "degli", "dei", "del", "della", "delle", "dello",
+		"di", "dov", "dove", "e", "è", "ed", "era", "gli", "i", "il", "in", "io", "l",
+		"la", "le", "lei", "li", "lo", "loro", "lui", "ma", "mi", "mio", "ne", "negli",
+		"nei", "nel", "nella", "nelle", "nello", "noi", "non", "nostro", "o", "per",
+		"perché", "più", "quale", "quanto", "quella", "quello", "questa", "questo",
+		"se", "si", "sono", "su", "sua", "sue", "sugli", "sui", "sul", "sulla",
+		"sulle", "suo", "tra", "tu", "tutti", "tutto", "un", "una", "uno", "voi",
+	},
+	PortuguesePreset: {
+		"a", "à", "ao", "aos", "as", "às", "até", "com", "como", "da", "das", "de",
+		"dela", "dele", "do", "dos", "e", "é", "ela", "elas", "ele", "eles", "em",
+		"entre", "era", "essa", "esse", "esta", "está", "este", "eu", "foi", "há",
+		"isso", "isto", "já", "lhe", "mais", "mas", "me", "mesmo", "meu", "minha",
+		"muito", "na", "nas", "não", "nem", "no", "nos", "nós", "num", "numa", "o",
+		"os", "ou", "para", "pela", "pelas", "pelo", "pelos", "por", "quando",
+		"que", "quem", "se", "sem", "ser", "seu", "seus", "só", "sua", "suas",
+		"também", "te", "tem", "um", "uma", "você", "vocês",
+	},
 	NoPreset: {},
 }
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package synonyms
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// Expander looks up the synonyms of query terms. The terms and synonyms of
+// the config are split into terms the same way as the query, so that e.g.
+// "Cars" in the config matches the query term "car" of a stemming analyzer.
+type Expander struct {
+	synonyms map[string][][]string
+}
+
+// NewExpanderFromConfig creates an expander from the equivalence sets and
+// one-way mappings of the config. Entries which are split into more than one
+// term can only be used as synonyms, as they can't match a single query term.
+func NewExpanderFromConfig(config *models.SynonymConfig,
+	tokenize func(string) []string,
+) *Expander {
+	e := &Expander{synonyms: map[string][][]string{}}
+	if config == nil {
+		return e
+	}
+
+	for _, set := range config.Equivalents {
+		entries := tokenizeAll(set, tokenize)
+		for i, entry := range entries {
+			if len(entry) != 1 {
+				continue
+			}
+			for j, other := range entries {
+				if i != j {
+					e.add(entry[0], other)
+				}
+			}
+		}
+	}
+
+	for _, mapping := range config.Mappings {
+		if mapping == nil {
+			continue
+		}
+		synonyms := tokenizeAll(mapping.Synonyms, tokenize)
+		for _, entry := range tokenizeAll(mapping.Terms, tokenize) {
+			if len(entry) != 1 {
+				continue
+			}
+			for _, synonym := range synonyms {
+				e.add(entry[0], synonym)
+			}
+		}
+	}
+
+	return e
+}
+
+func tokenizeAll(entries []string, tokenize func(string) []string) [][]string {
+	out := make([][]string, len(entries))
+	for i, entry := range entries {
+		out[i] = tokenize(entry)
+	}
+	return out
+}
+
+func (e *Expander) add(term string, synonym []string) {
+	if len(synonym) == 0 || (len(synonym) == 1 && synonym[0] == term) {
+		return
+	}
+	for _, existing := range e.synonyms[term] {
+		if equal(existing, synonym) {
+			return
+		}
+	}
+	e.synonyms[term] = append(e.synonyms[term], synonym)
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Synonyms returns the synonyms of the term, each of which consists of one
+// or more terms
+func (e *Expander) Synonyms(term string) [][]string {
+	if e == nil {
+		return nil
+	}
+	return e.synonyms[term]
+}
+
+// IsEmpty indicates whether there are no synonyms at all
+func (e *Expander) IsEmpty() bool {
+	return e == nil || len(e.synonyms) == 0
+}
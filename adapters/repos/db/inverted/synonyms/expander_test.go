@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package synonyms
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestExpander(t *testing.T) {
+	tokenize := func(in string) []string {
+		return strings.Fields(strings.ToLower(in))
+	}
+
+	config := &models.SynonymConfig{
+		Equivalents: [][]string{
+			{"Car", "automobile", "motor car"},
+		},
+		Mappings: []*models.SynonymMapping{
+			{Terms: []string{"ipod", "i-pod"}, Synonyms: []string{"mp3 player", "iPod"}},
+			{Terms: []string{"car"}, Synonyms: []string{"vehicle", "automobile"}},
+		},
+	}
+	e := NewExpanderFromConfig(config, tokenize)
+
+	t.Run("equivalence set", func(t *testing.T) {
+		assert.Equal(t, [][]string{{"automobile"}, {"motor", "car"}, {"vehicle"}}, e.Synonyms("car"))
+		assert.Equal(t, [][]string{{"car"}, {"motor", "car"}}, e.Synonyms("automobile"))
+	})
+
+	t.Run("multiple terms can't be expanded", func(t *testing.T) {
+		assert.Empty(t, e.Synonyms("motor"))
+	})
+
+	t.Run("one-way mapping", func(t *testing.T) {
+		assert.Equal(t, [][]string{{"mp3", "player"}}, e.Synonyms("ipod"))
+		assert.Equal(t, [][]string{{"mp3", "player"}, {"ipod"}}, e.Synonyms("i-pod"))
+		assert.Empty(t, e.Synonyms("vehicle"))
+	})
+
+	t.Run("without config", func(t *testing.T) {
+		e := NewExpanderFromConfig(nil, tokenize)
+		assert.True(t, e.IsEmpty())
+		assert.Empty(t, e.Synonyms("car"))
+	})
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// TextAnalyzersFromConfig creates the named text analyzers defined in the
+// inverted index config of a class
+func TextAnalyzersFromConfig(conf *models.InvertedIndexConfig) (map[string]*helpers.TextAnalyzer, error) {
+	if conf == nil || len(conf.Analyzers) == 0 {
+		return nil, nil
+	}
+
+	analyzers := make(map[string]*helpers.TextAnalyzer, len(conf.Analyzers))
+	for _, analyzerConf := range conf.Analyzers {
+		if analyzerConf == nil {
+			continue
+		}
+		analyzer, err := helpers.NewTextAnalyzer(analyzerConf.Tokenization, analyzerConf.Filters)
+		if err != nil {
+			return nil, errors.Wrapf(err, "analyzer '%s'", analyzerConf.Name)
+		}
+		analyzers[analyzerConf.Name] = analyzer
+	}
+	return analyzers, nil
+}
+
+// TextAnalyzerForProperty returns the analyzer referenced by the property or,
+// if it doesn't reference one, an analyzer applying its tokenization
+func TextAnalyzerForProperty(prop *models.Property,
+	analyzers map[string]*helpers.TextAnalyzer,
+) (*helpers.TextAnalyzer, error) {
+	if prop.Analyzer == "" {
+		return helpers.NewTokenizationAnalyzer(prop.Tokenization), nil
+	}
+
+	analyzer, ok := analyzers[prop.Analyzer]
+	if !ok {
+		return nil, errors.Errorf("analyzer '%s' of property '%s' does not exist",
+			prop.Analyzer, prop.Name)
+	}
+	return analyzer, nil
+}
+
+func validateAnalyzersConfig(analyzers []*models.TextAnalyzerConfig) error {
+	names := make(map[string]struct{}, len(analyzers))
+	for _, analyzer := range analyzers {
+		if analyzer == nil {
+			return errors.Errorf("analyzers must not contain null entries")
+		}
+		if analyzer.Name == "" {
+			return errors.Errorf("analyzers must have a name")
+		}
+		if _, ok := names[analyzer.Name]; ok {
+			return errors.Errorf("analyzer '%s' is defined more than once", analyzer.Name)
+		}
+		names[analyzer.Name] = struct{}{}
+
+		if _, err := helpers.NewTextAnalyzer(analyzer.Tokenization, analyzer.Filters); err != nil {
+			return errors.Wrapf(err, "analyzer '%s'", analyzer.Name)
+		}
+	}
+
+	return nil
+}
@@ -72,6 +72,10 @@ type Bucket struct {
 	// is that of the bucket that holds objects
 	monitorCount bool
 
+	// memtables of a bucket without a WAL are only persisted once they are
+	// flushed to a segment
+	disableWAL bool
+
 	pauseTimer *prometheus.Timer // Times the pause
 }
 
@@ -633,6 +637,9 @@ func (b *Bucket) setNewActiveMemtable() error {
 	if err != nil {
 		return err
 	}
+	if b.disableWAL {
+		mt.commitlog.disable()
+	}
 
 	b.active = mt
 	return nil
@@ -102,6 +102,16 @@ func WithSecondaryKey(pos int, key []byte) SecondaryKeyOption {
 	}
 }
 
+// WithWALDisabled writes no WAL for the memtables of the bucket. Writes which
+// were not flushed to a segment are lost on a crash, so it is only meant for
+// offline imports which can be repeated.
+func WithWALDisabled() BucketOption {
+	return func(b *Bucket) error {
+		b.disableWAL = true
+		return nil
+	}
+}
+
 func WithMonitorCount() BucketOption {
 	return func(b *Bucket) error {
 		if b.strategy != StrategyReplace {
@@ -30,6 +30,8 @@ type commitLogger struct {
 	// e.g. when recovering from an existing log, we do not want to write into a
 	// new log again
 	paused bool
+	// a disabled logger never writes, see WithWALDisabled
+	disabled bool
 }
 
 type CommitType uint16
@@ -77,7 +79,7 @@ func newCommitLogger(path string) (*commitLogger, error) {
 }
 
 func (cl *commitLogger) put(node segmentReplaceNode) error {
-	if cl.paused {
+	if cl.paused || cl.disabled {
 		return nil
 	}
 
@@ -99,7 +101,7 @@ func (cl *commitLogger) put(node segmentReplaceNode) error {
 }
 
 func (cl *commitLogger) append(node segmentCollectionNode) error {
-	if cl.paused {
+	if cl.paused || cl.disabled {
 		return nil
 	}
 
@@ -121,7 +123,7 @@ func (cl *commitLogger) append(node segmentCollectionNode) error {
 }
 
 func (cl *commitLogger) add(node *roaringset.SegmentNode) error {
-	if cl.paused {
+	if cl.paused || cl.disabled {
 		return nil
 	}
 
@@ -168,6 +170,10 @@ func (cl *commitLogger) unpause() {
 	cl.paused = false
 }
 
+func (cl *commitLogger) disable() {
+	cl.disabled = true
+}
+
 func (cl *commitLogger) delete() error {
 	return os.Remove(cl.path)
 }
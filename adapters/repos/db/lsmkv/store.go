@@ -36,6 +36,9 @@ type Store struct {
 	metrics         *Metrics
 	compactionCycle cyclemanager.CycleManager
 	flushCycle      cyclemanager.CycleManager
+	// bucketOpts are applied to every bucket of the store, before the
+	// options of the bucket itself
+	bucketOpts []BucketOption
 
 	// Prevent concurrent manipulations to the bucketsByNameMap, most notably
 	// when initializing buckets in parallel
@@ -44,9 +47,9 @@ type Store struct {
 
 // New initializes a new [Store] based on the root dir. If state is present on
 // disk, it is loaded, if the folder is empty a new store is initialized in
-// there.
+// there. The bucket options are applied to every bucket of the store.
 func New(dir, rootDir string, logger logrus.FieldLogger,
-	metrics *Metrics,
+	metrics *Metrics, bucketOpts ...BucketOption,
 ) (*Store, error) {
 	s := &Store{
 		dir:             dir,
@@ -56,6 +59,7 @@ func New(dir, rootDir string, logger logrus.FieldLogger,
 		metrics:         metrics,
 		compactionCycle: cyclemanager.NewMulti(cyclemanager.CompactionCycleTicker()),
 		flushCycle:      cyclemanager.NewMulti(cyclemanager.MemtableFlushCycleTicker()),
+		bucketOpts:      bucketOpts,
 	}
 
 	return s, s.init()
@@ -121,6 +125,7 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 		return nil
 	}
 
+	opts = append(append([]BucketOption{}, s.bucketOpts...), opts...)
 	b, err := NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics,
 		s.compactionCycle, s.flushCycle, opts...)
 	if err != nil {
@@ -284,6 +289,7 @@ func (s *Store) CreateBucket(ctx context.Context, bucketName string,
 		return errors.Wrapf(err, "failed removing bucket %s files", bucketName)
 	}
 
+	opts = append(append([]BucketOption{}, s.bucketOpts...), opts...)
 	b, err := NewBucket(ctx, bucketDir, s.rootDir, s.logger, s.metrics,
 		s.compactionCycle, s.flushCycle, opts...)
 	if err != nil {
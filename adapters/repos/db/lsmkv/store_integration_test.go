@@ -17,6 +17,8 @@ package lsmkv
 import (
 	"context"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -82,3 +84,41 @@ func TestStoreLifecycle(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+func TestStoreWithoutWAL(t *testing.T) {
+	dirName := t.TempDir()
+
+	t.Run("write without a WAL", func(t *testing.T) {
+		store, err := New(dirName, "", nullLogger(), nil, WithWALDisabled())
+		require.Nil(t, err)
+
+		err = store.CreateOrLoadBucket(testCtx(), "bucket1", WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+		require.Nil(t, store.Bucket("bucket1").Put([]byte("name"), []byte("Jane Doe")))
+		require.Nil(t, store.WriteWALs())
+
+		wals, err := filepath.Glob(filepath.Join(dirName, "bucket1", "*.wal"))
+		require.Nil(t, err)
+		require.Len(t, wals, 1)
+		info, err := os.Stat(wals[0])
+		require.Nil(t, err)
+		assert.Zero(t, info.Size())
+
+		// shutting down flushes the memtable to a segment
+		require.Nil(t, store.Shutdown(context.Background()))
+	})
+
+	t.Run("read the segment", func(t *testing.T) {
+		store, err := New(dirName, "", nullLogger(), nil)
+		require.Nil(t, err)
+
+		err = store.CreateOrLoadBucket(testCtx(), "bucket1", WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+
+		res, err := store.Bucket("bucket1").Get([]byte("name"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("Jane Doe"), res)
+
+		require.Nil(t, store.Shutdown(context.Background()))
+	})
+}
@@ -16,8 +16,10 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
@@ -32,6 +34,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/objects"
 )
@@ -673,6 +676,143 @@ func Test_Merge_UntouchedPropsCorrectlyIndexed(t *testing.T) {
 	})
 }
 
+func Test_Merge_PropertyOperations(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		MaxImportGoroutinesFactor: 1,
+		QueryMaximumResults:       10000,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	hnswConfig := enthnsw.NewDefaultUserConfig()
+	hnswConfig.Skip = true
+	class := &models.Class{
+		Class:               "OperationsTestClass",
+		VectorIndexConfig:   hnswConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{Name: "counter", DataType: schema.DataTypeInt.PropString()},
+		},
+	}
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	id := uuidFromInt(1)
+	require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+		ID:    id,
+		Class: class.Class,
+		Properties: map[string]interface{}{
+			"tags":    []string{"initial"},
+			"counter": int64(0),
+		},
+	}, nil, nil))
+
+	find := func(t *testing.T, filter *filters.LocalFilter) search.Results {
+		res, err := repo.ClassSearch(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 5},
+			Filters:    filter,
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	t.Run("concurrent operations are all applied", func(t *testing.T) {
+		wg := sync.WaitGroup{}
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				err := repo.Merge(context.Background(), objects.MergeDocument{
+					Class:      class.Class,
+					ID:         id,
+					UpdateTime: int64(i + 1),
+					PropertyOperations: map[string]objects.PropertyOperation{
+						"counter": {
+							Operator: objects.OperatorInc,
+							Value:    1,
+							DataType: schema.DataTypeInt,
+						},
+						"tags": {
+							Operator: objects.OperatorAddToSet,
+							Value:    []interface{}{fmt.Sprintf("tag%d", i%5)},
+							DataType: schema.DataTypeTextArray,
+						},
+					},
+				}, nil)
+				assert.Nil(t, err)
+			}(i)
+		}
+		wg.Wait()
+
+		res, err := repo.Object(context.Background(), class.Class, id, nil,
+			additional.Properties{}, nil)
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		props := res.Schema.(map[string]interface{})
+		assert.Equal(t, float64(20), props["counter"])
+		assert.ElementsMatch(t, []string{"initial", "tag0", "tag1", "tag2", "tag3", "tag4"},
+			props["tags"])
+	})
+
+	t.Run("removing an element", func(t *testing.T) {
+		err := repo.Merge(context.Background(), objects.MergeDocument{
+			Class:      class.Class,
+			ID:         id,
+			UpdateTime: 100,
+			PropertyOperations: map[string]objects.PropertyOperation{
+				"tags": {
+					Operator: objects.OperatorRemove,
+					Value:    "initial",
+					DataType: schema.DataTypeTextArray,
+				},
+			},
+		}, nil)
+		require.Nil(t, err)
+	})
+
+	t.Run("inverted index reflects the operations", func(t *testing.T) {
+		eq := filters.OperatorEqual
+		assert.Len(t, find(t, buildFilter("tags", "initial", eq, dtText)), 0)
+		assert.Len(t, find(t, buildFilter("tags", "tag3", eq, dtText)), 1)
+		assert.Len(t, find(t, buildFilter("counter", 20, eq, dtInt)), 1)
+		assert.Len(t, find(t, buildFilter("counter", 0, eq, dtInt)), 0)
+	})
+
+	t.Run("operation on a property of the wrong type", func(t *testing.T) {
+		err := repo.Merge(context.Background(), objects.MergeDocument{
+			Class:      class.Class,
+			ID:         id,
+			UpdateTime: 200,
+			PropertyOperations: map[string]objects.PropertyOperation{
+				"counter": {
+					Operator: objects.OperatorAppend,
+					Value:    1,
+					DataType: schema.DataTypeInt,
+				},
+			},
+		}, nil)
+		require.NotNil(t, err)
+		var errInvalid objects.ErrInvalidUserInput
+		assert.True(t, errors.As(err, &errInvalid), err.Error())
+	})
+}
+
 func uuidFromInt(in int) strfmt.UUID {
 	return strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", in)).String())
 }
@@ -53,7 +53,9 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			MemtablesMinActiveSeconds: m.db.config.MemtablesMinActiveSeconds,
 			MemtablesMaxActiveSeconds: m.db.config.MemtablesMaxActiveSeconds,
 			TrackVectorDimensions:     m.db.config.TrackVectorDimensions,
+			BulkLoad:                  m.db.config.BulkLoad,
 			ReplicationFactor:         class.ReplicationConfig.Factor,
+			ChangeDataCapture:         m.db.config.ChangeDataCapture,
 		},
 		shardState,
 		// no backward-compatibility check required, since newly added classes will
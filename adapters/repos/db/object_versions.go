@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// ObjectVersions returns the version history of an object, newest first. If
+// the shard of the object is not stored on this node, the history is read
+// from the node which owns it.
+func (db *DB) ObjectVersions(ctx context.Context, class string, id strfmt.UUID,
+	adds additional.Properties,
+) ([]objects.ObjectVersion, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, nil
+	}
+
+	versions, err := idx.objectVersions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]objects.ObjectVersion, len(versions))
+	for i, version := range versions {
+		out[i] = objects.ObjectVersion{
+			Version: version.Version,
+			Deleted: version.Deleted,
+		}
+		if version.Object != nil {
+			out[i].Object, err = db.enrichRefsForSingle(ctx,
+				version.Object.SearchResult(adds), search.SelectProperties{}, adds)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// ObjectAsOf returns the version of an object which was current at asOf (in
+// ms since epoch), or nil if the object did not exist at that time
+func (db *DB) ObjectAsOf(ctx context.Context, class string, id strfmt.UUID,
+	asOf int64, adds additional.Properties,
+) (*search.Result, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, nil
+	}
+
+	obj, err := idx.objectAsOf(ctx, id, asOf)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, nil
+	}
+
+	return db.enrichRefsForSingle(ctx, obj.SearchResult(adds),
+		search.SelectProperties{}, adds)
+}
+
+// objectVersions reads the history of an object from the local shard or, if
+// the shard is stored on another node, from the remote index
+func (i *Index) objectVersions(ctx context.Context,
+	id strfmt.UUID,
+) ([]objects.StoredObjectVersion, error) {
+	shardName, err := i.shardFromUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	shard := i.shards.Load(shardName)
+	if shard == nil {
+		versions, err := i.remote.ObjectVersions(ctx, shardName, id)
+		if err != nil {
+			return nil, fmt.Errorf("get object versions from remote index: %w", err)
+		}
+		return versions, nil
+	}
+
+	versions, err := shard.objectVersions(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return storedObjectVersions(versions), nil
+}
+
+// objectAsOf reads a version of an object from the local shard or, if the
+// shard is stored on another node, from the remote index
+func (i *Index) objectAsOf(ctx context.Context, id strfmt.UUID,
+	asOf int64,
+) (*storobj.Object, error) {
+	shardName, err := i.shardFromUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	shard := i.shards.Load(shardName)
+	if shard == nil {
+		obj, err := i.remote.ObjectAsOf(ctx, shardName, id, asOf)
+		if err != nil {
+			return nil, fmt.Errorf("get object as of %d from remote index: %w", asOf, err)
+		}
+		return obj, nil
+	}
+
+	obj, err := shard.objectAsOf(ctx, id, asOf)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return obj, nil
+}
+
+func (i *Index) IncomingObjectVersions(ctx context.Context, shardName string,
+	id strfmt.UUID,
+) ([]objects.StoredObjectVersion, error) {
+	shard := i.shards.Load(shardName)
+	if shard == nil {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	versions, err := shard.objectVersions(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return storedObjectVersions(versions), nil
+}
+
+func (i *Index) IncomingObjectAsOf(ctx context.Context, shardName string,
+	id strfmt.UUID, asOf int64,
+) (*storobj.Object, error) {
+	shard := i.shards.Load(shardName)
+	if shard == nil {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	obj, err := shard.objectAsOf(ctx, id, asOf)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return obj, nil
+}
+
+func storedObjectVersions(in []objectVersion) []objects.StoredObjectVersion {
+	out := make([]objects.StoredObjectVersion, len(in))
+	for i, version := range in {
+		out[i] = objects.StoredObjectVersion{
+			Version: version.version,
+			Deleted: version.deleted,
+			Object:  version.object,
+		}
+	}
+	return out
+}
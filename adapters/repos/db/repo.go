@@ -126,6 +126,7 @@ type Config struct {
 	QueryLimit                int64
 	QueryMaximumResults       int64
 	ResourceUsage             config.ResourceUsage
+	ChangeDataCapture         config.ChangeDataCapture
 	MaxImportGoroutinesFactor float64
 	MemtablesFlushIdleAfter   int
 	MemtablesInitialSizeMB    int
@@ -133,8 +134,12 @@ type Config struct {
 	MemtablesMinActiveSeconds int
 	MemtablesMaxActiveSeconds int
 	TrackVectorDimensions     bool
-	ServerVersion             string
-	GitHash                   string
+	// BulkLoad prepares the db for an offline import with BulkLoad: buckets
+	// are written without a WAL and the vector index without a commit log.
+	// Such a db must not serve any other writes.
+	BulkLoad      bool
+	ServerVersion string
+	GitHash       string
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
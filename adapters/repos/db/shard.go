@@ -82,6 +82,17 @@ type Shard struct {
 
 	vectorCycles   *hnsw.MaintenanceCycles
 	geoPropsCycles *hnsw.MaintenanceCycles
+	objectTTLCycle cyclemanager.CycleManager
+
+	// objectVersionsCycle deletes versions exceeding the limits of the
+	// versioning config of the class
+	objectVersionsCycle cyclemanager.CycleManager
+	// objectVersionsSeq is appended to the keys of the versions bucket, so
+	// that writes within the same millisecond don't overwrite each other
+	objectVersionsSeq uint64
+
+	// changeLog is nil unless change data capture is enabled
+	changeLog *changeLog
 }
 
 func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
@@ -127,6 +138,16 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		return nil, errors.Wrapf(err, "init shard %q", s.ID())
 	}
 
+	s.initObjectTTL()
+
+	if err := s.initObjectVersions(ctx); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: object versions", s.ID())
+	}
+
+	if err := s.initChangeLog(ctx); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: change log", s.ID())
+	}
+
 	return s, nil
 }
 
@@ -181,6 +202,10 @@ func (s *Shard) initVectorIndex(
 		VectorForIDThunk:  s.vectorByIndexID,
 		DistanceProvider:  distProv,
 		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+			if s.index.Config.BulkLoad {
+				// the graph is written at once by bulkBuildVectorIndex
+				return &hnsw.NoopCommitLogger{}, nil
+			}
 			return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(), s.index.logger, s.vectorCycles.CommitLogMaintenance())
 		},
 	}, hnswUserConfig, s.vectorCycles.TombstoneCleanup())
@@ -253,7 +278,13 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 		metrics = lsmkv.NewMetrics(s.promMetrics, string(s.index.Config.ClassName), s.name)
 	}
 
-	store, err := lsmkv.New(s.DBPathLSM(), s.index.Config.RootPath, annotatedLogger, metrics)
+	var bucketOpts []lsmkv.BucketOption
+	if s.index.Config.BulkLoad {
+		bucketOpts = append(bucketOpts, lsmkv.WithWALDisabled())
+	}
+
+	store, err := lsmkv.New(s.DBPathLSM(), s.index.Config.RootPath, annotatedLogger,
+		metrics, bucketOpts...)
 	if err != nil {
 		return errors.Wrapf(err, "init lsmkv store at %s", s.DBPathLSM())
 	}
@@ -296,6 +327,17 @@ func (s *Shard) drop() error {
 	if err := s.geoPropsCycles.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "shutdown geo props cycles")
 	}
+	if err := s.objectTTLCycle.StopAndWait(ctx); err != nil {
+		return errors.Wrap(err, "shutdown object ttl cycle")
+	}
+	if err := s.objectVersionsCycle.StopAndWait(ctx); err != nil {
+		return errors.Wrap(err, "shutdown object versions cycle")
+	}
+	if s.changeLog != nil {
+		if err := s.changeLog.shutdown(ctx); err != nil {
+			return errors.Wrap(err, "shutdown change log")
+		}
+	}
 
 	if err := s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
@@ -330,6 +372,12 @@ func (s *Shard) drop() error {
 		return errors.Wrapf(err, "remove prop length tracker at %s", s.DBPathLSM())
 	}
 
+	// delete the delivered offset of the change log webhook
+	err = os.Remove(s.changeLogWebhookOffsetPath())
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove change log webhook offset at %s", s.DBPathLSM())
+	}
+
 	// TODO: can we remove this?
 	s.deletedDocIDs.BulkRemove(s.deletedDocIDs.GetAll())
 	s.propertyIndicesLock.Lock()
@@ -414,6 +462,14 @@ func (s *Shard) dynamicMemtableSizing() lsmkv.BucketOption {
 }
 
 func (s *Shard) createPropertyIndex(ctx context.Context, prop *models.Property, eg *errgroup.Group) {
+	if _, ok := schema.AsNested(prop.DataType); ok {
+		// nested properties are indexed per path, e.g. "address.city"
+		for _, pathProp := range schema.NestedPropertyPaths(prop) {
+			s.createPropertyIndex(ctx, pathProp, eg)
+		}
+		return
+	}
+
 	if !inverted.HasInvertedIndex(prop) {
 		return
 	}
@@ -568,6 +624,17 @@ func (s *Shard) shutdown(ctx context.Context) error {
 	if err := s.geoPropsCycles.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "shutdown geo props cycles")
 	}
+	if err := s.objectTTLCycle.StopAndWait(ctx); err != nil {
+		return errors.Wrap(err, "shutdown object ttl cycle")
+	}
+	if err := s.objectVersionsCycle.StopAndWait(ctx); err != nil {
+		return errors.Wrap(err, "shutdown object versions cycle")
+	}
+	if s.changeLog != nil {
+		if err := s.changeLog.shutdown(ctx); err != nil {
+			return errors.Wrap(err, "shutdown change log")
+		}
+	}
 
 	if err := s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
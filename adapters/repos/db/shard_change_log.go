@@ -0,0 +1,404 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+const (
+	// changeLogReadBatchSize limits the number of changes read while holding a
+	// cursor. The cursor is closed before the changes are handed to the
+	// consumer, so that a slow consumer can never block memtable flushes.
+	changeLogReadBatchSize = 100
+
+	// changeLogRetentionBatchSize limits the number of expired changes deleted
+	// at once, so that a cycle can be interrupted in between batches
+	changeLogRetentionBatchSize = 1000
+)
+
+var errChangeLogClosed = errors.New("change log closed")
+
+// changeLog is an ordered log of all writes to the objects of a shard. Each
+// change is stored in its own bucket with the big endian offset as the key,
+// so that a cursor iterates the changes in the order they were applied.
+type changeLog struct {
+	sync.Mutex
+
+	bucket    *lsmkv.Bucket
+	className string
+	shardName string
+	retention time.Duration
+	logger    logrus.FieldLogger
+
+	// next is the offset assigned to the next change
+	next uint64
+	// written is the offset up to which all changes have been written to the
+	// bucket. Changes are written outside of the lock and can complete out of
+	// order, so readers must not read past it, otherwise they could skip a
+	// change that is still being written.
+	written uint64
+	// completed holds the offsets above written whose writes have completed
+	completed map[uint64]struct{}
+	// notify is closed and replaced whenever written advances, so that
+	// subscribers can wait for new changes without polling
+	notify chan struct{}
+	// closed is closed on shutdown of the shard to end all subscriptions
+	closed chan struct{}
+	wg     sync.WaitGroup
+
+	retentionCycle cyclemanager.CycleManager
+}
+
+func (s *Shard) initChangeLog(ctx context.Context) error {
+	cfg := s.index.Config.ChangeDataCapture
+	if !cfg.Enabled {
+		return nil
+	}
+
+	if err := s.store.CreateOrLoadBucket(ctx, helpers.ChangeLogBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		s.dynamicMemtableSizing(),
+		s.memtableIdleConfig(),
+	); err != nil {
+		return errors.Wrap(err, "create change log bucket")
+	}
+
+	bucket := s.store.Bucket(helpers.ChangeLogBucketLSM)
+	next := nextChangeLogOffset(bucket)
+	l := &changeLog{
+		bucket:    bucket,
+		className: s.index.Config.ClassName.String(),
+		shardName: s.name,
+		retention: time.Duration(cfg.RetentionSeconds) * time.Second,
+		logger: s.index.logger.
+			WithField("action", "change_log").
+			WithField("class", s.index.Config.ClassName).
+			WithField("shard", s.name),
+		next:      next,
+		written:   next,
+		completed: map[uint64]struct{}{},
+		notify:    make(chan struct{}),
+		closed:    make(chan struct{}),
+	}
+
+	l.retentionCycle = cyclemanager.NewMulti(cyclemanager.ChangeLogRetentionCycleTicker())
+	l.retentionCycle.Register(l.deleteExpired)
+	l.retentionCycle.Start()
+
+	if cfg.WebhookURL != "" {
+		if err := l.startWebhook(cfg.WebhookURL, s.changeLogWebhookOffsetPath()); err != nil {
+			return errors.Wrap(err, "start change log webhook")
+		}
+	}
+
+	s.changeLog = l
+	return nil
+}
+
+// nextChangeLogOffset finds the offset following the last change in the
+// bucket. The replace cursor can only seek forward, so the last key is found
+// with a binary search over the key space, which takes at most 64 seeks.
+func nextChangeLogOffset(bucket *lsmkv.Bucket) uint64 {
+	c := bucket.Cursor()
+	defer c.Close()
+
+	if k, _ := c.First(); k == nil {
+		return 0
+	}
+
+	// invariant: there is a key >= lo
+	lo, hi := uint64(0), uint64(math.MaxUint64)
+	for lo < hi {
+		mid := lo + (hi-lo)/2 + 1
+		if k, _ := c.Seek(changeLogKey(mid)); k != nil {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	return lo + 1
+}
+
+func changeLogKey(offset uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, offset)
+	return key
+}
+
+// recordChange appends a change of the given object to the change log of the
+// shard, if change data capture is enabled. It needs to be called while
+// holding the doc id lock of the object, so that the order of the changes of
+// an object matches the order in which they were applied. It is called before
+// the object is written, so that a write which could not be recorded fails
+// without leaving the buckets in a partially updated state.
+func (s *Shard) recordChange(typ changelog.Type, id strfmt.UUID,
+	obj *storobj.Object,
+) error {
+	if s.changeLog == nil {
+		return nil
+	}
+
+	if err := s.changeLog.append(typ, id, obj); err != nil {
+		return errors.Wrap(err, "record change")
+	}
+
+	return nil
+}
+
+// append assigns the next offset to the change while holding the lock, but
+// writes it to the bucket without, so that concurrent writes to the shard
+// aren't serialized by the change log
+func (l *changeLog) append(typ changelog.Type, id strfmt.UUID,
+	obj *storobj.Object,
+) error {
+	l.Lock()
+	offset := l.next
+	l.next++
+	l.Unlock()
+
+	// the offset is marked as written even if the write fails, the gap is
+	// skipped by readers, but must not hold back the following changes
+	defer l.markWritten(offset)
+
+	change := changelog.Change{
+		Offset:    offset,
+		Type:      typ,
+		Class:     l.className,
+		Shard:     l.shardName,
+		ID:        id,
+		Timestamp: time.Now().UnixMilli(),
+	}
+	if obj != nil {
+		object := obj.Object
+		object.Vector = obj.Vector
+		change.Object = &object
+	}
+
+	data, err := json.Marshal(change)
+	if err != nil {
+		return errors.Wrap(err, "marshal change")
+	}
+
+	if err := l.bucket.Put(changeLogKey(offset), data); err != nil {
+		return errors.Wrap(err, "put change")
+	}
+
+	return nil
+}
+
+// markWritten records that the write of the change with the given offset has
+// completed and advances written as far as all writes before have completed
+func (l *changeLog) markWritten(offset uint64) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.completed[offset] = struct{}{}
+	advanced := false
+	for {
+		if _, ok := l.completed[l.written]; !ok {
+			break
+		}
+		delete(l.completed, l.written)
+		l.written++
+		advanced = true
+	}
+
+	if advanced {
+		close(l.notify)
+		l.notify = make(chan struct{})
+	}
+}
+
+// subscribe calls fn for every change starting at the given offset. Once all
+// changes have been read, it waits for new ones until the context is
+// cancelled, the change log is closed or fn returns an error.
+func (l *changeLog) subscribe(ctx context.Context, from uint64,
+	fn func(changelog.Change) error,
+) error {
+	for {
+		// the channel needs to be retrieved before reading, otherwise a change
+		// appended in between could be missed
+		l.Lock()
+		notify := l.notify
+		written := l.written
+		l.Unlock()
+
+		next, err := l.read(ctx, from, written, fn)
+		if err != nil {
+			return err
+		}
+		from = next
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-l.closed:
+			return errChangeLogClosed
+		case <-notify:
+		}
+	}
+}
+
+// read calls fn for all changes stored starting at the given offset up to,
+// but excluding, until and returns the offset to continue from
+func (l *changeLog) read(ctx context.Context, from, until uint64,
+	fn func(changelog.Change) error,
+) (uint64, error) {
+	for {
+		changes, err := l.readBatch(from, until)
+		if err != nil {
+			return from, err
+		}
+
+		for _, change := range changes {
+			if err := ctx.Err(); err != nil {
+				return from, err
+			}
+
+			if err := fn(change); err != nil {
+				return from, err
+			}
+			from = change.Offset + 1
+		}
+
+		if len(changes) < changeLogReadBatchSize {
+			return from, nil
+		}
+	}
+}
+
+func (l *changeLog) readBatch(from, until uint64) ([]changelog.Change, error) {
+	c := l.bucket.Cursor()
+	defer c.Close()
+
+	changes := make([]changelog.Change, 0, changeLogReadBatchSize)
+	for k, v := c.Seek(changeLogKey(from)); k != nil; k, v = c.Next() {
+		if binary.BigEndian.Uint64(k) >= until {
+			break
+		}
+
+		var change changelog.Change
+		if err := json.Unmarshal(v, &change); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal change %d",
+				binary.BigEndian.Uint64(k))
+		}
+
+		changes = append(changes, change)
+		if len(changes) == changeLogReadBatchSize {
+			break
+		}
+	}
+
+	return changes, nil
+}
+
+// deleteExpired is run periodically to delete the changes older than the
+// configured retention. The most recent change is always kept, as it is
+// needed to restore the next offset on startup.
+func (l *changeLog) deleteExpired(shouldBreak cyclemanager.ShouldBreakFunc) bool {
+	cutoff := time.Now().Add(-l.retention).UnixMilli()
+
+	deleted := 0
+	for !shouldBreak() {
+		keys, err := l.expiredKeys(cutoff)
+		if err != nil {
+			l.logger.WithError(err).Error("could not find expired changes")
+			break
+		}
+
+		for _, key := range keys {
+			if err := l.bucket.Delete(key); err != nil {
+				l.logger.WithError(err).Error("could not delete expired change")
+				return deleted > 0
+			}
+			deleted++
+		}
+
+		if len(keys) < changeLogRetentionBatchSize {
+			break
+		}
+	}
+
+	if deleted > 0 {
+		l.logger.WithField("deleted", deleted).Debug("deleted expired changes")
+	}
+
+	return deleted > 0
+}
+
+func (l *changeLog) expiredKeys(cutoff int64) ([][]byte, error) {
+	l.Lock()
+	written := l.written
+	l.Unlock()
+
+	if written == 0 {
+		return nil, nil
+	}
+	last := written - 1
+
+	c := l.bucket.Cursor()
+	defer c.Close()
+
+	var keys [][]byte
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if binary.BigEndian.Uint64(k) >= last {
+			break
+		}
+
+		var change struct {
+			Timestamp int64 `json:"timestamp"`
+		}
+		if err := json.Unmarshal(v, &change); err != nil {
+			return nil, fmt.Errorf("unmarshal change %d: %w",
+				binary.BigEndian.Uint64(k), err)
+		}
+
+		// changes are appended in order, so all following ones are newer
+		if change.Timestamp >= cutoff {
+			break
+		}
+
+		keys = append(keys, append([]byte{}, k...))
+		if len(keys) == changeLogRetentionBatchSize {
+			break
+		}
+	}
+
+	return keys, nil
+}
+
+func (l *changeLog) shutdown(ctx context.Context) error {
+	close(l.closed)
+	if err := l.retentionCycle.StopAndWait(ctx); err != nil {
+		return errors.Wrap(err, "stop retention cycle")
+	}
+
+	l.wg.Wait()
+	return nil
+}
@@ -0,0 +1,328 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestShard_ChangeLog(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className, func(i *Index) {
+		i.Config.ChangeDataCapture = config.ChangeDataCapture{
+			Enabled:          true,
+			RetentionSeconds: 3600,
+		}
+	})
+
+	objs := []*storobj.Object{testObject(className), testObject(className)}
+
+	t.Run("write objects", func(t *testing.T) {
+		for _, obj := range objs {
+			require.Nil(t, shd.putObject(ctx, obj))
+		}
+		require.Nil(t, shd.mergeObject(ctx, objects.MergeDocument{
+			Class:           className,
+			ID:              objs[0].ID(),
+			PrimitiveSchema: map[string]interface{}{"name": "updated"},
+			UpdateTime:      time.Now().UnixMilli(),
+		}))
+		require.Nil(t, shd.deleteObject(ctx, objs[1].ID()))
+	})
+
+	t.Run("read all changes", func(t *testing.T) {
+		changes := readChanges(t, idx, 0, 4)
+
+		expected := []struct {
+			typ changelog.Type
+			obj *storobj.Object
+		}{
+			{changelog.TypeCreate, objs[0]},
+			{changelog.TypeCreate, objs[1]},
+			{changelog.TypeUpdate, objs[0]},
+			{changelog.TypeDelete, objs[1]},
+		}
+		for i, exp := range expected {
+			assert.Equal(t, uint64(i), changes[i].Offset)
+			assert.Equal(t, exp.typ, changes[i].Type)
+			assert.Equal(t, exp.obj.ID(), changes[i].ID)
+			assert.Equal(t, className, changes[i].Class)
+			assert.Equal(t, shd.name, changes[i].Shard)
+		}
+
+		require.NotNil(t, changes[2].Object)
+		assert.Equal(t, "updated",
+			changes[2].Object.Properties.(map[string]interface{})["name"])
+		assert.Equal(t, models.C11yVector{1, 2, 3}, changes[2].Object.Vector)
+		assert.Nil(t, changes[3].Object)
+	})
+
+	t.Run("read from offset", func(t *testing.T) {
+		changes := readChanges(t, idx, 3, 1)
+		assert.Equal(t, uint64(3), changes[0].Offset)
+		assert.Equal(t, changelog.TypeDelete, changes[0].Type)
+	})
+
+	t.Run("subscribers are notified of new changes", func(t *testing.T) {
+		obj := testObject(className)
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			shd.putObject(ctx, obj)
+		}()
+
+		changes := readChanges(t, idx, 4, 1)
+		assert.Equal(t, uint64(4), changes[0].Offset)
+		assert.Equal(t, obj.ID(), changes[0].ID)
+	})
+
+	t.Run("offsets continue after restart", func(t *testing.T) {
+		require.Nil(t, shd.shutdown(ctx))
+
+		var err error
+		shd, err = NewShard(ctx, nil, shd.name, idx, &models.Class{Class: className},
+			idx.centralJobQueue)
+		require.Nil(t, err)
+		idx.shards.Store(shd.name, shd)
+
+		assert.Equal(t, uint64(5), shd.changeLog.next)
+	})
+
+	t.Run("expired changes are deleted except for the last one", func(t *testing.T) {
+		neverBreak := func() bool { return false }
+		assert.False(t, shd.changeLog.deleteExpired(neverBreak))
+
+		shd.changeLog.retention = 0
+		assert.True(t, shd.changeLog.deleteExpired(neverBreak))
+
+		changes := readChanges(t, idx, 0, 1)
+		assert.Equal(t, uint64(4), changes[0].Offset)
+	})
+
+	t.Run("concurrent writes are read in order without gaps", func(t *testing.T) {
+		count := 50
+		go func() {
+			var wg sync.WaitGroup
+			for i := 0; i < count; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					shd.putObject(ctx, testObject(className))
+				}()
+			}
+			wg.Wait()
+		}()
+
+		changes := readChanges(t, idx, 5, count)
+		for i, change := range changes {
+			assert.Equal(t, uint64(5+i), change.Offset)
+		}
+	})
+}
+
+func TestShard_ChangeLogFailure(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className, func(i *Index) {
+		i.Config.ChangeDataCapture = config.ChangeDataCapture{
+			Enabled:          true,
+			RetentionSeconds: 3600,
+		}
+	})
+
+	existing := testObject(className)
+	require.Nil(t, shd.putObject(ctx, existing))
+
+	// puts are only possible on buckets with the replace strategy, so every
+	// write to the change log fails while it points to this bucket
+	require.Nil(t, shd.store.CreateOrLoadBucket(ctx, "failing_change_log",
+		lsmkv.WithStrategy(lsmkv.StrategySetCollection)))
+	changeLogBucket := shd.changeLog.bucket
+	shd.changeLog.bucket = shd.store.Bucket("failing_change_log")
+
+	t.Run("put is aborted", func(t *testing.T) {
+		obj := testObject(className)
+		err := shd.putObject(ctx, obj)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "record change")
+
+		found, err := shd.objectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("merge is aborted", func(t *testing.T) {
+		err := shd.mergeObject(ctx, objects.MergeDocument{
+			Class:           className,
+			ID:              existing.ID(),
+			PrimitiveSchema: map[string]interface{}{"name": "updated"},
+			UpdateTime:      time.Now().UnixMilli(),
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "record change")
+
+		found, err := shd.objectByID(ctx, existing.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, found)
+		assert.Nil(t, found.Properties())
+	})
+
+	t.Run("delete is aborted", func(t *testing.T) {
+		err := shd.deleteObject(ctx, existing.ID())
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "record change")
+
+		found, err := shd.objectByID(ctx, existing.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.NotNil(t, found)
+	})
+
+	t.Run("writes succeed once the change log is restored", func(t *testing.T) {
+		shd.changeLog.bucket = changeLogBucket
+
+		require.Nil(t, shd.deleteObject(ctx, existing.ID()))
+
+		changes := readChanges(t, idx, 0, 2)
+		assert.Equal(t, changelog.TypeCreate, changes[0].Type)
+		assert.Equal(t, changelog.TypeDelete, changes[1].Type)
+		assert.Equal(t, existing.ID(), changes[1].ID)
+	})
+}
+
+func TestShard_ChangeLogWebhook(t *testing.T) {
+	var (
+		lock        sync.Mutex
+		received    []changelog.Change
+		failed      bool
+		unavailable bool
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		// fail the first delivery to verify that it is retried
+		if !failed || unavailable {
+			failed = true
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var change changelog.Change
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&change))
+		received = append(received, change)
+	}))
+	defer server.Close()
+
+	receivedCount := func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(received)
+	}
+
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className, func(i *Index) {
+		i.Config.ChangeDataCapture = config.ChangeDataCapture{
+			Enabled:          true,
+			RetentionSeconds: 3600,
+			WebhookURL:       server.URL,
+		}
+	})
+	defer func() { shd.shutdown(ctx) }()
+
+	objs := []*storobj.Object{testObject(className), testObject(className)}
+
+	t.Run("changes are delivered in order", func(t *testing.T) {
+		for _, obj := range objs {
+			require.Nil(t, shd.putObject(ctx, obj))
+		}
+
+		assert.Eventually(t, func() bool {
+			return receivedCount() == len(objs)
+		}, 10*time.Second, 50*time.Millisecond)
+
+		lock.Lock()
+		defer lock.Unlock()
+		for i, obj := range objs {
+			assert.Equal(t, uint64(i), received[i].Offset)
+			assert.Equal(t, obj.ID(), received[i].ID)
+		}
+	})
+
+	t.Run("undelivered changes are delivered after a restart", func(t *testing.T) {
+		lock.Lock()
+		unavailable = true
+		lock.Unlock()
+
+		obj := testObject(className)
+		require.Nil(t, shd.putObject(ctx, obj))
+		time.Sleep(100 * time.Millisecond)
+		require.Nil(t, shd.shutdown(ctx))
+		require.Equal(t, len(objs), receivedCount())
+
+		lock.Lock()
+		unavailable = false
+		lock.Unlock()
+
+		var err error
+		shd, err = NewShard(ctx, nil, shd.name, idx, &models.Class{Class: className},
+			idx.centralJobQueue)
+		require.Nil(t, err)
+		idx.shards.Store(shd.name, shd)
+
+		assert.Eventually(t, func() bool {
+			return receivedCount() == len(objs)+1
+		}, 10*time.Second, 50*time.Millisecond)
+
+		lock.Lock()
+		defer lock.Unlock()
+		assert.Equal(t, uint64(len(objs)), received[len(objs)].Offset)
+		assert.Equal(t, obj.ID(), received[len(objs)].ID)
+	})
+}
+
+func readChanges(t *testing.T, idx *Index, from uint64, count int) []changelog.Change {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var changes []changelog.Change
+	err := idx.subscribeChanges(ctx, "", from, func(change changelog.Change) error {
+		changes = append(changes, change)
+		if len(changes) == count {
+			cancel()
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, changes, count)
+
+	return changes
+}
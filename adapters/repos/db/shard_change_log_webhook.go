@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/changelog"
+)
+
+const (
+	changeLogWebhookTimeout    = 10 * time.Second
+	changeLogWebhookMinBackoff = 1 * time.Second
+	changeLogWebhookMaxBackoff = 1 * time.Minute
+)
+
+// startWebhook delivers every change to the given URL as a JSON POST
+// request. Changes are delivered one at a time and in order, a failed delivery
+// is retried with an increasing backoff until it succeeds or the shard is
+// shut down. The offset of the next change to deliver is persisted in the
+// given file, so that delivery resumes where it left off after a restart. On
+// the first start, delivery starts with the changes appended after startup.
+func (l *changeLog) startWebhook(url, offsetPath string) error {
+	l.Lock()
+	head := l.next
+	l.Unlock()
+
+	offset, err := newChangeLogWebhookOffset(offsetPath, head)
+	if err != nil {
+		return err
+	}
+	from := offset.get()
+
+	client := &http.Client{Timeout: changeLogWebhookTimeout}
+	logger := l.logger.WithField("webhook", url)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-l.closed
+		cancel()
+	}()
+
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		defer offset.close()
+
+		err := l.subscribe(ctx, from, func(change changelog.Change) error {
+			backoff := changeLogWebhookMinBackoff
+			for {
+				err := postChange(ctx, client, url, change)
+				if err == nil {
+					if err := offset.set(change.Offset + 1); err != nil {
+						logger.WithError(err).WithField("offset", change.Offset).
+							Warn("could not persist delivered change log offset")
+					}
+					return nil
+				}
+
+				logger.WithError(err).WithField("offset", change.Offset).
+					Warn("could not deliver change to webhook, retrying")
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(backoff):
+				}
+
+				backoff *= 2
+				if backoff > changeLogWebhookMaxBackoff {
+					backoff = changeLogWebhookMaxBackoff
+				}
+			}
+		})
+		if err != nil && ctx.Err() == nil && err != errChangeLogClosed {
+			logger.WithError(err).Error("change log webhook stopped")
+		}
+	}()
+
+	return nil
+}
+
+func (s *Shard) changeLogWebhookOffsetPath() string {
+	return path.Join(s.index.Config.RootPath, s.ID()+".changelogwebhook")
+}
+
+// changeLogWebhookOffset is the offset of the next change to deliver to the
+// webhook, persisted in a file next to the other files of the shard
+type changeLogWebhookOffset struct {
+	f      *os.File
+	offset uint64
+}
+
+// newChangeLogWebhookOffset reads the offset from the file. If the file does
+// not exist yet, it is created with the given initial offset.
+func newChangeLogWebhookOffset(fileName string,
+	initial uint64,
+) (*changeLogWebhookOffset, error) {
+	f, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		return nil, errors.Wrap(err, "open webhook offset file")
+	}
+
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, errors.Wrap(err, "stat webhook offset file")
+	}
+
+	o := &changeLogWebhookOffset{f: f}
+	if stat.Size() > 0 {
+		// the file has existed before, delivery resumes at its offset
+		if err := binary.Read(f, binary.LittleEndian, &o.offset); err != nil {
+			f.Close()
+			return nil, errors.Wrap(err, "read webhook offset from file")
+		}
+		// the offset can't be past the head, the log would have been reset
+		if o.offset > initial {
+			o.offset = initial
+		}
+		return o, nil
+	}
+
+	if err := o.set(initial); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return o, nil
+}
+
+func (o *changeLogWebhookOffset) get() uint64 {
+	return o.offset
+}
+
+func (o *changeLogWebhookOffset) set(offset uint64) error {
+	if _, err := o.f.Seek(0, io.SeekStart); err != nil {
+		return errors.Wrap(err, "seek webhook offset file")
+	}
+	if err := binary.Write(o.f, binary.LittleEndian, offset); err != nil {
+		return errors.Wrap(err, "write webhook offset to file")
+	}
+	o.offset = offset
+	return nil
+}
+
+func (o *changeLogWebhookOffset) close() error {
+	return o.f.Close()
+}
+
+func postChange(ctx context.Context, client *http.Client, url string,
+	change changelog.Change,
+) error {
+	body, err := json.Marshal(change)
+	if err != nil {
+		return fmt.Errorf("marshal change: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url,
+		bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return nil
+}
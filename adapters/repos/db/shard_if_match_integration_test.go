@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestShard_ConditionalWrites(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, _ := testShard(t, ctx, className)
+
+	ifMatch := func(v int64) *int64 { return &v }
+	requirePreconditionFailed := func(t *testing.T, err error) {
+		var errPrecondition objects.ErrPreconditionFailed
+		require.NotNil(t, err)
+		assert.True(t, errors.As(err, &errPrecondition), err.Error())
+	}
+	updateTime := func(t *testing.T, obj *storobj.Object) int64 {
+		found, err := shd.objectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, found)
+		return found.LastUpdateTimeUnix()
+	}
+
+	obj := testObject(className)
+	obj.Object.LastUpdateTimeUnix = 1000
+
+	t.Run("create only fails for missing expected version", func(t *testing.T) {
+		obj.IfMatch = ifMatch(999)
+		requirePreconditionFailed(t, shd.putObject(ctx, obj))
+	})
+
+	t.Run("create only succeeds if the object does not exist", func(t *testing.T) {
+		obj.IfMatch = ifMatch(0)
+		require.Nil(t, shd.putObject(ctx, obj))
+		assert.Equal(t, int64(1000), updateTime(t, obj))
+	})
+
+	t.Run("create only fails if the object exists", func(t *testing.T) {
+		obj.IfMatch = ifMatch(0)
+		requirePreconditionFailed(t, shd.putObject(ctx, obj))
+	})
+
+	t.Run("put with a stale version is rejected", func(t *testing.T) {
+		obj.Object.LastUpdateTimeUnix = 2000
+		obj.IfMatch = ifMatch(999)
+		requirePreconditionFailed(t, shd.putObject(ctx, obj))
+		assert.Equal(t, int64(1000), updateTime(t, obj))
+	})
+
+	t.Run("put with the current version succeeds", func(t *testing.T) {
+		obj.IfMatch = ifMatch(1000)
+		require.Nil(t, shd.putObject(ctx, obj))
+		assert.Equal(t, int64(2000), updateTime(t, obj))
+	})
+
+	t.Run("merge with a stale version is rejected", func(t *testing.T) {
+		err := shd.mergeObject(ctx, objects.MergeDocument{
+			Class:      className,
+			ID:         obj.ID(),
+			UpdateTime: 3000,
+			IfMatch:    ifMatch(1000),
+		})
+		requirePreconditionFailed(t, err)
+		assert.Equal(t, int64(2000), updateTime(t, obj))
+	})
+
+	t.Run("merge with the current version succeeds", func(t *testing.T) {
+		err := shd.mergeObject(ctx, objects.MergeDocument{
+			Class:      className,
+			ID:         obj.ID(),
+			UpdateTime: 3000,
+			IfMatch:    ifMatch(2000),
+		})
+		require.Nil(t, err)
+		assert.Equal(t, int64(3000), updateTime(t, obj))
+	})
+
+	t.Run("batch reports failed conditions per object", func(t *testing.T) {
+		stale := testObject(className)
+		stale.Object.ID = obj.ID()
+		stale.Object.LastUpdateTimeUnix = 4000
+		stale.IfMatch = ifMatch(2000)
+
+		created := testObject(className)
+		created.Object.LastUpdateTimeUnix = 4000
+		created.IfMatch = ifMatch(0)
+
+		errs := shd.putObjectBatch(ctx, []*storobj.Object{stale, created})
+		require.Len(t, errs, 2)
+		requirePreconditionFailed(t, errs[0])
+		assert.Nil(t, errs[1])
+		assert.Equal(t, int64(3000), updateTime(t, obj))
+		assert.Equal(t, int64(4000), updateTime(t, created))
+	})
+}
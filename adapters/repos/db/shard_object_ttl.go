@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// objectTTLBatchSize limits the number of expired objects deleted at once, so
+// that a cycle can be interrupted in between batches on shutdown
+const objectTTLBatchSize = 1000
+
+func (s *Shard) initObjectTTL() {
+	s.objectTTLCycle = cyclemanager.NewMulti(cyclemanager.ObjectTTLCycleTicker())
+	s.objectTTLCycle.Register(s.deleteExpiredObjects)
+	s.objectTTLCycle.Start()
+}
+
+// deleteExpiredObjects is run periodically to delete the objects of the
+// shard whose time to live (as set in the class' objectTtlConfig) has passed.
+// The config is read from the schema in each cycle, so updates of the class
+// take effect without restarting the shard.
+func (s *Shard) deleteExpiredObjects(shouldBreak cyclemanager.ShouldBreakFunc) bool {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(s.index.Config.ClassName)
+	if class == nil || class.ObjectTTLConfig == nil || !class.ObjectTTLConfig.Enabled {
+		return false
+	}
+
+	if s.isReadOnly() || s.index.isBackupInProgress() {
+		return false
+	}
+
+	logger := s.index.logger.
+		WithField("action", "delete_expired_objects").
+		WithField("class", class.Class).
+		WithField("shard", s.name)
+
+	ctx := context.Background()
+	docIDs, err := s.findDocIDs(ctx, objectTTLFilter(class, time.Now()))
+	if err != nil {
+		logger.WithError(err).Error("could not find expired objects")
+		return false
+	}
+
+	deleted, failed := 0, 0
+	for start := 0; start < len(docIDs); start += objectTTLBatchSize {
+		if shouldBreak() {
+			break
+		}
+
+		end := start + objectTTLBatchSize
+		if end > len(docIDs) {
+			end = len(docIDs)
+		}
+
+		for _, res := range s.deleteObjectBatch(ctx, docIDs[start:end], false) {
+			if res.Err != nil {
+				failed++
+				logger.WithError(res.Err).WithField("id", res.UUID).
+					Debug("could not delete expired object")
+				continue
+			}
+			deleted++
+		}
+	}
+
+	if failed > 0 {
+		logger.WithField("deleted", deleted).WithField("failed", failed).
+			Warn("could not delete all expired objects")
+	} else if deleted > 0 {
+		logger.WithField("deleted", deleted).Debug("deleted expired objects")
+	}
+
+	return len(docIDs) > 0
+}
+
+// objectTTLFilter matches all objects of the class which expired before the
+// given time. An object expires defaultTtl seconds after the time set in
+// deleteOn, which is either its creation time or the value of a date
+// property.
+func objectTTLFilter(class *models.Class, now time.Time) *filters.LocalFilter {
+	cfg := class.ObjectTTLConfig
+	deleteOn := cfg.DeleteOn
+	if deleteOn == "" {
+		deleteOn = filters.InternalPropCreationTimeUnix
+	}
+
+	return &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorLessThan,
+			On: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: schema.PropertyName(deleteOn),
+			},
+			Value: &filters.Value{
+				Value: now.Add(-time.Duration(cfg.DefaultTTL) * time.Second),
+				Type:  schema.DataTypeDate,
+			},
+		},
+	}
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestShard_DeleteExpiredObjects(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	ttlConfig := &models.ObjectTTLConfig{
+		Enabled:    false,
+		DefaultTTL: 60,
+		DeleteOn:   filters.InternalPropCreationTimeUnix,
+	}
+	shd, _ := testShard(t, ctx, className, func(i *Index) {
+		i.invertedIndexConfig.IndexTimestamps = true
+		i.getSchema = &fakeSchemaGetter{
+			shardState: singleShardState(),
+			schema: schema.Schema{
+				Objects: &models.Schema{
+					Classes: []*models.Class{
+						{
+							Class: className,
+							InvertedIndexConfig: &models.InvertedIndexConfig{
+								IndexTimestamps: true,
+							},
+							ObjectTTLConfig: ttlConfig,
+						},
+					},
+				},
+			},
+		}
+	})
+	neverBreak := func() bool { return false }
+
+	expired := time.Now().Add(-time.Hour).UnixMilli()
+	fresh := time.Now().UnixMilli()
+
+	t.Run("insert objects", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			obj := testObject(className)
+			obj.Object.CreationTimeUnix = expired
+			if i%2 == 0 {
+				obj.Object.CreationTimeUnix = fresh
+			}
+			require.Nil(t, shd.putObject(ctx, obj))
+		}
+	})
+
+	t.Run("nothing is deleted while the ttl is disabled", func(t *testing.T) {
+		assert.False(t, shd.deleteExpiredObjects(neverBreak))
+		assert.Equal(t, 10, shd.objectCount())
+	})
+
+	t.Run("expired objects are deleted once the ttl is enabled", func(t *testing.T) {
+		ttlConfig.Enabled = true
+
+		assert.True(t, shd.deleteExpiredObjects(neverBreak))
+
+		objs, err := shd.objectList(ctx, 100, nil, nil, additional.Properties{},
+			shd.index.Config.ClassName)
+		require.Nil(t, err)
+		require.Len(t, objs, 5)
+		for _, obj := range objs {
+			assert.Equal(t, fresh, obj.Object.CreationTimeUnix)
+		}
+	})
+
+	t.Run("no work is done when no object is expired", func(t *testing.T) {
+		assert.False(t, shd.deleteExpiredObjects(neverBreak))
+	})
+}
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func Test_ObjectTTLFilter(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		cfg              *models.ObjectTTLConfig
+		expectedProperty string
+		expectedCutoff   time.Time
+	}{
+		{
+			name: "creation time",
+			cfg: &models.ObjectTTLConfig{
+				Enabled:    true,
+				DeleteOn:   filters.InternalPropCreationTimeUnix,
+				DefaultTTL: 3600,
+			},
+			expectedProperty: filters.InternalPropCreationTimeUnix,
+			expectedCutoff:   now.Add(-time.Hour),
+		},
+		{
+			name:             "empty deleteOn defaults to the creation time",
+			cfg:              &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60},
+			expectedProperty: filters.InternalPropCreationTimeUnix,
+			expectedCutoff:   now.Add(-time.Minute),
+		},
+		{
+			name:             "date property marking the expiry",
+			cfg:              &models.ObjectTTLConfig{Enabled: true, DeleteOn: "expiresAt"},
+			expectedProperty: "expiresAt",
+			expectedCutoff:   now,
+		},
+		{
+			name: "date property with a grace period",
+			cfg: &models.ObjectTTLConfig{
+				Enabled:    true,
+				DeleteOn:   "expiresAt",
+				DefaultTTL: 86400,
+			},
+			expectedProperty: "expiresAt",
+			expectedCutoff:   now.Add(-24 * time.Hour),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := &models.Class{Class: "Session", ObjectTTLConfig: test.cfg}
+
+			assert.Equal(t, &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorLessThan,
+					On: &filters.Path{
+						Class:    "Session",
+						Property: schema.PropertyName(test.expectedProperty),
+					},
+					Value: &filters.Value{
+						Value: test.expectedCutoff,
+						Type:  schema.DataTypeDate,
+					},
+				},
+			}, objectTTLFilter(class, now))
+		})
+	}
+}
@@ -0,0 +1,394 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"os"
+	"path"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+const (
+	// every value in the versions bucket starts with one of these markers,
+	// deletions carry no object data
+	objectVersionMarkerObject  = byte(0)
+	objectVersionMarkerDeleted = byte(1)
+
+	// objectVersionsScanBatchSize limits the number of versions read while
+	// holding a cursor in the retention cycle
+	objectVersionsScanBatchSize = 1000
+
+	// every key in the versions bucket is the binary uuid of the object,
+	// followed by the version and a sequence number
+	objectVersionIDLength  = 16
+	objectVersionKeyLength = objectVersionIDLength + 16
+)
+
+// objectVersion is a single entry of the history of an object
+type objectVersion struct {
+	version int64
+	deleted bool
+	object  *storobj.Object
+}
+
+// objectVersionsBucketLock serializes the lazy creation of the versions
+// bucket across all shards, it is only taken if the bucket does not exist yet
+var objectVersionsBucketLock sync.Mutex
+
+// initObjectVersions loads the versions bucket if versioning is enabled for
+// the class or if there is history from a previous run. Otherwise it is only
+// created once versioning is enabled and the first version is written.
+func (s *Shard) initObjectVersions(ctx context.Context) error {
+	_, err := os.Stat(path.Join(s.DBPathLSM(), helpers.ObjectVersionsBucketLSM))
+	if s.versioningConfig() != nil || err == nil {
+		if _, err := s.objectVersionsBucket(ctx, true); err != nil {
+			return err
+		}
+	}
+
+	// the sequence starts at the current time, so that it never repeats a
+	// number used before a restart
+	s.objectVersionsSeq = uint64(time.Now().UnixNano())
+
+	s.objectVersionsCycle = cyclemanager.NewMulti(cyclemanager.ObjectVersionsCycleTicker())
+	s.objectVersionsCycle.Register(s.deleteExpiredObjectVersions)
+	s.objectVersionsCycle.Start()
+
+	return nil
+}
+
+// versioningConfig returns the versioning config of the class or nil if
+// versioning is disabled. The config is read from the schema on every call, so
+// updates of the class take effect without restarting the shard.
+func (s *Shard) versioningConfig() *models.ObjectVersioningConfig {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(s.index.Config.ClassName)
+	if class == nil || class.ObjectVersioningConfig == nil ||
+		!class.ObjectVersioningConfig.Enabled {
+		return nil
+	}
+
+	return class.ObjectVersioningConfig
+}
+
+func (s *Shard) objectVersionsBucket(ctx context.Context, create bool) (*lsmkv.Bucket, error) {
+	if b := s.store.Bucket(helpers.ObjectVersionsBucketLSM); b != nil || !create {
+		return b, nil
+	}
+
+	objectVersionsBucketLock.Lock()
+	defer objectVersionsBucketLock.Unlock()
+
+	if err := s.store.CreateOrLoadBucket(ctx, helpers.ObjectVersionsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		s.dynamicMemtableSizing(),
+		s.memtableIdleConfig(),
+	); err != nil {
+		return nil, errors.Wrap(err, "create object versions bucket")
+	}
+
+	return s.store.Bucket(helpers.ObjectVersionsBucketLSM), nil
+}
+
+// objectVersionKey is the id of the object followed by the big endian
+// version and sequence number, so that a cursor iterates the versions of an
+// object in order. The version is in ms, the sequence number keeps versions
+// written within the same ms apart.
+func objectVersionKey(idBytes []byte, version int64, seq uint64) []byte {
+	key := make([]byte, objectVersionKeyLength)
+	copy(key, idBytes)
+	binary.BigEndian.PutUint64(key[objectVersionIDLength:], uint64(version))
+	binary.BigEndian.PutUint64(key[objectVersionIDLength+8:], seq)
+	return key
+}
+
+func objectVersionFromKey(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key[objectVersionIDLength:]))
+}
+
+// recordVersion adds a version to the history of an object, if versioning is
+// enabled for the class. data is the binary representation of the object or
+// nil if the object was deleted. It needs to be called while holding the doc
+// id lock of the object and before the object is written, so that a write
+// which could not be recorded fails without changing the object. Versions
+// exceeding the limits of the config are not deleted here, so that the cost
+// of a write doesn't grow with the length of the history. They are deleted by
+// the retention cycle and hidden from reads until then.
+func (s *Shard) recordVersion(idBytes []byte, version int64, data []byte) error {
+	cfg := s.versioningConfig()
+	if cfg == nil {
+		return nil
+	}
+
+	bucket, err := s.objectVersionsBucket(context.Background(), true)
+	if err != nil {
+		return errors.Wrap(err, "record version")
+	}
+
+	value := []byte{objectVersionMarkerDeleted}
+	if data != nil {
+		value = make([]byte, 1+len(data))
+		value[0] = objectVersionMarkerObject
+		copy(value[1:], data)
+	}
+
+	seq := atomic.AddUint64(&s.objectVersionsSeq, 1)
+	if err := bucket.Put(objectVersionKey(idBytes, version, seq), value); err != nil {
+		return errors.Wrap(err, "record version")
+	}
+
+	return nil
+}
+
+// expiredObjectVersions returns how many of the oldest versions exceed the
+// limits of the config. A version expires once there are more than
+// maxVersions newer ones, or maxAgeHours after it was replaced by the next
+// version. The newest version is never expired.
+func expiredObjectVersions(versions []int64, cfg *models.ObjectVersioningConfig,
+	now time.Time,
+) int {
+	expired := 0
+	if cfg.MaxVersions > 0 && int64(len(versions)) > cfg.MaxVersions {
+		expired = len(versions) - int(cfg.MaxVersions)
+	}
+
+	if cfg.MaxAgeHours > 0 {
+		cutoff := now.Add(-time.Duration(cfg.MaxAgeHours) * time.Hour).UnixMilli()
+		for i := expired; i < len(versions)-1 && versions[i+1] < cutoff; i++ {
+			expired = i + 1
+		}
+	}
+
+	return expired
+}
+
+// objectVersions returns the history of an object, newest first. The current
+// state of the object is always part of the list, even if it was written
+// before versioning was enabled.
+func (s *Shard) objectVersions(ctx context.Context, id strfmt.UUID) ([]objectVersion, error) {
+	parsed, err := uuid.Parse(id.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid id %q", id)
+	}
+	idBytes, err := parsed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []objectVersion
+	bucket, err := s.objectVersionsBucket(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	if bucket != nil {
+		versions, err = readObjectVersions(bucket, idBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// versions exceeding the limits of the config are hidden until they are
+	// deleted by the retention cycle
+	if cfg := s.versioningConfig(); cfg != nil {
+		numbers := make([]int64, len(versions))
+		for i := range versions {
+			numbers[i] = versions[i].version
+		}
+		versions = versions[expiredObjectVersions(numbers, cfg, time.Now()):]
+	}
+
+	// newest first
+	for i, j := 0, len(versions)-1; i < j; i, j = i+1, j-1 {
+		versions[i], versions[j] = versions[j], versions[i]
+	}
+
+	current, err := s.store.Bucket(helpers.ObjectsBucketLSM).Get(idBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "get current object")
+	}
+	if current != nil {
+		obj, err := storobj.FromBinary(current)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshal current object")
+		}
+
+		if len(versions) == 0 || versions[0].version != obj.LastUpdateTimeUnix() {
+			versions = append([]objectVersion{{
+				version: obj.LastUpdateTimeUnix(),
+				object:  obj,
+			}}, versions...)
+		}
+	}
+
+	return versions, nil
+}
+
+// readObjectVersions returns the stored history of an object, oldest first
+func readObjectVersions(bucket *lsmkv.Bucket, idBytes []byte) ([]objectVersion, error) {
+	c := bucket.Cursor()
+	defer c.Close()
+
+	var versions []objectVersion
+	for k, v := c.Seek(idBytes); k != nil && bytes.HasPrefix(k, idBytes); k, v = c.Next() {
+		if len(k) != objectVersionKeyLength {
+			continue
+		}
+		version := objectVersion{version: objectVersionFromKey(k)}
+
+		if len(v) == 0 || v[0] == objectVersionMarkerDeleted {
+			version.deleted = true
+		} else {
+			obj, err := storobj.FromBinary(v[1:])
+			if err != nil {
+				return nil, errors.Wrapf(err, "unmarshal version %d", version.version)
+			}
+			version.object = obj
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+// objectAsOf returns the version of an object which was current at the given
+// time (in ms since epoch), or nil if the object did not exist at that time
+func (s *Shard) objectAsOf(ctx context.Context, id strfmt.UUID,
+	asOf int64,
+) (*storobj.Object, error) {
+	versions, err := s.objectVersions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, version := range versions {
+		if version.version <= asOf {
+			return version.object, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// deleteExpiredObjectVersions is run periodically to enforce the limits of
+// the versioning config. Writes do not prune the history of an object, so
+// this is the only place where expired versions are deleted.
+func (s *Shard) deleteExpiredObjectVersions(shouldBreak cyclemanager.ShouldBreakFunc) bool {
+	cfg := s.versioningConfig()
+	if cfg == nil {
+		return false
+	}
+
+	if s.isReadOnly() || s.index.isBackupInProgress() {
+		return false
+	}
+
+	bucket, err := s.objectVersionsBucket(context.Background(), false)
+	if err != nil || bucket == nil {
+		return false
+	}
+
+	now := time.Now()
+	deleted := 0
+	var from []byte
+	for !shouldBreak() {
+		keys, next := scanExpiredObjectVersions(bucket, from, cfg, now)
+		for _, key := range keys {
+			if err := bucket.Delete(key); err != nil {
+				s.index.logger.WithField("action", "delete_expired_object_versions").
+					WithField("shard", s.name).WithError(err).
+					Error("could not delete expired object version")
+				return deleted > 0
+			}
+			deleted++
+		}
+
+		if next == nil {
+			break
+		}
+		from = next
+	}
+
+	return deleted > 0
+}
+
+// scanExpiredObjectVersions reads the versions of the objects starting at
+// from and returns the keys of the expired ones, as well as the key to
+// continue from in the next batch. Batches always end at the boundary of an
+// object, so that all versions of an object are seen at once.
+func scanExpiredObjectVersions(bucket *lsmkv.Bucket, from []byte,
+	cfg *models.ObjectVersioningConfig, now time.Time,
+) ([][]byte, []byte) {
+	c := bucket.Cursor()
+	defer c.Close()
+
+	var k []byte
+	if from == nil {
+		k, _ = c.First()
+	} else {
+		k, _ = c.Seek(from)
+	}
+
+	var (
+		expired  [][]byte
+		id       []byte
+		keys     [][]byte
+		versions []int64
+		read     int
+	)
+	flush := func() {
+		expired = append(expired, keys[:expiredObjectVersions(versions, cfg, now)]...)
+	}
+
+	for ; k != nil; k, _ = c.Next() {
+		if len(k) != objectVersionKeyLength {
+			continue
+		}
+		keyID := k[:objectVersionIDLength]
+
+		if !bytes.Equal(keyID, id) {
+			if id != nil {
+				flush()
+				if read >= objectVersionsScanBatchSize {
+					return expired, append([]byte{}, k...)
+				}
+			}
+			id = append([]byte{}, keyID...)
+			keys = nil
+			versions = versions[:0]
+		}
+
+		keys = append(keys, append([]byte{}, k...))
+		versions = append(versions, objectVersionFromKey(k))
+		read++
+	}
+
+	if id != nil {
+		flush()
+	}
+
+	return expired, nil
+}
@@ -0,0 +1,268 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestShard_ObjectVersions(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	versioningConfig := &models.ObjectVersioningConfig{
+		Enabled:     true,
+		MaxVersions: 3,
+	}
+	shd, _ := testShard(t, ctx, className, func(i *Index) {
+		i.getSchema = &fakeSchemaGetter{
+			shardState: singleShardState(),
+			schema: schema.Schema{
+				Objects: &models.Schema{
+					Classes: []*models.Class{
+						{
+							Class:                  className,
+							ObjectVersioningConfig: versioningConfig,
+						},
+					},
+				},
+			},
+		}
+	})
+
+	obj := testObject(className)
+	id := obj.ID()
+	name := func(obj *storobj.Object) interface{} {
+		return obj.Properties().(map[string]interface{})["name"]
+	}
+
+	t.Run("write versions", func(t *testing.T) {
+		for i, v := range []string{"v1", "v2"} {
+			obj.Object.Properties = map[string]interface{}{"name": v}
+			obj.Object.LastUpdateTimeUnix = int64(i+1) * 1000
+			require.Nil(t, shd.putObject(ctx, obj))
+		}
+
+		require.Nil(t, shd.mergeObject(ctx, objects.MergeDocument{
+			Class:           className,
+			ID:              id,
+			PrimitiveSchema: map[string]interface{}{"name": "v3"},
+			UpdateTime:      3000,
+		}))
+	})
+
+	t.Run("list versions", func(t *testing.T) {
+		versions, err := shd.objectVersions(ctx, id)
+		require.Nil(t, err)
+		require.Len(t, versions, 3)
+
+		for i, expected := range []string{"v3", "v2", "v1"} {
+			assert.Equal(t, int64(3-i)*1000, versions[i].version)
+			assert.False(t, versions[i].deleted)
+			assert.Equal(t, expected, name(versions[i].object))
+		}
+	})
+
+	t.Run("read as of a point in time", func(t *testing.T) {
+		res, err := shd.objectAsOf(ctx, id, 1500)
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "v1", name(res))
+
+		res, err = shd.objectAsOf(ctx, id, time.Now().UnixMilli())
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "v3", name(res))
+
+		res, err = shd.objectAsOf(ctx, id, 500)
+		require.Nil(t, err)
+		assert.Nil(t, res, "object did not exist yet")
+	})
+
+	t.Run("only maxVersions are kept", func(t *testing.T) {
+		obj.Object.Properties = map[string]interface{}{"name": "v4"}
+		obj.Object.LastUpdateTimeUnix = 4000
+		require.Nil(t, shd.putObject(ctx, obj))
+
+		versions, err := shd.objectVersions(ctx, id)
+		require.Nil(t, err)
+		require.Len(t, versions, 3)
+		assert.Equal(t, int64(4000), versions[0].version)
+		assert.Equal(t, int64(2000), versions[2].version)
+
+		// writes don't delete old versions, the retention cycle does
+		neverBreak := func() bool { return false }
+		assert.True(t, shd.deleteExpiredObjectVersions(neverBreak))
+		assert.False(t, shd.deleteExpiredObjectVersions(neverBreak))
+
+		versions, err = shd.objectVersions(ctx, id)
+		require.Nil(t, err)
+		require.Len(t, versions, 3)
+		assert.Equal(t, int64(2000), versions[2].version)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		_, err := shd.objectVersions(ctx, "not-a-uuid")
+		assert.NotNil(t, err)
+
+		_, err = shd.objectAsOf(ctx, "not-a-uuid", 1000)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("deletions are part of the history", func(t *testing.T) {
+		require.Nil(t, shd.deleteObject(ctx, id))
+
+		versions, err := shd.objectVersions(ctx, id)
+		require.Nil(t, err)
+		require.Len(t, versions, 3)
+		assert.True(t, versions[0].deleted)
+		assert.Nil(t, versions[0].object)
+		assert.Equal(t, int64(4000), versions[1].version)
+
+		res, err := shd.objectAsOf(ctx, id, time.Now().Add(time.Second).UnixMilli())
+		require.Nil(t, err)
+		assert.Nil(t, res, "object is deleted")
+
+		res, err = shd.objectAsOf(ctx, id, 4000)
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "v4", name(res))
+	})
+
+	t.Run("versions exceeding maxAgeHours are deleted periodically", func(t *testing.T) {
+		neverBreak := func() bool { return false }
+		versioningConfig.MaxVersions = 0
+		versioningConfig.MaxAgeHours = 1
+
+		assert.True(t, shd.deleteExpiredObjectVersions(neverBreak))
+		assert.False(t, shd.deleteExpiredObjectVersions(neverBreak))
+
+		// v4 was replaced by the deletion just now, so it is kept
+		versions, err := shd.objectVersions(ctx, id)
+		require.Nil(t, err)
+		require.Len(t, versions, 2)
+		assert.True(t, versions[0].deleted)
+		assert.Equal(t, int64(4000), versions[1].version)
+	})
+
+	t.Run("history is kept after restart", func(t *testing.T) {
+		require.Nil(t, shd.shutdown(ctx))
+
+		var err error
+		shd, err = NewShard(ctx, nil, shd.name, shd.index, &models.Class{Class: className},
+			shd.index.centralJobQueue)
+		require.Nil(t, err)
+		defer shd.shutdown(ctx)
+
+		versions, err := shd.objectVersions(ctx, id)
+		require.Nil(t, err)
+		assert.Len(t, versions, 2)
+	})
+}
+
+func TestShard_ObjectVersionsWithinOneMillisecond(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, _ := testShard(t, ctx, className, func(i *Index) {
+		i.getSchema = &fakeSchemaGetter{
+			shardState: singleShardState(),
+			schema: schema.Schema{
+				Objects: &models.Schema{
+					Classes: []*models.Class{
+						{
+							Class:                  className,
+							ObjectVersioningConfig: &models.ObjectVersioningConfig{Enabled: true},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	obj := testObject(className)
+	for _, v := range []string{"v1", "v2", "v3"} {
+		obj.Object.Properties = map[string]interface{}{"name": v}
+		obj.Object.LastUpdateTimeUnix = 1000
+		require.Nil(t, shd.putObject(ctx, obj))
+	}
+	require.Nil(t, shd.deleteObject(ctx, obj.ID()))
+
+	versions, err := shd.objectVersions(ctx, obj.ID())
+	require.Nil(t, err)
+	require.Len(t, versions, 4)
+	assert.True(t, versions[0].deleted)
+	for i, expected := range []string{"v3", "v2", "v1"} {
+		assert.Equal(t, int64(1000), versions[i+1].version)
+		assert.Equal(t, expected,
+			versions[i+1].object.Properties().(map[string]interface{})["name"])
+	}
+}
+
+func TestExpiredObjectVersions(t *testing.T) {
+	now := time.UnixMilli(10 * 3600 * 1000)
+	hours := func(h int64) int64 { return h * 3600 * 1000 }
+
+	tests := []struct {
+		name     string
+		versions []int64
+		cfg      models.ObjectVersioningConfig
+		expected int
+	}{
+		{
+			name:     "within maxVersions",
+			versions: []int64{1, 2, 3},
+			cfg:      models.ObjectVersioningConfig{MaxVersions: 3},
+			expected: 0,
+		},
+		{
+			name:     "exceeding maxVersions",
+			versions: []int64{1, 2, 3, 4, 5},
+			cfg:      models.ObjectVersioningConfig{MaxVersions: 2},
+			expected: 3,
+		},
+		{
+			name:     "versions replaced before maxAgeHours",
+			versions: []int64{hours(1), hours(2), hours(8), hours(9)},
+			cfg:      models.ObjectVersioningConfig{MaxAgeHours: 1},
+			expected: 2,
+		},
+		{
+			name:     "the newest version never expires",
+			versions: []int64{hours(1), hours(2)},
+			cfg:      models.ObjectVersioningConfig{MaxAgeHours: 1},
+			expected: 1,
+		},
+		{
+			name:     "both limits",
+			versions: []int64{hours(1), hours(8), hours(9), hours(9) + 1},
+			cfg:      models.ObjectVersioningConfig{MaxVersions: 3, MaxAgeHours: 3},
+			expected: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected,
+				expiredObjectVersions(test.versions, &test.cfg, now))
+		})
+	}
+}
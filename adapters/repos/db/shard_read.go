@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -94,6 +95,13 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 	return objects, nil
 }
 
+// objectsByDocID returns the objects with the given doc ids, including their
+// vectors. Doc ids which no longer exist are skipped.
+func (s *Shard) objectsByDocID(docIDs []uint64) ([]*storobj.Object, error) {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	return storobj.ObjectsByDocID(bucket, docIDs, additional.Properties{Vector: true})
+}
+
 // TODO: This does an actual read which is not really needed, if we see this
 // come up in profiling, we could optimize this by adding an explicit Exists()
 // on the LSMKV which only checks the bloom filters, which at least in the case
@@ -440,6 +448,14 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "get existing doc id from object binary")
 	}
 
+	// see comment in shard_write_put.go::putObjectLSM
+	if err := s.recordChange(changelog.TypeDelete, id, nil); err != nil {
+		return err
+	}
+	if err := s.recordVersion(idBytes, time.Now().UnixMilli(), nil); err != nil {
+		return err
+	}
+
 	err = bucket.Delete(idBytes)
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
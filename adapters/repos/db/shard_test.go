@@ -31,6 +31,8 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorprecision"
 )
 
 func TestShard_UpdateStatus(t *testing.T) {
@@ -190,3 +192,26 @@ func TestShard_ParallelBatches(t *testing.T) {
 	require.Equal(t, totalObjects, int(shd.counter.Get()))
 	require.Nil(t, idx.drop())
 }
+
+func TestShard_HalfPrecisionVectors(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className, func(i *Index) {
+		i.vectorIndexUserConfig = enthnsw.UserConfig{
+			Skip:            true,
+			VectorPrecision: string(vectorprecision.Float16),
+		}
+	})
+
+	obj := testObject(className)
+	obj.Vector = []float32{0.1, 0.2, 0.3}
+	require.Nil(t, shd.putObject(ctx, obj))
+
+	res, err := shd.objectByID(ctx, obj.ID(), nil, additional.Properties{Vector: true})
+	require.Nil(t, err)
+	assert.Equal(t, vectorprecision.Float16.Round([]float32{0.1, 0.2, 0.3}), res.Vector)
+	assert.Equal(t, vectorprecision.Float16, res.VectorPrecision)
+
+	require.Nil(t, idx.drop())
+	require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+}
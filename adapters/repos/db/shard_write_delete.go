@@ -14,12 +14,14 @@ package db
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -54,6 +56,14 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "get existing doc id from object binary")
 	}
 
+	// see comment in shard_write_put.go::putObjectLSM
+	if err := s.recordChange(changelog.TypeDelete, id, nil); err != nil {
+		return err
+	}
+	if err := s.recordVersion(idBytes, time.Now().UnixMilli(), nil); err != nil {
+		return err
+	}
+
 	err = bucket.Delete(idBytes)
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
@@ -111,7 +121,20 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	if obj == nil || bucket == nil {
 		return nil
 	}
-	err := bucket.Delete(idBytes)
+	id, err := uuid.FromBytes(idBytes)
+	if err != nil {
+		return fmt.Errorf("parse object id: %w", err)
+	}
+
+	// see comment in shard_write_put.go::putObjectLSM
+	if err := s.recordChange(changelog.TypeDelete, strfmt.UUID(id.String()), nil); err != nil {
+		return err
+	}
+	if err := s.recordVersion(idBytes, time.Now().UnixMilli(), nil); err != nil {
+		return err
+	}
+
+	err = bucket.Delete(idBytes)
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
@@ -85,6 +85,15 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 		schemaMap[filters.InternalPropLastUpdateTimeUnix] = object.Object.LastUpdateTimeUnix
 	}
 
-	props, err := inverted.NewAnalyzer(s.isFallbackToSearchable).Object(schemaMap, c.Properties, object.ID())
+	textAnalyzers, err := inverted.TextAnalyzersFromConfig(c.InvertedIndexConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	analyzer := inverted.NewAnalyzer(s.isFallbackToSearchable)
+	if s.index.invertedIndexConfig.IndexPositions {
+		analyzer = inverted.NewPositionalAnalyzer(s.isFallbackToSearchable)
+	}
+	props, err := analyzer.WithTextAnalyzers(textAnalyzers).Object(schemaMap, c.Properties, object.ID())
 	return props, nilProps, err
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -83,6 +84,11 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		return nil, objectInsertStatus{}, errors.Wrap(err, "get bucket")
 	}
 
+	if err := checkIfMatch(merge.ID, previous, merge.IfMatch); err != nil {
+		lock.Unlock()
+		return nil, objectInsertStatus{}, err
+	}
+
 	nextObj, _, err := s.mergeObjectData(previous, merge)
 	if err != nil {
 		lock.Unlock()
@@ -96,12 +102,23 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 	}
 
 	nextObj.SetDocID(status.docID)
+	nextObj.VectorPrecision = s.vectorPrecision()
 	nextBytes, err := nextObj.MarshalBinary()
 	if err != nil {
 		lock.Unlock()
 		return nil, status, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
 	}
 
+	// see comment in shard_write_put.go::putObjectLSM
+	if err := s.recordChange(mergeChangeType(merge, previous), merge.ID, nextObj); err != nil {
+		lock.Unlock()
+		return nil, status, err
+	}
+	if err := s.recordVersion(idBytes, nextObj.LastUpdateTimeUnix(), nextBytes); err != nil {
+		lock.Unlock()
+		return nil, status, err
+	}
+
 	if err := s.upsertObjectDataLSM(bucket, idBytes, nextBytes, status.docID); err != nil {
 		lock.Unlock()
 		return nil, status, errors.Wrap(err, "upsert object data")
@@ -165,11 +182,20 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 	out.status = status
 
 	nextObj.SetDocID(status.docID) // is not changed
+	nextObj.VectorPrecision = s.vectorPrecision()
 	nextBytes, err := nextObj.MarshalBinary()
 	if err != nil {
 		return out, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
 	}
 
+	// see comment in shard_write_put.go::putObjectLSM
+	if err := s.recordChange(mergeChangeType(merge, previous), merge.ID, nextObj); err != nil {
+		return out, err
+	}
+	if err := s.recordVersion(idBytes, nextObj.LastUpdateTimeUnix(), nextBytes); err != nil {
+		return out, err
+	}
+
 	if err := s.upsertObjectDataLSM(bucket, idBytes, nextBytes, status.docID); err != nil {
 		return out, errors.Wrap(err, "upsert object data")
 	}
@@ -180,6 +206,22 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 	return out, nil
 }
 
+// mergeChangeType determines how a merge is recorded in the change log. A
+// merge which only adds references to an existing object is recorded as a
+// reference change.
+func mergeChangeType(merge objects.MergeDocument, previous []byte) changelog.Type {
+	switch {
+	case previous == nil:
+		return changelog.TypeCreate
+	case len(merge.References) > 0 && len(merge.PrimitiveSchema) == 0 &&
+		len(merge.PropertiesToDelete) == 0 && len(merge.PropertyOperations) == 0 &&
+		merge.Vector == nil:
+		return changelog.TypeReference
+	default:
+		return changelog.TypeUpdate
+	}
+}
+
 type mutableMergeResult struct {
 	next     *storobj.Object
 	previous *storobj.Object
@@ -205,12 +247,17 @@ func (s *Shard) mergeObjectData(previous []byte,
 		previousObj = p
 	}
 
-	return mergeProps(previousObj, merge), previousObj, nil
+	next, err := mergeProps(previousObj, merge)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return next, previousObj, nil
 }
 
 func mergeProps(previous *storobj.Object,
 	merge objects.MergeDocument,
-) *storobj.Object {
+) (*storobj.Object, error) {
 	next := previous.DeepCopyDangerous()
 	properties, ok := next.Properties().(map[string]interface{})
 	if !ok || properties == nil {
@@ -227,6 +274,16 @@ func mergeProps(previous *storobj.Object,
 		properties[propName] = value
 	}
 
+	// operations are applied to the stored value, this happens while the
+	// object is locked, so concurrent operations cannot get lost
+	for propName, op := range merge.PropertyOperations {
+		value, err := op.Apply(properties[propName])
+		if err != nil {
+			return nil, objects.NewErrInvalidUserInput("property %q: %v", propName, err)
+		}
+		properties[propName] = value
+	}
+
 	for _, ref := range merge.References {
 		propName := ref.From.Property.String()
 		prop := properties[propName]
@@ -247,5 +304,5 @@ func mergeProps(previous *storobj.Object,
 	next.Object.LastUpdateTimeUnix = merge.UpdateTime
 	next.SetProperties(properties)
 
-	return next
+	return next, nil
 }
@@ -18,14 +18,19 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorprecision"
+	"github.com/weaviate/weaviate/usecases/objects"
 )
 
 func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
@@ -121,6 +126,20 @@ func (s *Shard) updateVectorIndex(vector []float32,
 	return nil
 }
 
+// vectorPrecision is the encoding of the vectors in the objects bucket. It
+// is part of the vector index config, but immutable, so it applies to the
+// objects bucket as well.
+func (s *Shard) vectorPrecision() vectorprecision.Precision {
+	hnswUserConfig, ok := s.index.vectorIndexUserConfig.(hnswent.UserConfig)
+	if !ok {
+		return vectorprecision.Float32
+	}
+
+	// the config was validated when the class was created
+	precision, _ := vectorprecision.Parse(hnswUserConfig.VectorPrecision)
+	return precision
+}
+
 func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 ) (objectInsertStatus, error) {
 	before := time.Now()
@@ -138,6 +157,11 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 		return objectInsertStatus{}, err
 	}
 
+	if err := checkIfMatch(object.ID(), previous_object_bytes, object.IfMatch); err != nil {
+		lock.Unlock()
+		return objectInsertStatus{}, err
+	}
+
 	status, err := s.determineInsertStatus(previous_object_bytes, object)
 	if err != nil {
 		lock.Unlock()
@@ -146,12 +170,28 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 	s.metrics.PutObjectDetermineStatus(before)
 
 	object.SetDocID(status.docID)
+	object.VectorPrecision = s.vectorPrecision()
 	data, err := object.MarshalBinary()
 	if err != nil {
 		lock.Unlock()
 		return status, errors.Wrapf(err, "marshal object %s to binary", object.ID())
 	}
 
+	// the change and the version are recorded before the object is written,
+	// so that a failure aborts the write before the object was touched
+	changeType := changelog.TypeUpdate
+	if previous_object_bytes == nil {
+		changeType = changelog.TypeCreate
+	}
+	if err := s.recordChange(changeType, object.ID(), object); err != nil {
+		lock.Unlock()
+		return status, err
+	}
+	if err := s.recordVersion(idBytes, object.LastUpdateTimeUnix(), data); err != nil {
+		lock.Unlock()
+		return status, err
+	}
+
 	before = time.Now()
 	if err := s.upsertObjectDataLSM(bucket, idBytes, data, status.docID); err != nil {
 		lock.Unlock()
@@ -170,6 +210,39 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 	return status, nil
 }
 
+// checkIfMatch compares the update time of the stored object with the one
+// expected by a conditional write. It needs to be called while holding the
+// doc id lock of the object, so that the check and the write are atomic.
+func checkIfMatch(id strfmt.UUID, previous []byte, ifMatch *int64) error {
+	if ifMatch == nil {
+		return nil
+	}
+
+	if previous == nil {
+		if *ifMatch == 0 {
+			return nil
+		}
+		return objects.NewErrPreconditionFailed(
+			"object %s does not exist, expected lastUpdateTimeUnix %d", id, *ifMatch)
+	}
+
+	current, err := storobj.LastUpdateTimeUnixFromBinary(previous)
+	if err != nil {
+		return errors.Wrap(err, "read update time of stored object")
+	}
+
+	if *ifMatch == 0 {
+		return objects.NewErrPreconditionFailed(
+			"object %s already exists with lastUpdateTimeUnix %d", id, current)
+	}
+	if current != *ifMatch {
+		return objects.NewErrPreconditionFailed(
+			"object %s has lastUpdateTimeUnix %d, expected %d", id, current, *ifMatch)
+	}
+
+	return nil
+}
+
 type objectInsertStatus struct {
 	docID        uint64
 	docIDChanged bool
@@ -11,7 +11,10 @@
 
 package sorter
 
-import "github.com/weaviate/weaviate/entities/storobj"
+import (
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
 
 type comparable struct {
 	docID uint64
@@ -25,10 +28,13 @@ type comparable struct {
 type comparableCreator struct {
 	extractor *comparableValueExtractor
 	propNames []string
+	nears     []*models.GeoCoordinates
 }
 
-func newComparableCreator(extractor *comparableValueExtractor, propNames []string) *comparableCreator {
-	return &comparableCreator{extractor, propNames}
+func newComparableCreator(extractor *comparableValueExtractor, propNames []string,
+	nears []*models.GeoCoordinates,
+) *comparableCreator {
+	return &comparableCreator{extractor, propNames, nears}
 }
 
 func (c *comparableCreator) createFromBytes(docID uint64, objData []byte) *comparable {
@@ -39,6 +45,9 @@ func (c *comparableCreator) createFromBytesWithPayload(docID uint64, objData []b
 	values := make([]interface{}, len(c.propNames))
 	for level, propName := range c.propNames {
 		values[level] = c.extractor.extractFromBytes(objData, propName)
+		if c.nears[level] != nil {
+			values[level] = c.extractor.toGeoDistance(values[level], c.nears[level])
+		}
 	}
 	return &comparable{docID, values, payload}
 }
@@ -51,6 +60,9 @@ func (c *comparableCreator) createFromObjectWithPayload(object *storobj.Object,
 	values := make([]interface{}, len(c.propNames))
 	for level, propName := range c.propNames {
 		values[level] = c.extractor.extractFromObject(object, propName)
+		if c.nears[level] != nil {
+			values[level] = c.extractor.toGeoDistance(values[level], c.nears[level])
+		}
 	}
 	return &comparable{object.DocID(), values, payload}
 }
@@ -16,6 +16,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -24,10 +25,11 @@ import (
 
 type comparableValueExtractor struct {
 	dataTypesHelper *dataTypesHelper
+	geoDistancer    distancer.Provider
 }
 
 func newComparableValueExtractor(dataTypesHelper *dataTypesHelper) *comparableValueExtractor {
-	return &comparableValueExtractor{dataTypesHelper}
+	return &comparableValueExtractor{dataTypesHelper, distancer.NewGeoProvider()}
 }
 
 func (e *comparableValueExtractor) extractFromBytes(objData []byte, propName string) interface{} {
@@ -196,6 +198,29 @@ func (e *comparableValueExtractor) mustExtractGeoCoordinates(value []string) *mo
 	panic("sorter: not a geo coordinates")
 }
 
+// toGeoDistance converts the value extracted from a geoCoordinates property
+// into the distance to the given point in meters. Objects without coordinates
+// stay nil, so they are sorted like any other missing value.
+func (e *comparableValueExtractor) toGeoDistance(value interface{},
+	near *models.GeoCoordinates,
+) interface{} {
+	fa, ok := value.(*[]float64)
+	if !ok || fa == nil {
+		return (*float64)(nil)
+	}
+
+	// the extracted values are ordered as [longitude, latitude]
+	point := []float32{float32((*fa)[1]), float32((*fa)[0])}
+	dist, _, err := e.geoDistancer.SingleDist(point,
+		[]float32{*near.Latitude, *near.Longitude})
+	if err != nil {
+		return (*float64)(nil)
+	}
+
+	d := float64(dist)
+	return &d
+}
+
 func (e *comparableValueExtractor) toFloatArrayFromPhoneNumber(value *models.PhoneNumber) []float64 {
 	return []float64{float64(value.CountryCode), float64(value.National)}
 }
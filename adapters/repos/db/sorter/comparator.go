@@ -11,14 +11,23 @@
 
 package sorter
 
+import "github.com/weaviate/weaviate/entities/models"
+
 type comparator struct {
 	comparators []basicComparator
 }
 
-func newComparator(dataTypesHelper *dataTypesHelper, propNames []string, orders []string) *comparator {
+func newComparator(dataTypesHelper *dataTypesHelper, propNames []string, orders []string,
+	nears []*models.GeoCoordinates,
+) *comparator {
 	provider := &basicComparatorProvider{}
 	comparators := make([]basicComparator, len(propNames))
 	for level, propName := range propNames {
+		if nears[level] != nil {
+			// values were already converted to the distance to the point
+			comparators[level] = newFloat64Comparator(orders[level])
+			continue
+		}
 		dataType := dataTypesHelper.getType(propName)
 		comparators[level] = provider.provide(dataType, orders[level])
 	}
@@ -84,8 +84,12 @@ func (s *lsmSorter) createHelper(sort []filters.Sort, limit int) (*lsmSorterHelp
 		return nil, err
 	}
 
-	comparator := newComparator(s.dataTypesHelper, propNames, orders)
-	creator := newComparableCreator(s.valueExtractor, propNames)
+	nears, err := extractNears(sort)
+	if err != nil {
+		return nil, err
+	}
+	comparator := newComparator(s.dataTypesHelper, propNames, orders, nears)
+	creator := newComparableCreator(s.valueExtractor, propNames, nears)
 	return newLsmSorterHelper(s.bucket, comparator, creator, limit), nil
 }
 
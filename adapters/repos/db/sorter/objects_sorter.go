@@ -47,8 +47,12 @@ func (s objectsSorter) Sort(objects []*storobj.Object,
 	class := s.schema.GetClass(objects[0].Class())
 	dataTypesHelper := newDataTypesHelper(class)
 	valueExtractor := newComparableValueExtractor(dataTypesHelper)
-	comparator := newComparator(dataTypesHelper, propNames, orders)
-	creator := newComparableCreator(valueExtractor, propNames)
+	nears, err := extractNears(sort)
+	if err != nil {
+		return nil, nil, err
+	}
+	comparator := newComparator(dataTypesHelper, propNames, orders, nears)
+	creator := newComparableCreator(valueExtractor, propNames, nears)
 
 	return newObjectsSorterHelper(comparator, creator, limit).
 		sort(objects, scores)
@@ -17,6 +17,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
@@ -322,6 +323,20 @@ func TestObjectsSorter(t *testing.T) {
 			wantObjs:  []*storobj.Object{cityNil2, cityNil, cityAmsterdam, cityBerlin, cityWroclaw, cityNewYork},
 			wantDists: []float32{0.0, 0.0, 0.4, 0.2, 0.1, 0.3},
 		},
+		{
+			name:      "sort by geo distance asc",
+			sort:      sortNear("location", "asc", 52.52, 13.405),
+			limit:     4,
+			wantObjs:  []*storobj.Object{cityNil2, cityNil, cityBerlin, cityWroclaw, cityAmsterdam, cityNewYork},
+			wantDists: []float32{0.0, 0.0, 0.2, 0.1, 0.4, 0.3},
+		},
+		{
+			name:      "sort by geo distance desc",
+			sort:      sortNear("location", "desc", 52.37, 4.9),
+			limit:     2,
+			wantObjs:  []*storobj.Object{cityNewYork, cityWroclaw, cityBerlin, cityAmsterdam, cityNil2, cityNil},
+			wantDists: []float32{0.3, 0.1, 0.2, 0.4, 0.0, 0.0},
+		},
 	}
 
 	for _, tt := range tests {
@@ -401,6 +416,12 @@ func sort4(property1, order1, property2, order2, property3, order3, property4, o
 	}
 }
 
+func sortNear(property, order string, lat, lon float32) []filters.Sort {
+	srt := createSort(property, order)
+	srt.Near = &models.GeoCoordinates{Latitude: &lat, Longitude: &lon}
+	return []filters.Sort{srt}
+}
+
 func extractCityNames(in []*storobj.Object) []string {
 	out := make([]string, len(in))
 	for i := range in {
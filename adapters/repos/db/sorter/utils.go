@@ -14,6 +14,7 @@ package sorter
 import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 func extractPropNamesAndOrders(sort []filters.Sort) ([]string, []string, error) {
@@ -33,6 +34,19 @@ func extractPropNamesAndOrders(sort []filters.Sort) ([]string, []string, error)
 	return propNames, orders, nil
 }
 
+// extractNears returns the point to sort by distance for each sort parameter,
+// or nil if the parameter is sorted by value
+func extractNears(sort []filters.Sort) ([]*models.GeoCoordinates, error) {
+	nears := make([]*models.GeoCoordinates, len(sort))
+	for i, srt := range sort {
+		if srt.Near != nil && (srt.Near.Latitude == nil || srt.Near.Longitude == nil) {
+			return nil, errors.New("invalid near parameter: latitude and longitude must be set")
+		}
+		nears[i] = srt.Near
+	}
+	return nears, nil
+}
+
 func validateLimit(limit, elementsCount int) int {
 	if limit > elementsCount {
 		return elementsCount
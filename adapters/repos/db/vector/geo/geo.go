@@ -135,6 +135,94 @@ func (i *Index) WithinRange(ctx context.Context,
 	return i.vectorIndex.KnnSearchByVectorMaxDist(query, geoRange.Distance, 800, nil)
 }
 
+// WithinPolygon searches the index for all points within the polygon. The
+// index is queried for candidates around the bounding box of the polygon,
+// these are then checked against the polygon itself. It is thread-safe and
+// can be called concurrently.
+func (i *Index) WithinPolygon(ctx context.Context,
+	polygon filters.GeoPolygon,
+) ([]uint64, error) {
+	if err := polygon.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid arguments")
+	}
+
+	return i.withinShape(ctx, polygon.BoundingBox(), polygon.Contains)
+}
+
+// WithinBoundingBox searches the index for all points within the box. It is
+// thread-safe and can be called concurrently.
+func (i *Index) WithinBoundingBox(ctx context.Context,
+	box filters.GeoBoundingBox,
+) ([]uint64, error) {
+	if err := box.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid arguments")
+	}
+
+	return i.withinShape(ctx, box, box.Contains)
+}
+
+func (i *Index) withinShape(ctx context.Context, box filters.GeoBoundingBox,
+	contains func(lat, lon float32) bool,
+) ([]uint64, error) {
+	query, maxDist, err := boundingRange(box)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := i.vectorIndex.KnnSearchByVectorMaxDist(query, maxDist, 800, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]uint64, 0, len(candidates))
+	for _, id := range candidates {
+		coordinates, err := i.config.CoordinatesForID(ctx, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get coordinates of candidate %d", id)
+		}
+
+		if coordinates == nil || coordinates.Latitude == nil ||
+			coordinates.Longitude == nil {
+			continue
+		}
+
+		if contains(*coordinates.Latitude, *coordinates.Longitude) {
+			out = append(out, id)
+		}
+	}
+
+	return out, nil
+}
+
+// boundingRange returns the center of the box and the distance to its
+// furthest point. For large boxes the furthest point is not necessarily a
+// corner, so the edges are sampled and the distance is padded slightly.
+func boundingRange(box filters.GeoBoundingBox) ([]float32, float32, error) {
+	const samplesPerEdge = 8
+	const padding = 1.05
+
+	centerLat, centerLon := box.Point(0.5, 0.5)
+	center := []float32{centerLat, centerLon}
+	dist := distancer.NewGeoProvider()
+
+	maxDist := float32(0)
+	for s := 0; s <= samplesPerEdge; s++ {
+		pos := float32(s) / samplesPerEdge
+		for _, xy := range [][2]float32{{pos, 0}, {pos, 1}, {0, pos}, {1, pos}} {
+			lat, lon := box.Point(xy[0], xy[1])
+			d, _, err := dist.SingleDist(center, []float32{lat, lon})
+			if err != nil {
+				return nil, 0, errors.Wrap(err, "calculate bounding range")
+			}
+			if d > maxDist {
+				maxDist = d
+			}
+		}
+	}
+
+	return center, maxDist * padding, nil
+}
+
 func (i *Index) Delete(id uint64) error {
 	return i.vectorIndex.Delete(id)
 }
@@ -110,6 +110,89 @@ func TestGeoJourney(t *testing.T) {
 	})
 }
 
+func TestGeoWithinShapes(t *testing.T) {
+	elements := []models.GeoCoordinates{
+		{ // munich
+			Latitude:  ptFloat32(48.13743),
+			Longitude: ptFloat32(11.57549),
+		},
+		{ // stuttgart
+			Latitude:  ptFloat32(48.78232),
+			Longitude: ptFloat32(9.17702),
+		},
+		{ // berlin
+			Latitude:  ptFloat32(52.52000),
+			Longitude: ptFloat32(13.40495),
+		},
+		{ // fiji, east of the antimeridian
+			Latitude:  ptFloat32(-16.57820),
+			Longitude: ptFloat32(179.41445),
+		},
+		{ // samoa, west of the antimeridian
+			Latitude:  ptFloat32(-13.75903),
+			Longitude: ptFloat32(-172.10463),
+		},
+	}
+
+	getCoordinates := func(ctx context.Context, id uint64) (*models.GeoCoordinates, error) {
+		return &elements[id], nil
+	}
+
+	geoIndex, err := NewIndex(Config{
+		ID:                 "unit-test",
+		CoordinatesForID:   getCoordinates,
+		DisablePersistence: true,
+		RootPath:           "doesnt-matter-persistence-is-off",
+	}, cyclemanager.NewNoop(), cyclemanager.NewNoop())
+	require.Nil(t, err)
+
+	for id, coordinates := range elements {
+		err := geoIndex.Add(uint64(id), &coordinates)
+		require.Nil(t, err)
+	}
+
+	t.Run("polygon around bavaria", func(t *testing.T) {
+		// contains munich, but not stuttgart, even though stuttgart is closer
+		// to the center of the polygon than some of its corners
+		polygon := filters.GeoPolygon{Coordinates: []*models.GeoCoordinates{
+			{Latitude: ptFloat32(50.5), Longitude: ptFloat32(11.0)},
+			{Latitude: ptFloat32(49.5), Longitude: ptFloat32(9.9)},
+			{Latitude: ptFloat32(47.4), Longitude: ptFloat32(10.0)},
+			{Latitude: ptFloat32(47.5), Longitude: ptFloat32(13.0)},
+			{Latitude: ptFloat32(49.0), Longitude: ptFloat32(13.8)},
+			{Latitude: ptFloat32(50.3), Longitude: ptFloat32(12.2)},
+		}}
+
+		results, err := geoIndex.WithinPolygon(context.Background(), polygon)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0}, results)
+	})
+
+	t.Run("bounding box around southern germany", func(t *testing.T) {
+		results, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     &models.GeoCoordinates{Latitude: ptFloat32(50), Longitude: ptFloat32(7)},
+			BottomRight: &models.GeoCoordinates{Latitude: ptFloat32(47), Longitude: ptFloat32(14)},
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1}, results)
+	})
+
+	t.Run("bounding box crossing the antimeridian", func(t *testing.T) {
+		results, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     &models.GeoCoordinates{Latitude: ptFloat32(-10), Longitude: ptFloat32(175)},
+			BottomRight: &models.GeoCoordinates{Latitude: ptFloat32(-20), Longitude: ptFloat32(-170)},
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{3, 4}, results)
+	})
+
+	t.Run("invalid polygon", func(t *testing.T) {
+		_, err := geoIndex.WithinPolygon(context.Background(), filters.GeoPolygon{})
+		assert.EqualError(t, err, "invalid arguments: a polygon needs at least 3 "+
+			"coordinates, got 0")
+	})
+}
+
 func ptFloat32(in float32) *float32 {
 	return &in
 }
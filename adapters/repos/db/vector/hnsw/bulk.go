@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ContainsNode returns whether the graph contains a node for the id
+func (h *hnsw) ContainsNode(id uint64) bool {
+	return h.nodeByID(id) != nil
+}
+
+// WriteGraph writes the complete graph to a single file in the commit log
+// directory and removes the commit logs it replaces. A regular index persists
+// every change as it happens, so this is only meant for an index which was
+// built with a NoopCommitLogger, such as by an offline bulk load. The file
+// has the format of a condensed commit log and is loaded at startup like any
+// other. WriteGraph must not be called concurrently with changes to the
+// index.
+func (h *hnsw) WriteGraph() error {
+	h.RLock()
+	defer h.RUnlock()
+
+	previous, err := getCommitFileNames(h.rootPath, h.id)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	if len(previous) > 0 {
+		last, err := asTimeStamp(filepath.Base(previous[len(previous)-1]))
+		if err != nil {
+			return err
+		}
+		if last >= now {
+			now = last + 1
+		}
+	}
+
+	fileName := commitLogFileName(h.rootPath, h.id, fmt.Sprintf("%d.condensed", now))
+	tmpName := fileName + ".scratch.tmp"
+	if err := h.writeGraphToFile(tmpName); err != nil {
+		os.Remove(tmpName)
+		return errors.Wrap(err, "write graph")
+	}
+	if err := os.Rename(tmpName, fileName); err != nil {
+		return errors.Wrapf(err, "rename tmp (%q) to final (%q)", tmpName, fileName)
+	}
+
+	// the graph file must not be the latest file, as the commit logger would
+	// append to it at startup
+	active, err := os.Create(commitLogFileName(h.rootPath, h.id, fmt.Sprintf("%d", now+1)))
+	if err != nil {
+		return errors.Wrap(err, "create commit log file")
+	}
+	if err := active.Close(); err != nil {
+		return errors.Wrap(err, "create commit log file")
+	}
+
+	for _, name := range previous {
+		if err := os.Remove(name); err != nil {
+			return errors.Wrap(err, "cleanup replaced commit log")
+		}
+	}
+
+	return nil
+}
+
+func (h *hnsw) writeGraphToFile(fileName string) error {
+	f, err := os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	c := NewMemoryCondensor(h.logger)
+	c.newLogFile = f
+	c.newLog = NewWriterSize(f, 1*1024*1024)
+
+	empty := true
+	for _, node := range h.nodes {
+		if node == nil {
+			continue
+		}
+		empty = false
+
+		node.Lock()
+		level, connections := node.level, node.connections
+		node.Unlock()
+
+		if level > 0 {
+			// nodes at level zero are added implicitly by their links, see
+			// MemoryCondensor.Do
+			if err := c.AddNode(node); err != nil {
+				return errors.Wrapf(err, "write node %d", node.id)
+			}
+		}
+		for level, links := range connections {
+			if err := c.SetLinksAtLevel(node.id, level, links); err != nil {
+				return errors.Wrapf(err, "write links for node %d at level %d", node.id, level)
+			}
+		}
+	}
+
+	if !empty {
+		if err := c.SetEntryPointWithMaxLayer(h.entryPointID,
+			h.currentMaximumLayer); err != nil {
+			return errors.Wrap(err, "write entrypoint")
+		}
+	}
+
+	if err := c.newLog.Flush(); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -55,6 +55,38 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 		}
 	}
 
+	immutableStringFields := []immutableString{
+		{
+			// the vectors on disk would need to be re-encoded
+			name:     "vectorPrecision",
+			accessor: func(c ent.UserConfig) string { return c.VectorPrecision },
+		},
+	}
+
+	for _, u := range immutableStringFields {
+		if err := validateImmutableStringField(u, initialParsed, updatedParsed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type immutableString struct {
+	accessor func(c ent.UserConfig) string
+	name     string
+}
+
+func validateImmutableStringField(u immutableString,
+	previous, next ent.UserConfig,
+) error {
+	oldField := u.accessor(previous)
+	newField := u.accessor(next)
+	if oldField != newField {
+		return errors.Errorf("%s is immutable: attempted change from \"%s\" to \"%s\"",
+			u.name, oldField, newField)
+	}
+
 	return nil
 }
 
@@ -90,6 +122,7 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efMax, int64(parsed.DynamicEFMax))
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+	h.acornSearch.Store(parsed.FilterStrategy == ent.FilterStrategyAcorn)
 
 	if !parsed.PQ.Enabled {
 		callback()
@@ -55,6 +55,19 @@ func TestUserConfigUpdates(t *testing.T) {
 					"cleanupIntervalSeconds is immutable: " +
 						"attempted change from \"60\" to \"90\""),
 			},
+			{
+				name:    "attempting to change vector precision",
+				initial: ent.UserConfig{VectorPrecision: "float32"},
+				update:  ent.UserConfig{VectorPrecision: "float16"},
+				expectedError: errors.Errorf(
+					"vectorPrecision is immutable: " +
+						"attempted change from \"float32\" to \"float16\""),
+			},
+			{
+				name:    "changing the filter strategy",
+				initial: ent.UserConfig{FilterStrategy: "sweeping"},
+				update:  ent.UserConfig{FilterStrategy: "acorn"},
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
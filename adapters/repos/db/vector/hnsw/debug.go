@@ -130,6 +130,7 @@ func NewFromJSONDump(dumpBytes []byte, vecForID VectorForID) (*hnsw, error) {
 	index.currentMaximumLayer = dump.CurrentMaximumLayer
 	index.entryPointID = dump.Entrypoint
 	index.tombstones = dump.Tombstones
+	index.nodeCount.Store(int64(len(dump.Nodes)))
 
 	for _, n := range dump.Nodes {
 		index.nodes[n.ID] = &vertex{
@@ -166,6 +167,7 @@ func NewFromJSONDumpMap(dumpBytes []byte, vecForID VectorForID) (*hnsw, error) {
 	index.currentMaximumLayer = dump.CurrentMaximumLayer
 	index.entryPointID = dump.Entrypoint
 	index.tombstones = dump.Tombstones
+	index.nodeCount.Store(int64(len(dump.Nodes)))
 
 	for _, n := range dump.Nodes {
 		index.nodes[n.ID] = &vertex{
@@ -116,6 +116,7 @@ func (h *hnsw) resetUnsecured() error {
 	h.currentMaximumLayer = 0
 	h.initialInsertOnce = &sync.Once{}
 	h.nodes = make([]*vertex, initialSize)
+	h.nodeCount.Store(0)
 
 	return h.commitLog.Reset()
 }
@@ -574,6 +575,9 @@ func (h *hnsw) removeTombstonesAndNodes(deleteList helpers.AllowList, breakClean
 
 		h.resetLock.Lock()
 		if !breakCleanUpTombstonedNodes() {
+			if h.nodes[id] != nil {
+				h.nodeCount.Add(-1)
+			}
 			h.nodes[id] = nil
 			if h.compressed.Load() {
 				h.compressedVectorsCache.delete(context.TODO(), id)
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import "github.com/weaviate/weaviate/entities/vectorprecision"
+
+// HalfPrecisionProvider wraps the provider of the configured distance metric
+// for indexes which keep their vectors as float16 or bfloat16. All float32
+// calculations are passed through unchanged, the Distancers it creates can
+// additionally calculate the distance to a 16-bit encoded vector.
+type HalfPrecisionProvider struct {
+	Provider
+	precision vectorprecision.Precision
+}
+
+func NewHalfPrecisionProvider(provider Provider,
+	precision vectorprecision.Precision,
+) HalfPrecisionProvider {
+	return HalfPrecisionProvider{Provider: provider, precision: precision}
+}
+
+func (p HalfPrecisionProvider) Precision() vectorprecision.Precision {
+	return p.precision
+}
+
+func (p HalfPrecisionProvider) New(vec []float32) Distancer {
+	return &HalfPrecisionDistancer{
+		inner:     p.Provider.New(vec),
+		precision: p.precision,
+		buf:       make([]float32, len(vec)),
+	}
+}
+
+// HalfPrecisionDistancer converts the encoded vectors on the fly. The query
+// vector is kept at full precision.
+//
+// The decoding buffer is reused between calls, so a HalfPrecisionDistancer
+// must not be used concurrently. This matches how Distancers are used in
+// searches, where each search creates its own Distancer.
+type HalfPrecisionDistancer struct {
+	inner     Distancer
+	precision vectorprecision.Precision
+	buf       []float32
+}
+
+func (d *HalfPrecisionDistancer) Distance(vec []float32) (float32, bool, error) {
+	return d.inner.Distance(vec)
+}
+
+func (d *HalfPrecisionDistancer) DistanceToEncoded(vec []uint16) (float32, bool, error) {
+	d.buf = d.precision.DecodeInto(d.buf, vec)
+	return d.inner.Distance(d.buf)
+}
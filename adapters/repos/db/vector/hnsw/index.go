@@ -29,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/storobj"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorprecision"
 )
 
 type hnsw struct {
@@ -85,6 +86,14 @@ type hnsw struct {
 	// on filtered searches with less than n elements, perform flat search
 	flatSearchCutoff int64
 
+	// on restrictive filtered searches, only traverse nodes which pass the
+	// filter and use two-hop expansion to bridge filtered-out neighbors
+	acornSearch atomic.Bool
+
+	// nodeCount is the number of nodes in the graph, contrary to len(nodes)
+	// which is the capacity the index has grown to
+	nodeCount atomic.Int64
+
 	levelNormalizer float64
 
 	nodes []*vertex
@@ -94,6 +103,10 @@ type hnsw struct {
 
 	cache cache[float32]
 
+	// only set if vectors are kept as float16 or bfloat16, in which case it
+	// is also the cache above
+	halfCache *shardedLockCache[uint16]
+
 	commitLog CommitLogger
 
 	// a lookup of current tombstones (i.e. nodes that have received a tombstone,
@@ -208,8 +221,27 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCleanupCycle cyclemanager.Cycle
 		normalizeOnRead = true
 	}
 
-	vectorCache := newShardedLockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
-		cfg.Logger, normalizeOnRead, defaultDeletionInterval)
+	precision, err := vectorprecision.Parse(uc.VectorPrecision)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	var (
+		vectorCache      cache[float32]
+		vectorForID      VectorForID
+		multiVectorForID MultiVectorForID
+		halfCache        *shardedLockCache[uint16]
+	)
+	if precision.IsHalf() {
+		halfCache = newHalfPrecisionCache(cfg.VectorForIDThunk, precision,
+			uc.VectorCacheMaxObjects, cfg.Logger, normalizeOnRead, defaultDeletionInterval)
+		vectorCache, vectorForID, multiVectorForID = halfCache, halfCache.get, halfCache.multiGet
+		cfg.DistanceProvider = distancer.NewHalfPrecisionProvider(cfg.DistanceProvider, precision)
+	} else {
+		sharded := newShardedLockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
+			cfg.Logger, normalizeOnRead, defaultDeletionInterval)
+		vectorCache, vectorForID, multiVectorForID = sharded, sharded.get, sharded.multiGet
+	}
 
 	var compressedVectorsCache *compressedShardedLockCache
 	if uc.PQ.Enabled {
@@ -229,8 +261,9 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCleanupCycle cyclemanager.Cycle
 		flatSearchCutoff:       int64(uc.FlatSearchCutoff),
 		nodes:                  make([]*vertex, initialSize),
 		cache:                  vectorCache,
-		vectorForID:            vectorCache.get,
-		multiVectorForID:       vectorCache.multiGet,
+		halfCache:              halfCache,
+		vectorForID:            vectorForID,
+		multiVectorForID:       multiVectorForID,
 		compressedVectorsCache: compressedVectorsCache,
 		id:                     cfg.ID,
 		rootPath:               cfg.RootPath,
@@ -258,6 +291,8 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCleanupCycle cyclemanager.Cycle
 		className:          cfg.ClassName,
 	}
 
+	index.acornSearch.Store(uc.FilterStrategy == ent.FilterStrategyAcorn)
+
 	// TODO common_cycle_manager move to poststartup?
 	index.unregisterTombstoneCleanup = tombstoneCleanupCycle.Register(index.tombstoneCleanup)
 	index.insertMetrics = newInsertMetrics(index.metrics)
@@ -87,7 +87,7 @@ func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
 		return errors.Wrapf(err, "grow HNSW index to accommodate node %d", node.id)
 	}
 
-	h.nodes[node.id] = node
+	h.setNode(node)
 	if h.compressed.Load() {
 		compressed := h.pq.Encode(nodeVec)
 		h.storeCompressedVector(node.id, compressed)
@@ -172,7 +172,7 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 	}
 
 	h.Lock()
-	h.nodes[nodeId] = node
+	h.setNode(node)
 	h.Unlock()
 
 	h.insertMetrics.prepareAndInsertNode(before)
@@ -215,3 +215,12 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 
 	return nil
 }
+
+// setNode stores the node in the graph, it needs to be called with the lock
+// held
+func (h *hnsw) setNode(node *vertex) {
+	if h.nodes[node.id] == nil {
+		h.nodeCount.Add(1)
+	}
+	h.nodes[node.id] = node
+}
@@ -20,6 +20,7 @@ import (
 	"math/rand"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -100,6 +101,86 @@ func TestHnswPersistence(t *testing.T) {
 		})
 }
 
+func TestHnswPersistence_WriteGraph(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := t.TempDir()
+	indexID := "integrationtest_write_graph"
+	logger, _ := test.NewNullLogger()
+
+	newIndex := func(makeCL MakeCommitLogger) *hnsw {
+		index, err := New(Config{
+			RootPath:              dirName,
+			ID:                    indexID,
+			MakeCommitLoggerThunk: makeCL,
+			DistanceProvider:      distancer.NewCosineDistanceProvider(),
+			VectorForIDThunk:      testVectorForID,
+		}, ent.UserConfig{
+			MaxConnections: 30,
+			EFConstruction: 60,
+		}, cyclemanager.NewNoop())
+		require.Nil(t, err)
+		return index
+	}
+	// see index_test.go for more context
+	expectedResults := []uint64{
+		3, 5, 4, // cluster 2
+		7, 8, 6, // cluster 3
+		2, 1, 0, // cluster 1
+	}
+
+	// build the graph without a commit log
+	index := newIndex(func() (CommitLogger, error) {
+		return &NoopCommitLogger{}, nil
+	})
+	for i, vec := range testVectors[:5] {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+	require.Nil(t, index.WriteGraph())
+	assert.True(t, index.ContainsNode(4))
+	assert.False(t, index.ContainsNode(5))
+
+	t.Run("the graph is extended and written again", func(t *testing.T) {
+		index = newIndex(func() (CommitLogger, error) {
+			return &NoopCommitLogger{}, nil
+		})
+		for i, vec := range testVectors {
+			if !index.ContainsNode(uint64(i)) {
+				require.Nil(t, index.Add(uint64(i), vec))
+			}
+		}
+		require.Nil(t, index.WriteGraph())
+
+		// the graph file replaces the previous one and is followed by an empty
+		// commit log
+		files, err := getCommitFileNames(dirName, indexID)
+		require.Nil(t, err)
+		require.Len(t, files, 2)
+		assert.True(t, strings.HasSuffix(files[0], ".condensed"))
+	})
+
+	t.Run("the graph is loaded with a commit logger", func(t *testing.T) {
+		cl, err := NewCommitLogger(dirName, indexID, logger, cyclemanager.NewNoop())
+		require.Nil(t, err)
+		secondIndex := newIndex(func() (CommitLogger, error) {
+			return cl, nil
+		})
+
+		res, _, err := secondIndex.knnSearchByVector(testVectors[3], 50, 36, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expectedResults, res)
+
+		// new changes are not written into the graph file
+		require.Nil(t, secondIndex.Add(uint64(len(testVectors)), testVectors[0]))
+		require.Nil(t, secondIndex.Flush())
+		files, err := getCommitFileNames(dirName, indexID)
+		require.Nil(t, err)
+		info, err := os.Stat(files[len(files)-1])
+		require.Nil(t, err)
+		assert.NotZero(t, info.Size())
+		assert.False(t, strings.HasSuffix(files[len(files)-1], ".condensed"))
+	})
+}
+
 func TestHnswPersistence_CorruptWAL(t *testing.T) {
 	rand.Seed(time.Now().UnixNano())
 	dirName := t.TempDir()
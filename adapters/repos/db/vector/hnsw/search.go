@@ -420,6 +420,10 @@ func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
 func (h *hnsw) distanceToFloatNode(distancer distancer.Distancer,
 	nodeID uint64,
 ) (float32, bool, error) {
+	if h.halfCache != nil {
+		return h.distanceToHalfNode(distancer, nodeID)
+	}
+
 	candidateVec, err := h.vectorForID(context.Background(), nodeID)
 	if err != nil {
 		var e storobj.ErrNotFound
@@ -440,6 +444,44 @@ func (h *hnsw) distanceToFloatNode(distancer distancer.Distancer,
 	return dist, true, nil
 }
 
+// distanceToHalfNode calculates the distance without decoding the cached
+// vector into a new slice, as long as the distancer supports it
+func (h *hnsw) distanceToHalfNode(d distancer.Distancer,
+	nodeID uint64,
+) (float32, bool, error) {
+	halfDistancer, ok := d.(*distancer.HalfPrecisionDistancer)
+	if !ok {
+		vec, err := h.halfCache.get(context.Background(), nodeID)
+		if err != nil {
+			return h.handleVectorForIDErr(err, nodeID)
+		}
+		return d.Distance(vec)
+	}
+
+	vec, err := h.halfCache.getEncoded(context.Background(), nodeID)
+	if err != nil {
+		return h.handleVectorForIDErr(err, nodeID)
+	}
+
+	dist, _, err := halfDistancer.DistanceToEncoded(vec)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "calculate distance between candidate and query")
+	}
+
+	return dist, true, nil
+}
+
+func (h *hnsw) handleVectorForIDErr(err error, nodeID uint64) (float32, bool, error) {
+	var e storobj.ErrNotFound
+	if errors.As(err, &e) {
+		h.handleDeletedNode(e.DocID)
+		return 0, false, nil
+	}
+
+	// not a typed error, we can recover from, return with err
+	return 0, false, errors.Wrapf(err, "get vector of docID %d", nodeID)
+}
+
 // the underlying object seems to have been deleted, to recover from
 // this situation let's add a tombstone to the deleted object, so it
 // will be cleaned up and skip this candidate in the current search
@@ -522,7 +564,16 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 
 	eps := priorityqueue.NewMin(10)
 	eps.Insert(entryPointID, entryPointDistance)
-	res, err := h.searchLayerByVector(searchVec, eps, ef, 0, allowList)
+
+	var res *priorityqueue.Queue
+	if h.useAcorn(allowList) {
+		if err := h.addAcornSeeds(eps, searchVec, allowList); err != nil {
+			return nil, nil, errors.Wrap(err, "knn search: add filtered entrypoints")
+		}
+		res, err = h.searchLayerByVectorWithFilter(searchVec, eps, ef, allowList)
+	} else {
+		res, err = h.searchLayerByVector(searchVec, eps, ef, 0, allowList)
+	}
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
 	}
@@ -0,0 +1,236 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+)
+
+const (
+	// acornMaxSelectivity is the ratio of allowed to indexed nodes above which
+	// the regular sweeping traversal is used, even if acorn is configured. With
+	// permissive filters most neighbors pass the filter anyway and the two-hop
+	// expansion would only add overhead.
+	acornMaxSelectivity = 0.4
+
+	// acornSeeds is the number of additional entrypoints taken from the allow
+	// list. They make sure the traversal starts next to at least some matches,
+	// even if the entrypoint found on the upper layers is surrounded by nodes
+	// which are all filtered out.
+	acornSeeds = 10
+)
+
+// useAcorn decides whether a filtered search should use the filter-aware
+// traversal. The selectivity of the filter is estimated from the size of the
+// allow list relative to the number of nodes in the index.
+func (h *hnsw) useAcorn(allowList helpers.AllowList) bool {
+	if allowList == nil || !h.acornSearch.Load() {
+		return false
+	}
+
+	size := h.nodeCount.Load()
+
+	if size == 0 {
+		return false
+	}
+
+	return float64(allowList.Len())/float64(size) < acornMaxSelectivity
+}
+
+// addAcornSeeds adds the first allowed nodes as additional entrypoints for the
+// search on layer zero
+func (h *hnsw) addAcornSeeds(eps *priorityqueue.Queue, searchVec []float32,
+	allowList helpers.AllowList,
+) error {
+	it := allowList.LimitedIterator(acornSeeds)
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		if h.nodeByID(id) == nil || h.hasTombstone(id) {
+			continue
+		}
+
+		dist, ok, err := h.distBetweenNodeAndVec(id, searchVec)
+		if err != nil {
+			return errors.Wrapf(err, "distance between seed %d and query", id)
+		}
+		if !ok {
+			continue
+		}
+
+		eps.Insert(id, dist)
+	}
+
+	return nil
+}
+
+// searchLayerByVectorWithFilter is the filter-aware equivalent of
+// searchLayerByVector on layer zero. Contrary to the sweeping search, nodes
+// which do not pass the filter never become candidates. This keeps the
+// candidate queue focused on matches, so the worst result distance converges
+// just as quickly as in an unfiltered search. To not lose connectivity
+// through the filtered-out nodes, their neighbors are inspected as well
+// (two-hop expansion), as described in the ACORN paper.
+func (h *hnsw) searchLayerByVectorWithFilter(queryVector []float32,
+	entrypoints *priorityqueue.Queue, ef int, allowList helpers.AllowList,
+) (*priorityqueue.Queue, error) {
+	h.pools.visitedListsLock.Lock()
+	visited := h.pools.visitedLists.Borrow()
+	h.pools.visitedListsLock.Unlock()
+
+	candidates := h.pools.pqCandidates.GetMin(ef)
+	results := h.pools.pqResults.GetMax(ef)
+	var floatDistancer distancer.Distancer
+	var byteDistancer *ssdhelpers.PQDistancer
+	if h.compressed.Load() {
+		byteDistancer = h.pq.NewDistancer(queryVector)
+	} else {
+		floatDistancer = h.distancerProvider.New(queryVector)
+	}
+
+	distanceTo := func(id uint64) (float32, bool, error) {
+		if h.compressed.Load() {
+			return h.distanceToByteNode(byteDistancer, id)
+		}
+		return h.distanceToFloatNode(floatDistancer, id)
+	}
+
+	// the entrypoints are always used as candidates, even if they don't match
+	// the filter, otherwise a search could not start from the global
+	// entrypoint
+	h.insertViableEntrypointsAsCandidatesAndResults(entrypoints, candidates,
+		results, 0, visited, allowList)
+
+	worstResultDistance, err := h.currentWorstResultDistance(results, floatDistancer, byteDistancer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "calculate distance of current last result")
+	}
+
+	consider := func(id uint64) error {
+		distance, ok, err := distanceTo(id)
+		if err != nil {
+			return errors.Wrap(err, "calculate distance between candidate and query")
+		}
+
+		if !ok {
+			// node was deleted in the underlying object store
+			return nil
+		}
+
+		if distance >= worstResultDistance && results.Len() >= ef {
+			return nil
+		}
+
+		candidates.Insert(id, distance)
+		if h.hasTombstone(id) {
+			return nil
+		}
+
+		results.Insert(id, distance)
+		if results.Len() > ef {
+			results.Pop()
+		}
+		if results.Len() > 0 {
+			worstResultDistance = results.Top().Dist
+		}
+
+		return nil
+	}
+
+	connectionsReusable := make([]uint64, h.maximumConnectionsLayerZero)
+	secondHopReusable := make([]uint64, h.maximumConnectionsLayerZero)
+
+	for candidates.Len() > 0 {
+		if candidates.Top().Dist > worstResultDistance {
+			break
+		}
+
+		candidate := candidates.Pop()
+		connectionsReusable = h.copyConnectionsAtLayerZero(candidate.ID, connectionsReusable)
+
+		for _, neighborID := range connectionsReusable {
+			if visited.Visited(neighborID) {
+				continue
+			}
+			visited.Visit(neighborID)
+
+			if allowList.Contains(neighborID) {
+				if err := consider(neighborID); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			// the neighbor itself does not match the filter, but it might bridge
+			// to nodes which do
+			secondHopReusable = h.copyConnectionsAtLayerZero(neighborID, secondHopReusable)
+			for _, secondHopID := range secondHopReusable {
+				if visited.Visited(secondHopID) || !allowList.Contains(secondHopID) {
+					continue
+				}
+				visited.Visit(secondHopID)
+
+				if err := consider(secondHopID); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	h.pools.pqCandidates.Put(candidates)
+
+	h.pools.visitedListsLock.Lock()
+	h.pools.visitedLists.Return(visited)
+	h.pools.visitedListsLock.Unlock()
+
+	// results are passed on, so it's in the callers responsibility to return the
+	// list to the pool after using it
+	return results, nil
+}
+
+// copyConnectionsAtLayerZero copies the layer zero connections of the
+// specified node into the provided buffer. If the node does not exist
+// (anymore) an empty slice is returned.
+func (h *hnsw) copyConnectionsAtLayerZero(id uint64, buf []uint64) []uint64 {
+	node := h.nodeByID(id)
+	if node == nil {
+		return buf[:0]
+	}
+
+	node.Lock()
+	defer node.Unlock()
+
+	if len(node.connections) == 0 {
+		return buf[:0]
+	}
+
+	conns := node.connections[0]
+	if len(conns) > cap(buf) {
+		// see searchLayerByVector for how a node can end up with more
+		// connections than the allowed maximum
+		buf = make([]uint64, len(conns))
+	}
+	buf = buf[:len(conns)]
+	copy(buf, conns)
+	return buf
+}
+
+func (h *hnsw) currentWorstResultDistance(results *priorityqueue.Queue,
+	floatDistancer distancer.Distancer, byteDistancer *ssdhelpers.PQDistancer,
+) (float32, error) {
+	if h.compressed.Load() {
+		return h.currentWorstResultDistanceToByte(results, byteDistancer)
+	}
+	return h.currentWorstResultDistanceToFloat(results, floatDistancer)
+}
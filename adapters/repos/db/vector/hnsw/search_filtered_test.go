@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestFilteredSearchWithAcorn(t *testing.T) {
+	const (
+		size      = 3000
+		dims      = 16
+		k         = 10
+		queries   = 20
+		everyNth  = 25
+		minRecall = 0.9
+	)
+
+	r := rand.New(rand.NewSource(7))
+	randomVector := func() []float32 {
+		vec := make([]float32, dims)
+		for i := range vec {
+			vec[i] = r.Float32()
+		}
+		return vec
+	}
+
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = randomVector()
+	}
+
+	allowList := helpers.NewAllowList()
+	for i := 0; i < size; i += everyNth {
+		allowList.Insert(uint64(i))
+	}
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "filtered-search-acorn",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 100000,
+		FilterStrategy:        ent.FilterStrategyAcorn,
+	}, cyclemanager.NewNoop())
+	require.Nil(t, err)
+	index.forbidFlat = true
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	bruteForce := func(query []float32) []uint64 {
+		type scored struct {
+			id   uint64
+			dist float32
+		}
+		var all []scored
+		for _, id := range allowList.Slice() {
+			dist, _, err := distancer.NewL2SquaredProvider().SingleDist(query, vectors[id])
+			require.Nil(t, err)
+			all = append(all, scored{id, dist})
+		}
+		sort.Slice(all, func(a, b int) bool { return all[a].dist < all[b].dist })
+		out := make([]uint64, k)
+		for i := range out {
+			out[i] = all[i].id
+		}
+		return out
+	}
+
+	t.Run("acorn is picked for restrictive filters only", func(t *testing.T) {
+		assert.True(t, index.useAcorn(allowList))
+		assert.False(t, index.useAcorn(nil))
+
+		permissive := helpers.NewAllowList()
+		for i := 0; i < size; i++ {
+			permissive.Insert(uint64(i))
+		}
+		assert.False(t, index.useAcorn(permissive))
+	})
+
+	t.Run("selectivity is based on the number of nodes", func(t *testing.T) {
+		small, err := New(Config{
+			RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+			ID:                    "filtered-search-acorn-small",
+			MakeCommitLoggerThunk: MakeNoopCommitLogger,
+			DistanceProvider:      distancer.NewL2SquaredProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+		}, ent.UserConfig{
+			MaxConnections:        16,
+			EFConstruction:        64,
+			VectorCacheMaxObjects: 100000,
+			FilterStrategy:        ent.FilterStrategyAcorn,
+		}, cyclemanager.NewNoop())
+		require.Nil(t, err)
+		for i := 0; i < 100; i++ {
+			require.Nil(t, small.Add(uint64(i), vectors[i]))
+		}
+		// the index has room for many more nodes than it contains
+		require.Greater(t, len(small.nodes), 100)
+
+		// half of the nodes pass the filter, which is too permissive for acorn
+		half := helpers.NewAllowList()
+		for i := 0; i < 100; i += 2 {
+			half.Insert(uint64(i))
+		}
+		assert.False(t, small.useAcorn(half))
+	})
+
+	t.Run("results match the filter with high recall", func(t *testing.T) {
+		hits := 0
+		for i := 0; i < queries; i++ {
+			query := randomVector()
+			res, _, err := index.SearchByVector(query, k, allowList)
+			require.Nil(t, err)
+			require.Len(t, res, k)
+
+			for _, id := range res {
+				assert.True(t, allowList.Contains(id))
+			}
+
+			truth := bruteForce(query)
+			for _, id := range res {
+				for _, expected := range truth {
+					if id == expected {
+						hits++
+						break
+					}
+				}
+			}
+		}
+
+		recall := float64(hits) / float64(queries*k)
+		assert.GreaterOrEqual(t, recall, minRecall)
+	})
+
+	t.Run("switching back to sweeping through a config update", func(t *testing.T) {
+		uc := ent.NewDefaultUserConfig()
+		uc.FilterStrategy = ent.FilterStrategySweeping
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+		assert.False(t, index.useAcorn(allowList))
+	})
+}
@@ -117,6 +117,7 @@ func (h *hnsw) restoreFromDisk() error {
 	}
 
 	h.nodes = state.Nodes
+	h.nodeCount.Store(countNodes(state.Nodes))
 	h.currentMaximumLayer = int(state.Level)
 	h.entryPointID = state.Entrypoint
 	h.tombstones = state.Tombstones
@@ -200,3 +201,13 @@ func (h *hnsw) prefillCache() {
 		}
 	}()
 }
+
+func countNodes(nodes []*vertex) int64 {
+	count := int64(0)
+	for _, node := range nodes {
+		if node != nil {
+			count++
+		}
+	}
+	return count
+}
@@ -20,11 +20,24 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/vectorprecision"
 )
 
-type shardedLockCache struct {
+// cacheElement is the in-memory representation of a single vector
+// dimension. float32 vectors are cached as they are, uint16 is used for
+// float16 and bfloat16 encoded vectors.
+type cacheElement interface {
+	float32 | uint16
+}
+
+// shardedLockCache caches vectors as []T. The encode and decode functions
+// convert between the float32 vectors that the index works with and the
+// cached representation. For T=float32 they return their input unchanged.
+type shardedLockCache[T cacheElement] struct {
 	shardedLocks        []sync.RWMutex
-	cache               [][]float32
+	cache               [][]T
+	encode              func([]float32) []T
+	decode              func([]T) []float32
 	vectorForID         VectorForID
 	normalizeOnRead     bool
 	maxSize             int64
@@ -46,10 +59,34 @@ const defaultDeletionInterval = 3 * time.Second
 
 func newShardedLockCache(vecForID VectorForID, maxSize int,
 	logger logrus.FieldLogger, normalizeOnRead bool, deletionInterval time.Duration,
-) *shardedLockCache {
-	vc := &shardedLockCache{
+) *shardedLockCache[float32] {
+	identity := func(vec []float32) []float32 { return vec }
+	return newShardedLockCacheWithCodec(vecForID, identity, identity, maxSize,
+		logger, normalizeOnRead, deletionInterval)
+}
+
+// newHalfPrecisionCache keeps the vectors as float16 or bfloat16, so it only
+// needs half the memory of a float32 cache. Vectors obtained through get are
+// decoded into a new slice. Hot paths should use getEncoded together with a
+// distancer.HalfPrecisionDistancer instead, which decodes without allocating.
+func newHalfPrecisionCache(vecForID VectorForID, precision vectorprecision.Precision,
+	maxSize int, logger logrus.FieldLogger, normalizeOnRead bool,
+	deletionInterval time.Duration,
+) *shardedLockCache[uint16] {
+	decode := func(vec []uint16) []float32 { return precision.DecodeInto(nil, vec) }
+	return newShardedLockCacheWithCodec(vecForID, precision.Encode, decode, maxSize,
+		logger, normalizeOnRead, deletionInterval)
+}
+
+func newShardedLockCacheWithCodec[T cacheElement](vecForID VectorForID,
+	encode func([]float32) []T, decode func([]T) []float32, maxSize int,
+	logger logrus.FieldLogger, normalizeOnRead bool, deletionInterval time.Duration,
+) *shardedLockCache[T] {
+	vc := &shardedLockCache[T]{
 		vectorForID:      vecForID,
-		cache:            make([][]float32, initialSize),
+		cache:            make([][]T, initialSize),
+		encode:           encode,
+		decode:           decode,
 		normalizeOnRead:  normalizeOnRead,
 		count:            0,
 		maxSize:          int64(maxSize),
@@ -68,11 +105,28 @@ func newShardedLockCache(vecForID VectorForID, maxSize int,
 }
 
 //nolint:unused
-func (s *shardedLockCache) all() [][]float32 {
-	return s.cache
+func (s *shardedLockCache[T]) all() [][]float32 {
+	out := make([][]float32, len(s.cache))
+	for i, vec := range s.cache {
+		if vec == nil {
+			continue
+		}
+		out[i] = s.decode(vec)
+	}
+	return out
 }
 
-func (s *shardedLockCache) get(ctx context.Context, id uint64) ([]float32, error) {
+func (s *shardedLockCache[T]) get(ctx context.Context, id uint64) ([]float32, error) {
+	vec, err := s.getEncoded(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decode(vec), nil
+}
+
+// getEncoded returns the vector in its cached representation
+func (s *shardedLockCache[T]) getEncoded(ctx context.Context, id uint64) ([]T, error) {
 	s.shardedLocks[id%shardFactor].RLock()
 	vec := s.cache[id]
 	s.shardedLocks[id%shardFactor].RUnlock()
@@ -85,7 +139,7 @@ func (s *shardedLockCache) get(ctx context.Context, id uint64) ([]float32, error
 }
 
 //nolint:unused
-func (s *shardedLockCache) delete(ctx context.Context, id uint64) {
+func (s *shardedLockCache[T]) delete(ctx context.Context, id uint64) {
 	s.shardedLocks[id%shardFactor].Lock()
 	defer s.shardedLocks[id%shardFactor].Unlock()
 
@@ -97,7 +151,7 @@ func (s *shardedLockCache) delete(ctx context.Context, id uint64) {
 	atomic.AddInt64(&s.count, -1)
 }
 
-func (s *shardedLockCache) handleCacheMiss(ctx context.Context, id uint64) ([]float32, error) {
+func (s *shardedLockCache[T]) handleCacheMiss(ctx context.Context, id uint64) ([]T, error) {
 	vec, err := s.vectorForID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -111,30 +165,22 @@ func (s *shardedLockCache) handleCacheMiss(ctx context.Context, id uint64) ([]fl
 		vec = distancer.Normalize(vec)
 	}
 
+	encoded := s.encode(vec)
+
 	atomic.AddInt64(&s.count, 1)
 	s.shardedLocks[id%shardFactor].Lock()
-	s.cache[id] = vec
+	s.cache[id] = encoded
 	s.shardedLocks[id%shardFactor].Unlock()
 
-	return vec, nil
+	return encoded, nil
 }
 
-func (s *shardedLockCache) multiGet(ctx context.Context, ids []uint64) ([][]float32, []error) {
+func (s *shardedLockCache[T]) multiGet(ctx context.Context, ids []uint64) ([][]float32, []error) {
 	out := make([][]float32, len(ids))
 	errs := make([]error, len(ids))
 
 	for i, id := range ids {
-		s.shardedLocks[id%shardFactor].RLock()
-		vec := s.cache[id]
-		s.shardedLocks[id%shardFactor].RUnlock()
-
-		if vec == nil {
-			vecFromDisk, err := s.handleCacheMiss(ctx, id)
-			errs[i] = err
-			vec = vecFromDisk
-		}
-
-		out[i] = vec
+		out[i], errs[i] = s.get(ctx, id)
 	}
 
 	return out, errs
@@ -147,7 +193,7 @@ var prefetchFunc func(in uintptr) = func(in uintptr) {
 }
 
 //nolint:unused
-func (s *shardedLockCache) prefetch(id uint64) {
+func (s *shardedLockCache[T]) prefetch(id uint64) {
 	s.shardedLocks[id%shardFactor].RLock()
 	defer s.shardedLocks[id%shardFactor].RUnlock()
 
@@ -155,7 +201,7 @@ func (s *shardedLockCache) prefetch(id uint64) {
 }
 
 //nolint:unused
-func (s *shardedLockCache) preload(id uint64, vec []float32) {
+func (s *shardedLockCache[T]) preload(id uint64, vec []float32) {
 	s.shardedLocks[id%shardFactor].RLock()
 	defer s.shardedLocks[id%shardFactor].RUnlock()
 
@@ -164,11 +210,11 @@ func (s *shardedLockCache) preload(id uint64, vec []float32) {
 		atomic.StoreInt32(&s.dims, int32(len(vec)))
 	})
 
-	s.cache[id] = vec
+	s.cache[id] = s.encode(vec)
 }
 
 //nolint:unused
-func (s *shardedLockCache) grow(node uint64) {
+func (s *shardedLockCache[T]) grow(node uint64) {
 	if node < uint64(len(s.cache)) {
 		return
 	}
@@ -179,30 +225,30 @@ func (s *shardedLockCache) grow(node uint64) {
 	defer s.releaseAllLocks()
 
 	newSize := node + minimumIndexGrowthDelta
-	newCache := make([][]float32, newSize)
+	newCache := make([][]T, newSize)
 	copy(newCache, s.cache)
 	atomic.StoreInt64(&s.count, int64(newSize))
 	s.cache = newCache
 }
 
 //nolint:unused
-func (s *shardedLockCache) len() int32 {
+func (s *shardedLockCache[T]) len() int32 {
 	return int32(len(s.cache))
 }
 
 //nolint:unused
-func (s *shardedLockCache) countVectors() int64 {
+func (s *shardedLockCache[T]) countVectors() int64 {
 	return atomic.LoadInt64(&s.count)
 }
 
 //nolint:unused
-func (s *shardedLockCache) drop() {
+func (s *shardedLockCache[T]) drop() {
 	s.deleteAllVectors()
 	s.cancel <- true
 }
 
 //nolint:unused
-func (s *shardedLockCache) deleteAllVectors() {
+func (s *shardedLockCache[T]) deleteAllVectors() {
 	s.obtainAllLocks()
 	defer s.releaseAllLocks()
 
@@ -215,7 +261,7 @@ func (s *shardedLockCache) deleteAllVectors() {
 	atomic.StoreInt64(&s.count, 0)
 }
 
-func (s *shardedLockCache) watchForDeletion() {
+func (s *shardedLockCache[T]) watchForDeletion() {
 	go func() {
 		t := time.NewTicker(s.deletionInterval)
 		defer t.Stop()
@@ -230,7 +276,7 @@ func (s *shardedLockCache) watchForDeletion() {
 	}()
 }
 
-func (s *shardedLockCache) replaceIfFull() {
+func (s *shardedLockCache[T]) replaceIfFull() {
 	if atomic.LoadInt64(&s.count) >= atomic.LoadInt64(&s.maxSize) {
 		s.maintenanceLock.Lock()
 		s.deleteAllVectors()
@@ -238,7 +284,7 @@ func (s *shardedLockCache) replaceIfFull() {
 	}
 }
 
-func (s *shardedLockCache) obtainAllLocks() {
+func (s *shardedLockCache[T]) obtainAllLocks() {
 	wg := &sync.WaitGroup{}
 	for i := uint64(0); i < shardFactor; i++ {
 		wg.Add(1)
@@ -251,19 +297,19 @@ func (s *shardedLockCache) obtainAllLocks() {
 	wg.Wait()
 }
 
-func (s *shardedLockCache) releaseAllLocks() {
+func (s *shardedLockCache[T]) releaseAllLocks() {
 	for i := uint64(0); i < shardFactor; i++ {
 		s.shardedLocks[i].Unlock()
 	}
 }
 
 //nolint:unused
-func (s *shardedLockCache) updateMaxSize(size int64) {
+func (s *shardedLockCache[T]) updateMaxSize(size int64) {
 	atomic.StoreInt64(&s.maxSize, size)
 }
 
 //nolint:unused
-func (s *shardedLockCache) copyMaxSize() int64 {
+func (s *shardedLockCache[T]) copyMaxSize() int64 {
 	sizeCopy := atomic.LoadInt64(&s.maxSize)
 	return sizeCopy
 }
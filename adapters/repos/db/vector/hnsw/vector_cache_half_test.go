@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorprecision"
+)
+
+func TestHalfPrecisionCache(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectors := [][]float32{
+		{0.1, 0.2, 0.3},
+		{-1.5, 2.25, 1000.1},
+	}
+	vecForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		return vectors[id], nil
+	}
+
+	cache := newHalfPrecisionCache(vecForID, vectorprecision.Float16, 100,
+		logger, false, time.Hour)
+	defer cache.drop()
+
+	t.Run("cache miss is encoded", func(t *testing.T) {
+		encoded, err := cache.getEncoded(context.Background(), 1)
+		require.Nil(t, err)
+		assert.Equal(t, vectorprecision.Float16.Encode(vectors[1]), encoded)
+		assert.Equal(t, int64(1), cache.countVectors())
+	})
+
+	t.Run("get decodes", func(t *testing.T) {
+		vec, err := cache.get(context.Background(), 0)
+		require.Nil(t, err)
+		assert.Equal(t, vectorprecision.Float16.Round(vectors[0]), vec)
+	})
+
+	t.Run("preload encodes", func(t *testing.T) {
+		cache.preload(2, []float32{1, 2, 3})
+		encoded, err := cache.getEncoded(context.Background(), 2)
+		require.Nil(t, err)
+		assert.Equal(t, []uint16{0x3c00, 0x4000, 0x4200}, encoded)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		cache.delete(context.Background(), 2)
+		assert.Nil(t, cache.cache[2])
+	})
+}
+
+func TestHalfPrecisionIndex(t *testing.T) {
+	vectors := [][]float32{
+		{1, 1},
+		{2, 2},
+		{5, 5},
+		{8, 8},
+		{9, 9.5},
+	}
+
+	for _, precision := range []string{"float16", "bfloat16"} {
+		t.Run(precision, func(t *testing.T) {
+			index, err := New(Config{
+				RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+				ID:                    "half-precision-index",
+				MakeCommitLoggerThunk: MakeNoopCommitLogger,
+				DistanceProvider:      distancer.NewL2SquaredProvider(),
+				VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+					return vectors[int(id)], nil
+				},
+			}, ent.UserConfig{
+				MaxConnections:        30,
+				EFConstruction:        128,
+				VectorCacheMaxObjects: 100000,
+				VectorPrecision:       precision,
+			}, cyclemanager.NewNoop())
+			require.Nil(t, err)
+			require.NotNil(t, index.halfCache)
+
+			for i, vec := range vectors {
+				require.Nil(t, index.Add(uint64(i), vec))
+			}
+
+			res, dists, err := index.SearchByVector([]float32{8.5, 8.5}, 3, nil)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{3, 4, 2}, res)
+			assert.InDelta(t, 0.5, dists[0], 0.01)
+
+			require.Nil(t, index.Drop(context.Background()))
+		})
+	}
+}
@@ -76,7 +76,7 @@ func TestCacheCleanup(t *testing.T) {
 	})
 }
 
-func countCached(c *shardedLockCache) int {
+func countCached(c *shardedLockCache[float32]) int {
 	c.obtainAllLocks()
 	defer c.releaseAllLocks()
 
@@ -481,6 +481,9 @@ swagger:model BatchObjectsCreateBody
 */
 type BatchObjectsCreateBody struct {
 
+	// If true, each object is only written if its lastUpdateTimeUnix matches the stored object. Objects without a lastUpdateTimeUnix are only created if they do not exist yet.
+	Conditional bool `json:"conditional,omitempty"`
+
 	// Define which fields need to be returned. Default value is ALL
 	Fields []*string `json:"fields"`
 
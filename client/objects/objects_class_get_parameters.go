@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewObjectsClassGetParams creates a new ObjectsClassGetParams object,
@@ -72,6 +73,14 @@ ObjectsClassGetParams contains all the parameters to send to the API endpoint
 */
 type ObjectsClassGetParams struct {
 
+	/* AsOf.
+
+	   Return the version of the object which was current at this time (in ms since epoch). Requires objectVersioningConfig to be enabled for the class
+
+	   Format: int64
+	*/
+	AsOf *int64
+
 	// ClassName.
 	ClassName string
 
@@ -154,6 +163,17 @@ func (o *ObjectsClassGetParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithAsOf adds the asOf to the objects class get params
+func (o *ObjectsClassGetParams) WithAsOf(asOf *int64) *ObjectsClassGetParams {
+	o.SetAsOf(asOf)
+	return o
+}
+
+// SetAsOf adds the asOf to the objects class get params
+func (o *ObjectsClassGetParams) SetAsOf(asOf *int64) {
+	o.AsOf = asOf
+}
+
 // WithClassName adds the className to the objects class get params
 func (o *ObjectsClassGetParams) WithClassName(className string) *ObjectsClassGetParams {
 	o.SetClassName(className)
@@ -217,6 +237,23 @@ func (o *ObjectsClassGetParams) WriteToRequest(r runtime.ClientRequest, reg strf
 	}
 	var res []error
 
+	if o.AsOf != nil {
+
+		// query param asOf
+		var qrAsOf int64
+
+		if o.AsOf != nil {
+			qrAsOf = *o.AsOf
+		}
+		qAsOf := swag.FormatInt64(qrAsOf)
+		if qAsOf != "" {
+
+			if err := r.SetQueryParam("asOf", qAsOf); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param className
 	if err := r.SetPathParam("className", o.ClassName); err != nil {
 		return err
@@ -76,7 +76,7 @@ type ObjectsClassPatchParams struct {
 
 	/* Body.
 
-	   RFC 7396-style patch, the body contains the object to merge into the existing object.
+	   RFC 7396-style patch, the body contains the object to merge into the existing object. Instead of a value, a property can be set to an operator which is applied to the stored value: $append, $remove or $addToSet with a value or an array of values for array properties, or $inc with a number for int and number properties.
 	*/
 	Body *models.Object
 
@@ -100,6 +100,12 @@ type ObjectsClassPatchParams struct {
 	*/
 	ID strfmt.UUID
 
+	/* IfMatch.
+
+	   Only apply the write if the lastUpdateTimeUnix of the stored object equals this value. Use 0 to only write if the object does not exist yet.
+	*/
+	IfMatch *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -197,6 +203,17 @@ func (o *ObjectsClassPatchParams) SetID(id strfmt.UUID) {
 	o.ID = id
 }
 
+// WithIfMatch adds the ifMatch to the objects class patch params
+func (o *ObjectsClassPatchParams) WithIfMatch(ifMatch *string) *ObjectsClassPatchParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the objects class patch params
+func (o *ObjectsClassPatchParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsClassPatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -237,6 +254,14 @@ func (o *ObjectsClassPatchParams) WriteToRequest(r runtime.ClientRequest, reg st
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
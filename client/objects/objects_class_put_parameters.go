@@ -94,6 +94,12 @@ type ObjectsClassPutParams struct {
 	*/
 	ID strfmt.UUID
 
+	/* IfMatch.
+
+	   Only apply the write if the lastUpdateTimeUnix of the stored object equals this value. Use 0 to only write if the object does not exist yet.
+	*/
+	IfMatch *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -191,6 +197,17 @@ func (o *ObjectsClassPutParams) SetID(id strfmt.UUID) {
 	o.ID = id
 }
 
+// WithIfMatch adds the ifMatch to the objects class put params
+func (o *ObjectsClassPutParams) WithIfMatch(ifMatch *string) *ObjectsClassPutParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the objects class put params
+func (o *ObjectsClassPutParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsClassPutParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -231,6 +248,14 @@ func (o *ObjectsClassPutParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -57,6 +57,8 @@ type ClientService interface {
 
 	ObjectsClassReferencesPut(params *ObjectsClassReferencesPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassReferencesPutOK, error)
 
+	ObjectsClassVersions(params *ObjectsClassVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassVersionsOK, error)
+
 	ObjectsCreate(params *ObjectsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsCreateOK, error)
 
 	ObjectsDelete(params *ObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsDeleteNoContent, error)
@@ -410,6 +412,47 @@ func (a *Client) ObjectsClassReferencesPut(params *ObjectsClassReferencesPutPara
 	panic(msg)
 }
 
+/*
+ObjectsClassVersions gets the version history of an object based on its class and UUID
+
+List the stored versions of a data object, newest first.
+*/
+func (a *Client) ObjectsClassVersions(params *ObjectsClassVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassVersionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsClassVersionsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.class.versions",
+		Method:             "GET",
+		PathPattern:        "/objects/{className}/{id}/versions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsClassVersionsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsClassVersionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.class.versions: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsCreate creates objects between two objects object and subject
 
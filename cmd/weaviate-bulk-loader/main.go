@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Command weaviate-bulk-loader writes objects from JSONL or Parquet files
+// directly into the shards of a data directory, bypassing the REST and gRPC
+// APIs. Only the shards which the sharding state assigns to --node are
+// written, so the loader is run once per node. Each node is then started with
+// the resulting directory as its persistence data path and the same schema.
+//
+// Every object must have an id, so that all nodes assign it to the same
+// shard. Objects without a creation time are rejected, unless the same
+// --timestamp is passed on all nodes. Objects are written as-is: vectors must
+// be part of the input, vectorizer modules and auto-schema are not run and no
+// replication takes place.
+//
+// The loader does not use the write path of a running node. Buckets are
+// written without a WAL, full memtables are flushed straight to segments.
+// The vectors are added to the HNSW index in a single pass once all files
+// are loaded, without a commit log, and the finished graph is written to
+// disk at once. An interrupted load leaves an incomplete data path, which
+// must be removed before the load is repeated.
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/entities/objectfile"
+	"github.com/weaviate/weaviate/usecases/bulkload"
+	"github.com/weaviate/weaviate/usecases/config"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type options struct {
+	DataPath  string `long:"data-path" description:"persistence data path of the target node" required:"true"`
+	Schema    string `long:"schema" description:"file containing the schema and sharding state of the cluster" required:"true"`
+	Node      string `long:"node" description:"name of the node whose shards are written" required:"true"`
+	Class     string `long:"class" description:"class the objects are loaded into" required:"true"`
+	Format    string `long:"format" description:"format of the input files (jsonl or parquet), derived from the file extension if not set"`
+	BatchSize int    `long:"batch-size" description:"number of objects written at once" default:"1000"`
+	Timestamp int64  `long:"timestamp" description:"creation time (ms since epoch) of objects which don't set one, must be the same on all nodes"`
+
+	MemtablesMaxSizeMB int `long:"memtables-max-size-mb" description:"maximum size of a memtable before it is flushed to disk" default:"200"`
+
+	Args struct {
+		Files []string `positional-arg-name:"file" required:"1"`
+	} `positional-args:"true"`
+}
+
+func main() {
+	var opts options
+	parser := flags.NewParser(&opts, flags.Default)
+	parser.ShortDescription = "Weaviate bulk loader"
+	parser.LongDescription = "Write objects from JSONL or Parquet files directly into the shards of a data directory"
+	if _, err := parser.Parse(); err != nil {
+		code := 1
+		if fe, ok := err.(*flags.Error); ok {
+			if fe.Type == flags.ErrHelp {
+				code = 0
+			}
+		}
+		os.Exit(code)
+	}
+
+	logger := logrus.New()
+	logger.SetFormatter(&logrus.JSONFormatter{})
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := run(ctx, opts, logger); err != nil {
+		logger.WithField("action", "bulk_load").WithError(err).Error("bulk load failed")
+		cancel()
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, opts options, logger logrus.FieldLogger) (err error) {
+	state, err := readState(opts.Schema, opts.Node)
+	if err != nil {
+		return err
+	}
+
+	repo, err := db.New(logger, db.Config{
+		MemtablesFlushIdleAfter:   config.DefaultPersistenceFlushIdleMemtablesAfter,
+		MemtablesInitialSizeMB:    10,
+		MemtablesMaxSizeMB:        opts.MemtablesMaxSizeMB,
+		MemtablesMinActiveSeconds: config.DefaultPersistenceMemtablesMinDuration,
+		MemtablesMaxActiveSeconds: config.DefaultPersistenceMemtablesMaxDuration,
+		RootPath:                  opts.DataPath,
+		QueryMaximumResults:       config.DefaultQueryMaximumResults,
+		MaxImportGoroutinesFactor: config.DefaultMaxImportGoroutinesFactor,
+		BulkLoad:                  true,
+	}, nil, nil, nil, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "create db")
+	}
+	repo.SetSchemaGetter(bulkload.NewSchemaGetter(state, opts.Node))
+	if err := repo.WaitForStartup(ctx); err != nil {
+		return errors.Wrap(err, "start db")
+	}
+	// shutting down flushes all memtables to disk segments, so the data
+	// path can be used by a node afterwards. Without a WAL this is the only
+	// place where the last writes are persisted.
+	defer func() {
+		if serr := repo.Shutdown(context.Background()); serr != nil && err == nil {
+			err = errors.Wrap(serr, "shutdown db")
+		}
+	}()
+
+	loader := bulkload.NewLoader(repo, state.ObjectSchema, opts.BatchSize,
+		opts.Timestamp, logger)
+	for _, path := range opts.Args.Files {
+		stats, err := loadFile(ctx, loader, opts.Class, opts.Format, path)
+		if err != nil {
+			return errors.Wrapf(err, "load %q", path)
+		}
+		logger.WithFields(logrus.Fields{
+			"action":  "bulk_load",
+			"file":    path,
+			"class":   opts.Class,
+			"loaded":  stats.Loaded,
+			"skipped": stats.Skipped,
+		}).Info("file loaded")
+	}
+
+	return loader.Finish(ctx, opts.Class)
+}
+
+func readState(path, nodeName string) (*schemaUC.State, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open schema")
+	}
+	defer f.Close()
+
+	return bulkload.ReadState(f, nodeName)
+}
+
+func loadFile(ctx context.Context, loader *bulkload.Loader, className,
+	formatName, path string,
+) (bulkload.Stats, error) {
+	format, err := fileFormat(formatName, path)
+	if err != nil {
+		return bulkload.Stats{}, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return bulkload.Stats{}, err
+	}
+	defer f.Close()
+
+	var r objectfile.Reader
+	switch format {
+	case objectfile.FormatParquet:
+		info, err := f.Stat()
+		if err != nil {
+			return bulkload.Stats{}, err
+		}
+		r, err = objectfile.NewParquetReader(f, info.Size())
+		if err != nil {
+			return bulkload.Stats{}, err
+		}
+	default:
+		r = objectfile.NewJSONLReader(f)
+	}
+	defer r.Close()
+
+	return loader.Load(ctx, className, r)
+}
+
+func fileFormat(name, path string) (objectfile.Format, error) {
+	if name != "" {
+		return objectfile.ParseFormat(name)
+	}
+	return objectfile.FormatFromPath(path)
+}
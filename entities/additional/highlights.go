@@ -0,0 +1,28 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+// HighlightParams configure the fragments of the stored text around the
+// terms which matched a bm25 or hybrid query
+type HighlightParams struct {
+	// Properties to create fragments from, all text properties if empty
+	Properties        []string `json:"properties"`
+	FragmentSize      int      `json:"fragmentSize"`
+	NumberOfFragments int      `json:"numberOfFragments"`
+	PreTag            string   `json:"preTag"`
+	PostTag           string   `json:"postTag"`
+}
+
+type Highlight struct {
+	Property  string   `json:"property"`
+	Fragments []string `json:"fragments"`
+}
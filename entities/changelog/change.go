@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package changelog
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Type describes the kind of write that produced a change
+type Type string
+
+const (
+	TypeCreate    Type = "create"
+	TypeUpdate    Type = "update"
+	TypeDelete    Type = "delete"
+	TypeReference Type = "reference"
+)
+
+// Change is a single entry of a shard's change log. Offsets are assigned in
+// the order in which the writes were applied to the shard, they are unique
+// and strictly increasing per shard, but not across shards.
+type Change struct {
+	Offset    uint64      `json:"offset"`
+	Type      Type        `json:"type"`
+	Class     string      `json:"class"`
+	Shard     string      `json:"shard"`
+	ID        strfmt.UUID `json:"id"`
+	Timestamp int64       `json:"timestamp"`
+
+	// Object is the state of the object after the write. It is not set for
+	// deletes.
+	Object *models.Object `json:"object,omitempty"`
+}
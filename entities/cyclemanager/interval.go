@@ -64,3 +64,42 @@ func HnswCommitLoggerCycleTicker() CycleTicker {
 	return NewExpTicker(hnswCommitLoggerMinInterval, hnswCommitLoggerMaxInterval,
 		hnswCommitLoggerBase, hnswCommitLoggerSteps)
 }
+
+const (
+	objectTTLMinInterval = 30 * time.Second
+	objectTTLMaxInterval = 5 * time.Minute
+	objectTTLBase        = uint(2)
+	objectTTLSteps       = uint(4)
+)
+
+// 30s . 48s .. 84s .... 156s ........ 300s
+func ObjectTTLCycleTicker() CycleTicker {
+	return NewExpTicker(objectTTLMinInterval, objectTTLMaxInterval,
+		objectTTLBase, objectTTLSteps)
+}
+
+const (
+	changeLogRetentionMinInterval = 1 * time.Minute
+	changeLogRetentionMaxInterval = 10 * time.Minute
+	changeLogRetentionBase        = uint(2)
+	changeLogRetentionSteps       = uint(4)
+)
+
+// 1m . 1.6m .. 2.8m .... 5.2m ........ 10m
+func ChangeLogRetentionCycleTicker() CycleTicker {
+	return NewExpTicker(changeLogRetentionMinInterval, changeLogRetentionMaxInterval,
+		changeLogRetentionBase, changeLogRetentionSteps)
+}
+
+const (
+	objectVersionsMinInterval = 1 * time.Minute
+	objectVersionsMaxInterval = 10 * time.Minute
+	objectVersionsBase        = uint(2)
+	objectVersionsSteps       = uint(4)
+)
+
+// 1m . 1.6m .. 2.8m .... 5.2m ........ 10m
+func ObjectVersionsCycleTicker() CycleTicker {
+	return NewExpTicker(objectVersionsMinInterval, objectVersionsMaxInterval,
+		objectVersionsBase, objectVersionsSteps)
+}
@@ -38,33 +38,74 @@ func Class(c *models.Class) *models.Class {
 	if c.ReplicationConfig != nil {
 		replicationConf = &models.ReplicationConfig{Factor: c.ReplicationConfig.Factor}
 	}
+	var objectTTLConf *models.ObjectTTLConfig = nil
+	if c.ObjectTTLConfig != nil {
+		objectTTLConf = &models.ObjectTTLConfig{
+			DefaultTTL: c.ObjectTTLConfig.DefaultTTL,
+			DeleteOn:   c.ObjectTTLConfig.DeleteOn,
+			Enabled:    c.ObjectTTLConfig.Enabled,
+		}
+	}
+	var objectVersioningConf *models.ObjectVersioningConfig = nil
+	if c.ObjectVersioningConfig != nil {
+		objectVersioningConf = &models.ObjectVersioningConfig{
+			Enabled:     c.ObjectVersioningConfig.Enabled,
+			MaxAgeHours: c.ObjectVersioningConfig.MaxAgeHours,
+			MaxVersions: c.ObjectVersioningConfig.MaxVersions,
+		}
+	}
 
 	return &models.Class{
-		Class:               c.Class,
-		Description:         c.Description,
-		ModuleConfig:        c.ModuleConfig,
-		ShardingConfig:      c.ShardingConfig,
-		VectorIndexConfig:   c.VectorIndexConfig,
-		VectorIndexType:     c.VectorIndexType,
-		ReplicationConfig:   replicationConf,
-		Vectorizer:          c.Vectorizer,
-		InvertedIndexConfig: InvertedIndexConfig(c.InvertedIndexConfig),
-		Properties:          properties,
+		Class:                  c.Class,
+		Description:            c.Description,
+		ModuleConfig:           c.ModuleConfig,
+		ShardingConfig:         c.ShardingConfig,
+		VectorIndexConfig:      c.VectorIndexConfig,
+		VectorIndexType:        c.VectorIndexType,
+		ReplicationConfig:      replicationConf,
+		ObjectTTLConfig:        objectTTLConf,
+		ObjectVersioningConfig: objectVersioningConf,
+		Vectorizer:             c.Vectorizer,
+		InvertedIndexConfig:    InvertedIndexConfig(c.InvertedIndexConfig),
+		Properties:             properties,
 	}
 }
 
 func Prop(p *models.Property) *models.Property {
 	return &models.Property{
-		DataType:        p.DataType,
-		Description:     p.Description,
-		ModuleConfig:    p.ModuleConfig,
-		Name:            p.Name,
-		Tokenization:    p.Tokenization,
-		IndexFilterable: ptrBoolCopy(p.IndexFilterable),
-		IndexSearchable: ptrBoolCopy(p.IndexSearchable),
+		DataType:         p.DataType,
+		Description:      p.Description,
+		ModuleConfig:     p.ModuleConfig,
+		Name:             p.Name,
+		Tokenization:     p.Tokenization,
+		Analyzer:         p.Analyzer,
+		StopwordsPreset:  p.StopwordsPreset,
+		IndexFilterable:  ptrBoolCopy(p.IndexFilterable),
+		IndexSearchable:  ptrBoolCopy(p.IndexSearchable),
+		NestedProperties: NestedProps(p.NestedProperties),
 	}
 }
 
+func NestedProps(nps []*models.NestedProperty) []*models.NestedProperty {
+	if nps == nil {
+		return nil
+	}
+
+	copied := make([]*models.NestedProperty, len(nps))
+	for i, np := range nps {
+		copied[i] = &models.NestedProperty{
+			DataType:         np.DataType,
+			Description:      np.Description,
+			Name:             np.Name,
+			Tokenization:     np.Tokenization,
+			IndexFilterable:  ptrBoolCopy(np.IndexFilterable),
+			IndexSearchable:  ptrBoolCopy(np.IndexSearchable),
+			NestedProperties: NestedProps(np.NestedProperties),
+		}
+	}
+	return copied
+}
+
 func ptrBoolCopy(ptrBool *bool) *bool {
 	if ptrBool != nil {
 		b := *ptrBool
@@ -88,12 +129,38 @@ func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexCon
 		stopwords = &models.StopwordConfig{Additions: i.Stopwords.Additions, Preset: i.Stopwords.Preset, Removals: i.Stopwords.Removals}
 	}
 
+	var analyzers []*models.TextAnalyzerConfig = nil
+	if i.Analyzers != nil {
+		analyzers = make([]*models.TextAnalyzerConfig, len(i.Analyzers))
+		for j, a := range i.Analyzers {
+			if a != nil {
+				analyzers[j] = &models.TextAnalyzerConfig{Name: a.Name, Tokenization: a.Tokenization, Filters: a.Filters}
+			}
+		}
+	}
+
+	var synonyms *models.SynonymConfig = nil
+	if i.Synonyms != nil {
+		synonyms = &models.SynonymConfig{Equivalents: i.Synonyms.Equivalents}
+		if i.Synonyms.Mappings != nil {
+			synonyms.Mappings = make([]*models.SynonymMapping, len(i.Synonyms.Mappings))
+			for j, m := range i.Synonyms.Mappings {
+				if m != nil {
+					synonyms.Mappings[j] = &models.SynonymMapping{Terms: m.Terms, Synonyms: m.Synonyms}
+				}
+			}
+		}
+	}
+
 	return &models.InvertedIndexConfig{
+		Analyzers:              analyzers,
 		Bm25:                   bm25,
 		CleanupIntervalSeconds: i.CleanupIntervalSeconds,
 		IndexNullState:         i.IndexNullState,
+		IndexPositions:         i.IndexPositions,
 		IndexPropertyLength:    i.IndexPropertyLength,
 		IndexTimestamps:        i.IndexTimestamps,
 		Stopwords:              stopwords,
+		Synonyms:               synonyms,
 	}
 }
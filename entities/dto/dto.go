@@ -35,6 +35,8 @@ type GetParams struct {
 	KeywordRanking        *searchparams.KeywordRanking
 	HybridSearch          *searchparams.HybridSearch
 	GroupBy               *searchparams.GroupBy
+	MMR                   *searchparams.MMR
+	Boost                 *searchparams.Boost
 	SearchVector          []float32
 	Group                 *GroupParams
 	ModuleParams          map[string]interface{}
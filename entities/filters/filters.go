@@ -33,6 +33,10 @@ const (
 	OperatorWithinGeoRange
 	OperatorLike
 	OperatorIsNull
+	OperatorWithinGeoPolygon
+	OperatorWithinGeoBoundingBox
+	OperatorContainsAny
+	OperatorContainsAll
 )
 
 func (o Operator) OnValue() bool {
@@ -45,7 +49,11 @@ func (o Operator) OnValue() bool {
 		OperatorLessThanEqual,
 		OperatorWithinGeoRange,
 		OperatorLike,
-		OperatorIsNull:
+		OperatorIsNull,
+		OperatorWithinGeoPolygon,
+		OperatorWithinGeoBoundingBox,
+		OperatorContainsAny,
+		OperatorContainsAll:
 		return true
 	default:
 		return false
@@ -78,11 +86,38 @@ func (o Operator) Name() string {
 		return "Like"
 	case OperatorIsNull:
 		return "IsNull"
+	case OperatorWithinGeoPolygon:
+		return "WithinGeoPolygon"
+	case OperatorWithinGeoBoundingBox:
+		return "WithinGeoBoundingBox"
+	case OperatorContainsAny:
+		return "ContainsAny"
+	case OperatorContainsAll:
+		return "ContainsAll"
 	default:
 		panic("Unknown operator")
 	}
 }
 
+// IsGeo indicates whether the operator can only be served by the geo index of
+// a geoCoordinates property
+func (o Operator) IsGeo() bool {
+	switch o {
+	case OperatorWithinGeoRange,
+		OperatorWithinGeoPolygon,
+		OperatorWithinGeoBoundingBox:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsContains indicates whether the operator takes a list of values, each of
+// which is compared to the property individually
+func (o Operator) IsContains() bool {
+	return o == OperatorContainsAny || o == OperatorContainsAll
+}
+
 type LocalFilter struct {
 	Root *Clause `json:"root"`
 }
@@ -110,9 +145,47 @@ func (v *Value) UnmarshalJSON(data []byte) error {
 		v.Value = int(asFloat)
 	}
 
+	if asSlice, ok := v.Value.([]interface{}); ok {
+		v.Value = typedArrayValue(v.Type, asSlice)
+	}
+
 	return nil
 }
 
+// typedArrayValue restores the concrete slice type of an array value (as used
+// by the ContainsAny and ContainsAll operators) after JSON unmarshalling
+func typedArrayValue(dt schema.DataType, in []interface{}) interface{} {
+	switch dt {
+	case schema.DataTypeIntArray:
+		out := make([]int, len(in))
+		for i := range in {
+			asFloat, _ := in[i].(float64)
+			out[i] = int(asFloat)
+		}
+		return out
+	case schema.DataTypeNumberArray:
+		out := make([]float64, len(in))
+		for i := range in {
+			out[i], _ = in[i].(float64)
+		}
+		return out
+	case schema.DataTypeBooleanArray:
+		out := make([]bool, len(in))
+		for i := range in {
+			out[i], _ = in[i].(bool)
+		}
+		return out
+	case schema.DataTypeTextArray, schema.DataTypeStringArray, schema.DataTypeDateArray:
+		out := make([]string, len(in))
+		for i := range in {
+			out[i], _ = in[i].(string)
+		}
+		return out
+	default:
+		return in
+	}
+}
+
 type Clause struct {
 	Operator Operator `json:"operator"`
 	On       *Path    `json:"on"`
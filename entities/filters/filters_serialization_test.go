@@ -52,4 +52,24 @@ func TestSerializeValue(t *testing.T) {
 
 		assert.Equal(t, before, after)
 	})
+	t.Run("with array values", func(t *testing.T) {
+		values := []Value{
+			{Value: []string{"red", "blue"}, Type: schema.DataTypeTextArray},
+			{Value: []int{1, 2}, Type: schema.DataTypeIntArray},
+			{Value: []float64{1.5, 2}, Type: schema.DataTypeNumberArray},
+			{Value: []bool{true}, Type: schema.DataTypeBooleanArray},
+			{Value: []string{"2006-01-02T15:04:05Z"}, Type: schema.DataTypeDateArray},
+		}
+
+		for _, before := range values {
+			bytes, err := json.Marshal(before)
+			require.Nil(t, err)
+
+			var after Value
+			err = json.Unmarshal(bytes, &after)
+			require.Nil(t, err)
+
+			assert.Equal(t, before, after)
+		}
+	})
 }
@@ -33,6 +33,10 @@ func TestOperators(t *testing.T) {
 		{op: OperatorLessThan, expectedName: "LessThan", expectedOnValue: true},
 		{op: OperatorWithinGeoRange, expectedName: "WithinGeoRange", expectedOnValue: true},
 		{op: OperatorLike, expectedName: "Like", expectedOnValue: true},
+		{op: OperatorWithinGeoPolygon, expectedName: "WithinGeoPolygon", expectedOnValue: true},
+		{op: OperatorWithinGeoBoundingBox, expectedName: "WithinGeoBoundingBox", expectedOnValue: true},
+		{op: OperatorContainsAny, expectedName: "ContainsAny", expectedOnValue: true},
+		{op: OperatorContainsAll, expectedName: "ContainsAll", expectedOnValue: true},
 		{op: OperatorAnd, expectedName: "And", expectedOnValue: false},
 		{op: OperatorOr, expectedName: "Or", expectedOnValue: false},
 		{op: OperatorNot, expectedName: "Not", expectedOnValue: false},
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -82,6 +83,10 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 		return err
 	}
 
+	if cw.getOperator().IsContains() {
+		return validateContainsClause(prop, isPropLengthFilter, cw)
+	}
+
 	if cw.getOperator() == OperatorIsNull {
 		if !cw.isType(schema.DataTypeBoolean) {
 			return errors.Errorf("operator IsNull requires a booleanValue, got %q instead",
@@ -109,6 +114,12 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 		return nil
 	}
 
+	if dt, ok := schema.AsNested(prop.DataType); ok {
+		return errors.Errorf("Property %q is of data type %q, filter on its nested "+
+			"properties instead, using a path in the form of [%q, <nestedPropName>]",
+			propName, dt, propName)
+	}
+
 	if isUUIDType(prop.DataType[0]) {
 		return validateUUIDType(propName, cw)
 	}
@@ -142,6 +153,53 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 	return nil
 }
 
+// validateContainsClause validates the ContainsAny and ContainsAll operators.
+// These require an array value (e.g. "valueTextArray") whose elements are
+// each compared to the property, so the base type of the value has to match
+// the (base) type of the property.
+func validateContainsClause(prop *models.Property, isPropLengthFilter bool,
+	cw *clauseWrapper,
+) error {
+	op := cw.getOperator()
+	if isPropLengthFilter {
+		return errors.Errorf("operator %q cannot be used to filter for property length",
+			op.Name())
+	}
+
+	if schema.IsRefDataType(prop.DataType) {
+		return errors.Errorf("operator %q cannot be used on ref prop %q directly, "+
+			"use a path in the form of [<propName>, <ClassNameOfReferencedClass>, "+
+			"<primitvePropOnClass>] instead", op.Name(), prop.Name)
+	}
+
+	valueBaseType, ok := schema.IsArrayType(cw.origType)
+	if !ok {
+		return errors.Errorf("operator %q requires an array value such as "+
+			"\"valueTextArray\", got %q instead", op.Name(), cw.getValueNameFromType())
+	}
+
+	propType := schema.DataType(prop.DataType[0])
+	if baseType, ok := schema.IsArrayType(propType); ok {
+		propType = baseType
+	}
+
+	element := &clauseWrapper{
+		origType:  valueBaseType,
+		aliasType: deprecatedDataTypeAliases[valueBaseType],
+	}
+	if isUUIDType(string(propType)) {
+		propType = schema.DataTypeText
+	}
+	if !element.isType(propType) {
+		return errors.Errorf("data type filter cannot use %q on type %q, use %q instead",
+			valueNameFromDataType(valueBaseType)+"Array",
+			schema.DataType(prop.DataType[0]),
+			valueNameFromDataType(propType)+"Array")
+	}
+
+	return nil
+}
+
 func valueNameFromDataType(dt schema.DataType) string {
 	return "value" + strings.ToUpper(string(dt[0])) + string(dt[1:])
 }
@@ -306,3 +306,120 @@ func TestClauseWrapper(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateContainsOperators(t *testing.T) {
+	tests := []struct {
+		name     string
+		prop     schema.PropertyName
+		operator Operator
+		value    *Value
+		valid    bool
+	}{
+		{
+			name:     "ContainsAny with text array on text[] prop",
+			prop:     "colors",
+			operator: OperatorContainsAny,
+			value:    &Value{Value: []string{"red", "blue"}, Type: schema.DataTypeTextArray},
+			valid:    true,
+		},
+		{
+			name:     "ContainsAll with text array on text prop",
+			prop:     "name",
+			operator: OperatorContainsAll,
+			value:    &Value{Value: []string{"red", "blue"}, Type: schema.DataTypeTextArray},
+			valid:    true,
+		},
+		{
+			name:     "ContainsAny with int array on int[] prop",
+			prop:     "sizes",
+			operator: OperatorContainsAny,
+			value:    &Value{Value: []int{1, 2}, Type: schema.DataTypeIntArray},
+			valid:    true,
+		},
+		{
+			name:     "ContainsAll with text array on uuid[] prop",
+			prop:     "my_idz",
+			operator: OperatorContainsAll,
+			value:    &Value{Value: []string{"a32ef1fb-2a1d-4a1e-8e5f-7a43b5e1a0f7"}, Type: schema.DataTypeTextArray},
+			valid:    true,
+		},
+		{
+			name:     "[deprecated string] ContainsAny with string array on text[] prop",
+			prop:     "colors",
+			operator: OperatorContainsAny,
+			value:    &Value{Value: []string{"red"}, Type: schema.DataTypeStringArray},
+			valid:    true,
+		},
+		{
+			name:     "ContainsAny with a single value",
+			prop:     "colors",
+			operator: OperatorContainsAny,
+			value:    &Value{Value: "red", Type: schema.DataTypeText},
+			valid:    false,
+		},
+		{
+			name:     "ContainsAny with mismatching array type",
+			prop:     "sizes",
+			operator: OperatorContainsAny,
+			value:    &Value{Value: []string{"red"}, Type: schema.DataTypeTextArray},
+			valid:    false,
+		},
+		{
+			name:     "ContainsAll on a ref prop",
+			prop:     "ofBrand",
+			operator: OperatorContainsAll,
+			value:    &Value{Value: []int{1}, Type: schema.DataTypeIntArray},
+			valid:    false,
+		},
+		{
+			name:     "ContainsAny on property length",
+			prop:     "len(colors)",
+			operator: OperatorContainsAny,
+			value:    &Value{Value: []int{1}, Type: schema.DataTypeIntArray},
+			valid:    false,
+		},
+		{
+			name:     "Equal with an array value",
+			prop:     "colors",
+			operator: OperatorEqual,
+			value:    &Value{Value: []string{"red"}, Type: schema.DataTypeTextArray},
+			valid:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch := schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Car",
+						Properties: []*models.Property{
+							{Name: "name", DataType: schema.DataTypeText.PropString()},
+							{Name: "colors", DataType: schema.DataTypeTextArray.PropString()},
+							{Name: "sizes", DataType: schema.DataTypeIntArray.PropString()},
+							{Name: "my_idz", DataType: schema.DataTypeUUIDArray.PropString()},
+							{Name: "ofBrand", DataType: []string{"Brand"}},
+						},
+					},
+					{
+						Class: "Brand",
+						Properties: []*models.Property{
+							{Name: "name", DataType: schema.DataTypeText.PropString()},
+						},
+					},
+				},
+			}}
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    tt.value,
+				On:       &Path{Class: "Car", Property: tt.prop},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
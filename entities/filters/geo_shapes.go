@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// GeoPolygon to be used with fields of type GeoCoordinates. The polygon is
+// formed by connecting the coordinates in order, the last one is connected to
+// the first one implicitly. Polygons crossing the antimeridian are not
+// supported.
+type GeoPolygon struct {
+	Coordinates []*models.GeoCoordinates `json:"coordinates"`
+}
+
+func (p GeoPolygon) Validate() error {
+	if len(p.Coordinates) < 3 {
+		return fmt.Errorf("a polygon needs at least 3 coordinates, got %d",
+			len(p.Coordinates))
+	}
+
+	for i, c := range p.Coordinates {
+		if err := validateGeoCoordinates(c); err != nil {
+			return fmt.Errorf("coordinates at position %d: %v", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Contains checks whether the point lies within the polygon. Latitude and
+// longitude are treated as planar coordinates, which matches how the polygon
+// is drawn on a map.
+func (p GeoPolygon) Contains(lat, lon float32) bool {
+	inside := false
+	for i, j := 0, len(p.Coordinates)-1; i < len(p.Coordinates); j, i = i, i+1 {
+		latI, lonI := *p.Coordinates[i].Latitude, *p.Coordinates[i].Longitude
+		latJ, lonJ := *p.Coordinates[j].Latitude, *p.Coordinates[j].Longitude
+
+		if (latI > lat) != (latJ > lat) &&
+			lon < (lonJ-lonI)*(lat-latI)/(latJ-latI)+lonI {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// BoundingBox is the smallest GeoBoundingBox containing the polygon
+func (p GeoPolygon) BoundingBox() GeoBoundingBox {
+	minLat, maxLat := *p.Coordinates[0].Latitude, *p.Coordinates[0].Latitude
+	minLon, maxLon := *p.Coordinates[0].Longitude, *p.Coordinates[0].Longitude
+	for _, c := range p.Coordinates[1:] {
+		minLat = min32(minLat, *c.Latitude)
+		maxLat = max32(maxLat, *c.Latitude)
+		minLon = min32(minLon, *c.Longitude)
+		maxLon = max32(maxLon, *c.Longitude)
+	}
+
+	return GeoBoundingBox{
+		TopLeft:     &models.GeoCoordinates{Latitude: &maxLat, Longitude: &minLon},
+		BottomRight: &models.GeoCoordinates{Latitude: &minLat, Longitude: &maxLon},
+	}
+}
+
+// GeoBoundingBox to be used with fields of type GeoCoordinates. Identifies
+// a rectangle on the map by its top left and bottom right corner. If the
+// longitude of the top left corner is larger than the one of the bottom right
+// corner, the box crosses the antimeridian.
+type GeoBoundingBox struct {
+	TopLeft     *models.GeoCoordinates `json:"topLeft"`
+	BottomRight *models.GeoCoordinates `json:"bottomRight"`
+}
+
+func (b GeoBoundingBox) Validate() error {
+	if err := validateGeoCoordinates(b.TopLeft); err != nil {
+		return fmt.Errorf("topLeft: %v", err)
+	}
+
+	if err := validateGeoCoordinates(b.BottomRight); err != nil {
+		return fmt.Errorf("bottomRight: %v", err)
+	}
+
+	if *b.TopLeft.Latitude < *b.BottomRight.Latitude {
+		return fmt.Errorf("latitude of topLeft must not be smaller than the " +
+			"latitude of bottomRight")
+	}
+
+	return nil
+}
+
+// Contains checks whether the point lies within the box, including its edges
+func (b GeoBoundingBox) Contains(lat, lon float32) bool {
+	if lat > *b.TopLeft.Latitude || lat < *b.BottomRight.Latitude {
+		return false
+	}
+
+	left, right := *b.TopLeft.Longitude, *b.BottomRight.Longitude
+	if left <= right {
+		return lon >= left && lon <= right
+	}
+
+	// crosses the antimeridian
+	return lon >= left || lon <= right
+}
+
+// Width is the extent of the box in degrees of longitude
+func (b GeoBoundingBox) Width() float32 {
+	width := *b.BottomRight.Longitude - *b.TopLeft.Longitude
+	if width < 0 {
+		width += 360
+	}
+	return width
+}
+
+// Point returns the coordinates at the relative position within the box,
+// where (0, 0) is the top left and (1, 1) the bottom right corner.
+func (b GeoBoundingBox) Point(x, y float32) (lat, lon float32) {
+	top, bottom := *b.TopLeft.Latitude, *b.BottomRight.Latitude
+	lat = top - y*(top-bottom)
+	lon = *b.TopLeft.Longitude + x*b.Width()
+	if lon > 180 {
+		lon -= 360
+	}
+	return lat, lon
+}
+
+func validateGeoCoordinates(c *models.GeoCoordinates) error {
+	if c == nil || c.Latitude == nil || c.Longitude == nil {
+		return fmt.Errorf("latitude and longitude must be set")
+	}
+
+	if *c.Latitude < -90 || *c.Latitude > 90 {
+		return fmt.Errorf("latitude must be between -90 and 90, got %v", *c.Latitude)
+	}
+
+	if *c.Longitude < -180 || *c.Longitude > 180 {
+		return fmt.Errorf("longitude must be between -180 and 180, got %v",
+			*c.Longitude)
+	}
+
+	return nil
+}
+
+func min32(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max32(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestGeoPolygon(t *testing.T) {
+	// a triangle with an additional notch, so it's not convex
+	polygon := GeoPolygon{Coordinates: []*models.GeoCoordinates{
+		geoCoordinates(0, 0),
+		geoCoordinates(10, 0),
+		geoCoordinates(5, 5),
+		geoCoordinates(10, 10),
+		geoCoordinates(0, 10),
+	}}
+	assert.Nil(t, polygon.Validate())
+
+	t.Run("contains", func(t *testing.T) {
+		assert.True(t, polygon.Contains(2, 2))
+		assert.True(t, polygon.Contains(8, 1))
+		assert.True(t, polygon.Contains(8, 9))
+		assert.False(t, polygon.Contains(8, 5))
+		assert.False(t, polygon.Contains(-1, 5))
+		assert.False(t, polygon.Contains(5, 11))
+	})
+
+	t.Run("bounding box", func(t *testing.T) {
+		box := polygon.BoundingBox()
+		assert.Equal(t, geoCoordinates(10, 0), box.TopLeft)
+		assert.Equal(t, geoCoordinates(0, 10), box.BottomRight)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		err := GeoPolygon{Coordinates: polygon.Coordinates[:2]}.Validate()
+		assert.EqualError(t, err, "a polygon needs at least 3 coordinates, got 2")
+
+		err = GeoPolygon{Coordinates: []*models.GeoCoordinates{
+			geoCoordinates(0, 0), geoCoordinates(91, 0), geoCoordinates(0, 1),
+		}}.Validate()
+		assert.EqualError(t, err, "coordinates at position 1: latitude must be "+
+			"between -90 and 90, got 91")
+
+		err = GeoPolygon{Coordinates: []*models.GeoCoordinates{
+			geoCoordinates(0, 0), {}, geoCoordinates(0, 1),
+		}}.Validate()
+		assert.EqualError(t, err, "coordinates at position 1: latitude and "+
+			"longitude must be set")
+	})
+}
+
+func TestGeoBoundingBox(t *testing.T) {
+	t.Run("regular box", func(t *testing.T) {
+		box := GeoBoundingBox{
+			TopLeft:     geoCoordinates(50, 5),
+			BottomRight: geoCoordinates(45, 15),
+		}
+		assert.Nil(t, box.Validate())
+
+		assert.True(t, box.Contains(48, 11))
+		assert.True(t, box.Contains(50, 5))
+		assert.False(t, box.Contains(51, 11))
+		assert.False(t, box.Contains(48, 16))
+
+		assert.Equal(t, float32(10), box.Width())
+		lat, lon := box.Point(0.5, 0.5)
+		assert.Equal(t, float32(47.5), lat)
+		assert.Equal(t, float32(10), lon)
+	})
+
+	t.Run("box crossing the antimeridian", func(t *testing.T) {
+		box := GeoBoundingBox{
+			TopLeft:     geoCoordinates(10, 170),
+			BottomRight: geoCoordinates(-10, -170),
+		}
+		assert.Nil(t, box.Validate())
+
+		assert.True(t, box.Contains(0, 175))
+		assert.True(t, box.Contains(0, -175))
+		assert.False(t, box.Contains(0, 0))
+
+		assert.Equal(t, float32(20), box.Width())
+		lat, lon := box.Point(0.75, 0)
+		assert.Equal(t, float32(10), lat)
+		assert.Equal(t, float32(-175), lon)
+	})
+
+	t.Run("top below bottom", func(t *testing.T) {
+		box := GeoBoundingBox{
+			TopLeft:     geoCoordinates(40, 5),
+			BottomRight: geoCoordinates(45, 15),
+		}
+		assert.EqualError(t, box.Validate(), "latitude of topLeft must not be "+
+			"smaller than the latitude of bottomRight")
+	})
+}
+
+func geoCoordinates(lat, lon float32) *models.GeoCoordinates {
+	return &models.GeoCoordinates{Latitude: &lat, Longitude: &lon}
+}
@@ -94,6 +94,9 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 	// Now go through the path elements, step over it in increments of two.
 	// Simple case:      ClassName -> property
 	// Nested path case: ClassName -> HasRef -> ClassOfRef -> Property
+	// Nested properties of object properties follow their property without a
+	// class in between and are joined to a single property path, e.g.
+	// ClassName -> address -> city becomes ClassName -> address.city
 	for i := 0; i < len(pathElements); i += 2 {
 		lengthRemaining := len(pathElements) - i
 		if lengthRemaining < 2 {
@@ -138,6 +141,16 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 
 		}
 
+		for i+2 < len(pathElements) {
+			rawNestedName, ok := pathElements[i+2].(string)
+			if !ok || !isNestedPropertySegment(rawNestedName) {
+				break
+			}
+			propertyName = schema.PropertyName(string(propertyName) +
+				schema.NestedPathSeparator + rawNestedName)
+			i++
+		}
+
 		current.Child = &Path{
 			Class:    className,
 			Property: propertyName,
@@ -149,3 +162,15 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 
 	return sentinel.Child, nil
 }
+
+// isNestedPropertySegment distinguishes the names of nested properties from
+// the class names which follow reference properties in a path, as class
+// names always start with a capital letter, which nested property names
+// must not (see the schema validation of nested properties)
+func isNestedPropertySegment(segment string) bool {
+	if _, err := schema.ValidateClassName(segment); err == nil {
+		return false
+	}
+	_, err := schema.ValidatePropertyName(segment)
+	return err == nil
+}
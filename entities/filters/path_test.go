@@ -47,6 +47,38 @@ func Test_ParsePath(t *testing.T) {
 		assert.Equal(t, expectedPath, path, "should parse the path correctly")
 	})
 
+	t.Run("with a nested property", func(t *testing.T) {
+		rootClass := "City"
+		segments := []interface{}{"address", "location", "street"}
+		expectedPath := &Path{
+			Class:    "City",
+			Property: "address.location.street",
+		}
+
+		path, err := ParsePath(segments, rootClass)
+
+		require.Nil(t, err, "should not error")
+		assert.Equal(t, expectedPath, path, "should parse the path correctly")
+	})
+
+	t.Run("with a nested property of a referenced class", func(t *testing.T) {
+		rootClass := "City"
+		segments := []interface{}{"inCountry", "Country", "capital", "name"}
+		expectedPath := &Path{
+			Class:    "City",
+			Property: "inCountry",
+			Child: &Path{
+				Class:    "Country",
+				Property: "capital.name",
+			},
+		}
+
+		path, err := ParsePath(segments, rootClass)
+
+		require.Nil(t, err, "should not error")
+		assert.Equal(t, expectedPath, path, "should parse the path correctly")
+	})
+
 	t.Run("with nested refs", func(t *testing.T) {
 		rootClass := "City"
 		segments := []interface{}{"inCountry", "Country", "inContinent", "Continent", "onPlanet", "Planet", "name"}
@@ -11,10 +11,15 @@
 
 package filters
 
-// Sort contains path and order (asc, desc) information
+import "github.com/weaviate/weaviate/entities/models"
+
+// Sort contains path and order (asc, desc) information. Near can only be set
+// for geoCoordinates properties, the results are then sorted by their
+// distance to that point.
 type Sort struct {
-	Path  []string `json:"path"`
-	Order string   `json:"order"`
+	Path  []string               `json:"path"`
+	Order string                 `json:"order"`
+	Near  *models.GeoCoordinates `json:"near,omitempty"`
 }
 
 // ExtractSortFromArgs gets the sort parameters
@@ -37,7 +42,20 @@ func ExtractSortFromArgs(in []interface{}) []Sort {
 			if ok {
 				order = orderParam.(string)
 			}
-			args = append(args, Sort{path, order})
+			var near *models.GeoCoordinates
+			nearParam, ok := sortFilter["near"].(map[string]interface{})
+			if ok {
+				near = &models.GeoCoordinates{}
+				if lat, ok := nearParam["latitude"].(float64); ok {
+					latitude := float32(lat)
+					near.Latitude = &latitude
+				}
+				if lon, ok := nearParam["longitude"].(float64); ok {
+					longitude := float32(lon)
+					near.Longitude = &longitude
+				}
+			}
+			args = append(args, Sort{path, order, near})
 		}
 	}
 
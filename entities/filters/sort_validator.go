@@ -15,6 +15,7 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -58,6 +59,10 @@ func validateSortClause(sch schema.Schema, className schema.ClassName, sort Sort
 		propName := schema.PropertyName(path[0])
 		if IsInternalProperty(propName) {
 			// handle internal properties
+			if sort.Near != nil {
+				return errors.Errorf("sorting by distance requires a property "+
+					"of type geoCoordinates, got %q", propName)
+			}
 			return nil
 		}
 		prop, err := sch.GetProperty(className, propName)
@@ -65,6 +70,10 @@ func validateSortClause(sch schema.Schema, className schema.ClassName, sort Sort
 			return err
 		}
 
+		if sort.Near != nil {
+			return validateSortNear(prop.Name, prop.DataType, sort.Near)
+		}
+
 		if isUUIDType(prop.DataType[0]) {
 			return fmt.Errorf("prop %q is of type uuid/uuid[]: "+
 				"sorting by uuid is currently not supported - if you believe it should be, "+
@@ -81,3 +90,18 @@ func validateSortClause(sch schema.Schema, className schema.ClassName, sort Sort
 			"path must have exactly one argument")
 	}
 }
+
+func validateSortNear(propName string, dataType []string,
+	near *models.GeoCoordinates,
+) error {
+	if len(dataType) != 1 || schema.DataType(dataType[0]) != schema.DataTypeGeoCoordinates {
+		return errors.Errorf("sorting by distance requires a property of type "+
+			"geoCoordinates, property %q is of type %v", propName, dataType)
+	}
+
+	if err := validateGeoCoordinates(near); err != nil {
+		return errors.Wrap(err, "invalid near parameter")
+	}
+
+	return nil
+}
@@ -23,6 +23,7 @@ func TestSortValidation(t *testing.T) {
 	tests := []struct {
 		name  string
 		prop  string
+		near  *models.GeoCoordinates
 		valid bool
 	}{
 		{
@@ -50,6 +51,30 @@ func TestSortValidation(t *testing.T) {
 			valid: false,
 			prop:  "my_idz",
 		},
+		{
+			name:  "geo prop near a point",
+			valid: true,
+			prop:  "location",
+			near:  &models.GeoCoordinates{Latitude: ptFloat32(52.5), Longitude: ptFloat32(13.4)},
+		},
+		{
+			name:  "geo prop near a point without longitude",
+			valid: false,
+			prop:  "location",
+			near:  &models.GeoCoordinates{Latitude: ptFloat32(52.5)},
+		},
+		{
+			name:  "non-geo prop near a point",
+			valid: false,
+			prop:  "horsepower",
+			near:  &models.GeoCoordinates{Latitude: ptFloat32(52.5), Longitude: ptFloat32(13.4)},
+		},
+		{
+			name:  "internal prop near a point",
+			valid: false,
+			prop:  "_creationTimeUnix",
+			near:  &models.GeoCoordinates{Latitude: ptFloat32(52.5), Longitude: ptFloat32(13.4)},
+		},
 	}
 
 	for _, tt := range tests {
@@ -64,6 +89,7 @@ func TestSortValidation(t *testing.T) {
 							{Name: "horsepower", DataType: []string{"int"}},
 							{Name: "my_id", DataType: []string{"uuid"}},
 							{Name: "my_idz", DataType: []string{"uuid[]"}},
+							{Name: "location", DataType: []string{"geoCoordinates"}},
 						},
 					},
 				},
@@ -72,6 +98,7 @@ func TestSortValidation(t *testing.T) {
 			sort := []Sort{{
 				Path:  []string{tt.prop},
 				Order: "asc",
+				Near:  tt.near,
 			}}
 
 			err := ValidateSort(sch, schema.ClassName("Car"), sort)
@@ -83,3 +110,7 @@ func TestSortValidation(t *testing.T) {
 		})
 	}
 }
+
+func ptFloat32(in float32) *float32 {
+	return &in
+}
@@ -45,6 +45,12 @@ type Class struct {
 	// multi tenancy config
 	MultiTenancyConfig *MultiTenancyConfig `json:"multiTenancyConfig,omitempty"`
 
+	// object Ttl config
+	ObjectTTLConfig *ObjectTTLConfig `json:"objectTtlConfig,omitempty"`
+
+	// object versioning config
+	ObjectVersioningConfig *ObjectVersioningConfig `json:"objectVersioningConfig,omitempty"`
+
 	// The properties of the class.
 	Properties []*Property `json:"properties"`
 
@@ -76,6 +82,14 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateObjectTTLConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateObjectVersioningConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProperties(formats); err != nil {
 		res = append(res, err)
 	}
@@ -128,6 +142,44 @@ func (m *Class) validateMultiTenancyConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateObjectTTLConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.ObjectTTLConfig) { // not required
+		return nil
+	}
+
+	if m.ObjectTTLConfig != nil {
+		if err := m.ObjectTTLConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectTtlConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectTtlConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateObjectVersioningConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.ObjectVersioningConfig) { // not required
+		return nil
+	}
+
+	if m.ObjectVersioningConfig != nil {
+		if err := m.ObjectVersioningConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectVersioningConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectVersioningConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateProperties(formats strfmt.Registry) error {
 	if swag.IsZero(m.Properties) { // not required
 		return nil
@@ -185,6 +237,14 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateObjectTTLConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateObjectVersioningConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProperties(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -231,6 +291,38 @@ func (m *Class) contextValidateMultiTenancyConfig(ctx context.Context, formats s
 	return nil
 }
 
+func (m *Class) contextValidateObjectTTLConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ObjectTTLConfig != nil {
+		if err := m.ObjectTTLConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectTtlConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectTtlConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) contextValidateObjectVersioningConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ObjectVersioningConfig != nil {
+		if err := m.ObjectVersioningConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectVersioningConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectVersioningConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateProperties(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Properties); i++ {
@@ -19,6 +19,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -31,6 +32,9 @@ import (
 // swagger:model Property
 type Property struct {
 
+	// Name of a text analyzer defined in the invertedIndexConfig of the class. Optional. Applies to text and text[] data types. If set, the analyzer is used instead of the tokenization of the property, both at import and at query time
+	Analyzer string `json:"analyzer,omitempty"`
+
 	// Can be a reference to another type when it starts with a capital (for example Person), otherwise "string" or "int".
 	DataType []string `json:"dataType"`
 
@@ -52,8 +56,14 @@ type Property struct {
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
 
-	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims). Not supported for remaining data types
-	// Enum: [word lowercase whitespace field]
+	// The nested properties of a property of data type "object" or "object[]". Required for these data types, not allowed for any other.
+	NestedProperties []*NestedProperty `json:"nestedProperties,omitempty"`
+
+	// Name of a stopword preset which replaces the preset of the invertedIndexConfig for this property, e.g. for a property in a different language than the rest of the class. Optional. Applies to text and text[] data types. The additions and removals of the class still apply
+	StopwordsPreset string `json:"stopwordsPreset,omitempty"`
+
+	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims), `trigram` (splits into lowercased trigrams of each word, speeds up infix `Like` filters), `cjk` (splits chinese, japanese and korean text into overlapping bigrams and all other text into lowercased words). Not supported for remaining data types
+	// Enum: [word lowercase whitespace field trigram cjk]
 	Tokenization string `json:"tokenization,omitempty"`
 }
 
@@ -61,6 +71,10 @@ type Property struct {
 func (m *Property) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateNestedProperties(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTokenization(formats); err != nil {
 		res = append(res, err)
 	}
@@ -71,11 +85,37 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Property) validateNestedProperties(formats strfmt.Registry) error {
+	if swag.IsZero(m.NestedProperties) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+		if swag.IsZero(m.NestedProperties[i]) { // not required
+			continue
+		}
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 var propertyTypeTokenizationPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field","trigram","cjk"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -96,6 +136,12 @@ const (
 
 	// PropertyTokenizationField captures enum value "field"
 	PropertyTokenizationField string = "field"
+
+	// PropertyTokenizationTrigram captures enum value "trigram"
+	PropertyTokenizationTrigram string = "trigram"
+
+	// PropertyTokenizationCjk captures enum value "cjk"
+	PropertyTokenizationCjk string = "cjk"
 )
 
 // prop value enum
@@ -119,8 +165,37 @@ func (m *Property) validateTokenization(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this property based on context it is used
+// ContextValidate validate this property based on the context it is used
 func (m *Property) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNestedProperties(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Property) contextValidateNestedProperties(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -31,7 +31,7 @@ type StopwordConfig struct {
 	// stopwords to be considered additionally
 	Additions []string `json:"additions"`
 
-	// pre-existing list of common words by language
+	// pre-existing list of common words by language, one of 'en' (default), 'de', 'fr', 'es', 'nl', 'it', 'pt' or 'none'
 	Preset string `json:"preset,omitempty"`
 
 	// stopwords to be removed from consideration
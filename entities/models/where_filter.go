@@ -37,7 +37,7 @@ type WhereFilter struct {
 
 	// operator to use
 	// Example: GreaterThanEqual
-	// Enum: [And Or Equal Like Not NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull]
+	// Enum: [And Or Equal Like Not NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull WithinGeoPolygon WithinGeoBoundingBox ContainsAny ContainsAll]
 	Operator string `json:"operator,omitempty"`
 
 	// path to the property currently being filtered
@@ -48,10 +48,24 @@ type WhereFilter struct {
 	// Example: false
 	ValueBoolean *bool `json:"valueBoolean,omitempty"`
 
+	// value as an array of booleans, to be used with the ContainsAny and ContainsAll operators
+	// Example: [true,false]
+	ValueBooleanArray []bool `json:"valueBooleanArray,omitempty"`
+
 	// value as date (as string)
 	// Example: TODO
 	ValueDate *string `json:"valueDate,omitempty"`
 
+	// value as an array of dates (as strings), to be used with the ContainsAny and ContainsAll operators
+	// Example: ["2006-01-02T15:04:05Z"]
+	ValueDateArray []string `json:"valueDateArray,omitempty"`
+
+	// value as a bounding box of geo coordinates
+	ValueGeoBoundingBox *WhereFilterGeoBoundingBox `json:"valueGeoBoundingBox,omitempty"`
+
+	// value as a polygon of geo coordinates
+	ValueGeoPolygon *WhereFilterGeoPolygon `json:"valueGeoPolygon,omitempty"`
+
 	// value as geo coordinates and distance
 	ValueGeoRange *WhereFilterGeoRange `json:"valueGeoRange,omitempty"`
 
@@ -59,10 +73,18 @@ type WhereFilter struct {
 	// Example: 2000
 	ValueInt *int64 `json:"valueInt,omitempty"`
 
+	// value as an array of integers, to be used with the ContainsAny and ContainsAll operators
+	// Example: [100,200]
+	ValueIntArray []int64 `json:"valueIntArray,omitempty"`
+
 	// value as number/float
 	// Example: 3.14
 	ValueNumber *float64 `json:"valueNumber,omitempty"`
 
+	// value as an array of numbers/floats, to be used with the ContainsAny and ContainsAll operators
+	// Example: [3.14,2.71]
+	ValueNumberArray []float64 `json:"valueNumberArray,omitempty"`
+
 	// value as text (deprecated as of v1.19; alias for valueText)
 	// Example: my search term
 	ValueString *string `json:"valueString,omitempty"`
@@ -70,6 +92,10 @@ type WhereFilter struct {
 	// value as text
 	// Example: my search term
 	ValueText *string `json:"valueText,omitempty"`
+
+	// value as an array of texts, to be used with the ContainsAny and ContainsAll operators
+	// Example: ["red","blue"]
+	ValueTextArray []string `json:"valueTextArray,omitempty"`
 }
 
 // Validate validates this where filter
@@ -84,6 +110,14 @@ func (m *WhereFilter) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateValueGeoBoundingBox(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValueGeoPolygon(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateValueGeoRange(formats); err != nil {
 		res = append(res, err)
 	}
@@ -124,7 +158,7 @@ var whereFilterTypeOperatorPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","Not","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","Not","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull","WithinGeoPolygon","WithinGeoBoundingBox","ContainsAny","ContainsAll"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -169,6 +203,18 @@ const (
 
 	// WhereFilterOperatorIsNull captures enum value "IsNull"
 	WhereFilterOperatorIsNull string = "IsNull"
+
+	// WhereFilterOperatorWithinGeoPolygon captures enum value "WithinGeoPolygon"
+	WhereFilterOperatorWithinGeoPolygon string = "WithinGeoPolygon"
+
+	// WhereFilterOperatorWithinGeoBoundingBox captures enum value "WithinGeoBoundingBox"
+	WhereFilterOperatorWithinGeoBoundingBox string = "WithinGeoBoundingBox"
+
+	// WhereFilterOperatorContainsAny captures enum value "ContainsAny"
+	WhereFilterOperatorContainsAny string = "ContainsAny"
+
+	// WhereFilterOperatorContainsAll captures enum value "ContainsAll"
+	WhereFilterOperatorContainsAll string = "ContainsAll"
 )
 
 // prop value enum
@@ -192,6 +238,44 @@ func (m *WhereFilter) validateOperator(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *WhereFilter) validateValueGeoBoundingBox(formats strfmt.Registry) error {
+	if swag.IsZero(m.ValueGeoBoundingBox) { // not required
+		return nil
+	}
+
+	if m.ValueGeoBoundingBox != nil {
+		if err := m.ValueGeoBoundingBox.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoBoundingBox")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoBoundingBox")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *WhereFilter) validateValueGeoPolygon(formats strfmt.Registry) error {
+	if swag.IsZero(m.ValueGeoPolygon) { // not required
+		return nil
+	}
+
+	if m.ValueGeoPolygon != nil {
+		if err := m.ValueGeoPolygon.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoPolygon")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoPolygon")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *WhereFilter) validateValueGeoRange(formats strfmt.Registry) error {
 	if swag.IsZero(m.ValueGeoRange) { // not required
 		return nil
@@ -219,6 +303,14 @@ func (m *WhereFilter) ContextValidate(ctx context.Context, formats strfmt.Regist
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateValueGeoBoundingBox(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateValueGeoPolygon(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateValueGeoRange(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -249,6 +341,38 @@ func (m *WhereFilter) contextValidateOperands(ctx context.Context, formats strfm
 	return nil
 }
 
+func (m *WhereFilter) contextValidateValueGeoBoundingBox(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ValueGeoBoundingBox != nil {
+		if err := m.ValueGeoBoundingBox.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoBoundingBox")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoBoundingBox")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *WhereFilter) contextValidateValueGeoPolygon(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ValueGeoPolygon != nil {
+		if err := m.ValueGeoPolygon.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoPolygon")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoPolygon")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *WhereFilter) contextValidateValueGeoRange(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.ValueGeoRange != nil {
@@ -26,6 +26,7 @@ const (
 	Img2Vec             ModuleType = "Img2Vec"
 	Multi2Vec           ModuleType = "Multi2Vec"
 	Ref2Vec             ModuleType = "Ref2Vec"
+	Reranker            ModuleType = "Reranker"
 	Text2MultiVec       ModuleType = "Text2MultiVec"
 	Text2TextGenerative ModuleType = "Text2TextGenerative"
 	Text2TextSummarize  ModuleType = "Text2TextSummarize"
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objectfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type jsonlReader struct {
+	dec  *json.Decoder
+	line int
+}
+
+// NewJSONLReader reads objects which are encoded as one JSON object per line
+func NewJSONLReader(r io.Reader) Reader {
+	dec := json.NewDecoder(r)
+	// keep numbers as json.Number, the same as properties of the REST API, so
+	// that large integers are not truncated
+	dec.UseNumber()
+	return &jsonlReader{dec: dec}
+}
+
+func (r *jsonlReader) Read() (*models.Object, error) {
+	var obj models.Object
+	if err := r.dec.Decode(&obj); err != nil {
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, fmt.Errorf("decode object %d: %w", r.line+1, err)
+	}
+	r.line++
+
+	return &obj, nil
+}
+
+func (r *jsonlReader) Close() error {
+	return nil
+}
+
+type jsonlWriter struct {
+	enc *json.Encoder
+}
+
+// NewJSONLWriter writes objects encoded as one JSON object per line
+func NewJSONLWriter(w io.Writer) Writer {
+	return &jsonlWriter{enc: json.NewEncoder(w)}
+}
+
+func (w *jsonlWriter) Write(obj *models.Object) error {
+	if err := w.enc.Encode(obj); err != nil {
+		return fmt.Errorf("encode object %s: %w", obj.ID, err)
+	}
+	return nil
+}
+
+func (w *jsonlWriter) Close() error {
+	return nil
+}
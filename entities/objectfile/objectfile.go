@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package objectfile reads and writes objects in portable file formats, which
+// are independent of the internal storage format of a shard.
+package objectfile
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type Format string
+
+const (
+	// FormatJSONL stores one object per line, encoded like an object of the
+	// REST API
+	FormatJSONL Format = "jsonl"
+	// FormatParquet stores one object per row, see parquetObject for the
+	// columns
+	FormatParquet Format = "parquet"
+)
+
+// ParseFormat returns the format with the given name
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(strings.ToLower(name)); f {
+	case FormatJSONL, FormatParquet:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unsupported format %q, must be one of %q, %q",
+			name, FormatJSONL, FormatParquet)
+	}
+}
+
+// FormatFromPath returns the format of a file based on its extension
+func FormatFromPath(path string) (Format, error) {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".jsonl", ".ndjson":
+		return FormatJSONL, nil
+	case ".parquet":
+		return FormatParquet, nil
+	default:
+		return "", fmt.Errorf("unsupported file extension %q of %s", ext, path)
+	}
+}
+
+// Reader reads objects from a file. Read returns io.EOF once all objects
+// have been read.
+type Reader interface {
+	Read() (*models.Object, error)
+	Close() error
+}
+
+// Writer writes objects to a file. Close must be called to complete the
+// file, it does not close the underlying writer.
+type Writer interface {
+	Write(obj *models.Object) error
+	Close() error
+}
+
+// Extension returns the file extension of the format, including the dot
+func (f Format) Extension() string {
+	return "." + string(f)
+}
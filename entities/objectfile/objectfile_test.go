@@ -0,0 +1,204 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objectfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+func TestFormatFromPath(t *testing.T) {
+	for path, expected := range map[string]Format{
+		"objects.jsonl":     FormatJSONL,
+		"objects.NDJSON":    FormatJSONL,
+		"/tmp/part.parquet": FormatParquet,
+	} {
+		f, err := FormatFromPath(path)
+		require.Nil(t, err)
+		assert.Equal(t, expected, f)
+	}
+
+	_, err := FormatFromPath("objects.csv")
+	assert.NotNil(t, err)
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := ParseFormat("Parquet")
+	require.Nil(t, err)
+	assert.Equal(t, FormatParquet, f)
+
+	_, err = ParseFormat("csv")
+	assert.NotNil(t, err)
+}
+
+func TestJSONLReader(t *testing.T) {
+	in := `{"class":"Article","id":"8d6d6a4c-e69b-4e5c-a0c0-d0ab1f3d5c1e","properties":{"title":"first","words":12},"vector":[0.1,0.2]}
+{"class":"Article","id":"3b1b3a1e-8b4a-4f63-8a36-1c1b2a3d4e5f","properties":{"title":"second"}}
+`
+	r := NewJSONLReader(strings.NewReader(in))
+
+	obj, err := r.Read()
+	require.Nil(t, err)
+	assert.Equal(t, "Article", obj.Class)
+	assert.Equal(t, strfmt.UUID("8d6d6a4c-e69b-4e5c-a0c0-d0ab1f3d5c1e"), obj.ID)
+	assert.Equal(t, models.C11yVector{0.1, 0.2}, obj.Vector)
+	props := obj.Properties.(map[string]interface{})
+	assert.Equal(t, "first", props["title"])
+	assert.Equal(t, json.Number("12"), props["words"])
+
+	obj, err = r.Read()
+	require.Nil(t, err)
+	assert.Equal(t, strfmt.UUID("3b1b3a1e-8b4a-4f63-8a36-1c1b2a3d4e5f"), obj.ID)
+	assert.Nil(t, obj.Vector)
+
+	_, err = r.Read()
+	assert.Equal(t, io.EOF, err)
+	require.Nil(t, r.Close())
+}
+
+func TestJSONLReader_InvalidLine(t *testing.T) {
+	r := NewJSONLReader(strings.NewReader("{\"class\":\"Article\"}\n{invalid\n"))
+
+	_, err := r.Read()
+	require.Nil(t, err)
+
+	_, err = r.Read()
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "object 2")
+}
+
+func TestParquetReader(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := writer.NewParquetWriterFromWriter(&buf, new(parquetObject), 1)
+	require.Nil(t, err)
+
+	// more rows than a single batch, to verify reading across batches
+	rows := make([]parquetObject, parquetBatchSize+10)
+	for i := range rows {
+		rows[i] = parquetObject{
+			ID:               fmt.Sprintf("00000000-0000-0000-0000-%012d", i),
+			Class:            "Article",
+			Properties:       fmt.Sprintf(`{"words":%d}`, i),
+			Vector:           []float32{float32(i), 1},
+			CreationTimeUnix: int64(i),
+		}
+		require.Nil(t, w.Write(rows[i]))
+	}
+	require.Nil(t, w.WriteStop())
+
+	r, err := NewParquetReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.Nil(t, err)
+	defer r.Close()
+
+	var objs []*models.Object
+	for {
+		obj, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		require.Nil(t, err)
+		objs = append(objs, obj)
+	}
+
+	require.Len(t, objs, len(rows))
+	for i, obj := range objs {
+		assert.Equal(t, strfmt.UUID(rows[i].ID), obj.ID)
+		assert.Equal(t, "Article", obj.Class)
+		assert.Equal(t, models.C11yVector{float32(i), 1}, obj.Vector)
+		assert.Equal(t, int64(i), obj.CreationTimeUnix)
+		assert.Equal(t, json.Number(fmt.Sprint(i)),
+			obj.Properties.(map[string]interface{})["words"])
+	}
+}
+
+func TestParquetReader_InvalidFile(t *testing.T) {
+	in := []byte("not a parquet file")
+	_, err := NewParquetReader(bytes.NewReader(in), int64(len(in)))
+	assert.NotNil(t, err)
+}
+
+func TestWriters_RoundTrip(t *testing.T) {
+	objs := []*models.Object{
+		{
+			Class: "Article",
+			ID:    "8d6d6a4c-e69b-4e5c-a0c0-d0ab1f3d5c1e",
+			Properties: map[string]interface{}{
+				"title": "first",
+				"words": json.Number("12"),
+				"hasAuthor": []interface{}{
+					map[string]interface{}{
+						"beacon": "weaviate://localhost/Author/3b1b3a1e-8b4a-4f63-8a36-1c1b2a3d4e5f",
+					},
+				},
+			},
+			Vector:             models.C11yVector{0.1, 0.2},
+			CreationTimeUnix:   1000,
+			LastUpdateTimeUnix: 2000,
+		},
+		{
+			Class:            "Article",
+			ID:               "3b1b3a1e-8b4a-4f63-8a36-1c1b2a3d4e5f",
+			Properties:       map[string]interface{}{"title": "second"},
+			CreationTimeUnix: 3000,
+		},
+	}
+
+	for _, format := range []Format{FormatJSONL, FormatParquet} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			var w Writer
+			var err error
+			if format == FormatParquet {
+				w, err = NewParquetWriter(&buf)
+				require.Nil(t, err)
+			} else {
+				w = NewJSONLWriter(&buf)
+			}
+			for _, obj := range objs {
+				require.Nil(t, w.Write(obj))
+			}
+			require.Nil(t, w.Close())
+
+			var r Reader
+			if format == FormatParquet {
+				r, err = NewParquetReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+				require.Nil(t, err)
+			} else {
+				r = NewJSONLReader(&buf)
+			}
+			defer r.Close()
+
+			for _, expected := range objs {
+				obj, err := r.Read()
+				require.Nil(t, err)
+				assert.Equal(t, expected.ID, obj.ID)
+				assert.Equal(t, expected.Class, obj.Class)
+				assert.Equal(t, expected.Properties, obj.Properties)
+				assert.Equal(t, expected.Vector, obj.Vector)
+				assert.Equal(t, expected.CreationTimeUnix, obj.CreationTimeUnix)
+				assert.Equal(t, expected.LastUpdateTimeUnix, obj.LastUpdateTimeUnix)
+			}
+			_, err = r.Read()
+			assert.Equal(t, io.EOF, err)
+		})
+	}
+}
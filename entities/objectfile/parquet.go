@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objectfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+const (
+	// parquetBatchSize is the number of rows decoded at once
+	parquetBatchSize = 128
+	// parquetParallelism is the number of goroutines used to decode a batch
+	parquetParallelism = 4
+)
+
+// parquetObject is the layout of a row of a parquet file. Properties are
+// stored as JSON, as their types depend on the schema of the class.
+type parquetObject struct {
+	ID                 string    `parquet:"name=id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Class              string    `parquet:"name=class, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Properties         string    `parquet:"name=properties, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Vector             []float32 `parquet:"name=vector, type=LIST, valuetype=FLOAT"`
+	CreationTimeUnix   int64     `parquet:"name=creationTimeUnix, type=INT64"`
+	LastUpdateTimeUnix int64     `parquet:"name=lastUpdateTimeUnix, type=INT64"`
+}
+
+type parquetReader struct {
+	r    *reader.ParquetReader
+	left int64
+	rows []parquetObject
+}
+
+// NewParquetReader reads objects from a parquet file of the given size
+func NewParquetReader(r io.ReaderAt, size int64) (Reader, error) {
+	pr, err := reader.NewParquetReader(&readerAtFile{r: r, size: size,
+		SectionReader: io.NewSectionReader(r, 0, size)}, new(parquetObject),
+		parquetParallelism)
+	if err != nil {
+		return nil, fmt.Errorf("open parquet file: %w", err)
+	}
+
+	return &parquetReader{r: pr, left: pr.GetNumRows()}, nil
+}
+
+func (r *parquetReader) Read() (*models.Object, error) {
+	if len(r.rows) == 0 {
+		if r.left == 0 {
+			return nil, io.EOF
+		}
+
+		n := int64(parquetBatchSize)
+		if n > r.left {
+			n = r.left
+		}
+		r.rows = make([]parquetObject, n)
+		if err := r.r.Read(&r.rows); err != nil {
+			return nil, fmt.Errorf("read parquet rows: %w", err)
+		}
+		r.left -= n
+	}
+
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+
+	obj := &models.Object{
+		ID:                 strfmt.UUID(row.ID),
+		Class:              row.Class,
+		CreationTimeUnix:   row.CreationTimeUnix,
+		LastUpdateTimeUnix: row.LastUpdateTimeUnix,
+	}
+	if len(row.Vector) > 0 {
+		obj.Vector = row.Vector
+	}
+	if row.Properties != "" {
+		dec := json.NewDecoder(strings.NewReader(row.Properties))
+		dec.UseNumber()
+		var props map[string]interface{}
+		if err := dec.Decode(&props); err != nil {
+			return nil, fmt.Errorf("decode properties of object %s: %w", row.ID, err)
+		}
+		obj.Properties = props
+	}
+
+	return obj, nil
+}
+
+func (r *parquetReader) Close() error {
+	r.r.ReadStop()
+	return nil
+}
+
+type parquetWriter struct {
+	w *writer.ParquetWriter
+}
+
+// NewParquetWriter writes objects as rows of a parquet file
+func NewParquetWriter(w io.Writer) (Writer, error) {
+	pw, err := writer.NewParquetWriterFromWriter(w, new(parquetObject),
+		parquetParallelism)
+	if err != nil {
+		return nil, fmt.Errorf("create parquet writer: %w", err)
+	}
+
+	return &parquetWriter{w: pw}, nil
+}
+
+func (w *parquetWriter) Write(obj *models.Object) error {
+	row := parquetObject{
+		ID:                 obj.ID.String(),
+		Class:              obj.Class,
+		Vector:             obj.Vector,
+		CreationTimeUnix:   obj.CreationTimeUnix,
+		LastUpdateTimeUnix: obj.LastUpdateTimeUnix,
+	}
+	if obj.Properties != nil {
+		props, err := json.Marshal(obj.Properties)
+		if err != nil {
+			return fmt.Errorf("encode properties of object %s: %w", obj.ID, err)
+		}
+		row.Properties = string(props)
+	}
+
+	if err := w.w.Write(row); err != nil {
+		return fmt.Errorf("write parquet row: %w", err)
+	}
+	return nil
+}
+
+// Close writes the footer of the parquet file
+func (w *parquetWriter) Close() error {
+	if err := w.w.WriteStop(); err != nil {
+		return fmt.Errorf("complete parquet file: %w", err)
+	}
+	return nil
+}
+
+// readerAtFile is a read-only source.ParquetFile, the parquet reader opens
+// a separate file for every column which it reads concurrently
+type readerAtFile struct {
+	*io.SectionReader
+	r    io.ReaderAt
+	size int64
+}
+
+func (f *readerAtFile) Open(string) (source.ParquetFile, error) {
+	return &readerAtFile{r: f.r, size: f.size,
+		SectionReader: io.NewSectionReader(f.r, 0, f.size)}, nil
+}
+
+func (f *readerAtFile) Create(string) (source.ParquetFile, error) {
+	return nil, fmt.Errorf("parquet file is read-only")
+}
+
+func (f *readerAtFile) Write([]byte) (int, error) {
+	return 0, fmt.Errorf("parquet file is read-only")
+}
+
+func (f *readerAtFile) Close() error {
+	return nil
+}
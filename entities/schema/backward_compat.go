@@ -41,6 +41,10 @@ func GetPropertyByName(c *models.Class, propName string) (*models.Property, erro
 	for _, prop := range c.Properties {
 		// Check if the name of the property is the given name, that's the property we need
 		if prop.Name == strings.Split(propName, ".")[0] {
+			// the path of a nested property, e.g. "address.city"
+			if _, ok := AsNested(prop.DataType); ok && strings.Contains(propName, NestedPathSeparator) {
+				return GetNestedPropertyByPath(c, propName)
+			}
 			return prop, nil
 		}
 	}
@@ -113,7 +117,9 @@ func IsValidValueDataType(dt string) bool {
 		string(DataTypeIntArray),
 		string(DataTypeNumberArray),
 		string(DataTypeBooleanArray),
-		string(DataTypeDateArray):
+		string(DataTypeDateArray),
+		string(DataTypeObject),
+		string(DataTypeObjectArray):
 		return true
 	}
 	return false
@@ -55,6 +55,11 @@ const (
 	DataTypeUUID DataType = "uuid"
 	// DataTypeUUIDArray is the array version of DataTypeUUID
 	DataTypeUUIDArray DataType = "uuid[]"
+	// DataTypeObject is a JSON sub-document, its structure is declared by
+	// the nested properties of the property
+	DataTypeObject DataType = "object"
+	// DataTypeObjectArray is the array version of DataTypeObject
+	DataTypeObjectArray DataType = "object[]"
 
 	// deprecated as of v1.19, replaced by DataTypeText + relevant tokenization setting
 	// DataTypeString The data type is a value of type string
@@ -79,6 +84,10 @@ var PrimitiveDataTypes []DataType = []DataType{
 	DataTypeUUID, DataTypeUUIDArray,
 }
 
+var NestedDataTypes []DataType = []DataType{
+	DataTypeObject, DataTypeObjectArray,
+}
+
 var DeprecatedPrimitiveDataTypes []DataType = []DataType{
 	// deprecated as of v1.19
 	DataTypeString, DataTypeStringArray,
@@ -89,12 +98,15 @@ type PropertyKind int
 const (
 	PropertyKindPrimitive PropertyKind = 1
 	PropertyKindRef       PropertyKind = 2
+	PropertyKindNested    PropertyKind = 3
 )
 
 type PropertyDataType interface {
 	Kind() PropertyKind
 	IsPrimitive() bool
 	AsPrimitive() DataType
+	IsNested() bool
+	AsNested() DataType
 	IsReference() bool
 	Classes() []ClassName
 	ContainsClass(name ClassName) bool
@@ -103,6 +115,7 @@ type PropertyDataType interface {
 type propertyDataType struct {
 	kind          PropertyKind
 	primitiveType DataType
+	nestedType    DataType
 	classes       []ClassName
 }
 
@@ -154,6 +167,18 @@ func (p *propertyDataType) AsPrimitive() DataType {
 	return p.primitiveType
 }
 
+func (p *propertyDataType) IsNested() bool {
+	return p.kind == PropertyKindNested
+}
+
+func (p *propertyDataType) AsNested() DataType {
+	if p.kind != PropertyKindNested {
+		panic("not nested type")
+	}
+
+	return p.nestedType
+}
+
 func (p *propertyDataType) IsReference() bool {
 	return p.kind == PropertyKindRef
 }
@@ -212,6 +237,14 @@ func (s *Schema) FindPropertyDataTypeWithRefs(
 				}, nil
 			}
 		}
+		for _, dt := range NestedDataTypes {
+			if dataType[0] == dt.String() {
+				return &propertyDataType{
+					kind:       PropertyKindNested,
+					nestedType: dt,
+				}, nil
+			}
+		}
 		if len(dataType[0]) == 0 {
 			return nil, fmt.Errorf("dataType cannot be an empty string")
 		}
@@ -260,8 +293,24 @@ func AsPrimitive(dataType []string) (DataType, bool) {
 		if len(dataType[0]) == 0 {
 			return "", true
 		}
+		if _, ok := AsNested(dataType); ok {
+			return "", false
+		}
 
 		return "", unicode.IsLower(rune(dataType[0][0]))
 	}
 	return "", false
 }
+
+// AsNested returns the nested data type, i.e. object or object[], if the
+// data type is one
+func AsNested(dataType []string) (DataType, bool) {
+	if len(dataType) == 1 {
+		for _, dt := range NestedDataTypes {
+			if dataType[0] == dt.String() {
+				return dt, true
+			}
+		}
+	}
+	return "", false
+}
@@ -19,6 +19,7 @@ type InvertedIndexConfig struct {
 	IndexTimestamps     bool
 	IndexNullState      bool
 	IndexPropertyLength bool
+	IndexPositions      bool
 }
 
 type BM25Config struct {
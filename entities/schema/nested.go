@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NestedPathSeparator joins the names of a property of data type object or
+// object[] and its nested properties to the path of a nested property, e.g.
+// "address.city". Such paths name the inverted buckets of nested properties
+// and can be used in filters.
+const NestedPathSeparator = "."
+
+// NestedLeafDataTypes are the data types allowed for nested properties
+// besides object and object[]
+var NestedLeafDataTypes []DataType = []DataType{
+	DataTypeText, DataTypeInt, DataTypeNumber, DataTypeBoolean, DataTypeDate,
+	DataTypeUUID, DataTypeTextArray, DataTypeIntArray, DataTypeNumberArray,
+	DataTypeBooleanArray, DataTypeDateArray, DataTypeUUIDArray,
+}
+
+// IsNestedLeafDataType checks whether the data type is allowed for nested
+// properties which are not objects themselves
+func IsNestedLeafDataType(dataType []string) bool {
+	if len(dataType) != 1 {
+		return false
+	}
+	for _, dt := range NestedLeafDataTypes {
+		if dataType[0] == dt.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// NestedPropertyPaths returns a pseudo property for every nested property of
+// the property which is not an object itself. The pseudo properties are named
+// by their path and can be indexed like any top-level property. Nested
+// properties within an object[] are of the array version of their data
+// type, as an object can hold many values for them.
+func NestedPropertyPaths(prop *models.Property) []*models.Property {
+	dt, ok := AsNested(prop.DataType)
+	if !ok {
+		return nil
+	}
+	return nestedPropertyPaths(prop.Name, prop.NestedProperties,
+		dt == DataTypeObjectArray, prop.IndexFilterable)
+}
+
+func nestedPropertyPaths(prefix string, nps []*models.NestedProperty,
+	inArray bool, indexFilterable *bool,
+) []*models.Property {
+	var out []*models.Property
+	for _, np := range nps {
+		path := prefix + NestedPathSeparator + np.Name
+		filterable := np.IndexFilterable
+		if filterable == nil {
+			filterable = indexFilterable
+		}
+
+		if dt, ok := AsNested(np.DataType); ok {
+			out = append(out, nestedPropertyPaths(path, np.NestedProperties,
+				inArray || dt == DataTypeObjectArray, filterable)...)
+			continue
+		}
+		if !IsNestedLeafDataType(np.DataType) {
+			continue
+		}
+
+		out = append(out, nestedPathProperty(path, np, inArray, filterable))
+	}
+	return out
+}
+
+func nestedPathProperty(path string, np *models.NestedProperty, inArray bool,
+	indexFilterable *bool,
+) *models.Property {
+	dataType := np.DataType
+	if inArray {
+		if _, isArray := IsArrayType(DataType(dataType[0])); !isArray {
+			dataType = []string{dataType[0] + "[]"}
+		}
+	}
+
+	return &models.Property{
+		Name:            path,
+		DataType:        dataType,
+		Description:     np.Description,
+		Tokenization:    np.Tokenization,
+		IndexFilterable: indexFilterable,
+		IndexSearchable: np.IndexSearchable,
+	}
+}
+
+// MergeNestedProperties adds the nested properties of update which are not
+// part of existing yet. Nested properties of data type object or object[]
+// which are part of both are merged recursively, the data types of existing
+// nested properties are never changed. The inputs are not modified, added is
+// false if update holds no new nested properties.
+func MergeNestedProperties(existing, update []*models.NestedProperty,
+) (merged []*models.NestedProperty, added bool) {
+	merged = make([]*models.NestedProperty, len(existing))
+	copy(merged, existing)
+
+	for _, np := range update {
+		pos := -1
+		for i := range merged {
+			if merged[i].Name == np.Name {
+				pos = i
+				break
+			}
+		}
+		if pos < 0 {
+			merged = append(merged, np)
+			added = true
+			continue
+		}
+
+		if _, ok := AsNested(merged[pos].DataType); !ok {
+			continue
+		}
+		if _, ok := AsNested(np.DataType); !ok {
+			continue
+		}
+		nested, nestedAdded := MergeNestedProperties(merged[pos].NestedProperties,
+			np.NestedProperties)
+		if nestedAdded {
+			updated := *merged[pos]
+			updated.NestedProperties = nested
+			merged[pos] = &updated
+			added = true
+		}
+	}
+
+	return merged, added
+}
+
+// GetNestedPropertyByPath returns the pseudo property of the nested property
+// at the path, as it is returned by NestedPropertyPaths
+func GetNestedPropertyByPath(class *models.Class, path string) (*models.Property, error) {
+	segments := strings.Split(path, NestedPathSeparator)
+	var prop *models.Property
+	for _, p := range class.Properties {
+		if p.Name == segments[0] {
+			prop = p
+			break
+		}
+	}
+	if prop == nil {
+		return nil, fmt.Errorf(ErrorNoSuchProperty, path, class.Class)
+	}
+
+	dt, ok := AsNested(prop.DataType)
+	if !ok {
+		return nil, fmt.Errorf("property %q of class %q is not of data type object or object[]",
+			prop.Name, class.Class)
+	}
+
+	inArray := dt == DataTypeObjectArray
+	filterable := prop.IndexFilterable
+	nps := prop.NestedProperties
+	for i, segment := range segments[1:] {
+		var np *models.NestedProperty
+		for _, candidate := range nps {
+			if candidate.Name == segment {
+				np = candidate
+				break
+			}
+		}
+		if np == nil {
+			return nil, fmt.Errorf("no such nested property %q in path %q of class %q",
+				segment, path, class.Class)
+		}
+		if np.IndexFilterable != nil {
+			filterable = np.IndexFilterable
+		}
+
+		dt, ok := AsNested(np.DataType)
+		if !ok {
+			if i != len(segments)-2 {
+				return nil, fmt.Errorf("nested property %q in path %q of class %q "+
+					"is not of data type object or object[]", segment, path, class.Class)
+			}
+			return nestedPathProperty(path, np, inArray, filterable), nil
+		}
+		inArray = inArray || dt == DataTypeObjectArray
+		nps = np.NestedProperties
+	}
+
+	return nil, fmt.Errorf("path %q of class %q ends at a nested property of data type "+
+		"object or object[]", path, class.Class)
+}
@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func nestedTestClass() *models.Class {
+	vFalse := false
+	return &models.Class{
+		Class: "Person",
+		Properties: []*models.Property{
+			{Name: "name", DataType: DataTypeText.PropString()},
+			{
+				Name:     "address",
+				DataType: DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: DataTypeText.PropString(), Tokenization: "field"},
+					{Name: "zip", DataType: DataTypeInt.PropString(), IndexFilterable: &vFalse},
+					{
+						Name:     "geo",
+						DataType: DataTypeObject.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "tags", DataType: DataTypeTextArray.PropString()},
+						},
+					},
+				},
+			},
+			{
+				Name:     "pets",
+				DataType: DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "name", DataType: DataTypeText.PropString()},
+					{Name: "born", DataType: DataTypeDate.PropString()},
+				},
+			},
+		},
+	}
+}
+
+func TestNestedPropertyPaths(t *testing.T) {
+	class := nestedTestClass()
+	vFalse := false
+
+	t.Run("object", func(t *testing.T) {
+		paths := NestedPropertyPaths(class.Properties[1])
+		assert.Equal(t, []*models.Property{
+			{Name: "address.city", DataType: []string{"text"}, Tokenization: "field"},
+			{Name: "address.zip", DataType: []string{"int"}, IndexFilterable: &vFalse},
+			{Name: "address.geo.tags", DataType: []string{"text[]"}},
+		}, paths)
+	})
+
+	t.Run("object array", func(t *testing.T) {
+		paths := NestedPropertyPaths(class.Properties[2])
+		assert.Equal(t, []*models.Property{
+			{Name: "pets.name", DataType: []string{"text[]"}},
+			{Name: "pets.born", DataType: []string{"date[]"}},
+		}, paths)
+	})
+
+	t.Run("primitive", func(t *testing.T) {
+		assert.Nil(t, NestedPropertyPaths(class.Properties[0]))
+	})
+}
+
+func TestGetNestedPropertyByPath(t *testing.T) {
+	class := nestedTestClass()
+
+	t.Run("leaf of an object", func(t *testing.T) {
+		prop, err := GetPropertyByName(class, "address.geo.tags")
+		require.Nil(t, err)
+		assert.Equal(t, &models.Property{Name: "address.geo.tags", DataType: []string{"text[]"}}, prop)
+	})
+
+	t.Run("leaf of an object array", func(t *testing.T) {
+		dt, err := GetPropertyDataType(class, "pets.born")
+		require.Nil(t, err)
+		assert.Equal(t, DataTypeDateArray, *dt)
+	})
+
+	t.Run("unknown nested property", func(t *testing.T) {
+		_, err := GetPropertyByName(class, "address.country")
+		assert.EqualError(t, err, "no such nested property \"country\" in path "+
+			"\"address.country\" of class \"Person\"")
+	})
+
+	t.Run("path ending at an object", func(t *testing.T) {
+		_, err := GetPropertyByName(class, "address.geo")
+		assert.EqualError(t, err, "path \"address.geo\" of class \"Person\" ends at a "+
+			"nested property of data type object or object[]")
+	})
+
+	t.Run("path through a primitive", func(t *testing.T) {
+		_, err := GetPropertyByName(class, "address.city.name")
+		assert.EqualError(t, err, "nested property \"city\" in path \"address.city.name\" "+
+			"of class \"Person\" is not of data type object or object[]")
+	})
+}
+
+func TestMergeNestedProperties(t *testing.T) {
+	existing := nestedTestClass().Properties[1].NestedProperties
+
+	t.Run("nothing new", func(t *testing.T) {
+		merged, added := MergeNestedProperties(existing, []*models.NestedProperty{
+			{Name: "city", DataType: DataTypeText.PropString()},
+			{Name: "zip", DataType: DataTypeText.PropString()},
+		})
+		assert.False(t, added)
+		assert.Equal(t, existing, merged)
+	})
+
+	t.Run("new nested properties", func(t *testing.T) {
+		merged, added := MergeNestedProperties(existing, []*models.NestedProperty{
+			{Name: "street", DataType: DataTypeText.PropString()},
+			{
+				Name:     "geo",
+				DataType: DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "lat", DataType: DataTypeNumber.PropString()},
+				},
+			},
+		})
+		assert.True(t, added)
+		require.Len(t, merged, 4)
+		assert.Equal(t, "street", merged[3].Name)
+		assert.Equal(t, []*models.NestedProperty{
+			{Name: "tags", DataType: DataTypeTextArray.PropString()},
+			{Name: "lat", DataType: DataTypeNumber.PropString()},
+		}, merged[2].NestedProperties)
+
+		// the existing nested properties are left untouched
+		assert.Len(t, existing, 3)
+		assert.Len(t, existing[2].NestedProperties, 1)
+	})
+}
+
+func TestFindPropertyDataType_Nested(t *testing.T) {
+	s := Empty()
+	for _, dt := range NestedDataTypes {
+		pdt, err := s.FindPropertyDataType(dt.PropString())
+		require.Nil(t, err)
+		assert.True(t, pdt.IsNested())
+		assert.False(t, pdt.IsPrimitive())
+		assert.False(t, pdt.IsReference())
+		assert.Equal(t, dt, pdt.AsNested())
+
+		_, ok := AsPrimitive(dt.PropString())
+		assert.False(t, ok)
+	}
+}
@@ -24,6 +24,12 @@ type KeywordRanking struct {
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
 	AutoCut                int      `json:"autocut"`
+	// Fuzziness is the maximum edit distance for query terms without an
+	// explicit fuzziness such as colour~1
+	Fuzziness int `json:"fuzziness"`
+	// FuzzyPenalty reduces the score of terms matched by their fuzziness for
+	// each edit, defaults to 0.5 if not set
+	FuzzyPenalty *float64 `json:"fuzzyPenalty"`
 }
 
 type WeightedSearchResult struct {
@@ -41,8 +47,21 @@ type HybridSearch struct {
 	Vector      []float32   `json:"vector"`
 	AutoCut     int         `json:"autocut"`
 	Properties  []string    `json:"properties"`
+	// FusionType decides how the sparse and dense result sets are combined,
+	// defaults to FusionTypeRanked if not set
+	FusionType string `json:"fusionType"`
 }
 
+const (
+	// FusionTypeRanked combines result sets by the reciprocal of their ranks
+	FusionTypeRanked = "rankedFusion"
+	// FusionTypeRelativeScore combines min-max normalized scores
+	FusionTypeRelativeScore = "relativeScoreFusion"
+	// FusionTypeDistributionBased combines scores normalized by the mean and
+	// standard deviation of their result set
+	FusionTypeDistributionBased = "distributionBasedFusion"
+)
+
 type NearObject struct {
 	ID           string  `json:"id"`
 	Beacon       string  `json:"beacon"`
@@ -80,3 +99,57 @@ type GroupBy struct {
 	Groups          int
 	ObjectsPerGroup int
 }
+
+// MMR re-selects the results of a vector search by maximal marginal
+// relevance to make them more diverse
+type MMR struct {
+	// Lambda weighs relevance against diversity, 1 is relevance only,
+	// defaults to 0.5 if not set
+	Lambda *float64
+	// FetchK is the number of candidates fetched from the vector index,
+	// defaults to four times the limit if not set
+	FetchK int
+}
+
+// Boost combines the primary score of the results with functions over
+// their property values, after they were retrieved
+type Boost struct {
+	// Decay functions score a date, number or geoCoordinates property by the
+	// distance of its value from an origin
+	Decay []BoostDecay
+	// FieldValueFactors score a number or int property by its value
+	FieldValueFactors []BoostFieldValueFactor
+	// ScoreMode combines the function scores, one of multiply (default),
+	// sum, avg, max or min
+	ScoreMode string
+	// BoostMode combines the function score with the primary score, one of
+	// multiply (default), sum or replace
+	BoostMode string
+}
+
+type BoostDecay struct {
+	Property string
+	// Function is one of gauss (default), linear or exp
+	Function string
+	// Origin, Scale and Offset are parsed according to the data type of the
+	// property: RFC3339 timestamps or "now" and durations such as "7d" for
+	// dates, "lat,lon" and distances such as "10km" for geoCoordinates
+	Origin string
+	Scale  string
+	Offset string
+	// Decay is the score at a distance of Scale from the origin, defaults
+	// to 0.5 if not set
+	Decay float64
+}
+
+type BoostFieldValueFactor struct {
+	Property string
+	// Factor is multiplied with the property value, defaults to 1 if not set
+	Factor float64
+	// Modifier is applied to the multiplied value, one of none (default),
+	// log1p, ln1p, square, sqrt or reciprocal
+	Modifier string
+	// Missing is used for objects without a value, if not set these objects
+	// are not affected by the function
+	Missing *float64
+}
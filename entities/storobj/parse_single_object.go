@@ -138,7 +138,8 @@ func extractPropsBytes(data []byte) ([]byte, error) {
 
 	vecLen := binary.LittleEndian.Uint16(data[discardBytesPreVector : discardBytesPreVector+2])
 
-	classNameStart := discardBytesPreVector + 2 + vecLen*4
+	bytesPerDim := uint16(vectorPrecisionFromEncoding(data[9]).BytesPerDimension())
+	classNameStart := discardBytesPreVector + 2 + vecLen*bytesPerDim
 
 	classNameLen := binary.LittleEndian.Uint16(data[classNameStart : classNameStart+2])
 
@@ -29,9 +29,42 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorprecision"
 	"github.com/weaviate/weaviate/usecases/byte_operations"
 )
 
+// The byte following the doc id used to indicate the (long deprecated) kind
+// of the object. All objects written before half-precision vectors were
+// introduced contain 0 (action) or 1 (thing) and always hold a float32 vector.
+// Any other value indicates the 16-bit encoding of the vector.
+const (
+	vectorEncodingFloat32  uint8 = 1
+	vectorEncodingFloat16  uint8 = 2
+	vectorEncodingBFloat16 uint8 = 3
+)
+
+func vectorPrecisionFromEncoding(encoding uint8) vectorprecision.Precision {
+	switch encoding {
+	case vectorEncodingFloat16:
+		return vectorprecision.Float16
+	case vectorEncodingBFloat16:
+		return vectorprecision.BFloat16
+	default:
+		return vectorprecision.Float32
+	}
+}
+
+func vectorEncodingFromPrecision(p vectorprecision.Precision) uint8 {
+	switch p {
+	case vectorprecision.Float16:
+		return vectorEncodingFloat16
+	case vectorprecision.BFloat16:
+		return vectorEncodingBFloat16
+	default:
+		return vectorEncodingFloat32
+	}
+}
+
 type Object struct {
 	MarshallerVersion uint8
 	Object            models.Object `json:"object"`
@@ -41,12 +74,25 @@ type Object struct {
 	BelongsToShard    string        `json:"-"`
 	IsConsistent      bool          `json:"-"`
 
+	// VectorPrecision controls how the vector is encoded by MarshalBinary. It
+	// is set from the class config on writes and from the stored encoding on
+	// reads, so that re-marshalling an object keeps its encoding. New and
+	// FromObject default to float32, the zero value is treated the same way.
+	VectorPrecision vectorprecision.Precision `json:"-"`
+
+	// IfMatch makes a write of the object conditional. It is only applied if
+	// the stored version of the object has this lastUpdateTimeUnix, a value of
+	// 0 requires that the object does not exist yet. It is not part of the
+	// binary representation of the object.
+	IfMatch *int64 `json:"-"`
+
 	docID uint64
 }
 
 func New(docID uint64) *Object {
 	return &Object{
 		MarshallerVersion: 1,
+		VectorPrecision:   vectorprecision.Float32,
 		docID:             docID,
 	}
 }
@@ -68,6 +114,7 @@ func FromObject(object *models.Object, vector []float32) *Object {
 		Vector:            vector,
 		MarshallerVersion: 1,
 		VectorLen:         len(vector),
+		VectorPrecision:   vectorprecision.Float32,
 	}
 }
 
@@ -90,7 +137,7 @@ func FromBinaryUUIDOnly(data []byte) (*Object, error) {
 	}
 
 	ko.docID = byteOps.ReadUint64()
-	byteOps.MoveBufferPositionForward(1) // ignore kind-byte
+	ko.VectorPrecision = vectorPrecisionFromEncoding(byteOps.ReadUint8())
 	uuidObj, err := uuid.FromBytes(byteOps.ReadBytesFromBuffer(16))
 	if err != nil {
 		return nil, fmt.Errorf("parse uuid: %w", err)
@@ -100,7 +147,7 @@ func FromBinaryUUIDOnly(data []byte) (*Object, error) {
 	byteOps.MoveBufferPositionForward(16)
 
 	vecLen := byteOps.ReadUint16()
-	byteOps.MoveBufferPositionForward(uint64(vecLen * 4))
+	byteOps.MoveBufferPositionForward(uint64(vecLen) * uint64(ko.VectorPrecision.BytesPerDimension()))
 	classNameLen := byteOps.ReadUint16()
 
 	ko.Object.Class = string(byteOps.ReadBytesFromBuffer(uint64(classNameLen)))
@@ -151,11 +198,18 @@ func FromBinaryOptional(data []byte,
 	ec.AddWrap(binary.Read(r, le, &updateTime), "update time")
 	ec.AddWrap(binary.Read(r, le, &vectorLength), "vector length")
 	ko.VectorLen = int(vectorLength)
+	ko.VectorPrecision = vectorPrecisionFromEncoding(kindByte)
 	if addProp.Vector {
-		ko.Vector = make([]float32, vectorLength)
-		ec.AddWrap(binary.Read(r, le, &ko.Vector), "read vector")
+		if ko.VectorPrecision.IsHalf() {
+			encoded := make([]uint16, vectorLength)
+			ec.AddWrap(binary.Read(r, le, &encoded), "read vector")
+			ko.Vector = ko.VectorPrecision.DecodeInto(nil, encoded)
+		} else {
+			ko.Vector = make([]float32, vectorLength)
+			ec.AddWrap(binary.Read(r, le, &ko.Vector), "read vector")
+		}
 	} else {
-		io.CopyN(io.Discard, r, int64(vectorLength*4))
+		io.CopyN(io.Discard, r, int64(vectorLength)*int64(ko.VectorPrecision.BytesPerDimension()))
 	}
 	ec.AddWrap(binary.Read(r, le, &classNameLength), "class name length")
 	className := make([]byte, classNameLength)
@@ -442,6 +496,21 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 	return docID, err
 }
 
+// LastUpdateTimeUnixFromBinary reads only the update time from the binary
+// representation of an object, see MarshalBinary for its position
+func LastUpdateTimeUnixFromBinary(in []byte) (int64, error) {
+	const offset = 1 + 8 + 1 + 16 + 8
+	if len(in) < offset+8 {
+		return 0, errors.Errorf("binary object too short: %d bytes", len(in))
+	}
+
+	if version := in[0]; version != 1 {
+		return 0, errors.Errorf("unsupported binary marshaller version %d", version)
+	}
+
+	return int64(binary.LittleEndian.Uint64(in[offset : offset+8])), nil
+}
+
 // MarshalBinary creates the binary representation of a kind object. Regardless
 // of the marshaller version the first byte is a uint8 indicating the version
 // followed by the payload which depends on the specific version
@@ -451,12 +520,12 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 // ------------------------------------------------
 // 1          | uint8     | MarshallerVersion = 1
 // 8          | uint64    | index id, keep early so id-only lookups are maximum efficient
-// 1          | uint8     | kind, 0=action, 1=thing - deprecated
+// 1          | uint8     | vector encoding, 0,1=float32 (formerly kind), 2=float16, 3=bfloat16
 // 16         | uint128   | uuid
 // 8          | int64     | create time
 // 8          | int64     | update time
 // 2          | uint16    | VectorLength
-// n*4        | []float32 | vector of length n
+// n*4 or n*2 | []float32 | vector of length n, n*2 if encoded as float16/bfloat16
 // 2          | uint16    | length of class name
 // n          | []byte    | className
 // 4          | uint32    | length of schema json
@@ -470,9 +539,8 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		return nil, errors.Errorf("unsupported marshaller version %d", ko.MarshallerVersion)
 	}
 
-	kindByte := uint8(0)
-	// Deprecated Kind field
-	kindByte = 1
+	vectorEncoding := vectorEncodingFromPrecision(ko.VectorPrecision)
+	bytesPerDim := uint32(ko.VectorPrecision.BytesPerDimension())
 
 	idParsed, err := uuid.Parse(ko.ID().String())
 	if err != nil {
@@ -501,12 +569,12 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	}
 	vectorWeightsLength := uint32(len(vectorWeights))
 
-	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*4 + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength
+	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*bytesPerDim + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength
 	byteBuffer := make([]byte, totalBufferLength)
 	byteOps := byte_operations.ByteOperations{Buffer: byteBuffer}
 	byteOps.WriteByte(ko.MarshallerVersion)
 	byteOps.WriteUint64(ko.docID)
-	byteOps.WriteByte(kindByte)
+	byteOps.WriteByte(vectorEncoding)
 
 	byteOps.CopyBytesToBuffer(idBytes)
 
@@ -514,8 +582,14 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	byteOps.WriteUint64(uint64(ko.LastUpdateTimeUnix()))
 	byteOps.WriteUint16(uint16(vectorLength))
 
-	for j := uint32(0); j < vectorLength; j++ {
-		byteOps.WriteUint32(math.Float32bits(ko.Vector[j]))
+	if ko.VectorPrecision.IsHalf() {
+		for j := uint32(0); j < vectorLength; j++ {
+			byteOps.WriteUint16(ko.VectorPrecision.EncodeValue(ko.Vector[j]))
+		}
+	} else {
+		for j := uint32(0); j < vectorLength; j++ {
+			byteOps.WriteUint32(math.Float32bits(ko.Vector[j]))
+		}
 	}
 
 	byteOps.WriteUint16(uint16(classNameLength))
@@ -560,9 +634,11 @@ func UnmarshalPropertiesFromObject(data []byte, properties *map[string]interface
 
 	startPos := uint64(1 + 8 + 1 + 16 + 8 + 8) // elements at the start
 	byteOps := byte_operations.ByteOperations{Position: startPos, Buffer: data}
-	// get the length of the vector, each element is a float32 (4 bytes)
+	// get the length of the vector, each element is a float32 (4 bytes) or a
+	// 16-bit float (2 bytes)
+	bytesPerDim := uint64(vectorPrecisionFromEncoding(data[9]).BytesPerDimension())
 	vectorLength := uint64(byteOps.ReadUint16())
-	byteOps.MoveBufferPositionForward(vectorLength * 4)
+	byteOps.MoveBufferPositionForward(vectorLength * bytesPerDim)
 
 	classnameLength := uint64(byteOps.ReadUint16())
 	byteOps.MoveBufferPositionForward(classnameLength)
@@ -641,7 +717,7 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 
 	byteOps := byte_operations.ByteOperations{Position: 1, Buffer: data}
 	ko.docID = byteOps.ReadUint64()
-	byteOps.MoveBufferPositionForward(1) // kind-byte
+	ko.VectorPrecision = vectorPrecisionFromEncoding(byteOps.ReadUint8())
 
 	uuidParsed, err := uuid.FromBytes(data[byteOps.Position : byteOps.Position+16])
 	if err != nil {
@@ -655,8 +731,14 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 	vectorLength := byteOps.ReadUint16()
 	ko.VectorLen = int(vectorLength)
 	ko.Vector = make([]float32, vectorLength)
-	for j := 0; j < int(vectorLength); j++ {
-		ko.Vector[j] = math.Float32frombits(byteOps.ReadUint32())
+	if ko.VectorPrecision.IsHalf() {
+		for j := 0; j < int(vectorLength); j++ {
+			ko.Vector[j] = ko.VectorPrecision.DecodeValue(byteOps.ReadUint16())
+		}
+	} else {
+		for j := 0; j < int(vectorLength); j++ {
+			ko.Vector[j] = math.Float32frombits(byteOps.ReadUint32())
+		}
 	}
 
 	classNameLength := uint64(byteOps.ReadUint16())
@@ -712,7 +794,16 @@ func VectorFromBinary(in []byte) ([]float32, error) {
 
 	out := make([]float32, vecLen)
 	vecStart := 44
-	vecEnd := vecStart + int(vecLen*4)
+
+	if precision := vectorPrecisionFromEncoding(in[9]); precision.IsHalf() {
+		for i := range out {
+			start := vecStart + i*2
+			out[i] = precision.DecodeValue(binary.LittleEndian.Uint16(in[start : start+2]))
+		}
+		return out, nil
+	}
+
+	vecEnd := vecStart + int(vecLen)*4
 
 	i := 0
 	for start := vecStart; start < vecEnd; start += 4 {
@@ -819,6 +910,7 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		docID:             ko.docID,
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
+		VectorPrecision:   ko.VectorPrecision,
 	}
 }
 
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorprecision"
 )
 
 func TestStorageObjectMarshalling(t *testing.T) {
@@ -69,6 +70,12 @@ func TestStorageObjectMarshalling(t *testing.T) {
 		assert.Equal(t, uint64(7), id)
 	})
 
+	t.Run("extract only update time and compare", func(t *testing.T) {
+		updated, err := LastUpdateTimeUnixFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, int64(56789), updated)
+	})
+
 	t.Run("extract single text prop", func(t *testing.T) {
 		prop, ok, err := ParseAndExtractTextProp(asBinary, "name")
 		require.Nil(t, err)
@@ -499,3 +506,88 @@ func TestStorageObjectMarshallingWithGroup(t *testing.T) {
 		assert.Equal(t, "value2", group.Hits[1]["property1"])
 	})
 }
+
+func TestStorageObjectMarshallingHalfPrecision(t *testing.T) {
+	vector := []float32{1, 2, 0.7, -0.3333}
+
+	for _, precision := range []vectorprecision.Precision{
+		vectorprecision.Float16, vectorprecision.BFloat16,
+	} {
+		t.Run(string(precision), func(t *testing.T) {
+			before := FromObject(
+				&models.Object{
+					Class:              "MyFavoriteClass",
+					CreationTimeUnix:   123456,
+					LastUpdateTimeUnix: 56789,
+					ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+					Properties: map[string]interface{}{
+						"name": "MyName",
+					},
+				},
+				vector,
+			)
+			before.SetDocID(7)
+			before.VectorPrecision = precision
+
+			asBinary, err := before.MarshalBinary()
+			require.Nil(t, err)
+
+			full, err := before.DeepCopyDangerous().MarshalBinary()
+			require.Nil(t, err)
+			assert.Equal(t, asBinary, full)
+
+			expectedVector := precision.Round(vector)
+
+			t.Run("unmarshal full object", func(t *testing.T) {
+				after, err := FromBinary(asBinary)
+				require.Nil(t, err)
+				assert.Equal(t, precision, after.VectorPrecision)
+				assert.Equal(t, expectedVector, after.Vector)
+				assert.Equal(t, before.Object, after.Object)
+
+				// re-marshalling must keep the encoding
+				again, err := after.MarshalBinary()
+				require.Nil(t, err)
+				assert.Equal(t, asBinary, again)
+			})
+
+			t.Run("unmarshal optional", func(t *testing.T) {
+				after, err := FromBinaryOptional(asBinary, additional.Properties{Vector: true})
+				require.Nil(t, err)
+				assert.Equal(t, expectedVector, after.Vector)
+				assert.Equal(t, before.Object.Properties, after.Object.Properties)
+
+				after, err = FromBinaryOptional(asBinary, additional.Properties{})
+				require.Nil(t, err)
+				assert.Nil(t, after.Vector)
+				assert.Equal(t, before.Object.Properties, after.Object.Properties)
+			})
+
+			t.Run("unmarshal uuid only", func(t *testing.T) {
+				after, err := FromBinaryUUIDOnly(asBinary)
+				require.Nil(t, err)
+				assert.Equal(t, before.ID(), after.ID())
+				assert.Equal(t, before.Class(), after.Class())
+			})
+
+			t.Run("unmarshal vector only", func(t *testing.T) {
+				vec, err := VectorFromBinary(asBinary)
+				require.Nil(t, err)
+				assert.Equal(t, expectedVector, vec)
+			})
+
+			t.Run("unmarshal properties only", func(t *testing.T) {
+				props := map[string]interface{}{}
+				err := UnmarshalPropertiesFromObject(asBinary, &props,
+					[]string{"name"}, [][]string{{"name"}})
+				require.Nil(t, err)
+				assert.Equal(t, "MyName", props["name"])
+
+				prop, ok, err := ParseAndExtractTextProp(asBinary, "name")
+				require.Nil(t, err)
+				require.True(t, ok)
+				assert.Equal(t, []string{"MyName"}, prop)
+			})
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorprecision"
 )
 
 const (
@@ -30,6 +31,16 @@ const (
 	DistanceHamming   = "hamming"
 )
 
+const (
+	// FilterStrategySweeping is the classic HNSW traversal which visits every
+	// neighbor and simply skips results that are not on the allow list
+	FilterStrategySweeping = "sweeping"
+	// FilterStrategyAcorn only keeps nodes which pass the filter as candidates
+	// and reaches further matches through two-hop expansion of filtered-out
+	// neighbors, which keeps latency stable with restrictive filters
+	FilterStrategyAcorn = "acorn"
+)
+
 const (
 	// Set these defaults if the user leaves them blank
 	DefaultCleanupIntervalSeconds = 5 * 60
@@ -43,6 +54,8 @@ const (
 	DefaultSkip                   = false
 	DefaultFlatSearchCutoff       = 40000
 	DefaultDistanceMetric         = DistanceCosine
+	DefaultFilterStrategy         = FilterStrategySweeping
+	DefaultVectorPrecision        = string(vectorprecision.Float32)
 
 	// Fail validation if those criteria are not met
 	MinmumMaxConnections = 4
@@ -62,6 +75,8 @@ type UserConfig struct {
 	VectorCacheMaxObjects  int      `json:"vectorCacheMaxObjects"`
 	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
 	Distance               string   `json:"distance"`
+	FilterStrategy         string   `json:"filterStrategy"`
+	VectorPrecision        string   `json:"vectorPrecision"`
 	PQ                     PQConfig `json:"pq"`
 }
 
@@ -84,6 +99,8 @@ func (u *UserConfig) SetDefaults() {
 	u.Skip = DefaultSkip
 	u.FlatSearchCutoff = DefaultFlatSearchCutoff
 	u.Distance = DefaultDistanceMetric
+	u.FilterStrategy = DefaultFilterStrategy
+	u.VectorPrecision = DefaultVectorPrecision
 	u.PQ = PQConfig{
 		Enabled:        DefaultPQEnabled,
 		BitCompression: DefaultPQBitCompression,
@@ -177,6 +194,18 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := optionalStringFromMap(asMap, "filterStrategy", func(v string) {
+		uc.FilterStrategy = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := optionalStringFromMap(asMap, "vectorPrecision", func(v string) {
+		uc.VectorPrecision = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := parsePQMap(asMap, &uc.PQ); err != nil {
 		return uc, err
 	}
@@ -200,6 +229,19 @@ func (u *UserConfig) validate() error {
 		))
 	}
 
+	switch u.FilterStrategy {
+	case FilterStrategySweeping, FilterStrategyAcorn:
+	default:
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"filterStrategy must be one of %q, %q, got %q",
+			FilterStrategySweeping, FilterStrategyAcorn, u.FilterStrategy,
+		))
+	}
+
+	if _, err := vectorprecision.Parse(u.VectorPrecision); err != nil {
+		errMsgs = append(errMsgs, err.Error())
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -45,6 +45,8 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
 				Distance:               DefaultDistanceMetric,
+				FilterStrategy:         DefaultFilterStrategy,
+				VectorPrecision:        DefaultVectorPrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -74,6 +76,8 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
 				Distance:               DefaultDistanceMetric,
+				FilterStrategy:         DefaultFilterStrategy,
+				VectorPrecision:        DefaultVectorPrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -114,6 +118,8 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "l2-squared",
+				FilterStrategy:         DefaultFilterStrategy,
+				VectorPrecision:        DefaultVectorPrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -154,6 +160,8 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "manhattan",
+				FilterStrategy:         DefaultFilterStrategy,
+				VectorPrecision:        DefaultVectorPrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -194,6 +202,8 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "hamming",
+				FilterStrategy:         DefaultFilterStrategy,
+				VectorPrecision:        DefaultVectorPrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -232,6 +242,8 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               DefaultDistanceMetric,
+				FilterStrategy:         DefaultFilterStrategy,
+				VectorPrecision:        DefaultVectorPrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -279,6 +291,8 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               DefaultDistanceMetric,
+				FilterStrategy:         DefaultFilterStrategy,
+				VectorPrecision:        DefaultVectorPrecision,
 				PQ: PQConfig{
 					Enabled:   true,
 					Segments:  64,
@@ -324,6 +338,8 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               DefaultDistanceMetric,
+				FilterStrategy:         DefaultFilterStrategy,
+				VectorPrecision:        DefaultVectorPrecision,
 				PQ: PQConfig{
 					Enabled:   true,
 					Segments:  64,
@@ -389,6 +405,8 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               DefaultDistanceMetric,
+				FilterStrategy:         DefaultFilterStrategy,
+				VectorPrecision:        DefaultVectorPrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -401,6 +419,82 @@ func Test_UserConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with acorn filter strategy",
+			input: map[string]interface{}{
+				"filterStrategy": "acorn",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				FilterStrategy:         FilterStrategyAcorn,
+				VectorPrecision:        DefaultVectorPrecision,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+			},
+		},
+		{
+			name: "invalid filter strategy",
+			input: map[string]interface{}{
+				"filterStrategy": "bogus",
+			},
+			expectErr:    true,
+			expectErrMsg: "filterStrategy must be one of",
+		},
+		{
+			name: "with half precision vectors",
+			input: map[string]interface{}{
+				"vectorPrecision": "float16",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				FilterStrategy:         DefaultFilterStrategy,
+				VectorPrecision:        "float16",
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+			},
+		},
+		{
+			name: "invalid vector precision",
+			input: map[string]interface{}{
+				"vectorPrecision": "float8",
+			},
+			expectErr:    true,
+			expectErrMsg: "vector precision must be one of",
+		},
 		{
 			name: "invalid max connections (json)",
 			input: map[string]interface{}{
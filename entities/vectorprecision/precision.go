@@ -0,0 +1,206 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package vectorprecision contains the conversions between float32 vectors
+// and their 16-bit representations. Vectors are always handed to and from the
+// user as float32, the reduced precision only applies to how they are stored
+// on disk and cached in memory.
+package vectorprecision
+
+import (
+	"fmt"
+	"math"
+)
+
+type Precision string
+
+const (
+	Float32  Precision = "float32"
+	Float16  Precision = "float16"
+	BFloat16 Precision = "bfloat16"
+)
+
+// Parse validates the user input. An empty input falls back to Float32
+func Parse(in string) (Precision, error) {
+	switch p := Precision(in); p {
+	case "", Float32:
+		return Float32, nil
+	case Float16, BFloat16:
+		return p, nil
+	default:
+		return "", fmt.Errorf("vector precision must be one of %q, %q, %q, got %q",
+			Float32, Float16, BFloat16, in)
+	}
+}
+
+// IsHalf indicates whether vectors are stored using 16 bits per dimension
+func (p Precision) IsHalf() bool {
+	return p == Float16 || p == BFloat16
+}
+
+// BytesPerDimension is the size of a single vector element on disk
+func (p Precision) BytesPerDimension() int {
+	if p.IsHalf() {
+		return 2
+	}
+	return 4
+}
+
+// EncodeValue converts a single float32 into the 16-bit representation of
+// the precision. It must only be called on half precisions.
+func (p Precision) EncodeValue(f float32) uint16 {
+	if p == BFloat16 {
+		return BFloat16Bits(f)
+	}
+	return Float16Bits(f)
+}
+
+// DecodeValue converts a single 16-bit value back to a float32. It must only
+// be called on half precisions.
+func (p Precision) DecodeValue(h uint16) float32 {
+	if p == BFloat16 {
+		return BFloat16FromBits(h)
+	}
+	return Float16FromBits(h)
+}
+
+// Encode converts the entire vector into its 16-bit representation
+func (p Precision) Encode(vec []float32) []uint16 {
+	out := make([]uint16, len(vec))
+	for i, f := range vec {
+		out[i] = p.EncodeValue(f)
+	}
+	return out
+}
+
+// DecodeInto converts the 16-bit vector back to float32. The provided buffer
+// is reused if it is large enough, so decoding in a loop does not allocate.
+func (p Precision) DecodeInto(dst []float32, src []uint16) []float32 {
+	if cap(dst) < len(src) {
+		dst = make([]float32, len(src))
+	}
+	dst = dst[:len(src)]
+	for i, h := range src {
+		dst[i] = p.DecodeValue(h)
+	}
+	return dst
+}
+
+// Round returns a copy of the vector containing exactly the values that would
+// be obtained after encoding and decoding it again
+func (p Precision) Round(vec []float32) []float32 {
+	if !p.IsHalf() {
+		return vec
+	}
+
+	out := make([]float32, len(vec))
+	for i, f := range vec {
+		out[i] = p.DecodeValue(p.EncodeValue(f))
+	}
+	return out
+}
+
+// Float16Bits converts a float32 to an IEEE 754 half-precision float using
+// round-to-nearest-even. Values outside of the representable range become
+// +/- infinity.
+func Float16Bits(f float32) uint16 {
+	b := math.Float32bits(f)
+	sign := uint16(b>>16) & 0x8000
+	rawExp := (b >> 23) & 0xff
+	mant := b & 0x7fffff
+
+	if rawExp == 0xff {
+		if mant != 0 {
+			// NaN, keep it quiet
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+
+	exp := int32(rawExp) - 127 + 15
+	if exp >= 0x1f {
+		return sign | 0x7c00
+	}
+
+	if exp <= 0 {
+		// subnormal in half precision (or too small to be represented at all)
+		if exp < -10 {
+			return sign
+		}
+
+		full := mant | 0x800000
+		shift := uint32(14 - exp)
+		half := full >> shift
+		rem := full & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rem > halfway || (rem == halfway && half&1 == 1) {
+			half++
+		}
+		return sign | uint16(half)
+	}
+
+	half := uint16(exp)<<10 | uint16(mant>>13)
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		// a carry into the exponent is intended, it correctly rounds up to the
+		// next power of two (or infinity)
+		half++
+	}
+	return sign | half
+}
+
+// Float16FromBits converts an IEEE 754 half-precision float to a float32.
+// The conversion is lossless.
+func Float16FromBits(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+
+		// subnormal, normalize it for the larger exponent range of float32
+		e := uint32(127 - 15 + 1)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		mant &= 0x3ff
+		return math.Float32frombits(sign | e<<23 | mant<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
+
+// BFloat16Bits converts a float32 to a bfloat16 using round-to-nearest-even.
+// bfloat16 keeps the exponent range of float32 and only truncates the
+// mantissa.
+func BFloat16Bits(f float32) uint16 {
+	b := math.Float32bits(f)
+	if f != f {
+		// NaN, make sure truncation can't turn it into infinity
+		return uint16(b>>16) | 0x40
+	}
+
+	rounding := uint32(0x7fff) + (b>>16)&1
+	return uint16((b + rounding) >> 16)
+}
+
+// BFloat16FromBits converts a bfloat16 to a float32. The conversion is
+// lossless.
+func BFloat16FromBits(h uint16) float32 {
+	return math.Float32frombits(uint32(h) << 16)
+}
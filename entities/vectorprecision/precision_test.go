@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorprecision
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	p, err := Parse("")
+	require.Nil(t, err)
+	assert.Equal(t, Float32, p)
+
+	p, err = Parse("bfloat16")
+	require.Nil(t, err)
+	assert.Equal(t, BFloat16, p)
+	assert.True(t, p.IsHalf())
+	assert.Equal(t, 2, p.BytesPerDimension())
+
+	_, err = Parse("float8")
+	assert.NotNil(t, err)
+}
+
+func TestFloat16(t *testing.T) {
+	tests := []struct {
+		in   float32
+		bits uint16
+	}{
+		{in: 0, bits: 0x0000},
+		{in: 1, bits: 0x3c00},
+		{in: -2, bits: 0xc000},
+		{in: 0.5, bits: 0x3800},
+		{in: 65504, bits: 0x7bff},                  // max half
+		{in: 1e6, bits: 0x7c00},                    // overflows to inf
+		{in: float32(math.Inf(-1)), bits: 0xfc00},  // -inf
+		{in: 5.960464477539063e-08, bits: 0x0001},  // smallest subnormal
+		{in: 6.097555160522461e-05, bits: 0x03ff},  // largest subnormal
+		{in: 1e-10, bits: 0x0000},                  // underflows to zero
+		{in: 1.0009765625, bits: 0x3c01},           // exactly representable
+		{in: 1.00048828125, bits: 0x3c00},          // halfway, rounds to even
+		{in: 1.00146484375, bits: 0x3c02},          // halfway, rounds to even
+		{in: 0.333333333, bits: 0x3555},            // rounded
+		{in: 6.103515625e-05, bits: 0x0400},        // smallest normal
+		{in: 65519, bits: 0x7bff},                  // rounds down to max
+		{in: 65520, bits: 0x7c00},                  // rounds up to inf
+		{in: 2.9802322387695312e-08, bits: 0x0000}, // halfway to the smallest subnormal
+		{in: 4.470348358154297e-08, bits: 0x0001},  // above halfway
+		{in: 0.1, bits: 0x2e66},                    // rounded
+		{in: -123.456, bits: 0xd7b7},               // rounded
+		{in: float32(math.Inf(1)), bits: 0x7c00},   // inf
+		{in: 3.14159265, bits: 0x4248},             // pi
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.bits, Float16Bits(test.in), "encode %v", test.in)
+	}
+
+	t.Run("decoding is lossless", func(t *testing.T) {
+		for h := 0; h < 1<<16; h++ {
+			f := Float16FromBits(uint16(h))
+			if f != f {
+				// NaNs don't have a unique representation
+				continue
+			}
+			assert.Equal(t, uint16(h), Float16Bits(f))
+		}
+	})
+
+	t.Run("NaN stays NaN", func(t *testing.T) {
+		f := Float16FromBits(Float16Bits(float32(math.NaN())))
+		assert.True(t, f != f)
+	})
+}
+
+func TestBFloat16(t *testing.T) {
+	tests := []struct {
+		in   float32
+		bits uint16
+	}{
+		{in: 0, bits: 0x0000},
+		{in: 1, bits: 0x3f80},
+		{in: -2, bits: 0xc000},
+		{in: 1e30, bits: 0x714a},
+		{in: float32(math.Inf(1)), bits: 0x7f80},
+		{in: 3.14159265, bits: 0x4049},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.bits, BFloat16Bits(test.in), "encode %v", test.in)
+	}
+
+	t.Run("decoding is lossless", func(t *testing.T) {
+		for h := 0; h < 1<<16; h++ {
+			f := BFloat16FromBits(uint16(h))
+			if f != f {
+				continue
+			}
+			assert.Equal(t, uint16(h), BFloat16Bits(f))
+		}
+	})
+
+	t.Run("NaN stays NaN", func(t *testing.T) {
+		f := BFloat16FromBits(BFloat16Bits(float32(math.NaN())))
+		assert.True(t, f != f)
+	})
+}
+
+func TestEncodeDecode(t *testing.T) {
+	vec := []float32{0.1, -0.2, 0.3, 1.5}
+
+	for _, p := range []Precision{Float16, BFloat16} {
+		encoded := p.Encode(vec)
+		decoded := p.DecodeInto(nil, encoded)
+		require.Len(t, decoded, len(vec))
+		assert.Equal(t, p.Round(vec), decoded)
+		for i := range vec {
+			assert.InDelta(t, vec[i], decoded[i], 0.01)
+		}
+
+		buf := make([]float32, 0, 10)
+		reused := p.DecodeInto(buf, encoded)
+		assert.Equal(t, decoded, reused)
+	}
+
+	assert.Equal(t, vec, Float32.Round(vec))
+}
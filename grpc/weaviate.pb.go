@@ -27,6 +27,192 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type HybridSearchParams_FusionType int32
+
+const (
+	HybridSearchParams_FUSION_TYPE_UNSPECIFIED        HybridSearchParams_FusionType = 0
+	HybridSearchParams_FUSION_TYPE_RANKED             HybridSearchParams_FusionType = 1
+	HybridSearchParams_FUSION_TYPE_RELATIVE_SCORE     HybridSearchParams_FusionType = 2
+	HybridSearchParams_FUSION_TYPE_DISTRIBUTION_BASED HybridSearchParams_FusionType = 3
+)
+
+// Enum value maps for HybridSearchParams_FusionType.
+var (
+	HybridSearchParams_FusionType_name = map[int32]string{
+		0: "FUSION_TYPE_UNSPECIFIED",
+		1: "FUSION_TYPE_RANKED",
+		2: "FUSION_TYPE_RELATIVE_SCORE",
+		3: "FUSION_TYPE_DISTRIBUTION_BASED",
+	}
+	HybridSearchParams_FusionType_value = map[string]int32{
+		"FUSION_TYPE_UNSPECIFIED":        0,
+		"FUSION_TYPE_RANKED":             1,
+		"FUSION_TYPE_RELATIVE_SCORE":     2,
+		"FUSION_TYPE_DISTRIBUTION_BASED": 3,
+	}
+)
+
+func (x HybridSearchParams_FusionType) Enum() *HybridSearchParams_FusionType {
+	p := new(HybridSearchParams_FusionType)
+	*p = x
+	return p
+}
+
+func (x HybridSearchParams_FusionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HybridSearchParams_FusionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_weaviate_proto_enumTypes[0].Descriptor()
+}
+
+func (HybridSearchParams_FusionType) Type() protoreflect.EnumType {
+	return &file_weaviate_proto_enumTypes[0]
+}
+
+func (x HybridSearchParams_FusionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HybridSearchParams_FusionType.Descriptor instead.
+func (HybridSearchParams_FusionType) EnumDescriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type Filters_Operator int32
+
+const (
+	Filters_OPERATOR_UNSPECIFIED        Filters_Operator = 0
+	Filters_OPERATOR_EQUAL              Filters_Operator = 1
+	Filters_OPERATOR_NOT_EQUAL          Filters_Operator = 2
+	Filters_OPERATOR_GREATER_THAN       Filters_Operator = 3
+	Filters_OPERATOR_GREATER_THAN_EQUAL Filters_Operator = 4
+	Filters_OPERATOR_LESS_THAN          Filters_Operator = 5
+	Filters_OPERATOR_LESS_THAN_EQUAL    Filters_Operator = 6
+	Filters_OPERATOR_AND                Filters_Operator = 7
+	Filters_OPERATOR_OR                 Filters_Operator = 8
+	Filters_OPERATOR_LIKE               Filters_Operator = 9
+	Filters_OPERATOR_IS_NULL            Filters_Operator = 10
+	Filters_OPERATOR_CONTAINS_ANY       Filters_Operator = 11
+	Filters_OPERATOR_CONTAINS_ALL       Filters_Operator = 12
+)
+
+// Enum value maps for Filters_Operator.
+var (
+	Filters_Operator_name = map[int32]string{
+		0:  "OPERATOR_UNSPECIFIED",
+		1:  "OPERATOR_EQUAL",
+		2:  "OPERATOR_NOT_EQUAL",
+		3:  "OPERATOR_GREATER_THAN",
+		4:  "OPERATOR_GREATER_THAN_EQUAL",
+		5:  "OPERATOR_LESS_THAN",
+		6:  "OPERATOR_LESS_THAN_EQUAL",
+		7:  "OPERATOR_AND",
+		8:  "OPERATOR_OR",
+		9:  "OPERATOR_LIKE",
+		10: "OPERATOR_IS_NULL",
+		11: "OPERATOR_CONTAINS_ANY",
+		12: "OPERATOR_CONTAINS_ALL",
+	}
+	Filters_Operator_value = map[string]int32{
+		"OPERATOR_UNSPECIFIED":        0,
+		"OPERATOR_EQUAL":              1,
+		"OPERATOR_NOT_EQUAL":          2,
+		"OPERATOR_GREATER_THAN":       3,
+		"OPERATOR_GREATER_THAN_EQUAL": 4,
+		"OPERATOR_LESS_THAN":          5,
+		"OPERATOR_LESS_THAN_EQUAL":    6,
+		"OPERATOR_AND":                7,
+		"OPERATOR_OR":                 8,
+		"OPERATOR_LIKE":               9,
+		"OPERATOR_IS_NULL":            10,
+		"OPERATOR_CONTAINS_ANY":       11,
+		"OPERATOR_CONTAINS_ALL":       12,
+	}
+)
+
+func (x Filters_Operator) Enum() *Filters_Operator {
+	p := new(Filters_Operator)
+	*p = x
+	return p
+}
+
+func (x Filters_Operator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Filters_Operator) Descriptor() protoreflect.EnumDescriptor {
+	return file_weaviate_proto_enumTypes[1].Descriptor()
+}
+
+func (Filters_Operator) Type() protoreflect.EnumType {
+	return &file_weaviate_proto_enumTypes[1]
+}
+
+func (x Filters_Operator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Filters_Operator.Descriptor instead.
+func (Filters_Operator) EnumDescriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type ChangeEvent_Type int32
+
+const (
+	ChangeEvent_TYPE_UNSPECIFIED ChangeEvent_Type = 0
+	ChangeEvent_TYPE_CREATE      ChangeEvent_Type = 1
+	ChangeEvent_TYPE_UPDATE      ChangeEvent_Type = 2
+	ChangeEvent_TYPE_DELETE      ChangeEvent_Type = 3
+	ChangeEvent_TYPE_REFERENCE   ChangeEvent_Type = 4
+)
+
+// Enum value maps for ChangeEvent_Type.
+var (
+	ChangeEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_CREATE",
+		2: "TYPE_UPDATE",
+		3: "TYPE_DELETE",
+		4: "TYPE_REFERENCE",
+	}
+	ChangeEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_CREATE":      1,
+		"TYPE_UPDATE":      2,
+		"TYPE_DELETE":      3,
+		"TYPE_REFERENCE":   4,
+	}
+)
+
+func (x ChangeEvent_Type) Enum() *ChangeEvent_Type {
+	p := new(ChangeEvent_Type)
+	*p = x
+	return p
+}
+
+func (x ChangeEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_weaviate_proto_enumTypes[2].Descriptor()
+}
+
+func (ChangeEvent_Type) Type() protoreflect.EnumType {
+	return &file_weaviate_proto_enumTypes[2]
+}
+
+func (x ChangeEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeEvent_Type.Descriptor instead.
+func (ChangeEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{21, 0}
+}
+
 type SearchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -40,6 +226,7 @@ type SearchRequest struct {
 	Properties           *Properties           `protobuf:"bytes,6,opt,name=properties,proto3" json:"properties,omitempty"`
 	HybridSearch         *HybridSearchParams   `protobuf:"bytes,7,opt,name=hybrid_search,json=hybridSearch,proto3" json:"hybrid_search,omitempty"`
 	Bm25Search           *BM25SearchParams     `protobuf:"bytes,8,opt,name=bm25_search,json=bm25Search,proto3" json:"bm25_search,omitempty"`
+	Filters              *Filters              `protobuf:"bytes,9,opt,name=filters,proto3" json:"filters,omitempty"`
 }
 
 func (x *SearchRequest) Reset() {
@@ -130,19 +317,27 @@ func (x *SearchRequest) GetBm25Search() *BM25SearchParams {
 	return nil
 }
 
+func (x *SearchRequest) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
 type AdditionalProperties struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid               bool `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Vector             bool `protobuf:"varint,2,opt,name=vector,proto3" json:"vector,omitempty"`
-	CreationTimeUnix   bool `protobuf:"varint,3,opt,name=creationTimeUnix,proto3" json:"creationTimeUnix,omitempty"`
-	LastUpdateTimeUnix bool `protobuf:"varint,4,opt,name=lastUpdateTimeUnix,proto3" json:"lastUpdateTimeUnix,omitempty"`
-	Distance           bool `protobuf:"varint,5,opt,name=distance,proto3" json:"distance,omitempty"`
-	Certainty          bool `protobuf:"varint,6,opt,name=certainty,proto3" json:"certainty,omitempty"`
-	Score              bool `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
-	ExplainScore       bool `protobuf:"varint,8,opt,name=explainScore,proto3" json:"explainScore,omitempty"`
+	Uuid               bool             `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Vector             bool             `protobuf:"varint,2,opt,name=vector,proto3" json:"vector,omitempty"`
+	CreationTimeUnix   bool             `protobuf:"varint,3,opt,name=creationTimeUnix,proto3" json:"creationTimeUnix,omitempty"`
+	LastUpdateTimeUnix bool             `protobuf:"varint,4,opt,name=lastUpdateTimeUnix,proto3" json:"lastUpdateTimeUnix,omitempty"`
+	Distance           bool             `protobuf:"varint,5,opt,name=distance,proto3" json:"distance,omitempty"`
+	Certainty          bool             `protobuf:"varint,6,opt,name=certainty,proto3" json:"certainty,omitempty"`
+	Score              bool             `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
+	ExplainScore       bool             `protobuf:"varint,8,opt,name=explainScore,proto3" json:"explainScore,omitempty"`
+	Highlights         *HighlightParams `protobuf:"bytes,9,opt,name=highlights,proto3" json:"highlights,omitempty"`
 }
 
 func (x *AdditionalProperties) Reset() {
@@ -233,6 +428,92 @@ func (x *AdditionalProperties) GetExplainScore() bool {
 	return false
 }
 
+func (x *AdditionalProperties) GetHighlights() *HighlightParams {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type HighlightParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Properties        []string `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
+	FragmentSize      uint32   `protobuf:"varint,2,opt,name=fragment_size,json=fragmentSize,proto3" json:"fragment_size,omitempty"`
+	NumberOfFragments uint32   `protobuf:"varint,3,opt,name=number_of_fragments,json=numberOfFragments,proto3" json:"number_of_fragments,omitempty"`
+	PreTag            string   `protobuf:"bytes,4,opt,name=pre_tag,json=preTag,proto3" json:"pre_tag,omitempty"`
+	PostTag           string   `protobuf:"bytes,5,opt,name=post_tag,json=postTag,proto3" json:"post_tag,omitempty"`
+}
+
+func (x *HighlightParams) Reset() {
+	*x = HighlightParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HighlightParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighlightParams) ProtoMessage() {}
+
+func (x *HighlightParams) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighlightParams.ProtoReflect.Descriptor instead.
+func (*HighlightParams) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HighlightParams) GetProperties() []string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *HighlightParams) GetFragmentSize() uint32 {
+	if x != nil {
+		return x.FragmentSize
+	}
+	return 0
+}
+
+func (x *HighlightParams) GetNumberOfFragments() uint32 {
+	if x != nil {
+		return x.NumberOfFragments
+	}
+	return 0
+}
+
+func (x *HighlightParams) GetPreTag() string {
+	if x != nil {
+		return x.PreTag
+	}
+	return ""
+}
+
+func (x *HighlightParams) GetPostTag() string {
+	if x != nil {
+		return x.PostTag
+	}
+	return ""
+}
+
 type Properties struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -245,7 +526,7 @@ type Properties struct {
 func (x *Properties) Reset() {
 	*x = Properties{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[2]
+		mi := &file_weaviate_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -258,7 +539,7 @@ func (x *Properties) String() string {
 func (*Properties) ProtoMessage() {}
 
 func (x *Properties) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[2]
+	mi := &file_weaviate_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,7 +552,7 @@ func (x *Properties) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Properties.ProtoReflect.Descriptor instead.
 func (*Properties) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{2}
+	return file_weaviate_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Properties) GetNonRefProperties() []string {
@@ -296,14 +577,15 @@ type HybridSearchParams struct {
 	Query      string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	Properties []string `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
 	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
-	Vector []float32 `protobuf:"fixed32,3,rep,packed,name=vector,proto3" json:"vector,omitempty"`
-	Alpha  float32   `protobuf:"fixed32,4,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	Vector     []float32                     `protobuf:"fixed32,3,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	Alpha      float32                       `protobuf:"fixed32,4,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	FusionType HybridSearchParams_FusionType `protobuf:"varint,5,opt,name=fusion_type,json=fusionType,proto3,enum=weaviategrpc.HybridSearchParams_FusionType" json:"fusion_type,omitempty"`
 }
 
 func (x *HybridSearchParams) Reset() {
 	*x = HybridSearchParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[3]
+		mi := &file_weaviate_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -316,7 +598,7 @@ func (x *HybridSearchParams) String() string {
 func (*HybridSearchParams) ProtoMessage() {}
 
 func (x *HybridSearchParams) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[3]
+	mi := &file_weaviate_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +611,7 @@ func (x *HybridSearchParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HybridSearchParams.ProtoReflect.Descriptor instead.
 func (*HybridSearchParams) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{3}
+	return file_weaviate_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *HybridSearchParams) GetQuery() string {
@@ -360,6 +642,13 @@ func (x *HybridSearchParams) GetAlpha() float32 {
 	return 0
 }
 
+func (x *HybridSearchParams) GetFusionType() HybridSearchParams_FusionType {
+	if x != nil {
+		return x.FusionType
+	}
+	return HybridSearchParams_FUSION_TYPE_UNSPECIFIED
+}
+
 type BM25SearchParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -372,7 +661,7 @@ type BM25SearchParams struct {
 func (x *BM25SearchParams) Reset() {
 	*x = BM25SearchParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[4]
+		mi := &file_weaviate_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -385,7 +674,7 @@ func (x *BM25SearchParams) String() string {
 func (*BM25SearchParams) ProtoMessage() {}
 
 func (x *BM25SearchParams) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[4]
+	mi := &file_weaviate_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +687,7 @@ func (x *BM25SearchParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BM25SearchParams.ProtoReflect.Descriptor instead.
 func (*BM25SearchParams) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{4}
+	return file_weaviate_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BM25SearchParams) GetQuery() string {
@@ -415,33 +704,43 @@ func (x *BM25SearchParams) GetProperties() []string {
 	return nil
 }
 
-type RefProperties struct {
+type Filters struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LinkedClass       string      `protobuf:"bytes,1,opt,name=linked_class,json=linkedClass,proto3" json:"linked_class,omitempty"`
-	ReferenceProperty string      `protobuf:"bytes,2,opt,name=reference_property,json=referenceProperty,proto3" json:"reference_property,omitempty"`
-	LinkedProperties  *Properties `protobuf:"bytes,3,opt,name=linked_properties,json=linkedProperties,proto3" json:"linked_properties,omitempty"`
-}
-
-func (x *RefProperties) Reset() {
-	*x = RefProperties{}
+	Operator Filters_Operator `protobuf:"varint,1,opt,name=operator,proto3,enum=weaviategrpc.Filters_Operator" json:"operator,omitempty"`
+	On       []string         `protobuf:"bytes,2,rep,name=on,proto3" json:"on,omitempty"`
+	Filters  []*Filters       `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	// Types that are assignable to TestValue:
+	//	*Filters_ValueText
+	//	*Filters_ValueInt
+	//	*Filters_ValueBoolean
+	//	*Filters_ValueNumber
+	//	*Filters_ValueTextArray
+	//	*Filters_ValueIntArray
+	//	*Filters_ValueBooleanArray
+	//	*Filters_ValueNumberArray
+	TestValue isFilters_TestValue `protobuf_oneof:"test_value"`
+}
+
+func (x *Filters) Reset() {
+	*x = Filters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[5]
+		mi := &file_weaviate_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefProperties) String() string {
+func (x *Filters) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefProperties) ProtoMessage() {}
+func (*Filters) ProtoMessage() {}
 
-func (x *RefProperties) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[5]
+func (x *Filters) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,127 +751,493 @@ func (x *RefProperties) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefProperties.ProtoReflect.Descriptor instead.
-func (*RefProperties) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Filters.ProtoReflect.Descriptor instead.
+func (*Filters) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RefProperties) GetLinkedClass() string {
+func (x *Filters) GetOperator() Filters_Operator {
 	if x != nil {
-		return x.LinkedClass
+		return x.Operator
 	}
-	return ""
+	return Filters_OPERATOR_UNSPECIFIED
 }
 
-func (x *RefProperties) GetReferenceProperty() string {
+func (x *Filters) GetOn() []string {
 	if x != nil {
-		return x.ReferenceProperty
+		return x.On
 	}
-	return ""
+	return nil
 }
 
-func (x *RefProperties) GetLinkedProperties() *Properties {
+func (x *Filters) GetFilters() []*Filters {
 	if x != nil {
-		return x.LinkedProperties
+		return x.Filters
 	}
 	return nil
 }
 
-type NearVectorParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
-	Vector    []float32 `protobuf:"fixed32,1,rep,packed,name=vector,proto3" json:"vector,omitempty"`
-	Certainty *float64  `protobuf:"fixed64,2,opt,name=certainty,proto3,oneof" json:"certainty,omitempty"`
-	Distance  *float64  `protobuf:"fixed64,3,opt,name=distance,proto3,oneof" json:"distance,omitempty"`
+func (m *Filters) GetTestValue() isFilters_TestValue {
+	if m != nil {
+		return m.TestValue
+	}
+	return nil
 }
 
-func (x *NearVectorParams) Reset() {
-	*x = NearVectorParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Filters) GetValueText() string {
+	if x, ok := x.GetTestValue().(*Filters_ValueText); ok {
+		return x.ValueText
 	}
+	return ""
 }
 
-func (x *NearVectorParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Filters) GetValueInt() int64 {
+	if x, ok := x.GetTestValue().(*Filters_ValueInt); ok {
+		return x.ValueInt
+	}
+	return 0
 }
 
-func (*NearVectorParams) ProtoMessage() {}
+func (x *Filters) GetValueBoolean() bool {
+	if x, ok := x.GetTestValue().(*Filters_ValueBoolean); ok {
+		return x.ValueBoolean
+	}
+	return false
+}
 
-func (x *NearVectorParams) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Filters) GetValueNumber() float64 {
+	if x, ok := x.GetTestValue().(*Filters_ValueNumber); ok {
+		return x.ValueNumber
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use NearVectorParams.ProtoReflect.Descriptor instead.
-func (*NearVectorParams) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{6}
+func (x *Filters) GetValueTextArray() *TextArray {
+	if x, ok := x.GetTestValue().(*Filters_ValueTextArray); ok {
+		return x.ValueTextArray
+	}
+	return nil
 }
 
-func (x *NearVectorParams) GetVector() []float32 {
-	if x != nil {
-		return x.Vector
+func (x *Filters) GetValueIntArray() *IntArray {
+	if x, ok := x.GetTestValue().(*Filters_ValueIntArray); ok {
+		return x.ValueIntArray
 	}
 	return nil
 }
 
-func (x *NearVectorParams) GetCertainty() float64 {
-	if x != nil && x.Certainty != nil {
-		return *x.Certainty
+func (x *Filters) GetValueBooleanArray() *BooleanArray {
+	if x, ok := x.GetTestValue().(*Filters_ValueBooleanArray); ok {
+		return x.ValueBooleanArray
 	}
-	return 0
+	return nil
 }
 
-func (x *NearVectorParams) GetDistance() float64 {
-	if x != nil && x.Distance != nil {
-		return *x.Distance
+func (x *Filters) GetValueNumberArray() *NumberArray {
+	if x, ok := x.GetTestValue().(*Filters_ValueNumberArray); ok {
+		return x.ValueNumberArray
 	}
-	return 0
+	return nil
 }
 
-type NearObjectParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type isFilters_TestValue interface {
+	isFilters_TestValue()
+}
 
-	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Certainty *float64 `protobuf:"fixed64,2,opt,name=certainty,proto3,oneof" json:"certainty,omitempty"`
-	Distance  *float64 `protobuf:"fixed64,3,opt,name=distance,proto3,oneof" json:"distance,omitempty"`
+type Filters_ValueText struct {
+	ValueText string `protobuf:"bytes,4,opt,name=value_text,json=valueText,proto3,oneof"`
 }
 
-func (x *NearObjectParams) Reset() {
-	*x = NearObjectParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type Filters_ValueInt struct {
+	ValueInt int64 `protobuf:"varint,5,opt,name=value_int,json=valueInt,proto3,oneof"`
 }
 
-func (x *NearObjectParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type Filters_ValueBoolean struct {
+	ValueBoolean bool `protobuf:"varint,6,opt,name=value_boolean,json=valueBoolean,proto3,oneof"`
 }
 
-func (*NearObjectParams) ProtoMessage() {}
+type Filters_ValueNumber struct {
+	ValueNumber float64 `protobuf:"fixed64,7,opt,name=value_number,json=valueNumber,proto3,oneof"`
+}
 
-func (x *NearObjectParams) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+type Filters_ValueTextArray struct {
+	ValueTextArray *TextArray `protobuf:"bytes,8,opt,name=value_text_array,json=valueTextArray,proto3,oneof"`
+}
+
+type Filters_ValueIntArray struct {
+	ValueIntArray *IntArray `protobuf:"bytes,9,opt,name=value_int_array,json=valueIntArray,proto3,oneof"`
+}
+
+type Filters_ValueBooleanArray struct {
+	ValueBooleanArray *BooleanArray `protobuf:"bytes,10,opt,name=value_boolean_array,json=valueBooleanArray,proto3,oneof"`
+}
+
+type Filters_ValueNumberArray struct {
+	ValueNumberArray *NumberArray `protobuf:"bytes,11,opt,name=value_number_array,json=valueNumberArray,proto3,oneof"`
+}
+
+func (*Filters_ValueText) isFilters_TestValue() {}
+
+func (*Filters_ValueInt) isFilters_TestValue() {}
+
+func (*Filters_ValueBoolean) isFilters_TestValue() {}
+
+func (*Filters_ValueNumber) isFilters_TestValue() {}
+
+func (*Filters_ValueTextArray) isFilters_TestValue() {}
+
+func (*Filters_ValueIntArray) isFilters_TestValue() {}
+
+func (*Filters_ValueBooleanArray) isFilters_TestValue() {}
+
+func (*Filters_ValueNumberArray) isFilters_TestValue() {}
+
+type TextArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *TextArray) Reset() {
+	*x = TextArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextArray) ProtoMessage() {}
+
+func (x *TextArray) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextArray.ProtoReflect.Descriptor instead.
+func (*TextArray) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TextArray) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type IntArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []int64 `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *IntArray) Reset() {
+	*x = IntArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntArray) ProtoMessage() {}
+
+func (x *IntArray) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntArray.ProtoReflect.Descriptor instead.
+func (*IntArray) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IntArray) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type BooleanArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []bool `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *BooleanArray) Reset() {
+	*x = BooleanArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BooleanArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BooleanArray) ProtoMessage() {}
+
+func (x *BooleanArray) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BooleanArray.ProtoReflect.Descriptor instead.
+func (*BooleanArray) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BooleanArray) GetValues() []bool {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type NumberArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *NumberArray) Reset() {
+	*x = NumberArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumberArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberArray) ProtoMessage() {}
+
+func (x *NumberArray) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberArray.ProtoReflect.Descriptor instead.
+func (*NumberArray) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NumberArray) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type RefProperties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkedClass       string      `protobuf:"bytes,1,opt,name=linked_class,json=linkedClass,proto3" json:"linked_class,omitempty"`
+	ReferenceProperty string      `protobuf:"bytes,2,opt,name=reference_property,json=referenceProperty,proto3" json:"reference_property,omitempty"`
+	LinkedProperties  *Properties `protobuf:"bytes,3,opt,name=linked_properties,json=linkedProperties,proto3" json:"linked_properties,omitempty"`
+}
+
+func (x *RefProperties) Reset() {
+	*x = RefProperties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefProperties) ProtoMessage() {}
+
+func (x *RefProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefProperties.ProtoReflect.Descriptor instead.
+func (*RefProperties) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RefProperties) GetLinkedClass() string {
+	if x != nil {
+		return x.LinkedClass
+	}
+	return ""
+}
+
+func (x *RefProperties) GetReferenceProperty() string {
+	if x != nil {
+		return x.ReferenceProperty
+	}
+	return ""
+}
+
+func (x *RefProperties) GetLinkedProperties() *Properties {
+	if x != nil {
+		return x.LinkedProperties
+	}
+	return nil
+}
+
+type NearVectorParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
+	Vector    []float32 `protobuf:"fixed32,1,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	Certainty *float64  `protobuf:"fixed64,2,opt,name=certainty,proto3,oneof" json:"certainty,omitempty"`
+	Distance  *float64  `protobuf:"fixed64,3,opt,name=distance,proto3,oneof" json:"distance,omitempty"`
+}
+
+func (x *NearVectorParams) Reset() {
+	*x = NearVectorParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearVectorParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearVectorParams) ProtoMessage() {}
+
+func (x *NearVectorParams) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearVectorParams.ProtoReflect.Descriptor instead.
+func (*NearVectorParams) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NearVectorParams) GetVector() []float32 {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+func (x *NearVectorParams) GetCertainty() float64 {
+	if x != nil && x.Certainty != nil {
+		return *x.Certainty
+	}
+	return 0
+}
+
+func (x *NearVectorParams) GetDistance() float64 {
+	if x != nil && x.Distance != nil {
+		return *x.Distance
+	}
+	return 0
+}
+
+type NearObjectParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Certainty *float64 `protobuf:"fixed64,2,opt,name=certainty,proto3,oneof" json:"certainty,omitempty"`
+	Distance  *float64 `protobuf:"fixed64,3,opt,name=distance,proto3,oneof" json:"distance,omitempty"`
+}
+
+func (x *NearObjectParams) Reset() {
+	*x = NearObjectParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearObjectParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearObjectParams) ProtoMessage() {}
+
+func (x *NearObjectParams) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
 		return ms
 	}
@@ -581,7 +1246,7 @@ func (x *NearObjectParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NearObjectParams.ProtoReflect.Descriptor instead.
 func (*NearObjectParams) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{7}
+	return file_weaviate_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *NearObjectParams) GetId() string {
@@ -617,7 +1282,7 @@ type SearchReply struct {
 func (x *SearchReply) Reset() {
 	*x = SearchReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[8]
+		mi := &file_weaviate_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -630,7 +1295,7 @@ func (x *SearchReply) String() string {
 func (*SearchReply) ProtoMessage() {}
 
 func (x *SearchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[8]
+	mi := &file_weaviate_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,7 +1308,7 @@ func (x *SearchReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchReply.ProtoReflect.Descriptor instead.
 func (*SearchReply) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{8}
+	return file_weaviate_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SearchReply) GetResults() []*SearchResult {
@@ -672,7 +1337,7 @@ type SearchResult struct {
 func (x *SearchResult) Reset() {
 	*x = SearchResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[9]
+		mi := &file_weaviate_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -685,7 +1350,7 @@ func (x *SearchResult) String() string {
 func (*SearchResult) ProtoMessage() {}
 
 func (x *SearchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[9]
+	mi := &file_weaviate_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +1363,7 @@ func (x *SearchResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
 func (*SearchResult) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{9}
+	return file_weaviate_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SearchResult) GetProperties() *ResultProperties {
@@ -720,26 +1385,27 @@ type ResultAdditionalProps struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                        string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Vector                    []float32 `protobuf:"fixed32,2,rep,packed,name=vector,proto3" json:"vector,omitempty"`
-	CreationTimeUnix          int64     `protobuf:"varint,3,opt,name=creation_time_unix,json=creationTimeUnix,proto3" json:"creation_time_unix,omitempty"`
-	CreationTimeUnixPresent   bool      `protobuf:"varint,4,opt,name=creation_time_unix_present,json=creationTimeUnixPresent,proto3" json:"creation_time_unix_present,omitempty"`
-	LastUpdateTimeUnix        int64     `protobuf:"varint,5,opt,name=last_update_time_unix,json=lastUpdateTimeUnix,proto3" json:"last_update_time_unix,omitempty"`
-	LastUpdateTimeUnixPresent bool      `protobuf:"varint,6,opt,name=last_update_time_unix_present,json=lastUpdateTimeUnixPresent,proto3" json:"last_update_time_unix_present,omitempty"`
-	Distance                  float32   `protobuf:"fixed32,7,opt,name=distance,proto3" json:"distance,omitempty"`
-	DistancePresent           bool      `protobuf:"varint,8,opt,name=distance_present,json=distancePresent,proto3" json:"distance_present,omitempty"`
-	Certainty                 float32   `protobuf:"fixed32,9,opt,name=certainty,proto3" json:"certainty,omitempty"`
-	CertaintyPresent          bool      `protobuf:"varint,10,opt,name=certainty_present,json=certaintyPresent,proto3" json:"certainty_present,omitempty"`
-	Score                     float32   `protobuf:"fixed32,11,opt,name=score,proto3" json:"score,omitempty"`
-	ScorePresent              bool      `protobuf:"varint,12,opt,name=score_present,json=scorePresent,proto3" json:"score_present,omitempty"`
-	ExplainScore              string    `protobuf:"bytes,13,opt,name=explain_score,json=explainScore,proto3" json:"explain_score,omitempty"`
-	ExplainScorePresent       bool      `protobuf:"varint,14,opt,name=explain_score_present,json=explainScorePresent,proto3" json:"explain_score_present,omitempty"`
+	Id                        string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Vector                    []float32    `protobuf:"fixed32,2,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	CreationTimeUnix          int64        `protobuf:"varint,3,opt,name=creation_time_unix,json=creationTimeUnix,proto3" json:"creation_time_unix,omitempty"`
+	CreationTimeUnixPresent   bool         `protobuf:"varint,4,opt,name=creation_time_unix_present,json=creationTimeUnixPresent,proto3" json:"creation_time_unix_present,omitempty"`
+	LastUpdateTimeUnix        int64        `protobuf:"varint,5,opt,name=last_update_time_unix,json=lastUpdateTimeUnix,proto3" json:"last_update_time_unix,omitempty"`
+	LastUpdateTimeUnixPresent bool         `protobuf:"varint,6,opt,name=last_update_time_unix_present,json=lastUpdateTimeUnixPresent,proto3" json:"last_update_time_unix_present,omitempty"`
+	Distance                  float32      `protobuf:"fixed32,7,opt,name=distance,proto3" json:"distance,omitempty"`
+	DistancePresent           bool         `protobuf:"varint,8,opt,name=distance_present,json=distancePresent,proto3" json:"distance_present,omitempty"`
+	Certainty                 float32      `protobuf:"fixed32,9,opt,name=certainty,proto3" json:"certainty,omitempty"`
+	CertaintyPresent          bool         `protobuf:"varint,10,opt,name=certainty_present,json=certaintyPresent,proto3" json:"certainty_present,omitempty"`
+	Score                     float32      `protobuf:"fixed32,11,opt,name=score,proto3" json:"score,omitempty"`
+	ScorePresent              bool         `protobuf:"varint,12,opt,name=score_present,json=scorePresent,proto3" json:"score_present,omitempty"`
+	ExplainScore              string       `protobuf:"bytes,13,opt,name=explain_score,json=explainScore,proto3" json:"explain_score,omitempty"`
+	ExplainScorePresent       bool         `protobuf:"varint,14,opt,name=explain_score_present,json=explainScorePresent,proto3" json:"explain_score_present,omitempty"`
+	Highlights                []*Highlight `protobuf:"bytes,15,rep,name=highlights,proto3" json:"highlights,omitempty"`
 }
 
 func (x *ResultAdditionalProps) Reset() {
 	*x = ResultAdditionalProps{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[10]
+		mi := &file_weaviate_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -752,7 +1418,7 @@ func (x *ResultAdditionalProps) String() string {
 func (*ResultAdditionalProps) ProtoMessage() {}
 
 func (x *ResultAdditionalProps) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[10]
+	mi := &file_weaviate_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,7 +1431,7 @@ func (x *ResultAdditionalProps) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResultAdditionalProps.ProtoReflect.Descriptor instead.
 func (*ResultAdditionalProps) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{10}
+	return file_weaviate_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ResultAdditionalProps) GetId() string {
@@ -845,25 +1511,87 @@ func (x *ResultAdditionalProps) GetScore() float32 {
 	return 0
 }
 
-func (x *ResultAdditionalProps) GetScorePresent() bool {
-	if x != nil {
-		return x.ScorePresent
-	}
-	return false
+func (x *ResultAdditionalProps) GetScorePresent() bool {
+	if x != nil {
+		return x.ScorePresent
+	}
+	return false
+}
+
+func (x *ResultAdditionalProps) GetExplainScore() string {
+	if x != nil {
+		return x.ExplainScore
+	}
+	return ""
+}
+
+func (x *ResultAdditionalProps) GetExplainScorePresent() bool {
+	if x != nil {
+		return x.ExplainScorePresent
+	}
+	return false
+}
+
+func (x *ResultAdditionalProps) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type Highlight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Property  string   `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Fragments []string `protobuf:"bytes,2,rep,name=fragments,proto3" json:"fragments,omitempty"`
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ResultAdditionalProps) GetExplainScore() string {
+func (x *Highlight) GetProperty() string {
 	if x != nil {
-		return x.ExplainScore
+		return x.Property
 	}
 	return ""
 }
 
-func (x *ResultAdditionalProps) GetExplainScorePresent() bool {
+func (x *Highlight) GetFragments() []string {
 	if x != nil {
-		return x.ExplainScorePresent
+		return x.Fragments
 	}
-	return false
+	return nil
 }
 
 type ResultProperties struct {
@@ -879,7 +1607,7 @@ type ResultProperties struct {
 func (x *ResultProperties) Reset() {
 	*x = ResultProperties{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[11]
+		mi := &file_weaviate_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -892,7 +1620,7 @@ func (x *ResultProperties) String() string {
 func (*ResultProperties) ProtoMessage() {}
 
 func (x *ResultProperties) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[11]
+	mi := &file_weaviate_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -905,7 +1633,7 @@ func (x *ResultProperties) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResultProperties.ProtoReflect.Descriptor instead.
 func (*ResultProperties) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{11}
+	return file_weaviate_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ResultProperties) GetNonRefProperties() *structpb.Struct {
@@ -941,7 +1669,7 @@ type ReturnRefProperties struct {
 func (x *ReturnRefProperties) Reset() {
 	*x = ReturnRefProperties{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[12]
+		mi := &file_weaviate_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -954,7 +1682,7 @@ func (x *ReturnRefProperties) String() string {
 func (*ReturnRefProperties) ProtoMessage() {}
 
 func (x *ReturnRefProperties) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[12]
+	mi := &file_weaviate_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -967,7 +1695,7 @@ func (x *ReturnRefProperties) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReturnRefProperties.ProtoReflect.Descriptor instead.
 func (*ReturnRefProperties) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{12}
+	return file_weaviate_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ReturnRefProperties) GetProperties() []*ResultProperties {
@@ -984,13 +1712,196 @@ func (x *ReturnRefProperties) GetPropName() string {
 	return ""
 }
 
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClassName  string `protobuf:"bytes,1,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	FromOffset uint64 `protobuf:"varint,2,opt,name=from_offset,json=fromOffset,proto3" json:"from_offset,omitempty"`
+	Shard      string `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SubscribeRequest) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetFromOffset() uint64 {
+	if x != nil {
+		return x.FromOffset
+	}
+	return 0
+}
+
+func (x *SubscribeRequest) GetShard() string {
+	if x != nil {
+		return x.Shard
+	}
+	return ""
+}
+
+type ChangeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset     uint64           `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Type       ChangeEvent_Type `protobuf:"varint,2,opt,name=type,proto3,enum=weaviategrpc.ChangeEvent_Type" json:"type,omitempty"`
+	ClassName  string           `protobuf:"bytes,3,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	Shard      string           `protobuf:"bytes,4,opt,name=shard,proto3" json:"shard,omitempty"`
+	Id         string           `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp  int64            `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Properties *structpb.Struct `protobuf:"bytes,7,opt,name=properties,proto3" json:"properties,omitempty"`
+	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
+	Vector             []float32 `protobuf:"fixed32,8,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	CreationTimeUnix   int64     `protobuf:"varint,9,opt,name=creation_time_unix,json=creationTimeUnix,proto3" json:"creation_time_unix,omitempty"`
+	LastUpdateTimeUnix int64     `protobuf:"varint,10,opt,name=last_update_time_unix,json=lastUpdateTimeUnix,proto3" json:"last_update_time_unix,omitempty"`
+}
+
+func (x *ChangeEvent) Reset() {
+	*x = ChangeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEvent) ProtoMessage() {}
+
+func (x *ChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEvent.ProtoReflect.Descriptor instead.
+func (*ChangeEvent) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChangeEvent) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ChangeEvent) GetType() ChangeEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ChangeEvent_TYPE_UNSPECIFIED
+}
+
+func (x *ChangeEvent) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *ChangeEvent) GetShard() string {
+	if x != nil {
+		return x.Shard
+	}
+	return ""
+}
+
+func (x *ChangeEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ChangeEvent) GetProperties() *structpb.Struct {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetVector() []float32 {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetCreationTimeUnix() int64 {
+	if x != nil {
+		return x.CreationTimeUnix
+	}
+	return 0
+}
+
+func (x *ChangeEvent) GetLastUpdateTimeUnix() int64 {
+	if x != nil {
+		return x.LastUpdateTimeUnix
+	}
+	return 0
+}
+
 var File_weaviate_proto protoreflect.FileDescriptor
 
 var file_weaviate_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x0c, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x1c,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe1, 0x03, 0x0a,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x04, 0x0a,
 	0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
 	0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
@@ -1021,151 +1932,298 @@ var file_weaviate_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
 	0x72, 0x70, 0x63, 0x2e, 0x42, 0x4d, 0x32, 0x35, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x62, 0x6d, 0x32, 0x35, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x22, 0x92, 0x02, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69,
-	0x78, 0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69,
-	0x78, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x7e, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x70,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x10, 0x6e, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x12, 0x42, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76,
-	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x12, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x22,
-	0x48, 0x0a, 0x10, 0x42, 0x4d, 0x32, 0x35, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x0d, 0x52, 0x65,
-	0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
-	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x2d,
-	0x0a, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x45, 0x0a,
-	0x11, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
-	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x52, 0x10, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74,
-	0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69,
-	0x6e, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x22, 0x81, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x61, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e,
-	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x65, 0x72, 0x74,
-	0x61, 0x69, 0x6e, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x64, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x65,
-	0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x22, 0x57, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f,
-	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x22, 0xa8, 0x01,
-	0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e,
-	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x58,
-	0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f,
-	0x70, 0x73, 0x52, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xc5, 0x04, 0x0a, 0x15, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f,
-	0x70, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x70,
-	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x50, 0x72,
-	0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x40, 0x0a, 0x1d, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69,
-	0x78, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x19, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55,
-	0x6e, 0x69, 0x78, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x12,
-	0x2b, 0x0a, 0x11, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x65,
-	0x73, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x65, 0x72, 0x74,
-	0x61, 0x69, 0x6e, 0x74, 0x79, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73,
-	0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6c, 0x61,
-	0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15,
-	0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72,
-	0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x65, 0x78, 0x70,
-	0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
-	0x22, 0xb8, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x12, 0x6e, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66,
-	0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x10, 0x6e, 0x6f, 0x6e, 0x52,
-	0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x09,
-	0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x52, 0x08, 0x72, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x13, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
-	0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x32,
-	0x4e, 0x0a, 0x08, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42,
-	0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65,
-	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f,
-	0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x2f, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0xd1, 0x02, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e,
+	0x69, 0x78, 0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e,
+	0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0f, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x61,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e,
+	0x0a, 0x13, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x66, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x4f, 0x66, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x72, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x54, 0x61, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x22, 0x7e, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x42,
+	0x0a, 0x0e, 0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x22, 0xce, 0x02, 0x0a, 0x12, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x02, 0x52,
+	0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x4c, 0x0a,
+	0x0b, 0x66, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x2e, 0x46, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0a, 0x66, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0a,
+	0x46, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x55,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x55, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x1e, 0x0a, 0x1a, 0x46, 0x55, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
+	0x45, 0x4c, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x02, 0x12,
+	0x22, 0x0a, 0x1e, 0x46, 0x55, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44,
+	0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x53, 0x45,
+	0x44, 0x10, 0x03, 0x22, 0x48, 0x0a, 0x10, 0x42, 0x4d, 0x32, 0x35, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x87, 0x07,
+	0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x02, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x23, 0x0a,
+	0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x43, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x78, 0x74,
+	0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x65,
+	0x78, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x40, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x49, 0x6e, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x49, 0x6e, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x4c, 0x0a, 0x13, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x48, 0x00, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x65,
+	0x61, 0x6e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x49, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00,
+	0x52, 0x10, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x22, 0xc4, 0x02, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x18, 0x0a, 0x14, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a,
+	0x12, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x51,
+	0x55, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f,
+	0x52, 0x5f, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x10, 0x03,
+	0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x47, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10,
+	0x04, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4c, 0x45,
+	0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f,
+	0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x4f, 0x52, 0x5f, 0x41, 0x4e, 0x44, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4f, 0x52, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x09, 0x12, 0x14, 0x0a,
+	0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x49, 0x53, 0x5f, 0x4e, 0x55, 0x4c,
+	0x4c, 0x10, 0x0a, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f,
+	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x0b, 0x12, 0x19,
+	0x0a, 0x15, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x49, 0x4e, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x0c, 0x42, 0x0c, 0x0a, 0x0a, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x23, 0x0a, 0x09, 0x54, 0x65, 0x78, 0x74, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x22, 0x0a, 0x08,
+	0x49, 0x6e, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x22, 0x26, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x72, 0x61, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x0b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0xa8, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x11, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x10, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x10, 0x4e,
+	0x65, 0x61, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x52,
+	0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61,
+	0x69, 0x6e, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x65,
+	0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x61, 0x72, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x63,
+	0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x57, 0x0a, 0x0b, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74,
+	0x6f, 0x6f, 0x6b, 0x22, 0xa8, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xfe,
+	0x04, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x3b,
+	0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x75, 0x6e, 0x69, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x55, 0x6e, 0x69, 0x78, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x75, 0x6e, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x40,
+	0x0a, 0x1d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61,
+	0x69, 0x6e, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x63, 0x65, 0x72, 0x74,
+	0x61, 0x69, 0x6e, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e,
+	0x74, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22,
+	0x45, 0x0a, 0x09, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x61,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x12, 0x6e,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x10, 0x6e, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x08, 0x72, 0x65, 0x66, 0x50, 0x72, 0x6f,
+	0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x72, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x68, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66,
+	0x72, 0x6f, 0x6d, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x22,
+	0xd3, 0x03, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x37,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x31, 0x0a,
+	0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78,
+	0x22, 0x63, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02,
+	0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10,
+	0x03, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45,
+	0x4e, 0x43, 0x45, 0x10, 0x04, 0x32, 0x9a, 0x01, 0x0a, 0x08, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x12, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00,
+	0x30, 0x01, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1181,47 +2239,74 @@ func file_weaviate_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_weaviate_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-	file_weaviate_proto_goTypes  = []interface{}{
-		(*SearchRequest)(nil),         // 0: weaviategrpc.SearchRequest
-		(*AdditionalProperties)(nil),  // 1: weaviategrpc.AdditionalProperties
-		(*Properties)(nil),            // 2: weaviategrpc.Properties
-		(*HybridSearchParams)(nil),    // 3: weaviategrpc.HybridSearchParams
-		(*BM25SearchParams)(nil),      // 4: weaviategrpc.BM25SearchParams
-		(*RefProperties)(nil),         // 5: weaviategrpc.RefProperties
-		(*NearVectorParams)(nil),      // 6: weaviategrpc.NearVectorParams
-		(*NearObjectParams)(nil),      // 7: weaviategrpc.NearObjectParams
-		(*SearchReply)(nil),           // 8: weaviategrpc.SearchReply
-		(*SearchResult)(nil),          // 9: weaviategrpc.SearchResult
-		(*ResultAdditionalProps)(nil), // 10: weaviategrpc.ResultAdditionalProps
-		(*ResultProperties)(nil),      // 11: weaviategrpc.ResultProperties
-		(*ReturnRefProperties)(nil),   // 12: weaviategrpc.ReturnRefProperties
-		(*structpb.Struct)(nil),       // 13: google.protobuf.Struct
+	file_weaviate_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+	file_weaviate_proto_msgTypes  = make([]protoimpl.MessageInfo, 22)
+	file_weaviate_proto_goTypes   = []interface{}{
+		(HybridSearchParams_FusionType)(0), // 0: weaviategrpc.HybridSearchParams.FusionType
+		(Filters_Operator)(0),              // 1: weaviategrpc.Filters.Operator
+		(ChangeEvent_Type)(0),              // 2: weaviategrpc.ChangeEvent.Type
+		(*SearchRequest)(nil),              // 3: weaviategrpc.SearchRequest
+		(*AdditionalProperties)(nil),       // 4: weaviategrpc.AdditionalProperties
+		(*HighlightParams)(nil),            // 5: weaviategrpc.HighlightParams
+		(*Properties)(nil),                 // 6: weaviategrpc.Properties
+		(*HybridSearchParams)(nil),         // 7: weaviategrpc.HybridSearchParams
+		(*BM25SearchParams)(nil),           // 8: weaviategrpc.BM25SearchParams
+		(*Filters)(nil),                    // 9: weaviategrpc.Filters
+		(*TextArray)(nil),                  // 10: weaviategrpc.TextArray
+		(*IntArray)(nil),                   // 11: weaviategrpc.IntArray
+		(*BooleanArray)(nil),               // 12: weaviategrpc.BooleanArray
+		(*NumberArray)(nil),                // 13: weaviategrpc.NumberArray
+		(*RefProperties)(nil),              // 14: weaviategrpc.RefProperties
+		(*NearVectorParams)(nil),           // 15: weaviategrpc.NearVectorParams
+		(*NearObjectParams)(nil),           // 16: weaviategrpc.NearObjectParams
+		(*SearchReply)(nil),                // 17: weaviategrpc.SearchReply
+		(*SearchResult)(nil),               // 18: weaviategrpc.SearchResult
+		(*ResultAdditionalProps)(nil),      // 19: weaviategrpc.ResultAdditionalProps
+		(*Highlight)(nil),                  // 20: weaviategrpc.Highlight
+		(*ResultProperties)(nil),           // 21: weaviategrpc.ResultProperties
+		(*ReturnRefProperties)(nil),        // 22: weaviategrpc.ReturnRefProperties
+		(*SubscribeRequest)(nil),           // 23: weaviategrpc.SubscribeRequest
+		(*ChangeEvent)(nil),                // 24: weaviategrpc.ChangeEvent
+		(*structpb.Struct)(nil),            // 25: google.protobuf.Struct
 	}
 )
 
 var file_weaviate_proto_depIdxs = []int32{
-	1,  // 0: weaviategrpc.SearchRequest.additional_properties:type_name -> weaviategrpc.AdditionalProperties
-	6,  // 1: weaviategrpc.SearchRequest.near_vector:type_name -> weaviategrpc.NearVectorParams
-	7,  // 2: weaviategrpc.SearchRequest.near_object:type_name -> weaviategrpc.NearObjectParams
-	2,  // 3: weaviategrpc.SearchRequest.properties:type_name -> weaviategrpc.Properties
-	3,  // 4: weaviategrpc.SearchRequest.hybrid_search:type_name -> weaviategrpc.HybridSearchParams
-	4,  // 5: weaviategrpc.SearchRequest.bm25_search:type_name -> weaviategrpc.BM25SearchParams
-	5,  // 6: weaviategrpc.Properties.ref_properties:type_name -> weaviategrpc.RefProperties
-	2,  // 7: weaviategrpc.RefProperties.linked_properties:type_name -> weaviategrpc.Properties
-	9,  // 8: weaviategrpc.SearchReply.results:type_name -> weaviategrpc.SearchResult
-	11, // 9: weaviategrpc.SearchResult.properties:type_name -> weaviategrpc.ResultProperties
-	10, // 10: weaviategrpc.SearchResult.additional_properties:type_name -> weaviategrpc.ResultAdditionalProps
-	13, // 11: weaviategrpc.ResultProperties.non_ref_properties:type_name -> google.protobuf.Struct
-	12, // 12: weaviategrpc.ResultProperties.ref_props:type_name -> weaviategrpc.ReturnRefProperties
-	11, // 13: weaviategrpc.ReturnRefProperties.properties:type_name -> weaviategrpc.ResultProperties
-	0,  // 14: weaviategrpc.Weaviate.Search:input_type -> weaviategrpc.SearchRequest
-	8,  // 15: weaviategrpc.Weaviate.Search:output_type -> weaviategrpc.SearchReply
-	15, // [15:16] is the sub-list for method output_type
-	14, // [14:15] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	4,  // 0: weaviategrpc.SearchRequest.additional_properties:type_name -> weaviategrpc.AdditionalProperties
+	15, // 1: weaviategrpc.SearchRequest.near_vector:type_name -> weaviategrpc.NearVectorParams
+	16, // 2: weaviategrpc.SearchRequest.near_object:type_name -> weaviategrpc.NearObjectParams
+	6,  // 3: weaviategrpc.SearchRequest.properties:type_name -> weaviategrpc.Properties
+	7,  // 4: weaviategrpc.SearchRequest.hybrid_search:type_name -> weaviategrpc.HybridSearchParams
+	8,  // 5: weaviategrpc.SearchRequest.bm25_search:type_name -> weaviategrpc.BM25SearchParams
+	9,  // 6: weaviategrpc.SearchRequest.filters:type_name -> weaviategrpc.Filters
+	5,  // 7: weaviategrpc.AdditionalProperties.highlights:type_name -> weaviategrpc.HighlightParams
+	14, // 8: weaviategrpc.Properties.ref_properties:type_name -> weaviategrpc.RefProperties
+	0,  // 9: weaviategrpc.HybridSearchParams.fusion_type:type_name -> weaviategrpc.HybridSearchParams.FusionType
+	1,  // 10: weaviategrpc.Filters.operator:type_name -> weaviategrpc.Filters.Operator
+	9,  // 11: weaviategrpc.Filters.filters:type_name -> weaviategrpc.Filters
+	10, // 12: weaviategrpc.Filters.value_text_array:type_name -> weaviategrpc.TextArray
+	11, // 13: weaviategrpc.Filters.value_int_array:type_name -> weaviategrpc.IntArray
+	12, // 14: weaviategrpc.Filters.value_boolean_array:type_name -> weaviategrpc.BooleanArray
+	13, // 15: weaviategrpc.Filters.value_number_array:type_name -> weaviategrpc.NumberArray
+	6,  // 16: weaviategrpc.RefProperties.linked_properties:type_name -> weaviategrpc.Properties
+	18, // 17: weaviategrpc.SearchReply.results:type_name -> weaviategrpc.SearchResult
+	21, // 18: weaviategrpc.SearchResult.properties:type_name -> weaviategrpc.ResultProperties
+	19, // 19: weaviategrpc.SearchResult.additional_properties:type_name -> weaviategrpc.ResultAdditionalProps
+	20, // 20: weaviategrpc.ResultAdditionalProps.highlights:type_name -> weaviategrpc.Highlight
+	25, // 21: weaviategrpc.ResultProperties.non_ref_properties:type_name -> google.protobuf.Struct
+	22, // 22: weaviategrpc.ResultProperties.ref_props:type_name -> weaviategrpc.ReturnRefProperties
+	21, // 23: weaviategrpc.ReturnRefProperties.properties:type_name -> weaviategrpc.ResultProperties
+	2,  // 24: weaviategrpc.ChangeEvent.type:type_name -> weaviategrpc.ChangeEvent.Type
+	25, // 25: weaviategrpc.ChangeEvent.properties:type_name -> google.protobuf.Struct
+	3,  // 26: weaviategrpc.Weaviate.Search:input_type -> weaviategrpc.SearchRequest
+	23, // 27: weaviategrpc.Weaviate.Subscribe:input_type -> weaviategrpc.SubscribeRequest
+	17, // 28: weaviategrpc.Weaviate.Search:output_type -> weaviategrpc.SearchReply
+	24, // 29: weaviategrpc.Weaviate.Subscribe:output_type -> weaviategrpc.ChangeEvent
+	28, // [28:30] is the sub-list for method output_type
+	26, // [26:28] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_weaviate_proto_init() }
@@ -1255,7 +2340,7 @@ func file_weaviate_proto_init() {
 			}
 		}
 		file_weaviate_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Properties); i {
+			switch v := v.(*HighlightParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1267,7 +2352,7 @@ func file_weaviate_proto_init() {
 			}
 		}
 		file_weaviate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HybridSearchParams); i {
+			switch v := v.(*Properties); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1279,7 +2364,7 @@ func file_weaviate_proto_init() {
 			}
 		}
 		file_weaviate_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BM25SearchParams); i {
+			switch v := v.(*HybridSearchParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1291,7 +2376,7 @@ func file_weaviate_proto_init() {
 			}
 		}
 		file_weaviate_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefProperties); i {
+			switch v := v.(*BM25SearchParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1303,7 +2388,7 @@ func file_weaviate_proto_init() {
 			}
 		}
 		file_weaviate_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NearVectorParams); i {
+			switch v := v.(*Filters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1315,7 +2400,7 @@ func file_weaviate_proto_init() {
 			}
 		}
 		file_weaviate_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NearObjectParams); i {
+			switch v := v.(*TextArray); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1327,7 +2412,7 @@ func file_weaviate_proto_init() {
 			}
 		}
 		file_weaviate_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchReply); i {
+			switch v := v.(*IntArray); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1339,7 +2424,7 @@ func file_weaviate_proto_init() {
 			}
 		}
 		file_weaviate_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResult); i {
+			switch v := v.(*BooleanArray); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1351,7 +2436,7 @@ func file_weaviate_proto_init() {
 			}
 		}
 		file_weaviate_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResultAdditionalProps); i {
+			switch v := v.(*NumberArray); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1363,7 +2448,7 @@ func file_weaviate_proto_init() {
 			}
 		}
 		file_weaviate_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResultProperties); i {
+			switch v := v.(*RefProperties); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1375,6 +2460,90 @@ func file_weaviate_proto_init() {
 			}
 		}
 		file_weaviate_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NearVectorParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NearObjectParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResultAdditionalProps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Highlight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResultProperties); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReturnRefProperties); i {
 			case 0:
 				return &v.state
@@ -1386,21 +2555,56 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
+		file_weaviate_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_weaviate_proto_msgTypes[6].OneofWrappers = []interface{}{}
-	file_weaviate_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*Filters_ValueText)(nil),
+		(*Filters_ValueInt)(nil),
+		(*Filters_ValueBoolean)(nil),
+		(*Filters_ValueNumber)(nil),
+		(*Filters_ValueTextArray)(nil),
+		(*Filters_ValueIntArray)(nil),
+		(*Filters_ValueBooleanArray)(nil),
+		(*Filters_ValueNumberArray)(nil),
+	}
+	file_weaviate_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[13].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_weaviate_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      3,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_weaviate_proto_goTypes,
 		DependencyIndexes: file_weaviate_proto_depIdxs,
+		EnumInfos:         file_weaviate_proto_enumTypes,
 		MessageInfos:      file_weaviate_proto_msgTypes,
 	}.Build()
 	File_weaviate_proto = out.File
@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rank
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/modules/reranker-transformers/ent"
+)
+
+type rerankerClient interface {
+	Rank(ctx context.Context, query string, documents []string) ([]ent.RankResult, error)
+}
+
+type RankProvider struct {
+	client rerankerClient
+}
+
+func New(client rerankerClient) *RankProvider {
+	return &RankProvider{client}
+}
+
+func (p *RankProvider) AdditionalPropertyDefaultValue() interface{} {
+	return &Params{}
+}
+
+func (p *RankProvider) ExtractAdditionalFn(param []*ast.Argument) interface{} {
+	return p.parseRankArguments(param)
+}
+
+func (p *RankProvider) AdditionalFieldFn(classname string) *graphql.Field {
+	return p.additionalRankField(classname)
+}
+
+func (p *RankProvider) AdditionalPropertyFn(ctx context.Context,
+	in []search.Result, params interface{}, limit *int,
+	argumentModuleParams map[string]interface{}, cfg moduletools.ClassConfig,
+) ([]search.Result, error) {
+	if parameters, ok := params.(*Params); ok {
+		return p.rerank(ctx, in, parameters)
+	}
+	return nil, errors.New("wrong parameters")
+}
@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rank
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+)
+
+func (p *RankProvider) additionalRankField(classname string) *graphql.Field {
+	return &graphql.Field{
+		Args: graphql.FieldConfigArgument{
+			"property": &graphql.ArgumentConfig{
+				Description:  "Property which contains the text to rerank by",
+				Type:         graphql.String,
+				DefaultValue: nil,
+			},
+			"query": &graphql.ArgumentConfig{
+				Description:  "Query to score the text of the property against",
+				Type:         graphql.String,
+				DefaultValue: nil,
+			},
+		},
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalRerank", classname),
+			Fields: graphql.Fields{
+				"document": &graphql.Field{Type: graphql.String},
+				"score":    &graphql.Field{Type: graphql.Float},
+			},
+		})),
+	}
+}
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tailor-inc/graphql"
+)
+
+func Test_additionalRankField(t *testing.T) {
+	// given
+	rankProvider := &RankProvider{}
+	classname := "Class"
+
+	// when
+	rank := rankProvider.additionalRankField(classname)
+
+	assert.NotNil(t, rank)
+	assert.Equal(t, "ClassAdditionalRerank", rank.Type.Name())
+	assert.NotNil(t, rank.Type)
+	rankObjectList, rankObjectListOK := rank.Type.(*graphql.List)
+	assert.True(t, rankObjectListOK)
+	rankObject, rankObjectOK := rankObjectList.OfType.(*graphql.Object)
+	assert.True(t, rankObjectOK)
+	assert.Equal(t, 2, len(rankObject.Fields()))
+	assert.NotNil(t, rankObject.Fields()["document"])
+	assert.NotNil(t, rankObject.Fields()["score"])
+
+	assert.NotNil(t, rank.Args)
+	assert.Equal(t, 2, len(rank.Args))
+	assert.NotNil(t, rank.Args["property"])
+	assert.NotNil(t, rank.Args["query"])
+}
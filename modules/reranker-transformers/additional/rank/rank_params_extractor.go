@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rank
+
+import (
+	"log"
+
+	"github.com/tailor-inc/graphql/language/ast"
+)
+
+func (p *RankProvider) parseRankArguments(args []*ast.Argument) *Params {
+	out := &Params{}
+
+	for _, arg := range args {
+		switch arg.Name.Value {
+		case "property":
+			out.Property = arg.Value.(*ast.StringValue).Value
+		case "query":
+			out.Query = arg.Value.(*ast.StringValue).Value
+		default:
+			// ignore what we don't recognize
+			log.Printf("Igonore not recognized value: %v", arg.Name.Value)
+		}
+	}
+
+	return out
+}
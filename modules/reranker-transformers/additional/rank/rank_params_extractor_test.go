@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tailor-inc/graphql/language/ast"
+)
+
+func Test_parseRankArguments(t *testing.T) {
+	type args struct {
+		args []*ast.Argument
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Params
+	}{
+		{
+			name: "Should create with no params",
+			args: args{},
+			want: &Params{},
+		},
+		{
+			name: "Should create with all params",
+			args: args{
+				args: []*ast.Argument{
+					createStringArg("property", "content"),
+					createStringArg("query", "Which fruit is red?"),
+				},
+			},
+			want: &Params{
+				Property: "content",
+				Query:    "Which fruit is red?",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &RankProvider{}
+			actual := p.parseRankArguments(tt.args.args)
+			assert.Equal(t, tt.want, actual)
+		})
+	}
+}
+
+func createStringArg(name, value string) *ast.Argument {
+	n := ast.Name{
+		Value: name,
+	}
+	val := ast.StringValue{
+		Kind:  "Kind",
+		Value: value,
+	}
+	arg := ast.Argument{
+		Name:  ast.NewName(&n),
+		Kind:  "Kind",
+		Value: &val,
+	}
+	a := ast.NewArgument(&arg)
+	return a
+}
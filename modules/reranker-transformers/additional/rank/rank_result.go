@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rank
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/modules/reranker-transformers/ent"
+)
+
+// rerank scores the given property of each result against the query and
+// orders the results by that score, best first.
+func (p *RankProvider) rerank(ctx context.Context,
+	in []search.Result, params *Params,
+) ([]search.Result, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if params == nil {
+		return nil, fmt.Errorf("no params provided")
+	}
+
+	property, query := params.GetProperty(), params.GetQuery()
+
+	// check if user parameter values are valid
+	if property == "" {
+		return in, errors.New("no property provided")
+	}
+	if query == "" {
+		return in, errors.New("no query provided")
+	}
+
+	documents := make([]string, len(in))
+	for i := range in {
+		if schema, ok := in[i].Object().Properties.(map[string]interface{}); ok {
+			documents[i] = documentText(schema[property])
+		}
+	}
+
+	scores, err := p.client.Rank(ctx, query, documents)
+	if err != nil {
+		return in, err
+	}
+
+	for i := range in {
+		ap := in[i].AdditionalProperties
+		if ap == nil {
+			ap = models.AdditionalProperties{}
+		}
+		ap["rerank"] = []ent.RankResult{scores[i]}
+		in[i].AdditionalProperties = ap
+	}
+
+	sort.SliceStable(in, func(a, b int) bool {
+		return rankScore(in[a]) > rankScore(in[b])
+	})
+
+	return in, nil
+}
+
+func documentText(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []string:
+		return strings.Join(v, " ")
+	case []interface{}:
+		texts := make([]string, 0, len(v))
+		for _, elem := range v {
+			if text, ok := elem.(string); ok {
+				texts = append(texts, text)
+			}
+		}
+		return strings.Join(texts, " ")
+	default:
+		return ""
+	}
+}
+
+func rankScore(res search.Result) float64 {
+	return res.AdditionalProperties["rerank"].([]ent.RankResult)[0].Score
+}
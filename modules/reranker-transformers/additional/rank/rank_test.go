@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rank
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/modules/reranker-transformers/ent"
+)
+
+func TestAdditionalRankProvider(t *testing.T) {
+	t.Run("should fail with empty params", func(t *testing.T) {
+		// given
+		rankProvider := New(&fakeRerankerClient{})
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "content",
+				},
+			},
+		}
+		limit := 1
+
+		// when
+		out, err := rankProvider.AdditionalPropertyFn(context.Background(), in, &Params{}, &limit, nil, nil)
+
+		// then
+		require.NotNil(t, err)
+		require.NotEmpty(t, out)
+		assert.EqualError(t, err, "no property provided")
+	})
+
+	t.Run("should fail without query", func(t *testing.T) {
+		// given
+		rankProvider := New(&fakeRerankerClient{})
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "content",
+				},
+			},
+		}
+		limit := 1
+
+		// when
+		out, err := rankProvider.AdditionalPropertyFn(context.Background(), in,
+			&Params{Property: "content"}, &limit, nil, nil)
+
+		// then
+		require.NotNil(t, err)
+		require.NotEmpty(t, out)
+		assert.EqualError(t, err, "no query provided")
+	})
+
+	t.Run("should rerank by score", func(t *testing.T) {
+		// given
+		rankProvider := New(&fakeRerankerClient{})
+		in := []search.Result{
+			{
+				ID:     "first",
+				Schema: map[string]interface{}{"content": "I work at Apple"},
+			},
+			{
+				ID:     "second",
+				Schema: map[string]interface{}{"content": "red apples are the best apples"},
+			},
+			{
+				ID:     "third",
+				Schema: map[string]interface{}{"other": "apples"},
+			},
+			{
+				ID:     "fourth",
+				Schema: map[string]interface{}{"content": []string{"apples", "are", "red"}},
+			},
+		}
+		limit := 4
+
+		// when
+		out, err := rankProvider.AdditionalPropertyFn(context.Background(), in,
+			&Params{Property: "content", Query: "red apples"}, &limit, nil, nil)
+
+		// then
+		require.Nil(t, err)
+		require.Len(t, out, 4)
+		ids := make([]strfmt.UUID, len(out))
+		for i := range out {
+			ids[i] = out[i].ID
+		}
+		assert.Equal(t, []strfmt.UUID{"second", "fourth", "first", "third"}, ids)
+
+		rank, ok := out[0].AdditionalProperties["rerank"].([]ent.RankResult)
+		require.True(t, ok)
+		assert.Equal(t, []ent.RankResult{{Document: "red apples are the best apples", Score: 3}}, rank)
+	})
+}
+
+// fakeRerankerClient scores a document by how often it contains the words
+// of the query
+type fakeRerankerClient struct{}
+
+func (c *fakeRerankerClient) Rank(ctx context.Context, query string, documents []string,
+) ([]ent.RankResult, error) {
+	out := make([]ent.RankResult, len(documents))
+	for i, document := range documents {
+		out[i].Document = document
+		for _, word := range strings.Fields(query) {
+			out[i].Score += float64(strings.Count(document, word))
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMeta(t *testing.T) {
+	t.Run("when the server is providing meta", func(t *testing.T) {
+		server := httptest.NewServer(&testMetaHandler{t: t})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		meta, err := c.MetaInfo()
+
+		assert.Nil(t, err)
+		assert.NotNil(t, meta)
+		metaModel := meta["model"]
+		assert.True(t, metaModel != nil)
+		model, modelOK := metaModel.(map[string]interface{})
+		assert.True(t, modelOK)
+		assert.True(t, model["_name_or_path"] != nil)
+		assert.True(t, model["architectures"] != nil)
+	})
+}
+
+type testMetaHandler struct {
+	t *testing.T
+	// the test handler will report as not ready before the time has passed
+	readyTime time.Time
+}
+
+func (f *testMetaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/meta", r.URL.String())
+	assert.Equal(f.t, http.MethodGet, r.Method)
+
+	if time.Since(f.readyTime) < 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	w.Write([]byte(f.metaInfo()))
+}
+
+func (f *testMetaHandler) metaInfo() string {
+	return `{
+		"model": {
+		"_name_or_path": "cross-encoder/ms-marco-MiniLM-L-6-v2",
+		"architectures": [
+		"BertForSequenceClassification"
+		],
+		"hidden_size": 384,
+		"id2label": {
+		"0": "LABEL_0"
+		},
+		"max_position_embeddings": 512,
+		"model_type": "bert",
+		"num_attention_heads": 12,
+		"num_hidden_layers": 6,
+		"transformers_version": "4.29.2",
+		"vocab_size": 30522
+		}
+		}`
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/reranker-transformers/ent"
+)
+
+func TestRank(t *testing.T) {
+	t.Run("when the server has a successful answer", func(t *testing.T) {
+		server := httptest.NewServer(&testRankHandler{
+			t: t,
+			res: rankResponse{
+				Scores: []documentScore{
+					{Document: "I work at Apple", Score: 0.15},
+					{Document: "Apples are fruit", Score: 0.85},
+				},
+			},
+		})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		res, err := c.Rank(context.Background(), "Which fruit is red?",
+			[]string{"I work at Apple", "Apples are fruit"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []ent.RankResult{
+			{Document: "I work at Apple", Score: 0.15},
+			{Document: "Apples are fruit", Score: 0.85},
+		}, res)
+	})
+
+	t.Run("when the server has a an error", func(t *testing.T) {
+		server := httptest.NewServer(&testRankHandler{
+			t: t,
+			res: rankResponse{
+				Error: "some error from the server",
+			},
+		})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		_, err := c.Rank(context.Background(), "Which fruit is red?",
+			[]string{"I work at Apple"})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "some error from the server")
+	})
+
+	t.Run("when the server returns too few scores", func(t *testing.T) {
+		server := httptest.NewServer(&testRankHandler{
+			t: t,
+			res: rankResponse{
+				Scores: []documentScore{{Document: "I work at Apple", Score: 0.15}},
+			},
+		})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		_, err := c.Rank(context.Background(), "Which fruit is red?",
+			[]string{"I work at Apple", "Apples are fruit"})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "expected 2 scores, got 1")
+	})
+}
+
+type testRankHandler struct {
+	t   *testing.T
+	res rankResponse
+}
+
+func (f *testRankHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/rerank", r.URL.String())
+	assert.Equal(f.t, http.MethodPost, r.Method)
+
+	var input rankInput
+	require.Nil(f.t, json.NewDecoder(r.Body).Decode(&input))
+	assert.Equal(f.t, "Which fruit is red?", input.Query)
+
+	if f.res.Error != "" {
+		w.WriteHeader(500)
+	}
+
+	jsonBytes, _ := json.Marshal(f.res)
+	w.Write(jsonBytes)
+}
@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modrerankertransformers
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	rerankeradditional "github.com/weaviate/weaviate/modules/reranker-transformers/additional"
+	rerankeradditionalrank "github.com/weaviate/weaviate/modules/reranker-transformers/additional/rank"
+	"github.com/weaviate/weaviate/modules/reranker-transformers/client"
+	"github.com/weaviate/weaviate/modules/reranker-transformers/ent"
+)
+
+func New() *RerankerModule {
+	return &RerankerModule{}
+}
+
+type RerankerModule struct {
+	reranker                     rerankerClient
+	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+}
+
+type rerankerClient interface {
+	Rank(ctx context.Context, query string, documents []string) ([]ent.RankResult, error)
+	MetaInfo() (map[string]interface{}, error)
+}
+
+func (m *RerankerModule) Name() string {
+	return "reranker-transformers"
+}
+
+func (m *RerankerModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Reranker
+}
+
+func (m *RerankerModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	if err := m.initAdditional(ctx, params.GetLogger()); err != nil {
+		return errors.Wrap(err, "init additional")
+	}
+	return nil
+}
+
+func (m *RerankerModule) initAdditional(ctx context.Context,
+	logger logrus.FieldLogger,
+) error {
+	uri := os.Getenv("RERANKER_INFERENCE_API")
+	if uri == "" {
+		return errors.Errorf("required variable RERANKER_INFERENCE_API is not set")
+	}
+
+	client := client.New(uri, logger)
+	if err := client.WaitForStartup(ctx, 1*time.Second); err != nil {
+		return errors.Wrap(err, "init remote reranker module")
+	}
+
+	m.reranker = client
+
+	rankProvider := rerankeradditionalrank.New(m.reranker)
+	m.additionalPropertiesProvider = rerankeradditional.New(rankProvider)
+
+	return nil
+}
+
+func (m *RerankerModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *RerankerModule) MetaInfo() (map[string]interface{}, error) {
+	return m.reranker.MetaInfo()
+}
+
+func (m *RerankerModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
+	return m.additionalPropertiesProvider.AdditionalProperties()
+}
+
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.AdditionalProperties(New())
+	_ = modulecapabilities.MetaProvider(New())
+)
@@ -49,6 +49,8 @@ func testGetSchemaWithoutClient(t *testing.T) {
 					"dynamicEfMax":           float64(500),
 					"dynamicEfFactor":        float64(8),
 					"distance":               "cosine",
+					"filterStrategy":         "sweeping",
+					"vectorPrecision":        "float32",
 					"pq": map[string]interface{}{
 						"bitCompression": false,
 						"centroids":      float64(256),
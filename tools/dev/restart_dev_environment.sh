@@ -27,6 +27,9 @@ fi
 if [[ "$*" == *--sum* ]]; then
   ADDITIONAL_SERVICES+=('sum-transformers')
 fi
+if [[ "$*" == *--reranker* ]]; then
+  ADDITIONAL_SERVICES+=('reranker-transformers')
+fi
 if [[ "$*" == *--image* ]]; then
   ADDITIONAL_SERVICES+=('i2v-neural')
 fi
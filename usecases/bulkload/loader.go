@@ -0,0 +1,219 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package bulkload loads objects from files directly into the shards of a
+// data directory, without a running node. See cmd/weaviate-bulk-loader.
+package bulkload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/objectfile"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+)
+
+// DefaultBatchSize is the number of objects written to the shards at once
+const DefaultBatchSize = 1000
+
+// Repo writes objects directly into the local shards of a class
+type Repo interface {
+	BulkLoad(ctx context.Context, className string, objs []*storobj.Object) (int, error)
+	// FinishBulkLoad builds the vector index of the local shards of a class
+	// once all objects are written
+	FinishBulkLoad(ctx context.Context, className string) error
+}
+
+// Stats summarizes a load
+type Stats struct {
+	// Loaded is the number of objects written to local shards
+	Loaded int
+	// Skipped is the number of objects which belong to shards of other nodes
+	Skipped int
+}
+
+type Loader struct {
+	repo      Repo
+	schema    *models.Schema
+	validator *validation.Validator
+	batchSize int
+	timestamp int64
+	logger    logrus.FieldLogger
+}
+
+// NewLoader creates a loader which writes batches of batchSize objects.
+// Objects without a creation time are loaded with the given timestamp (in ms
+// since epoch), or rejected if it is 0.
+func NewLoader(repo Repo, schema *models.Schema, batchSize int, timestamp int64,
+	logger logrus.FieldLogger,
+) *Loader {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	// referenced objects can not be checked for existence, as they may be
+	// part of a later batch or of a shard of another node
+	exists := func(context.Context, string, strfmt.UUID,
+		*additional.ReplicationProperties,
+	) (bool, error) {
+		return true, nil
+	}
+
+	return &Loader{
+		repo:      repo,
+		schema:    schema,
+		validator: validation.New(exists, &config.WeaviateConfig{}, nil),
+		batchSize: batchSize,
+		timestamp: timestamp,
+		logger:    logger,
+	}
+}
+
+// Load reads all objects from r and writes them into the shards of the
+// class. Objects which do not specify a class are assumed to be of this
+// class, objects of other classes and objects without an id are rejected.
+// Vectors are written as they are, vectorizer modules are not run.
+func (l *Loader) Load(ctx context.Context, className string,
+	r objectfile.Reader,
+) (Stats, error) {
+	var stats Stats
+
+	class := l.class(className)
+	if class == nil {
+		return stats, fmt.Errorf("class %q not found in schema", className)
+	}
+
+	batch := make([]*storobj.Object, 0, l.batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		skipped, err := l.repo.BulkLoad(ctx, className, batch)
+		if err != nil {
+			return err
+		}
+		stats.Skipped += skipped
+		stats.Loaded += len(batch) - skipped
+		batch = batch[:0]
+
+		l.logger.WithField("action", "bulk_load").
+			WithField("class", className).
+			WithField("loaded", stats.Loaded).
+			WithField("skipped", stats.Skipped).
+			Debug("wrote batch of objects")
+		return nil
+	}
+
+	for n := 0; ; n++ {
+		obj, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stats, err
+		}
+
+		stobj, err := l.prepareObject(ctx, class, obj)
+		if err != nil {
+			return stats, errors.Wrapf(err, "object %d (%s)", n, obj.ID)
+		}
+
+		batch = append(batch, stobj)
+		if len(batch) == l.batchSize {
+			if err := flush(); err != nil {
+				return stats, err
+			}
+		}
+	}
+
+	return stats, flush()
+}
+
+// Finish builds the vector index of the class from all loaded objects. It is
+// called once after all files of the class are loaded.
+func (l *Loader) Finish(ctx context.Context, className string) error {
+	if l.class(className) == nil {
+		return fmt.Errorf("class %q not found in schema", className)
+	}
+
+	before := time.Now()
+	if err := l.repo.FinishBulkLoad(ctx, className); err != nil {
+		return errors.Wrap(err, "build vector index")
+	}
+
+	l.logger.WithField("action", "bulk_load").
+		WithField("class", className).
+		WithField("took", time.Since(before)).
+		Info("built vector index")
+	return nil
+}
+
+func (l *Loader) class(name string) *models.Class {
+	for _, class := range l.schema.Classes {
+		if class.Class == name {
+			return class
+		}
+	}
+	return nil
+}
+
+// prepareObject validates the object and fills in the fields which would
+// otherwise be set by the import endpoints
+func (l *Loader) prepareObject(ctx context.Context, class *models.Class,
+	obj *models.Object,
+) (*storobj.Object, error) {
+	if obj.Class == "" {
+		obj.Class = class.Class
+	}
+	if obj.Class != class.Class {
+		return nil, fmt.Errorf("object is of class %q, expected %q",
+			obj.Class, class.Class)
+	}
+
+	// the loader runs once per node over the same files, an id generated
+	// here would differ between the runs and with it the target shard
+	if obj.ID == "" {
+		return nil, fmt.Errorf("object has no id, ids are required for a bulk load")
+	}
+	if _, err := uuid.Parse(obj.ID.String()); err != nil {
+		return nil, fmt.Errorf("invalid id: %w", err)
+	}
+
+	// for the same reason, the timestamps must not depend on the time of the
+	// run, otherwise the replicas of an object would differ
+	if obj.CreationTimeUnix == 0 {
+		if l.timestamp == 0 {
+			return nil, fmt.Errorf("object has no creationTimeUnix and no " +
+				"default timestamp is set")
+		}
+		obj.CreationTimeUnix = l.timestamp
+	}
+	if obj.LastUpdateTimeUnix == 0 {
+		obj.LastUpdateTimeUnix = obj.CreationTimeUnix
+	}
+
+	if err := l.validator.Object(ctx, obj, class); err != nil {
+		return nil, err
+	}
+
+	return storobj.FromObject(obj, obj.Vector), nil
+}
@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bulkload
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/objectfile"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestLoader(t *testing.T) {
+	sch := &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Article",
+				Properties: []*models.Property{
+					{Name: "title", DataType: []string{"text"}},
+					{Name: "words", DataType: []string{"int"}},
+					{Name: "ofAuthor", DataType: []string{"Author"}},
+				},
+			},
+		},
+	}
+
+	in := `{"id":"8d6d6a4c-e69b-4e5c-a0c0-d0ab1f3d5c1e","properties":{"title":"first","words":12,"ofAuthor":[{"beacon":"weaviate://localhost/Author/3b1b3a1e-8b4a-4f63-8a36-1c1b2a3d4e5f"}]},"vector":[0.1,0.2]}
+{"class":"Article","id":"0b7d2c1e-3f4a-4b5c-8d6e-7f8091a2b3c4","properties":{"title":"second"},"vector":[0.3,0.4],"creationTimeUnix":1000}
+{"class":"Article","id":"5c4c3a1e-8b4a-4f63-8a36-1c1b2a3d4e5f","properties":{"title":"third"}}
+`
+
+	t.Run("load objects in batches", func(t *testing.T) {
+		repo := &fakeRepo{skip: 1}
+		logger, _ := test.NewNullLogger()
+		l := NewLoader(repo, sch, 2, 2000, logger)
+
+		stats, err := l.Load(context.Background(), "Article",
+			objectfile.NewJSONLReader(strings.NewReader(in)))
+		require.Nil(t, err)
+		assert.Equal(t, Stats{Loaded: 1, Skipped: 2}, stats)
+
+		require.Len(t, repo.batches, 2)
+		assert.Len(t, repo.batches[0], 2)
+		assert.Len(t, repo.batches[1], 1)
+
+		first := repo.batches[0][0]
+		assert.Equal(t, "Article", first.Class().String())
+		assert.Equal(t, []float32{0.1, 0.2}, first.Vector)
+		props := first.Properties().(map[string]interface{})
+		assert.Equal(t, int64(12), props["words"])
+		assert.IsType(t, models.MultipleRef{}, props["ofAuthor"])
+		assert.Equal(t, int64(2000), first.CreationTimeUnix())
+		assert.Equal(t, int64(2000), first.LastUpdateTimeUnix())
+
+		second := repo.batches[0][1]
+		assert.Equal(t, "0b7d2c1e-3f4a-4b5c-8d6e-7f8091a2b3c4", second.ID().String())
+		assert.Equal(t, int64(1000), second.CreationTimeUnix())
+		assert.Equal(t, int64(1000), second.LastUpdateTimeUnix())
+
+		require.Nil(t, l.Finish(context.Background(), "Article"))
+		assert.Equal(t, []string{"Article"}, repo.finished)
+	})
+
+	t.Run("objects of another class are rejected", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		l := NewLoader(&fakeRepo{}, sch, 2, 2000, logger)
+
+		_, err := l.Load(context.Background(), "Article", objectfile.NewJSONLReader(
+			strings.NewReader(`{"class":"Author","properties":{}}`)))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `object is of class "Author"`)
+	})
+
+	t.Run("objects without an id are rejected", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		repo := &fakeRepo{}
+		l := NewLoader(repo, sch, 2, 2000, logger)
+
+		_, err := l.Load(context.Background(), "Article", objectfile.NewJSONLReader(
+			strings.NewReader(`{"properties":{"title":"no id"}}`)))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "object has no id")
+		assert.Empty(t, repo.batches)
+	})
+
+	t.Run("objects without a creation time are rejected without a timestamp", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		repo := &fakeRepo{}
+		l := NewLoader(repo, sch, 2, 0, logger)
+
+		_, err := l.Load(context.Background(), "Article", objectfile.NewJSONLReader(
+			strings.NewReader(`{"id":"8d6d6a4c-e69b-4e5c-a0c0-d0ab1f3d5c1e","properties":{"title":"no time"}}`)))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "object has no creationTimeUnix")
+		assert.Empty(t, repo.batches)
+	})
+
+	t.Run("invalid properties are rejected", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		l := NewLoader(&fakeRepo{}, sch, 2, 2000, logger)
+
+		_, err := l.Load(context.Background(), "Article", objectfile.NewJSONLReader(
+			strings.NewReader(`{"id":"8d6d6a4c-e69b-4e5c-a0c0-d0ab1f3d5c1e","properties":{"words":"many"}}`)))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		l := NewLoader(&fakeRepo{}, sch, 2, 2000, logger)
+
+		_, err := l.Load(context.Background(), "Author",
+			objectfile.NewJSONLReader(strings.NewReader("")))
+		assert.NotNil(t, err)
+	})
+}
+
+func TestReadState(t *testing.T) {
+	in := `{
+		"object": {"classes": [{"class": "Article", "vectorIndexType": "hnsw", "vectorIndexConfig": {"distance": "dot"}}]},
+		"ShardingState": {"Article": {"indexID": "article", "physical": {"abc": {"name": "abc", "belongsToNodes": ["node1"]}}}}
+	}`
+
+	state, err := ReadState(strings.NewReader(in), "node1")
+	require.Nil(t, err)
+
+	class := state.ObjectSchema.Classes[0]
+	assert.Equal(t, int64(1), class.ReplicationConfig.Factor)
+	assert.Equal(t, "dot", class.VectorIndexConfig.(hnsw.UserConfig).Distance)
+	assert.True(t, state.ShardingState["Article"].IsShardLocal("abc"))
+
+	_, err = ReadState(strings.NewReader(`{"object": {"classes": [{"class": "Article", "vectorIndexType": "hnsw"}]}}`), "node1")
+	assert.NotNil(t, err, "sharding state is missing")
+}
+
+type fakeRepo struct {
+	// skip is the number of objects of every batch reported as skipped
+	skip     int
+	batches  [][]*storobj.Object
+	finished []string
+}
+
+func (f *fakeRepo) BulkLoad(ctx context.Context, className string,
+	objs []*storobj.Object,
+) (int, error) {
+	f.batches = append(f.batches, append([]*storobj.Object{}, objs...))
+	if f.skip > len(objs) {
+		return len(objs), nil
+	}
+	return f.skip, nil
+}
+
+func (f *fakeRepo) FinishBulkLoad(ctx context.Context, className string) error {
+	f.finished = append(f.finished, className)
+	return nil
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bulkload
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/replica"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// ReadState reads the schema and sharding state of a cluster, as persisted
+// by the schema manager, and prepares it to be used by the given node
+func ReadState(r io.Reader, nodeName string) (*schemaUC.State, error) {
+	var state schemaUC.State
+	if err := json.NewDecoder(r).Decode(&state); err != nil {
+		return nil, errors.Wrap(err, "decode schema state")
+	}
+	if state.ObjectSchema == nil {
+		return nil, fmt.Errorf("schema state contains no schema")
+	}
+
+	for _, class := range state.ObjectSchema.Classes {
+		if class.VectorIndexType != "hnsw" {
+			return nil, fmt.Errorf("class %s: unsupported vector index type: %q",
+				class.Class, class.VectorIndexType)
+		}
+		parsed, err := hnsw.ParseAndValidateConfig(class.VectorIndexConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "class %s: vector index config", class.Class)
+		}
+		class.VectorIndexConfig = parsed
+
+		if err := replica.ValidateConfig(class); err != nil {
+			return nil, errors.Wrapf(err, "class %s: replication config", class.Class)
+		}
+
+		shardState, ok := state.ShardingState[class.Class]
+		if !ok {
+			return nil, fmt.Errorf("class %s: no sharding state", class.Class)
+		}
+		shardState.SetLocalName(nodeName)
+	}
+
+	return &state, nil
+}
+
+// SchemaGetter serves a fixed schema state to a database which is used
+// without a cluster, e.g. while loading data offline
+type SchemaGetter struct {
+	state    *schemaUC.State
+	nodeName string
+}
+
+func NewSchemaGetter(state *schemaUC.State, nodeName string) *SchemaGetter {
+	return &SchemaGetter{state: state, nodeName: nodeName}
+}
+
+func (g *SchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return schema.Schema{Objects: g.state.ObjectSchema}
+}
+
+func (g *SchemaGetter) ShardingState(class string) *sharding.State {
+	return g.state.ShardingState[class]
+}
+
+func (g *SchemaGetter) Nodes() []string {
+	return []string{g.nodeName}
+}
+
+func (g *SchemaGetter) NodeName() string {
+	return g.nodeName
+}
+
+func (g *SchemaGetter) ClusterHealthScore() int {
+	return 0
+}
+
+func (g *SchemaGetter) ResolveParentNodes(class, shardName string) (map[string]string, error) {
+	return nil, fmt.Errorf("other nodes can not be resolved while loading offline")
+}
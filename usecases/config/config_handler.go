@@ -71,33 +71,34 @@ type Flags struct {
 
 // Config outline of the config file
 type Config struct {
-	Name                                string         `json:"name" yaml:"name"`
-	Debug                               bool           `json:"debug" yaml:"debug"`
-	QueryDefaults                       QueryDefaults  `json:"query_defaults" yaml:"query_defaults"`
-	QueryMaximumResults                 int64          `json:"query_maximum_results" yaml:"query_maximum_results"`
-	Contextionary                       Contextionary  `json:"contextionary" yaml:"contextionary"`
-	Authentication                      Authentication `json:"authentication" yaml:"authentication"`
-	Authorization                       Authorization  `json:"authorization" yaml:"authorization"`
-	Origin                              string         `json:"origin" yaml:"origin"`
-	Persistence                         Persistence    `json:"persistence" yaml:"persistence"`
-	DefaultVectorizerModule             string         `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
-	DefaultVectorDistanceMetric         string         `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
-	EnableModules                       string         `json:"enable_modules" yaml:"enable_modules"`
-	ModulesPath                         string         `json:"modules_path" yaml:"modules_path"`
-	AutoSchema                          AutoSchema     `json:"auto_schema" yaml:"auto_schema"`
-	Cluster                             cluster.Config `json:"cluster" yaml:"cluster"`
-	Monitoring                          Monitoring     `json:"monitoring" yaml:"monitoring"`
-	GRPC                                GRPC           `json:"grpc" yaml:"grpc"`
-	Profiling                           Profiling      `json:"profiling" yaml:"profiling"`
-	ResourceUsage                       ResourceUsage  `json:"resource_usage" yaml:"resource_usage"`
-	MaxImportGoroutinesFactor           float64        `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
-	MaximumConcurrentGetRequests        int            `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
-	TrackVectorDimensions               bool           `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
-	ReindexVectorDimensionsAtStartup    bool           `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
-	RecountPropertiesAtStartup          bool           `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
-	ReindexSetToRoaringsetAtStartup     bool           `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
-	IndexMissingTextFilterableAtStartup bool           `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
-	DisableGraphQL                      bool           `json:"disable_graphql" yaml:"disable_graphql"`
+	Name                                string            `json:"name" yaml:"name"`
+	Debug                               bool              `json:"debug" yaml:"debug"`
+	QueryDefaults                       QueryDefaults     `json:"query_defaults" yaml:"query_defaults"`
+	QueryMaximumResults                 int64             `json:"query_maximum_results" yaml:"query_maximum_results"`
+	Contextionary                       Contextionary     `json:"contextionary" yaml:"contextionary"`
+	Authentication                      Authentication    `json:"authentication" yaml:"authentication"`
+	Authorization                       Authorization     `json:"authorization" yaml:"authorization"`
+	Origin                              string            `json:"origin" yaml:"origin"`
+	Persistence                         Persistence       `json:"persistence" yaml:"persistence"`
+	DefaultVectorizerModule             string            `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
+	DefaultVectorDistanceMetric         string            `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
+	EnableModules                       string            `json:"enable_modules" yaml:"enable_modules"`
+	ModulesPath                         string            `json:"modules_path" yaml:"modules_path"`
+	AutoSchema                          AutoSchema        `json:"auto_schema" yaml:"auto_schema"`
+	Cluster                             cluster.Config    `json:"cluster" yaml:"cluster"`
+	Monitoring                          Monitoring        `json:"monitoring" yaml:"monitoring"`
+	GRPC                                GRPC              `json:"grpc" yaml:"grpc"`
+	Profiling                           Profiling         `json:"profiling" yaml:"profiling"`
+	ResourceUsage                       ResourceUsage     `json:"resource_usage" yaml:"resource_usage"`
+	MaxImportGoroutinesFactor           float64           `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
+	MaximumConcurrentGetRequests        int               `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
+	TrackVectorDimensions               bool              `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
+	ReindexVectorDimensionsAtStartup    bool              `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
+	RecountPropertiesAtStartup          bool              `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
+	ReindexSetToRoaringsetAtStartup     bool              `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
+	IndexMissingTextFilterableAtStartup bool              `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
+	DisableGraphQL                      bool              `json:"disable_graphql" yaml:"disable_graphql"`
+	ChangeDataCapture                   ChangeDataCapture `json:"change_data_capture" yaml:"change_data_capture"`
 }
 
 type moduleProvider interface {
@@ -178,6 +179,14 @@ type GRPC struct {
 	Port int `json:"port" yaml:"port"`
 }
 
+// ChangeDataCapture configures the per-shard change logs, which record every
+// write to an object so that consumers can follow the changes of a class
+type ChangeDataCapture struct {
+	Enabled          bool   `json:"enabled" yaml:"enabled"`
+	RetentionSeconds int    `json:"retention_seconds" yaml:"retention_seconds"`
+	WebhookURL       string `json:"webhook_url" yaml:"webhook_url"`
+}
+
 type Profiling struct {
 	BlockProfileRate     int `json:"blockProfileRate" yaml:"blockProfileRate"`
 	MutexProfileFraction int `json:"mutexProfileFraction" yaml:"mutexProfileFraction"`
@@ -257,6 +257,21 @@ func FromEnv(config *Config) error {
 	}
 
 	config.DisableGraphQL = enabled(os.Getenv("DISABLE_GRAPHQL"))
+
+	if enabled(os.Getenv("CHANGE_DATA_CAPTURE_ENABLED")) {
+		config.ChangeDataCapture.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"CHANGE_DATA_CAPTURE_RETENTION_SECONDS",
+		func(val int) { config.ChangeDataCapture.RetentionSeconds = val },
+		DefaultChangeDataCaptureRetentionSeconds,
+	); err != nil {
+		return err
+	}
+
+	config.ChangeDataCapture.WebhookURL = os.Getenv("CHANGE_DATA_CAPTURE_WEBHOOK_URL")
+
 	return nil
 }
 
@@ -332,6 +347,7 @@ const (
 	DefaultPersistenceMemtablesMaxDuration    = 45
 	DefaultMaxConcurrentGetRequests           = 0
 	DefaultGRPCPort                           = 50051
+	DefaultChangeDataCaptureRetentionSeconds  = 24 * 60 * 60
 )
 
 const VectorizerModuleNone = "none"
@@ -406,3 +406,33 @@ func TestEnvironmentDisableGraphQL(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentChangeDataCaptureRetention(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    int
+		expectedErr bool
+	}{
+		{"Valid", []string{"3600"}, 3600, false},
+		{"not given", []string{}, DefaultChangeDataCaptureRetentionSeconds, false},
+		{"invalid factor", []string{"-1"}, -1, true},
+		{"zero factor", []string{"0"}, -1, true},
+		{"not parsable", []string{"I'm not a number"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("CHANGE_DATA_CAPTURE_RETENTION_SECONDS", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Equal(t, tt.expected, conf.ChangeDataCapture.RetentionSeconds)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/objectfile"
+)
+
+type Status string
+
+const (
+	Started      Status = "STARTED"
+	Transferring Status = "TRANSFERRING"
+	Success      Status = "SUCCESS"
+	Failed       Status = "FAILED"
+)
+
+// Descriptor describes an export, it is stored as MetaFile on the backend
+type Descriptor struct {
+	ID            string            `json:"id"`
+	Backend       string            `json:"backend"`
+	Class         string            `json:"class"`
+	Format        objectfile.Format `json:"format"`
+	IncludeVector bool              `json:"includeVector"`
+	Path          string            `json:"path"`
+	Status        Status            `json:"status"`
+	Error         string            `json:"error,omitempty"`
+	StartedAt     time.Time         `json:"startedAt"`
+	CompletedAt   time.Time         `json:"completedAt"`
+	Shards        []ShardDescriptor `json:"shards"`
+}
+
+// ShardDescriptor describes the export of a single shard, which is written
+// to its own file
+type ShardDescriptor struct {
+	Name    string `json:"name"`
+	File    string `json:"file"`
+	Status  Status `json:"status"`
+	Objects int64  `json:"objects"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Objects returns the number of objects exported so far
+func (d *Descriptor) Objects() int64 {
+	var n int64
+	for _, s := range d.Shards {
+		n += s.Objects
+	}
+	return n
+}
+
+func (d *Descriptor) copy() *Descriptor {
+	c := *d
+	c.Shards = make([]ShardDescriptor, len(d.Shards))
+	copy(c.Shards, d.Shards)
+	return &c
+}
@@ -0,0 +1,381 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package export writes the objects of a class to portable JSONL or Parquet
+// files on a backup backend. Unlike a backup, which is a snapshot of the
+// internal shard files, an export can be read without Weaviate.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/objectfile"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+const (
+	// MetaFile is stored next to the exported files and describes the export
+	MetaFile = "export.json"
+	// homePrefix separates exports from backups on the same backend, the
+	// files of an export are stored under exports/<id>
+	homePrefix = "exports"
+	// tempDirectory holds the files of an export in progress, relative to
+	// the data path of the backend
+	tempDirectory = ".export.tmp"
+)
+
+var regExpID = regexp.MustCompile("^[a-z0-9_-]+$")
+
+type BackendProvider interface {
+	BackupBackend(backend string) (modulecapabilities.BackupBackend, error)
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type schemaGetter interface {
+	GetSchemaSkipAuth() schema.Schema
+}
+
+// Sourcer reads the objects of the shards of a class
+type Sourcer interface {
+	// ExportShards returns the names of all shards of a class
+	ExportShards(className string) ([]string, error)
+	// ExportShard passes all objects of a shard which match the filters to
+	// fn, one page at a time
+	ExportShard(ctx context.Context, className, shardName string,
+		filters *filters.LocalFilter, addl additional.Properties,
+		fn func(search.Results) error) error
+}
+
+type Request struct {
+	// ID is the export ID
+	ID string
+	// Backend specifies on which backend the files are stored (gcs, s3, ..)
+	Backend string
+	// Class is the class whose objects are exported
+	Class string
+	// Where limits the export to the matching objects, optional
+	Where *models.WhereFilter
+	// Format of the exported files, defaults to JSONL
+	Format string
+	// IncludeVector adds the vector to each exported object
+	IncludeVector bool
+}
+
+type Manager struct {
+	// deps
+	logger     logrus.FieldLogger
+	authorizer authorizer
+	schema     schemaGetter
+	sourcer    Sourcer
+	backends   BackendProvider
+
+	sync.Mutex
+	// running is the export in progress, only one export runs at a time
+	running *Descriptor
+	// cancel cancels the running export, done is closed once it has ended
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewManager(
+	logger logrus.FieldLogger,
+	authorizer authorizer,
+	schema schemaGetter,
+	sourcer Sourcer,
+	backends BackendProvider,
+) *Manager {
+	return &Manager{
+		logger:     logger,
+		authorizer: authorizer,
+		schema:     schema,
+		sourcer:    sourcer,
+		backends:   backends,
+	}
+}
+
+// Export starts exporting the objects of a class. It returns as soon as
+// the export is started, its progress is reported by Status.
+func (m *Manager) Export(ctx context.Context, pr *models.Principal, req *Request,
+) (_ *Descriptor, err error) {
+	defer func(begin time.Time) {
+		logOperation(m.logger, "try_export", req.ID, req.Backend, begin, err)
+	}(time.Now())
+
+	resource := fmt.Sprintf("exports/%s/%s", req.Backend, req.ID)
+	if err := m.authorizer.Authorize(pr, "add", resource); err != nil {
+		return nil, err
+	}
+
+	format, where, err := m.validateRequest(req)
+	if err != nil {
+		return nil, backup.NewErrUnprocessable(err)
+	}
+
+	caps, err := m.backends.BackupBackend(req.Backend)
+	if err != nil {
+		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", req.Backend, err)
+		return nil, backup.NewErrUnprocessable(err)
+	}
+	store := &objStore{b: caps, basePath: homePath(req.ID)}
+
+	shards, err := m.sourcer.ExportShards(req.Class)
+	if err != nil {
+		return nil, backup.NewErrUnprocessable(err)
+	}
+
+	desc := &Descriptor{
+		ID:            req.ID,
+		Backend:       req.Backend,
+		Class:         req.Class,
+		Format:        format,
+		IncludeVector: req.IncludeVector,
+		Path:          store.HomeDir(),
+		Status:        Started,
+		StartedAt:     time.Now().UTC(),
+		Shards:        make([]ShardDescriptor, len(shards)),
+	}
+	for i, name := range shards {
+		desc.Shards[i] = ShardDescriptor{
+			Name:   name,
+			File:   name + format.Extension(),
+			Status: Started,
+		}
+	}
+
+	// reserve the export before checking the backend, so that concurrent
+	// requests for the same id can't both pass the check. The backend is
+	// accessed without holding the lock to not block Status.
+	exportCtx, err := m.reserve(desc)
+	if err != nil {
+		return nil, backup.NewErrUnprocessable(err)
+	}
+	started := false
+	defer func() {
+		if !started {
+			m.Lock()
+			m.finished()
+			m.Unlock()
+		}
+	}()
+
+	if err := store.Initialize(ctx); err != nil {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
+	}
+	if _, err := store.meta(ctx); err == nil {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf("export %q already exists at %q",
+			req.ID, store.HomeDir()))
+	}
+	if err := store.putMeta(ctx, desc); err != nil {
+		return nil, backup.NewErrUnprocessable(err)
+	}
+
+	e := &exporter{
+		logger:   m.logger,
+		sourcer:  m.sourcer,
+		store:    store,
+		desc:     desc,
+		where:    where,
+		lock:     &m.Mutex,
+		finished: m.finished,
+	}
+	started = true
+	res := desc.copy()
+	go e.run(exportCtx)
+
+	return res, nil
+}
+
+// Status returns the state of an export, including the progress of every
+// shard
+func (m *Manager) Status(ctx context.Context, pr *models.Principal,
+	backend, id string,
+) (_ *Descriptor, err error) {
+	defer func(begin time.Time) {
+		logOperation(m.logger, "export_status", id, backend, begin, err)
+	}(time.Now())
+
+	resource := fmt.Sprintf("exports/%s/%s", backend, id)
+	if err := m.authorizer.Authorize(pr, "get", resource); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	if r := m.running; r != nil && r.ID == id && r.Backend == backend {
+		desc := r.copy()
+		m.Unlock()
+		return desc, nil
+	}
+	m.Unlock()
+
+	caps, err := m.backends.BackupBackend(backend)
+	if err != nil {
+		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", backend, err)
+		return nil, backup.NewErrUnprocessable(err)
+	}
+	store := &objStore{b: caps, basePath: homePath(id)}
+	desc, err := store.meta(ctx)
+	if err != nil {
+		return nil, backup.NewErrNotFound(fmt.Errorf("export %q: %w", id, err))
+	}
+
+	return desc, nil
+}
+
+// Shutdown cancels the running export and waits until it has ended, its
+// descriptor is stored with status FAILED
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.Lock()
+	cancel, done := m.cancel, m.done
+	m.Unlock()
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait for export to end: %w", ctx.Err())
+	}
+}
+
+// reserve marks desc as the running export. It returns the context the
+// export runs with, which is cancelled by Shutdown.
+func (m *Manager) reserve(desc *Descriptor) (context.Context, error) {
+	m.Lock()
+	defer m.Unlock()
+	if m.running != nil {
+		return nil, fmt.Errorf("export %q already in progress", m.running.ID)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.running = desc
+	m.cancel = cancel
+	m.done = make(chan struct{})
+	return ctx, nil
+}
+
+// finished is called with the lock held once the running export has ended
+func (m *Manager) finished() {
+	m.cancel()
+	close(m.done)
+	m.running = nil
+	m.cancel = nil
+	m.done = nil
+}
+
+func (m *Manager) validateRequest(req *Request) (objectfile.Format, *filters.LocalFilter, error) {
+	if !regExpID.MatchString(req.ID) {
+		return "", nil, fmt.Errorf("invalid export id: %v must match %v", req.ID, regExpID)
+	}
+	if req.Class == "" {
+		return "", nil, fmt.Errorf("empty class")
+	}
+
+	format := objectfile.FormatJSONL
+	if req.Format != "" {
+		f, err := objectfile.ParseFormat(req.Format)
+		if err != nil {
+			return "", nil, err
+		}
+		format = f
+	}
+
+	sch := m.schema.GetSchemaSkipAuth()
+	class := sch.FindClassByName(schema.ClassName(req.Class))
+	if class == nil {
+		return "", nil, fmt.Errorf("class %q doesn't exist", req.Class)
+	}
+
+	if req.Where == nil {
+		return format, nil, nil
+	}
+	where, err := filterext.Parse(req.Where, class.Class)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parse where filter: %w", err)
+	}
+	if err := filters.ValidateFilters(sch, where); err != nil {
+		return "", nil, fmt.Errorf("invalid where filter: %w", err)
+	}
+
+	return format, where, nil
+}
+
+func homePath(id string) string {
+	return path.Join(homePrefix, id)
+}
+
+func logOperation(logger logrus.FieldLogger, name, id, backend string, begin time.Time, err error) {
+	le := logger.WithField("action", name).
+		WithField("export_id", id).WithField("backend", backend).
+		WithField("took", time.Since(begin))
+	if err != nil {
+		le.Error(err)
+	} else {
+		le.Info()
+	}
+}
+
+type objStore struct {
+	b        modulecapabilities.BackupBackend
+	basePath string
+}
+
+func (s *objStore) HomeDir() string {
+	return s.b.HomeDir(s.basePath)
+}
+
+func (s *objStore) Initialize(ctx context.Context) error {
+	return s.b.Initialize(ctx, s.basePath)
+}
+
+// meta downloads the descriptor of the export
+func (s *objStore) meta(ctx context.Context) (*Descriptor, error) {
+	bytes, err := s.b.GetObject(ctx, s.basePath, MetaFile)
+	if err != nil {
+		return nil, err
+	}
+	var desc Descriptor
+	if err := json.Unmarshal(bytes, &desc); err != nil {
+		return nil, fmt.Errorf("unmarshal meta file %q: %w", MetaFile, err)
+	}
+	return &desc, nil
+}
+
+// putMeta uploads the descriptor of the export
+func (s *objStore) putMeta(ctx context.Context, desc *Descriptor) error {
+	bytes, err := json.Marshal(desc)
+	if err != nil {
+		return fmt.Errorf("marshal meta file %q: %w", MetaFile, err)
+	}
+	if err := s.b.PutObject(ctx, s.basePath, MetaFile, bytes); err != nil {
+		return fmt.Errorf("upload meta file %q: %w", MetaFile, err)
+	}
+	return nil
+}
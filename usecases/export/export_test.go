@@ -0,0 +1,381 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/objectfile"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+
+	for _, format := range []objectfile.Format{objectfile.FormatJSONL, objectfile.FormatParquet} {
+		t.Run(string(format), func(t *testing.T) {
+			dataPath := t.TempDir()
+			backend := newFakeBackend(dataPath)
+			sourcer := newTestSourcer()
+			m := newTestManager(sourcer, backend, &fakeAuthorizer{})
+
+			desc, err := m.Export(ctx, nil, &Request{
+				ID:            "export-1",
+				Backend:       "fake",
+				Class:         "Article",
+				Format:        string(format),
+				IncludeVector: true,
+			})
+			require.Nil(t, err)
+			assert.Equal(t, "fake://exports/export-1", desc.Path)
+			assert.Equal(t, format, desc.Format)
+			assert.Len(t, desc.Shards, 2)
+
+			desc = waitForExport(t, m, "export-1")
+			require.Equal(t, Success, desc.Status, desc.Error)
+			assert.Equal(t, int64(3), desc.Objects())
+			assert.False(t, desc.CompletedAt.IsZero())
+
+			for _, shard := range desc.Shards {
+				assert.Equal(t, Success, shard.Status)
+				assert.Equal(t, shard.Name+"."+string(format), shard.File)
+
+				objs := readExportFile(t, backend, format, "exports/export-1/"+shard.File)
+				require.Len(t, objs, int(shard.Objects))
+				for _, obj := range objs {
+					assert.Equal(t, "Article", obj.Class)
+					assert.NotEmpty(t, obj.Vector)
+					assert.Contains(t, obj.Properties, "title")
+				}
+			}
+
+			// the descriptor of the finished export is read from the backend
+			_, err = backend.GetObject(ctx, "exports/export-1", MetaFile)
+			require.Nil(t, err)
+
+			// temporary files are removed
+			_, err = os.Stat(path.Join(dataPath, tempDirectory, "export-1"))
+			assert.True(t, os.IsNotExist(err))
+		})
+	}
+}
+
+func TestExport_WithoutVector(t *testing.T) {
+	backend := newFakeBackend(t.TempDir())
+	m := newTestManager(newTestSourcer(), backend, &fakeAuthorizer{})
+
+	_, err := m.Export(context.Background(), nil, &Request{
+		ID:      "export-1",
+		Backend: "fake",
+		Class:   "Article",
+	})
+	require.Nil(t, err)
+
+	desc := waitForExport(t, m, "export-1")
+	require.Equal(t, Success, desc.Status, desc.Error)
+	assert.Equal(t, objectfile.FormatJSONL, desc.Format)
+	for _, shard := range desc.Shards {
+		for _, obj := range readExportFile(t, backend, objectfile.FormatJSONL,
+			"exports/export-1/"+shard.File) {
+			assert.Nil(t, obj.Vector)
+		}
+	}
+}
+
+func TestExport_Filter(t *testing.T) {
+	sourcer := newTestSourcer()
+	m := newTestManager(sourcer, newFakeBackend(t.TempDir()), &fakeAuthorizer{})
+
+	_, err := m.Export(context.Background(), nil, &Request{
+		ID:      "export-1",
+		Backend: "fake",
+		Class:   "Article",
+		Where: &models.WhereFilter{
+			Operator:  "Equal",
+			Path:      []string{"title"},
+			ValueText: strPtr("first"),
+		},
+	})
+	require.Nil(t, err)
+	waitForExport(t, m, "export-1")
+
+	require.Len(t, sourcer.filters, 2)
+	for _, f := range sourcer.filters {
+		require.NotNil(t, f)
+		assert.Equal(t, "title", f.Root.On.Property.String())
+	}
+}
+
+func TestExport_ShardFails(t *testing.T) {
+	sourcer := newTestSourcer()
+	sourcer.failShard = "shard2"
+	m := newTestManager(sourcer, newFakeBackend(t.TempDir()), &fakeAuthorizer{})
+
+	_, err := m.Export(context.Background(), nil, &Request{
+		ID:      "export-1",
+		Backend: "fake",
+		Class:   "Article",
+	})
+	require.Nil(t, err)
+
+	desc := waitForExport(t, m, "export-1")
+	assert.Equal(t, Failed, desc.Status)
+	assert.Contains(t, desc.Error, "shard2")
+	for _, shard := range desc.Shards {
+		if shard.Name == "shard2" {
+			assert.Equal(t, Failed, shard.Status)
+			assert.Contains(t, shard.Error, "shard is broken")
+		}
+	}
+}
+
+func TestExport_InvalidRequests(t *testing.T) {
+	ctx := context.Background()
+	backend := newFakeBackend(t.TempDir())
+	m := newTestManager(newTestSourcer(), backend, &fakeAuthorizer{})
+
+	valid := func() *Request {
+		return &Request{ID: "export-1", Backend: "fake", Class: "Article"}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Request)
+		errMsg string
+	}{
+		{"invalid id", func(r *Request) { r.ID = "Export 1" }, "invalid export id"},
+		{"empty class", func(r *Request) { r.Class = "" }, "empty class"},
+		{"unknown class", func(r *Request) { r.Class = "Unknown" }, "doesn't exist"},
+		{"unknown format", func(r *Request) { r.Format = "csv" }, "unsupported format"},
+		{
+			"invalid filter", func(r *Request) {
+				r.Where = &models.WhereFilter{
+					Operator:  "Equal",
+					Path:      []string{"unknown"},
+					ValueText: strPtr("first"),
+				}
+			}, "where filter",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := valid()
+			test.modify(req)
+			_, err := m.Export(ctx, nil, req)
+			require.NotNil(t, err)
+			assert.IsType(t, backup.ErrUnprocessable{}, err)
+			assert.Contains(t, err.Error(), test.errMsg)
+		})
+	}
+
+	t.Run("unknown backend", func(t *testing.T) {
+		m := newTestManager(newTestSourcer(), nil, &fakeAuthorizer{})
+		_, err := m.Export(ctx, nil, valid())
+		require.NotNil(t, err)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+
+	t.Run("export exists", func(t *testing.T) {
+		_, err := m.Export(ctx, nil, valid())
+		require.Nil(t, err)
+		waitForExport(t, m, "export-1")
+
+		_, err = m.Export(ctx, nil, valid())
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "already exists")
+	})
+}
+
+func TestExport_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	backend := newFakeBackend(t.TempDir())
+	m := newTestManager(newTestSourcer(), backend, &fakeAuthorizer{})
+
+	// hold the upload of the first descriptor
+	uploading, release := make(chan struct{}), make(chan struct{})
+	var once sync.Once
+	backend.beforePut = func(key string) {
+		once.Do(func() {
+			close(uploading)
+			<-release
+		})
+	}
+	req := &Request{ID: "export-1", Backend: "fake", Class: "Article"}
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := m.Export(ctx, nil, req)
+		errs <- err
+	}()
+	<-uploading
+
+	t.Run("status doesn't wait for the upload", func(t *testing.T) {
+		desc, err := m.Status(ctx, nil, "fake", "export-1")
+		require.Nil(t, err)
+		assert.Equal(t, Started, desc.Status)
+	})
+
+	t.Run("same id is rejected", func(t *testing.T) {
+		_, err := m.Export(ctx, nil, req)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "already in progress")
+	})
+
+	close(release)
+	require.Nil(t, <-errs)
+	desc := waitForExport(t, m, "export-1")
+	assert.Equal(t, Success, desc.Status, desc.Error)
+}
+
+func TestExport_Shutdown(t *testing.T) {
+	ctx := context.Background()
+	sourcer := newTestSourcer()
+	sourcer.block = true
+	m := newTestManager(sourcer, newFakeBackend(t.TempDir()), &fakeAuthorizer{})
+
+	require.Nil(t, m.Shutdown(ctx))
+
+	_, err := m.Export(ctx, nil, &Request{ID: "export-1", Backend: "fake", Class: "Article"})
+	require.Nil(t, err)
+	require.Nil(t, m.Shutdown(ctx))
+
+	// the export has ended and is read from the backend
+	desc, err := m.Status(ctx, nil, "fake", "export-1")
+	require.Nil(t, err)
+	assert.Equal(t, Failed, desc.Status)
+	assert.Contains(t, desc.Error, context.Canceled.Error())
+}
+
+func TestExport_Status(t *testing.T) {
+	m := newTestManager(newTestSourcer(), newFakeBackend(t.TempDir()), &fakeAuthorizer{})
+
+	_, err := m.Status(context.Background(), nil, "fake", "unknown")
+	require.NotNil(t, err)
+	assert.IsType(t, backup.ErrNotFound{}, err)
+}
+
+func TestExport_Authorization(t *testing.T) {
+	ctx := context.Background()
+	authorizer := &fakeAuthorizer{err: assert.AnError}
+	m := newTestManager(newTestSourcer(), newFakeBackend(t.TempDir()), authorizer)
+
+	_, err := m.Export(ctx, nil, &Request{ID: "export-1", Backend: "fake", Class: "Article"})
+	assert.Equal(t, assert.AnError, err)
+	_, err = m.Status(ctx, nil, "fake", "export-1")
+	assert.Equal(t, assert.AnError, err)
+
+	assert.Equal(t, []string{"add exports/fake/export-1", "get exports/fake/export-1"},
+		authorizer.calls)
+}
+
+func newTestManager(sourcer Sourcer, backend *fakeBackend, authorizer authorizer) *Manager {
+	logger, _ := test.NewNullLogger()
+	provider := &fakeBackendProvider{backend: backend}
+	if backend == nil {
+		provider = &fakeBackendProvider{err: assert.AnError}
+	}
+	sch := &fakeSchemaGetter{schema: schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{{
+			Class: "Article",
+			Properties: []*models.Property{{
+				Name:     "title",
+				DataType: []string{string(schema.DataTypeText)},
+			}},
+		}},
+	}}}
+	return NewManager(logger, authorizer, sch, sourcer, provider)
+}
+
+func newTestSourcer() *fakeSourcer {
+	result := func(id string) search.Result {
+		return search.Result{
+			ID:        strfmt.UUID(id),
+			ClassName: "Article",
+			Schema:    map[string]interface{}{"title": "title of " + id},
+			Vector:    []float32{1, 2, 3},
+		}
+	}
+	return &fakeSourcer{shards: map[string][]search.Results{
+		"shard1": {
+			{result("8d6d6a4c-e69b-4e5c-a0c0-d0ab1f3d5c1e")},
+			{result("3b1b3a1e-8b4a-4f63-8a36-1c1b2a3d4e5f")},
+		},
+		"shard2": {
+			{result("00000000-0000-0000-0000-000000000001")},
+		},
+	}}
+}
+
+func waitForExport(t *testing.T, m *Manager, id string) *Descriptor {
+	var desc *Descriptor
+	require.Eventually(t, func() bool {
+		var err error
+		desc, err = m.Status(context.Background(), nil, "fake", id)
+		require.Nil(t, err)
+		return desc.Status == Success || desc.Status == Failed
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the export is released once the descriptor is stored
+	require.Eventually(t, func() bool {
+		m.Lock()
+		defer m.Unlock()
+		return m.running == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	return desc
+}
+
+func readExportFile(t *testing.T, backend *fakeBackend, format objectfile.Format,
+	key string,
+) []*models.Object {
+	backend.Lock()
+	data, ok := backend.objects[key]
+	backend.Unlock()
+	require.True(t, ok, "file %s not uploaded", key)
+
+	var r objectfile.Reader
+	if format == objectfile.FormatParquet {
+		var err error
+		r, err = objectfile.NewParquetReader(bytes.NewReader(data), int64(len(data)))
+		require.Nil(t, err)
+	} else {
+		r = objectfile.NewJSONLReader(bytes.NewReader(data))
+	}
+	defer r.Close()
+
+	var objs []*models.Object
+	for {
+		obj, err := r.Read()
+		if err == io.EOF {
+			return objs
+		}
+		require.Nil(t, err)
+		objs = append(objs, obj)
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/objectfile"
+	"github.com/weaviate/weaviate/entities/search"
+	"golang.org/x/sync/errgroup"
+)
+
+// exporter writes the shards of a running export in parallel. Every shard
+// is written to a temporary file first, which is uploaded once complete.
+type exporter struct {
+	logger  logrus.FieldLogger
+	sourcer Sourcer
+	store   *objStore
+	where   *filters.LocalFilter
+
+	// lock guards desc, which is read concurrently by Status
+	lock *sync.Mutex
+	desc *Descriptor
+	// finished is called with the lock held once the export has ended
+	finished func()
+}
+
+func (e *exporter) run(ctx context.Context) {
+	e.setStatus(Transferring)
+
+	tempDir := path.Join(tempDirectory, e.desc.ID)
+	defer os.RemoveAll(path.Join(e.store.b.SourceDataPath(), tempDir))
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(runtime.GOMAXPROCS(0))
+	for i := range e.desc.Shards {
+		i := i
+		eg.Go(func() error {
+			return e.shard(ctx, i, tempDir)
+		})
+	}
+	err := eg.Wait()
+
+	e.lock.Lock()
+	e.desc.CompletedAt = time.Now().UTC()
+	if err != nil {
+		e.desc.Status = Failed
+		e.desc.Error = err.Error()
+	} else {
+		e.desc.Status = Success
+	}
+	desc := e.desc.copy()
+	e.lock.Unlock()
+
+	// the descriptor must be stored before the export is released, as the
+	// status of a finished export is read from the backend
+	if perr := e.store.putMeta(context.Background(), desc); perr != nil {
+		e.logger.WithField("action", "export").
+			WithField("export_id", desc.ID).
+			WithError(perr).Error("store export descriptor")
+	}
+
+	e.lock.Lock()
+	e.finished()
+	e.lock.Unlock()
+
+	le := e.logger.WithField("action", "export").
+		WithField("export_id", desc.ID).WithField("backend", desc.Backend).
+		WithField("class", desc.Class).WithField("objects", desc.Objects()).
+		WithField("took", desc.CompletedAt.Sub(desc.StartedAt))
+	if err != nil {
+		le.Error(err)
+	} else {
+		le.Info("export completed")
+	}
+}
+
+// shard exports the i-th shard of the descriptor
+func (e *exporter) shard(ctx context.Context, i int, tempDir string) (err error) {
+	name, file := e.desc.Shards[i].Name, e.desc.Shards[i].File
+	e.setShardStatus(i, Transferring, nil)
+	defer func() {
+		if err != nil {
+			e.setShardStatus(i, Failed, err)
+			err = fmt.Errorf("shard %s: %w", name, err)
+		} else {
+			e.setShardStatus(i, Success, nil)
+		}
+	}()
+
+	// PutFile expects a path relative to the data path of the backend
+	srcPath := path.Join(tempDir, file)
+	absPath := path.Join(e.store.b.SourceDataPath(), srcPath)
+	defer os.Remove(absPath)
+
+	if err := e.writeShard(ctx, i, absPath); err != nil {
+		return err
+	}
+	if err := e.store.b.PutFile(ctx, e.store.basePath, file, srcPath); err != nil {
+		return fmt.Errorf("upload %s: %w", file, err)
+	}
+	return nil
+}
+
+func (e *exporter) writeShard(ctx context.Context, i int, absPath string) error {
+	if err := os.MkdirAll(path.Dir(absPath), os.ModePerm); err != nil {
+		return fmt.Errorf("create temp dir: %w", err)
+	}
+	f, err := os.Create(absPath)
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer f.Close()
+
+	buf := bufio.NewWriter(f)
+	w, err := newWriter(e.desc.Format, buf)
+	if err != nil {
+		return err
+	}
+
+	withVector := e.desc.IncludeVector
+	addl := additional.Properties{Vector: withVector}
+	err = e.sourcer.ExportShard(ctx, e.desc.Class, e.desc.Shards[i].Name,
+		e.where, addl, func(res search.Results) error {
+			for _, r := range res {
+				if err := w.Write(r.ObjectWithVector(withVector)); err != nil {
+					return err
+				}
+			}
+			e.lock.Lock()
+			e.desc.Shards[i].Objects += int64(len(res))
+			e.lock.Unlock()
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := buf.Flush(); err != nil {
+		return fmt.Errorf("write temp file: %w", err)
+	}
+	return f.Close()
+}
+
+func newWriter(format objectfile.Format, w io.Writer) (objectfile.Writer, error) {
+	if format == objectfile.FormatParquet {
+		return objectfile.NewParquetWriter(w)
+	}
+	return objectfile.NewJSONLWriter(w), nil
+}
+
+func (e *exporter) setStatus(st Status) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.desc.Status = st
+}
+
+func (e *exporter) setShardStatus(i int, st Status, err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.desc.Shards[i].Status = st
+	if err != nil {
+		e.desc.Shards[i].Error = err.Error()
+	}
+}
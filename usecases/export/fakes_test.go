@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package export
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+type fakeAuthorizer struct {
+	err   error
+	calls []string
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	a.calls = append(a.calls, verb+" "+resource)
+	return a.err
+}
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+type fakeBackendProvider struct {
+	backend modulecapabilities.BackupBackend
+	err     error
+}
+
+func (p *fakeBackendProvider) BackupBackend(backend string) (modulecapabilities.BackupBackend, error) {
+	return p.backend, p.err
+}
+
+// fakeSourcer serves pages of objects per shard, it fails for the shard
+// in failShard. If block is set, it doesn't return before ctx is cancelled.
+type fakeSourcer struct {
+	shards    map[string][]search.Results
+	failShard string
+	block     bool
+
+	sync.Mutex
+	filters []*filters.LocalFilter
+}
+
+func (s *fakeSourcer) ExportShards(className string) ([]string, error) {
+	names := make([]string, 0, len(s.shards))
+	for name := range s.shards {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (s *fakeSourcer) ExportShard(ctx context.Context, className, shardName string,
+	filters *filters.LocalFilter, addl additional.Properties,
+	fn func(search.Results) error,
+) error {
+	s.Lock()
+	s.filters = append(s.filters, filters)
+	s.Unlock()
+
+	if shardName == s.failShard {
+		return errors.New("shard is broken")
+	}
+	if s.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	for _, page := range s.shards[shardName] {
+		res := make(search.Results, len(page))
+		for i, r := range page {
+			res[i] = r
+			if !addl.Vector {
+				res[i].Vector = nil
+			}
+		}
+		if err := fn(res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fakeBackend keeps all uploaded files in memory, beforePut is called
+// before a file is uploaded if set
+type fakeBackend struct {
+	dataPath  string
+	beforePut func(key string)
+
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func newFakeBackend(dataPath string) *fakeBackend {
+	return &fakeBackend{dataPath: dataPath, objects: map[string][]byte{}}
+}
+
+func (b *fakeBackend) IsExternal() bool { return true }
+
+func (b *fakeBackend) Name() string { return "fake" }
+
+func (b *fakeBackend) HomeDir(backupID string) string {
+	return "fake://" + backupID
+}
+
+func (b *fakeBackend) SourceDataPath() string { return b.dataPath }
+
+func (b *fakeBackend) Initialize(ctx context.Context, backupID string) error { return nil }
+
+func (b *fakeBackend) GetObject(ctx context.Context, backupID, key string) ([]byte, error) {
+	b.Lock()
+	defer b.Unlock()
+	data, ok := b.objects[path.Join(backupID, key)]
+	if !ok {
+		return nil, backup.NewErrNotFound(errors.New("not found"))
+	}
+	return data, nil
+}
+
+func (b *fakeBackend) PutObject(ctx context.Context, backupID, key string, data []byte) error {
+	if b.beforePut != nil {
+		b.beforePut(key)
+	}
+	b.Lock()
+	defer b.Unlock()
+	b.objects[path.Join(backupID, key)] = data
+	return nil
+}
+
+func (b *fakeBackend) PutFile(ctx context.Context, backupID, key, srcPath string) error {
+	data, err := os.ReadFile(path.Join(b.dataPath, srcPath))
+	if err != nil {
+		return err
+	}
+	return b.PutObject(ctx, backupID, key, data)
+}
+
+func (b *fakeBackend) WriteToFile(ctx context.Context, backupID, key, destPath string) error {
+	return errors.New("not implemented")
+}
@@ -265,7 +265,7 @@ func (p *Provider) shouldIncludeClassArgument(class *models.Class, module string
 			return true
 		}
 	}
-	// Allow Text2Text (Generative, QnA, Summarize, NER) modules to be registered to a given class
+	// Allow Text2Text (Generative, QnA, Summarize, NER) and Reranker modules to be registered to a given class
 	// only if there's no configuration present and there's only one module of a given type enabled
 	return p.isOnlyOneModuleEnabledOfAGivenType(moduleType)
 }
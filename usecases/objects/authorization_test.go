@@ -86,6 +86,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName: "MergeObject",
 			additionalArgs: []interface{}{
 				&models.Object{Class: "class", ID: "foo"},
+				(*int64)(nil),
 				(*additional.ReplicationProperties)(nil),
 			},
 			expectedVerb:     "update",
@@ -97,6 +98,18 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedVerb:     "get",
 			expectedResource: "objects/foo",
 		},
+		{
+			methodName:       "GetObjectVersions",
+			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), additional.Properties{}},
+			expectedVerb:     "get",
+			expectedResource: "objects/class/foo",
+		},
+		{
+			methodName:       "GetObjectAsOf",
+			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), int64(0), additional.Properties{}},
+			expectedVerb:     "get",
+			expectedResource: "objects/class/foo",
+		},
 		{
 			methodName:       "HeadObject",
 			additionalArgs:   []interface{}{"class", strfmt.UUID("foo")},
@@ -212,6 +225,7 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			additionalArgs: []interface{}{
 				[]*models.Object{},
 				[]*string{},
+				false,
 				&additional.ReplicationProperties{},
 			},
 			expectedVerb:     "create",
@@ -239,6 +253,19 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedVerb:     "delete",
 			expectedResource: "batch/objects",
 		},
+
+		{
+			methodName: "UpdateObjects",
+			additionalArgs: []interface{}{
+				&models.BatchUpdateMatch{},
+				map[string]interface{}{},
+				(*bool)(nil),
+				(*string)(nil),
+				&additional.ReplicationProperties{},
+			},
+			expectedVerb:     "update",
+			expectedResource: "batch/objects",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
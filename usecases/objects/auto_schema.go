@@ -15,6 +15,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -113,16 +114,26 @@ func (m *autoSchemaManager) updateClass(ctx context.Context, principal *models.P
 	className string, properties []*models.Property, existingProperties []*models.Property,
 ) error {
 	propertiesToAdd := []*models.Property{}
+	propertiesToMerge := []*models.Property{}
 	for _, prop := range properties {
-		found := false
+		var found *models.Property
 		for _, classProp := range existingProperties {
 			if classProp.Name == schema.LowercaseFirstLetter(prop.Name) {
-				found = true
+				found = classProp
 				break
 			}
 		}
-		if !found {
+		if found == nil {
 			propertiesToAdd = append(propertiesToAdd, prop)
+			continue
+		}
+		// new keys of the objects of an existing object or object[] property
+		// are added to its nested properties
+		if _, ok := schema.AsNested(found.DataType); ok {
+			if _, added := schema.MergeNestedProperties(found.NestedProperties,
+				prop.NestedProperties); added {
+				propertiesToMerge = append(propertiesToMerge, prop)
+			}
 		}
 	}
 	for _, newProp := range propertiesToAdd {
@@ -134,6 +145,15 @@ func (m *autoSchemaManager) updateClass(ctx context.Context, principal *models.P
 			return err
 		}
 	}
+	for _, mergeProp := range propertiesToMerge {
+		m.logger.
+			WithField("auto_schema", "updateClass").
+			Debugf("update class %s add nested properties to property %s", className, mergeProp.Name)
+		err := m.schemaManager.MergeClassObjectProperty(ctx, principal, className, mergeProp)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -148,6 +168,9 @@ func (m *autoSchemaManager) getProperties(object *models.Object) []*models.Prope
 				DataType:    m.getDataTypes(dt),
 				Description: "This property was generated by Weaviate's auto-schema feature on " + now.Format(time.ANSIC),
 			}
+			if len(dt) == 1 && (dt[0] == schema.DataTypeObject || dt[0] == schema.DataTypeObjectArray) {
+				property.NestedProperties = m.getNestedProperties(value)
+			}
 			properties = append(properties, property)
 		}
 	}
@@ -186,9 +209,15 @@ func (m *autoSchemaManager) determineType(value interface{}) []schema.DataType {
 		if v["input"] != nil {
 			return []schema.DataType{schema.DataTypePhoneNumber}
 		}
+		if len(v) > 0 {
+			return []schema.DataType{schema.DataTypeObject}
+		}
 		return fallbackDataType
 	case []interface{}:
 		if len(v) > 0 {
+			if isObjectArray(v) {
+				return []schema.DataType{schema.DataTypeObjectArray}
+			}
 			dataType := []schema.DataType{}
 			for i := range v {
 				switch arrayVal := v[i].(type) {
@@ -238,3 +267,107 @@ func (m *autoSchemaManager) determineType(value interface{}) []schema.DataType {
 		return fallbackDataType
 	}
 }
+
+// isObjectArray checks whether the array holds objects which are not
+// references, i.e. the values of an object[] property
+func isObjectArray(values []interface{}) bool {
+	for i := range values {
+		obj, ok := values[i].(map[string]interface{})
+		if !ok || len(obj) == 0 || obj["beacon"] != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// getNestedProperties determines the nested properties of the value of an
+// object or object[] property. The nested properties of all objects of an
+// object[] are merged, with the data type of the first occurrence winning.
+// Like top-level properties, nested properties are named with a lowercase
+// first letter.
+func (m *autoSchemaManager) getNestedProperties(value interface{}) []*models.NestedProperty {
+	var objects []map[string]interface{}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		objects = append(objects, v)
+	case []interface{}:
+		for i := range v {
+			if obj, ok := v[i].(map[string]interface{}); ok {
+				objects = append(objects, obj)
+			}
+		}
+	}
+
+	nestedProperties := []*models.NestedProperty{}
+	byName := map[string]*models.NestedProperty{}
+	for _, obj := range objects {
+		for key, nestedValue := range obj {
+			name := schema.LowercaseFirstLetter(key)
+			if _, ok := byName[name]; ok {
+				continue
+			}
+			dt := m.determineNestedType(nestedValue)
+			nestedProperty := &models.NestedProperty{
+				Name:     name,
+				DataType: []string{dt.String()},
+			}
+			if dt == schema.DataTypeObject || dt == schema.DataTypeObjectArray {
+				nestedProperty.NestedProperties = m.getNestedProperties(nestedValue)
+			}
+			byName[name] = nestedProperty
+			nestedProperties = append(nestedProperties, nestedProperty)
+		}
+	}
+
+	sort.Slice(nestedProperties, func(i, j int) bool {
+		return nestedProperties[i].Name < nestedProperties[j].Name
+	})
+	return nestedProperties
+}
+
+// determineNestedType determines the data type of the value of a nested
+// property, which is limited to schema.NestedLeafDataTypes and the object
+// data types. Geo coordinates, phone numbers and references are not
+// supported within objects and are treated like any other object.
+func (m *autoSchemaManager) determineNestedType(value interface{}) schema.DataType {
+	switch v := value.(type) {
+	case string:
+		if _, err := time.Parse(time.RFC3339, v); err == nil {
+			return schema.DataTypeDate
+		}
+		return schema.DataTypeText
+	case json.Number:
+		if schema.DataType(m.config.DefaultNumber) == schema.DataTypeInt {
+			return schema.DataTypeInt
+		}
+		return schema.DataTypeNumber
+	case bool:
+		return schema.DataTypeBoolean
+	case map[string]interface{}:
+		if len(v) > 0 {
+			return schema.DataTypeObject
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			break
+		}
+		if isObjectArray(v) {
+			return schema.DataTypeObjectArray
+		}
+		switch arrayVal := v[0].(type) {
+		case string:
+			if _, err := time.Parse(time.RFC3339, arrayVal); err == nil {
+				return schema.DataTypeDateArray
+			}
+		case json.Number:
+			if schema.DataType(m.config.DefaultNumber) == schema.DataTypeInt {
+				return schema.DataTypeIntArray
+			}
+			return schema.DataTypeNumberArray
+		case bool:
+			return schema.DataTypeBooleanArray
+		}
+		return schema.DataTypeTextArray
+	}
+	return schema.DataTypeText
+}
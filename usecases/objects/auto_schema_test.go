@@ -150,6 +150,36 @@ func Test_autoSchemaManager_determineType(t *testing.T) {
 			},
 			want: []schema.DataType{schema.DataTypePhoneNumber},
 		},
+		{
+			name: "determine object",
+			fields: fields{
+				config: config.AutoSchema{
+					Enabled: true,
+				},
+			},
+			args: args{
+				value: map[string]interface{}{
+					"city":    "Amsterdam",
+					"zipCode": "1011",
+				},
+			},
+			want: []schema.DataType{schema.DataTypeObject},
+		},
+		{
+			name: "determine object array",
+			fields: fields{
+				config: config.AutoSchema{
+					Enabled: true,
+				},
+			},
+			args: args{
+				value: []interface{}{
+					map[string]interface{}{"city": "Amsterdam"},
+					map[string]interface{}{"city": "Berlin"},
+				},
+			},
+			want: []schema.DataType{schema.DataTypeObjectArray},
+		},
 		{
 			name: "determine cross reference",
 			fields: fields{
@@ -462,6 +492,176 @@ func Test_autoSchemaManager_autoSchema_update(t *testing.T) {
 	assert.Equal(t, "int[]", getProperty((schemaAfter.Objects.Classes)[0].Properties, "numberArray").DataType[0])
 }
 
+func Test_autoSchemaManager_autoSchema_createNested(t *testing.T) {
+	// given
+	schemaManager := &fakeSchemaManager{}
+	logger, _ := test.NewNullLogger()
+	autoSchemaManager := &autoSchemaManager{
+		schemaManager: schemaManager,
+		vectorRepo:    &fakeVectorRepo{},
+		config: config.AutoSchema{
+			Enabled:       true,
+			DefaultString: schema.DataTypeText.String(),
+			DefaultNumber: "int",
+			DefaultDate:   "date",
+		},
+		logger: logger,
+	}
+	obj := &models.Object{
+		Class: "Person",
+		Properties: map[string]interface{}{
+			"address": map[string]interface{}{
+				"city":    "Amsterdam",
+				"since":   "2002-10-02T15:00:00Z",
+				"numbers": []interface{}{json.Number("1"), json.Number("2")},
+				"geo": map[string]interface{}{
+					"latitude":  json.Number("52.37"),
+					"longitude": json.Number("4.89"),
+				},
+			},
+			"pets": []interface{}{
+				map[string]interface{}{"name": "Rex"},
+				map[string]interface{}{"name": "Tom", "age": json.Number("3")},
+			},
+		},
+	}
+
+	// when
+	err := autoSchemaManager.autoSchema(context.Background(), &models.Principal{}, obj)
+
+	// then
+	require.Nil(t, err)
+	schemaAfter := schemaManager.GetSchemaResponse
+	require.NotNil(t, schemaAfter.Objects)
+	require.Len(t, schemaAfter.Objects.Classes, 1)
+	properties := schemaAfter.Objects.Classes[0].Properties
+	require.Len(t, properties, 2)
+
+	address := getProperty(properties, "address")
+	require.NotNil(t, address)
+	assert.Equal(t, []string{"object"}, address.DataType)
+	require.Len(t, address.NestedProperties, 4)
+	assert.Equal(t, "city", address.NestedProperties[0].Name)
+	assert.Equal(t, []string{"text"}, address.NestedProperties[0].DataType)
+	assert.Equal(t, "geo", address.NestedProperties[1].Name)
+	assert.Equal(t, []string{"object"}, address.NestedProperties[1].DataType)
+	require.Len(t, address.NestedProperties[1].NestedProperties, 2)
+	assert.Equal(t, "latitude", address.NestedProperties[1].NestedProperties[0].Name)
+	assert.Equal(t, []string{"int"}, address.NestedProperties[1].NestedProperties[0].DataType)
+	assert.Equal(t, "numbers", address.NestedProperties[2].Name)
+	assert.Equal(t, []string{"int[]"}, address.NestedProperties[2].DataType)
+	assert.Equal(t, "since", address.NestedProperties[3].Name)
+	assert.Equal(t, []string{"date"}, address.NestedProperties[3].DataType)
+
+	pets := getProperty(properties, "pets")
+	require.NotNil(t, pets)
+	assert.Equal(t, []string{"object[]"}, pets.DataType)
+	require.Len(t, pets.NestedProperties, 2)
+	assert.Equal(t, "age", pets.NestedProperties[0].Name)
+	assert.Equal(t, []string{"int"}, pets.NestedProperties[0].DataType)
+	assert.Equal(t, "name", pets.NestedProperties[1].Name)
+	assert.Equal(t, []string{"text"}, pets.NestedProperties[1].DataType)
+}
+
+func Test_autoSchemaManager_autoSchema_nestedUppercaseKeys(t *testing.T) {
+	// given
+	schemaManager := &fakeSchemaManager{}
+	logger, _ := test.NewNullLogger()
+	autoSchemaManager := &autoSchemaManager{
+		schemaManager: schemaManager,
+		vectorRepo:    &fakeVectorRepo{},
+		config: config.AutoSchema{
+			Enabled:       true,
+			DefaultString: schema.DataTypeText.String(),
+			DefaultNumber: "int",
+			DefaultDate:   "date",
+		},
+		logger: logger,
+	}
+	obj := &models.Object{
+		Class: "Person",
+		Properties: map[string]interface{}{
+			"address": map[string]interface{}{
+				"City": "Amsterdam",
+				"Geo": map[string]interface{}{
+					"Lat": json.Number("52"),
+				},
+			},
+		},
+	}
+
+	// when
+	err := autoSchemaManager.autoSchema(context.Background(), &models.Principal{}, obj)
+
+	// then
+	require.Nil(t, err)
+	address := getProperty(schemaManager.GetSchemaResponse.Objects.Classes[0].Properties, "address")
+	require.NotNil(t, address)
+	require.Len(t, address.NestedProperties, 2)
+	assert.Equal(t, "city", address.NestedProperties[0].Name)
+	assert.Equal(t, "geo", address.NestedProperties[1].Name)
+	require.Len(t, address.NestedProperties[1].NestedProperties, 1)
+	assert.Equal(t, "lat", address.NestedProperties[1].NestedProperties[0].Name)
+}
+
+func Test_autoSchemaManager_autoSchema_updateNested(t *testing.T) {
+	// given
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Person",
+						Properties: []*models.Property{
+							{
+								Name:     "address",
+								DataType: schema.DataTypeObject.PropString(),
+								NestedProperties: []*models.NestedProperty{
+									{Name: "city", DataType: schema.DataTypeText.PropString()},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	logger, _ := test.NewNullLogger()
+	autoSchemaManager := &autoSchemaManager{
+		schemaManager: schemaManager,
+		vectorRepo:    &fakeVectorRepo{},
+		config: config.AutoSchema{
+			Enabled:       true,
+			DefaultString: schema.DataTypeText.String(),
+			DefaultNumber: "int",
+			DefaultDate:   "date",
+		},
+		logger: logger,
+	}
+	obj := &models.Object{
+		Class: "Person",
+		Properties: map[string]interface{}{
+			"address": map[string]interface{}{
+				"city": "Amsterdam",
+				"zip":  json.Number("1000"),
+			},
+		},
+	}
+
+	// when
+	err := autoSchemaManager.autoSchema(context.Background(), &models.Principal{}, obj)
+
+	// then
+	require.Nil(t, err)
+	properties := schemaManager.GetSchemaResponse.Objects.Classes[0].Properties
+	require.Len(t, properties, 1)
+	require.Len(t, properties[0].NestedProperties, 2)
+	assert.Equal(t, "city", properties[0].NestedProperties[0].Name)
+	assert.Equal(t, []string{"text"}, properties[0].NestedProperties[0].DataType)
+	assert.Equal(t, "zip", properties[0].NestedProperties[1].Name)
+	assert.Equal(t, []string{"int"}, properties[0].NestedProperties[1].DataType)
+}
+
 func getProperty(properties []*models.Property, name string) *models.Property {
 	for _, prop := range properties {
 		if prop.Name == name {
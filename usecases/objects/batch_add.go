@@ -25,9 +25,13 @@ import (
 	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
-// AddObjects Class Instances in batch to the connected DB
+// AddObjects Class Instances in batch to the connected DB. In a conditional
+// batch each object is only written if the lastUpdateTimeUnix it was sent with
+// matches the stored object, objects without a lastUpdateTimeUnix are only
+// created if they do not exist yet.
 func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Principal,
-	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+	objects []*models.Object, fields []*string, conditional bool,
+	repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
 	err := b.authorizer.Authorize(principal, "create", "batch/objects")
 	if err != nil {
@@ -45,18 +49,19 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 	defer b.metrics.BatchOp("total_uc_level", before.UnixNano())
 	defer b.metrics.BatchDec()
 
-	return b.addObjects(ctx, principal, objects, fields, repl)
+	return b.addObjects(ctx, principal, objects, fields, conditional, repl)
 }
 
 func (b *BatchManager) addObjects(ctx context.Context, principal *models.Principal,
-	classes []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+	classes []*models.Object, fields []*string, conditional bool,
+	repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
 	beforePreProcessing := time.Now()
 	if err := b.validateObjectForm(classes); err != nil {
 		return nil, NewErrInvalidUserInput("invalid param 'objects': %v", err)
 	}
 
-	batchObjects := b.validateObjectsConcurrently(ctx, principal, classes, fields, repl)
+	batchObjects := b.validateObjectsConcurrently(ctx, principal, classes, fields, conditional, repl)
 	b.metrics.BatchOp("total_preprocessing", beforePreProcessing.UnixNano())
 
 	var (
@@ -82,7 +87,8 @@ func (b *BatchManager) validateObjectForm(classes []*models.Object) error {
 }
 
 func (b *BatchManager) validateObjectsConcurrently(ctx context.Context, principal *models.Principal,
-	classes []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+	classes []*models.Object, fields []*string, conditional bool,
+	repl *additional.ReplicationProperties,
 ) BatchObjects {
 	fieldsToKeep := determineResponseFields(fields)
 	c := make(chan BatchObject, len(classes))
@@ -92,7 +98,7 @@ func (b *BatchManager) validateObjectsConcurrently(ctx context.Context, principa
 	// Generate a goroutine for each separate request
 	for i, object := range classes {
 		wg.Add(1)
-		go b.validateObject(ctx, principal, wg, object, i, &c, fieldsToKeep, repl)
+		go b.validateObject(ctx, principal, wg, object, i, &c, fieldsToKeep, conditional, repl)
 	}
 
 	wg.Wait()
@@ -102,12 +108,20 @@ func (b *BatchManager) validateObjectsConcurrently(ctx context.Context, principa
 
 func (b *BatchManager) validateObject(ctx context.Context, principal *models.Principal,
 	wg *sync.WaitGroup, concept *models.Object, originalIndex int, resultsC *chan BatchObject,
-	fieldsToKeep map[string]struct{}, repl *additional.ReplicationProperties,
+	fieldsToKeep map[string]struct{}, conditional bool, repl *additional.ReplicationProperties,
 ) {
 	defer wg.Done()
 
 	var id strfmt.UUID
 
+	// the incoming lastUpdateTimeUnix is the condition, it has to be read
+	// before the object is rebuilt with fresh timestamps below
+	var ifMatch *int64
+	if conditional {
+		lastUpdateTimeUnix := concept.LastUpdateTimeUnix
+		ifMatch = &lastUpdateTimeUnix
+	}
+
 	ec := &errorcompounder.ErrorCompounder{}
 
 	// Auto Schema
@@ -168,6 +182,7 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 		Err:           ec.ToError(),
 		OriginalIndex: originalIndex,
 		Vector:        object.Vector,
+		IfMatch:       ifMatch,
 	}
 }
 
@@ -84,11 +84,43 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 		expectedErr := NewErrInvalidUserInput("invalid param 'objects': cannot be empty, need at least" +
 			" one object for batching")
 
-		_, err := manager.AddObjects(ctx, nil, []*models.Object{}, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, []*models.Object{}, []*string{}, false, nil)
 
 		assert.Equal(t, expectedErr, err)
 	})
 
+	t.Run("conditional batch uses the incoming lastUpdateTimeUnix as condition", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		objects := []*models.Object{
+			{
+				Class:              "Foo",
+				Vector:             []float32{0.1, 0.1, 0.1111},
+				LastUpdateTimeUnix: 12345,
+			},
+			{
+				Class:  "Foo",
+				Vector: []float32{0.2, 0.2, 0.2222},
+			},
+		}
+
+		for range objects {
+			modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+				Return(nil, nil)
+		}
+
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, true, nil)
+		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
+
+		assert.Nil(t, err)
+		require.Len(t, repoCalledWithObjects, 2)
+		require.NotNil(t, repoCalledWithObjects[0].IfMatch)
+		assert.Equal(t, int64(12345), *repoCalledWithObjects[0].IfMatch)
+		require.NotNil(t, repoCalledWithObjects[1].IfMatch)
+		assert.Equal(t, int64(0), *repoCalledWithObjects[1].IfMatch,
+			"objects without lastUpdateTimeUnix must not exist yet")
+	})
+
 	t.Run("with objects without IDs", func(t *testing.T) {
 		reset()
 		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
@@ -108,7 +140,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -144,7 +176,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -184,7 +216,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -222,7 +254,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -255,7 +287,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -305,7 +337,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 		expectedErr := NewErrInvalidUserInput("invalid param 'objects': cannot be empty, need at least" +
 			" one object for batching")
 
-		_, err := manager.AddObjects(ctx, nil, []*models.Object{}, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, []*models.Object{}, []*string{}, false, nil)
 
 		assert.Equal(t, expectedErr, err)
 	})
@@ -328,7 +360,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 				Return(expectedVector, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -364,7 +396,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -394,7 +426,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -464,7 +496,7 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
 			Return(nil, nil)
 	}
-	addedObjects, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+	addedObjects, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 	assert.Nil(t, err)
 	require.Len(t, addedObjects, 2)
 	require.NotNil(t, addedObjects[0].Object.Properties)
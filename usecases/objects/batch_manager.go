@@ -43,6 +43,8 @@ type batchRepoNew interface {
 	BatchPutObjects(ctx context.Context, objects BatchObjects,
 		repl *additional.ReplicationProperties) (BatchObjects, error)
 	BatchDeleteObjects(ctx context.Context, params BatchDeleteParams, repl *additional.ReplicationProperties) (BatchDeleteResult, error)
+	BatchUpdateObjects(ctx context.Context, params BatchUpdateParams, merge BatchUpdateMergeFunc,
+		repl *additional.ReplicationProperties) (BatchUpdateResult, error)
 	AddBatchReferences(ctx context.Context, references BatchReferences,
 		repl *additional.ReplicationProperties) (BatchReferences, error)
 }
@@ -12,11 +12,14 @@
 package objects
 
 import (
+	"context"
+
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
 )
 
 // BatchObject is a helper type that groups all the info about one object in a
@@ -34,6 +37,10 @@ type BatchObject struct {
 	Object        *models.Object
 	UUID          strfmt.UUID
 	Vector        []float32
+	// IfMatch is the lastUpdateTimeUnix the stored object must have for the
+	// write to succeed, 0 requires that the object does not exist yet. It is
+	// only set for conditional batches.
+	IfMatch *int64
 }
 
 // BatchObjects groups many Object items together. The order matches the
@@ -90,3 +97,31 @@ type BatchDeleteResponse struct {
 	Params BatchDeleteParams
 	Result BatchDeleteResult
 }
+
+type BatchUpdateParams struct {
+	ClassName          schema.ClassName     `json:"className"`
+	Filters            *filters.LocalFilter `json:"filters"`
+	Properties         map[string]interface{}
+	PropertiesToDelete []string
+	DryRun             bool
+	Output             string
+}
+
+type BatchUpdateResult struct {
+	Matches int64
+	DryRun  bool
+	Objects BatchSimpleObjects
+}
+
+// BatchUpdateMergeFunc builds the merge document for an object matching the
+// filter of an update-by-query. It is called by the repo for every match, so
+// that the matches never need to be held at once.
+type BatchUpdateMergeFunc func(ctx context.Context, obj *search.Result) (*MergeDocument, error)
+
+type BatchUpdateResponse struct {
+	Match  *models.BatchUpdateMatch
+	DryRun bool
+	Output string
+	Params BatchUpdateParams
+	Result BatchUpdateResult
+}
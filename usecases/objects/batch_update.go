@@ -0,0 +1,257 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+)
+
+// UpdateObjects merges the given properties into all objects matching the
+// match filter
+func (b *BatchManager) UpdateObjects(ctx context.Context, principal *models.Principal,
+	match *models.BatchUpdateMatch, properties models.PropertySchema, dryRun *bool,
+	output *string, repl *additional.ReplicationProperties,
+) (*BatchUpdateResponse, error) {
+	err := b.authorizer.Authorize(principal, "update", "batch/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	b.metrics.BatchUpdateInc()
+	defer b.metrics.BatchUpdateDec()
+
+	return b.updateObjects(ctx, principal, match, properties, dryRun, output, repl)
+}
+
+func (b *BatchManager) updateObjects(ctx context.Context, principal *models.Principal,
+	match *models.BatchUpdateMatch, properties models.PropertySchema, dryRun *bool,
+	output *string, repl *additional.ReplicationProperties,
+) (*BatchUpdateResponse, error) {
+	params, class, err := b.validateBatchUpdate(ctx, principal, match, properties,
+		dryRun, output, repl)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("validate: %v", err)
+	}
+
+	// conditional merges make sure an object which was changed since it was
+	// found is not overwritten, they are not supported with replication
+	conditional := class.ReplicationConfig == nil || class.ReplicationConfig.Factor <= 1
+	updateTime := unixNow()
+	merge := func(ctx context.Context, obj *search.Result) (*MergeDocument, error) {
+		return b.mergeDocument(ctx, class, obj, *params, updateTime, conditional)
+	}
+
+	result, err := b.vectorRepo.BatchUpdateObjects(ctx, *params, merge, repl)
+	if err != nil {
+		return nil, NewErrInternal("batch update objects: %#v", err)
+	}
+
+	return &BatchUpdateResponse{
+		Match:  match,
+		Output: params.Output,
+		DryRun: params.DryRun,
+		Params: *params,
+		Result: result,
+	}, nil
+}
+
+// mergeDocument merges params.Properties into obj and re-vectorizes it if
+// needed
+func (b *BatchManager) mergeDocument(ctx context.Context, class *models.Class,
+	obj *search.Result, params BatchUpdateParams, updateTime int64,
+	conditional bool,
+) (*MergeDocument, error) {
+	primitive := make(map[string]interface{}, len(params.Properties))
+	for key, value := range params.Properties {
+		primitive[key] = value
+	}
+
+	objWithVec, err := b.mergeAndVectorize(ctx, class, obj, primitive,
+		params.PropertiesToDelete)
+	if err != nil {
+		return nil, fmt.Errorf("merge and vectorize: %w", err)
+	}
+
+	mergeDoc := MergeDocument{
+		Class:              class.Class,
+		ID:                 obj.ID,
+		PrimitiveSchema:    primitive,
+		Vector:             objWithVec.Vector,
+		UpdateTime:         updateTime,
+		PropertiesToDelete: params.PropertiesToDelete,
+	}
+	if objWithVec.Additional != nil {
+		mergeDoc.AdditionalProperties = objWithVec.Additional
+	}
+	if conditional {
+		ifMatch := obj.Updated
+		mergeDoc.IfMatch = &ifMatch
+	}
+
+	return &mergeDoc, nil
+}
+
+func (b *BatchManager) mergeAndVectorize(ctx context.Context, class *models.Class,
+	obj *search.Result, primitive map[string]interface{}, propertiesToDelete []string,
+) (*models.Object, error) {
+	old, ok := obj.Schema.(map[string]interface{})
+	if !ok {
+		old = map[string]interface{}{}
+	}
+
+	merged := make(map[string]interface{}, len(old)+len(primitive))
+	for key, value := range old {
+		merged[key] = value
+	}
+
+	objDiff := moduletools.NewObjectDiff(obj.Vector)
+	for key, value := range primitive {
+		objDiff.WithProp(key, old[key], value)
+		merged[key] = value
+	}
+	for _, key := range propertiesToDelete {
+		objDiff.WithProp(key, old[key], nil)
+		delete(merged, key)
+	}
+
+	var vector []float32
+	vectorizerName, err := b.modulesProvider.VectorizerName(class.Class)
+	if err != nil {
+		return nil, fmt.Errorf("find vectorizer name: %w", err)
+	}
+	if vectorizerName == config.VectorizerModuleNone {
+		vector = obj.Vector
+	}
+
+	// Note: vector is nil in case a vectorizer is configured, then the
+	// vectorizer will either set a new one or reuse the one in objDiff
+	merge := &models.Object{
+		Class:      class.Class,
+		ID:         obj.ID,
+		Properties: merged,
+		Vector:     vector,
+	}
+	if err := b.modulesProvider.UpdateVector(ctx, merge, class, objDiff,
+		b.findObject, b.logger); err != nil {
+		return nil, err
+	}
+
+	return merge, nil
+}
+
+func (b *BatchManager) validateBatchUpdate(ctx context.Context, principal *models.Principal,
+	match *models.BatchUpdateMatch, properties models.PropertySchema, dryRun *bool,
+	output *string, repl *additional.ReplicationProperties,
+) (*BatchUpdateParams, *models.Class, error) {
+	if match == nil {
+		return nil, nil, errors.New("empty match clause")
+	}
+
+	if len(match.Class) == 0 {
+		return nil, nil, errors.New("empty match.class clause")
+	}
+
+	if match.Where == nil {
+		return nil, nil, errors.New("empty match.where clause")
+	}
+
+	props, ok := properties.(map[string]interface{})
+	if !ok || len(props) == 0 {
+		return nil, nil, errors.New("empty properties clause")
+	}
+
+	// Validate schema given in body with the weaviate schema
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get schema: %s", err)
+	}
+
+	class := s.FindClassByName(schema.ClassName(match.Class))
+	if class == nil {
+		return nil, nil, fmt.Errorf("class: %v doesn't exist", match.Class)
+	}
+
+	filter, err := filterext.Parse(match.Where, class.Class)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse where filter: %s", err)
+	}
+
+	err = filters.ValidateFilters(s, filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid where filter: %s", err)
+	}
+
+	var propertiesToDelete []string
+	for key, value := range props {
+		if value == nil {
+			propertiesToDelete = append(propertiesToDelete, schema.LowercaseFirstLetter(key))
+		}
+	}
+
+	object := &models.Object{Class: class.Class, Properties: props}
+	err = validation.New(b.vectorRepo.Exists, b.config, repl).Object(ctx, object, class)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid properties: %s", err)
+	}
+
+	primitive, _ := object.Properties.(map[string]interface{})
+	for key, value := range primitive {
+		if _, ok := value.(models.MultipleRef); ok {
+			return nil, nil, fmt.Errorf("invalid properties: reference property %q "+
+				"cannot be updated in batch", key)
+		}
+	}
+
+	dryRunParam := false
+	if dryRun != nil {
+		dryRunParam = *dryRun
+	}
+
+	outputParam := OutputMinimal
+	if output != nil {
+		switch *output {
+		case OutputMinimal, OutputVerbose:
+			outputParam = *output
+		default:
+			return nil, nil, fmt.Errorf(`invalid output: "%s", possible values are: "%s", "%s"`,
+				*output, OutputMinimal, OutputVerbose)
+		}
+	}
+
+	params := &BatchUpdateParams{
+		ClassName:          schema.ClassName(class.Class),
+		Filters:            filter,
+		Properties:         primitive,
+		PropertiesToDelete: propertiesToDelete,
+		DryRun:             dryRunParam,
+		Output:             outputParam,
+	}
+	return params, class, nil
+}
@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchUpdate(t *testing.T) {
+	var (
+		vectorRepo      *fakeVectorRepo
+		modulesProvider *fakeModulesProvider
+		manager         *BatchManager
+	)
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Foo",
+					Properties: []*models.Property{
+						{
+							Name:         "name",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWhitespace,
+						},
+						{
+							Name:     "count",
+							DataType: schema.DataTypeInt.PropString(),
+						},
+						{
+							Name:     "ref",
+							DataType: []string{"Foo"},
+						},
+					},
+					VectorIndexConfig: hnsw.UserConfig{},
+					Vectorizer:        config.VectorizerModuleNone,
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		locks := &fakeLocks{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: sch,
+		}
+		logger, _ := test.NewNullLogger()
+		authorizer := &fakeAuthorizer{}
+		modulesProvider = getFakeModulesProvider()
+		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
+			schemaManager, &config.WeaviateConfig{}, logger, authorizer, nil)
+	}
+
+	ctx := context.Background()
+	match := &models.BatchUpdateMatch{
+		Class: "Foo",
+		Where: &models.WhereFilter{
+			Path:      []string{"name"},
+			Operator:  "Equal",
+			ValueText: ptString("value"),
+		},
+	}
+
+	t.Run("with invalid input", func(t *testing.T) {
+		reset()
+		tests := []struct {
+			name          string
+			match         *models.BatchUpdateMatch
+			properties    models.PropertySchema
+			output        *string
+			expectedError string
+		}{
+			{
+				name:          "no match",
+				properties:    map[string]interface{}{"name": "new"},
+				expectedError: "validate: empty match clause",
+			},
+			{
+				name:          "no properties",
+				match:         match,
+				expectedError: "validate: empty properties clause",
+			},
+			{
+				name:          "unknown property",
+				match:         match,
+				properties:    map[string]interface{}{"unknown": "new"},
+				expectedError: "validate: invalid properties: no such prop with name 'unknown' found in class 'Foo' in the schema. Check your schema files for which properties in this class are available",
+			},
+			{
+				name:          "wrong data type",
+				match:         match,
+				properties:    map[string]interface{}{"count": "new"},
+				expectedError: "validate: invalid properties: invalid integer property 'count' on class 'Foo': requires an integer, the given value is 'new'",
+			},
+			{
+				name:  "reference property",
+				match: match,
+				properties: map[string]interface{}{"ref": []interface{}{
+					map[string]interface{}{"beacon": "weaviate://localhost/Foo/8f3b8b28-0c6b-4c58-8b5a-b1d44e2b7b3c"},
+				}},
+				expectedError: "validate: invalid properties: reference property \"ref\" cannot be updated in batch",
+			},
+			{
+				name:          "invalid output",
+				match:         match,
+				properties:    map[string]interface{}{"name": "new"},
+				output:        ptString("all"),
+				expectedError: "validate: invalid output: \"all\", possible values are: \"minimal\", \"verbose\"",
+			},
+		}
+
+		vectorRepo.On("Exists", mock.Anything, mock.Anything).Return(true, nil)
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := manager.UpdateObjects(ctx, nil, test.match, test.properties,
+					nil, test.output, nil)
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			})
+		}
+	})
+
+	id1 := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	id2 := strfmt.UUID("6e2e8d1c-43c8-4bb1-8a57-8fbc1d0f4e2f")
+	found := search.Results{
+		{
+			ID:        id1,
+			ClassName: "Foo",
+			Schema:    map[string]interface{}{"name": "value", "count": int64(1)},
+			Vector:    []float32{1, 2, 3},
+			Updated:   100,
+		},
+		{
+			ID:        id2,
+			ClassName: "Foo",
+			Schema:    map[string]interface{}{"name": "value"},
+			Vector:    []float32{4, 5, 6},
+			Updated:   200,
+		},
+	}
+
+	t.Run("with dry run", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchUpdateObjects", schema.ClassName("Foo"), mock.Anything).
+			Return(found, nil).Once()
+
+		res, err := manager.UpdateObjects(ctx, nil, match,
+			map[string]interface{}{"count": json.Number("5")}, ptBool(true), nil, nil)
+		require.Nil(t, err)
+		assert.True(t, res.DryRun)
+		assert.Equal(t, OutputMinimal, res.Output)
+		assert.Equal(t, int64(2), res.Result.Matches)
+		assert.Equal(t, BatchSimpleObjects{{UUID: id1}, {UUID: id2}}, res.Result.Objects)
+		vectorRepo.AssertExpectations(t)
+		vectorRepo.AssertNotCalled(t, "Merge", mock.Anything)
+	})
+
+	t.Run("merging into every match", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchUpdateObjects", schema.ClassName("Foo"), mock.Anything).
+			Return(found, nil).Once()
+		modulesProvider.On("VectorizerName", "Foo").Return(config.VectorizerModuleNone, nil)
+		modulesProvider.On("UpdateVector", mock.Anything, mock.Anything).Return(nil, nil)
+		vectorRepo.On("Merge", mock.MatchedBy(func(doc MergeDocument) bool {
+			return doc.ID == id1
+		})).Return(nil).Once()
+		vectorRepo.On("Merge", mock.MatchedBy(func(doc MergeDocument) bool {
+			return doc.ID == id2
+		})).Return(NewErrPreconditionFailed("changed")).Once()
+
+		res, err := manager.UpdateObjects(ctx, nil, match,
+			map[string]interface{}{"count": json.Number("5"), "name": nil}, nil, ptString(OutputVerbose), nil)
+		require.Nil(t, err)
+		require.Len(t, res.Result.Objects, 2)
+		assert.Nil(t, res.Result.Objects[0].Err)
+		assert.Equal(t, NewErrPreconditionFailed("changed"), res.Result.Objects[1].Err)
+		vectorRepo.AssertExpectations(t)
+
+		merges := []MergeDocument{}
+		for _, call := range vectorRepo.Calls {
+			if call.Method == "Merge" {
+				merges = append(merges, call.Arguments.Get(0).(MergeDocument))
+			}
+		}
+		require.Len(t, merges, 2)
+		assert.Equal(t, map[string]interface{}{"count": int64(5)}, merges[0].PrimitiveSchema)
+		assert.Equal(t, []string{"name"}, merges[0].PropertiesToDelete)
+		assert.Equal(t, []float32{1, 2, 3}, merges[0].Vector)
+		assert.Equal(t, int64(100), *merges[0].IfMatch)
+		assert.Equal(t, []float32{4, 5, 6}, merges[1].Vector)
+		assert.Equal(t, int64(200), *merges[1].IfMatch)
+	})
+}
@@ -20,6 +20,7 @@ const (
 	StatusForbidden           = 403
 	StatusBadRequest          = 400
 	StatusNotFound            = 404
+	StatusPreconditionFailed  = 412
 	StatusInternalServerError = 500
 )
 
@@ -51,6 +52,10 @@ func (e *Error) BadRequest() bool {
 	return e.Code == StatusBadRequest
 }
 
+func (e *Error) PreconditionFailed() bool {
+	return e.Code == StatusPreconditionFailed
+}
+
 // ErrInvalidUserInput indicates a client-side error
 type ErrInvalidUserInput struct {
 	msg string
@@ -92,3 +97,18 @@ func (e ErrNotFound) Error() string {
 func NewErrNotFound(format string, args ...interface{}) ErrNotFound {
 	return ErrNotFound{msg: fmt.Sprintf(format, args...)}
 }
+
+// ErrPreconditionFailed indicates that a conditional write was rejected,
+// because the stored object did not match the expected version
+type ErrPreconditionFailed struct {
+	msg string
+}
+
+func (e ErrPreconditionFailed) Error() string {
+	return e.msg
+}
+
+// NewErrPreconditionFailed with Errorf signature
+func NewErrPreconditionFailed(format string, args ...interface{}) ErrPreconditionFailed {
+	return ErrPreconditionFailed{msg: fmt.Sprintf(format, args...)}
+}
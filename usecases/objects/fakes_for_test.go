@@ -115,6 +115,24 @@ func (f *fakeSchemaManager) AddClassProperty(ctx context.Context, principal *mod
 	return nil
 }
 
+func (f *fakeSchemaManager) MergeClassObjectProperty(ctx context.Context, principal *models.Principal,
+	class string, property *models.Property,
+) error {
+	classes := f.GetSchemaResponse.Objects.Classes
+	for _, c := range classes {
+		if c.Class != class {
+			continue
+		}
+		for _, prop := range c.Properties {
+			if prop.Name == property.Name {
+				prop.NestedProperties, _ = schema.MergeNestedProperties(
+					prop.NestedProperties, property.NestedProperties)
+			}
+		}
+	}
+	return nil
+}
+
 type fakeLocks struct {
 	Err error
 }
@@ -167,6 +185,26 @@ func (f *fakeVectorRepo) ObjectByID(ctx context.Context,
 	return nil, args.Error(1)
 }
 
+func (f *fakeVectorRepo) ObjectVersions(ctx context.Context, class string,
+	id strfmt.UUID, additional additional.Properties,
+) ([]ObjectVersion, error) {
+	args := f.Called(class, id, additional)
+	if args.Get(0) != nil {
+		return args.Get(0).([]ObjectVersion), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (f *fakeVectorRepo) ObjectAsOf(ctx context.Context, class string,
+	id strfmt.UUID, asOf int64, additional additional.Properties,
+) (*search.Result, error) {
+	args := f.Called(class, id, asOf, additional)
+	if args.Get(0) != nil {
+		return args.Get(0).(*search.Result), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (f *fakeVectorRepo) ObjectSearch(ctx context.Context, offset, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, additional additional.Properties,
 ) (search.Results, error) {
@@ -187,6 +225,13 @@ func (f *fakeVectorRepo) PutObject(ctx context.Context, concept *models.Object,
 	return args.Error(0)
 }
 
+func (f *fakeVectorRepo) PutObjectIfMatch(ctx context.Context, concept *models.Object,
+	vector []float32, ifMatch int64, repl *additional.ReplicationProperties,
+) error {
+	args := f.Called(concept, vector, ifMatch)
+	return args.Error(0)
+}
+
 func (f *fakeVectorRepo) BatchPutObjects(ctx context.Context, batch BatchObjects,
 	repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
@@ -206,6 +251,30 @@ func (f *fakeVectorRepo) BatchDeleteObjects(ctx context.Context, params BatchDel
 	return args.Get(0).(BatchDeleteResult), args.Error(1)
 }
 
+// BatchUpdateObjects merges into the objects returned by the mock the same
+// way the repo does, one at a time through Merge
+func (f *fakeVectorRepo) BatchUpdateObjects(ctx context.Context, params BatchUpdateParams,
+	merge BatchUpdateMergeFunc, repl *additional.ReplicationProperties,
+) (BatchUpdateResult, error) {
+	args := f.Called(params.ClassName, params.Filters)
+	found := args.Get(0).(search.Results)
+
+	res := BatchUpdateResult{Matches: int64(len(found)), DryRun: params.DryRun}
+	for i := range found {
+		obj := BatchSimpleObject{UUID: found[i].ID}
+		if !params.DryRun {
+			doc, err := merge(ctx, &found[i])
+			if err == nil {
+				err = f.Merge(ctx, *doc, repl)
+			}
+			obj.Err = err
+		}
+		res.Objects = append(res.Objects, obj)
+	}
+
+	return res, args.Error(1)
+}
+
 func (f *fakeVectorRepo) Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties) error {
 	args := f.Called(merge)
 	return args.Error(0)
@@ -91,6 +91,10 @@ type authorizer interface {
 type VectorRepo interface {
 	PutObject(ctx context.Context, concept *models.Object,
 		vector []float32, repl *additional.ReplicationProperties) error
+	// PutObjectIfMatch puts the object only if the stored object has the
+	// lastUpdateTimeUnix ifMatch, or does not exist if ifMatch is 0
+	PutObjectIfMatch(ctx context.Context, concept *models.Object,
+		vector []float32, ifMatch int64, repl *additional.ReplicationProperties) error
 	DeleteObject(ctx context.Context, className string, id strfmt.UUID, repl *additional.ReplicationProperties) error
 	// Object returns object of the specified class giving by its id
 	Object(ctx context.Context, class string, id strfmt.UUID, props search.SelectProperties,
@@ -104,6 +108,12 @@ type VectorRepo interface {
 	AddReference(ctx context.Context, className string, source strfmt.UUID, propName string, ref *models.SingleRef, repl *additional.ReplicationProperties) error
 	Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties) error
 	Query(context.Context, *QueryInput) (search.Results, *Error)
+	// ObjectVersions returns the version history of an object, newest first
+	ObjectVersions(ctx context.Context, class string, id strfmt.UUID,
+		additional additional.Properties) ([]ObjectVersion, error)
+	// ObjectAsOf returns the version of an object which was current at asOf
+	ObjectAsOf(ctx context.Context, class string, id strfmt.UUID, asOf int64,
+		additional additional.Properties) (*search.Result, error)
 }
 
 type ModulesProvider interface {
@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -34,10 +35,15 @@ type MergeDocument struct {
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
 	PropertiesToDelete   []string                    `json:"propertiesToDelete"`
+	IfMatch              *int64                      `json:"ifMatch,omitempty"`
+	// PropertyOperations are applied to the stored values of the properties
+	PropertyOperations map[string]PropertyOperation `json:"propertyOperations,omitempty"`
 }
 
+// MergeObject merges updates into an existing object. If ifMatch is set, the
+// object is only updated if its lastUpdateTimeUnix still equals ifMatch.
 func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
-	updates *models.Object, repl *additional.ReplicationProperties,
+	updates *models.Object, ifMatch *int64, repl *additional.ReplicationProperties,
 ) *Error {
 	if err := m.validateInputs(updates); err != nil {
 		return &Error{"bad request", StatusBadRequest, err}
@@ -51,6 +57,19 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	m.metrics.MergeObjectInc()
 	defer m.metrics.MergeObjectDec()
 
+	var operations map[string]PropertyOperation
+	if props, ok := updates.Properties.(map[string]interface{}); ok {
+		class, err := m.schemaManager.GetClass(ctx, principal, cls)
+		if err != nil {
+			return &Error{"bad request", StatusBadRequest, err}
+		}
+		if class != nil {
+			if operations, err = extractPropertyOperations(props, class); err != nil {
+				return &Error{"bad request", StatusBadRequest, err}
+			}
+		}
+	}
+
 	var propertiesToDelete []string
 	if updates.Properties != nil {
 		for key, val := range updates.Properties.(map[string]interface{}) {
@@ -74,17 +93,44 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	if obj == nil {
 		return &Error{"not found", StatusNotFound, err}
 	}
-	return m.patchObject(ctx, principal, obj, updates, repl, propertiesToDelete)
+	if ifMatch != nil && *ifMatch != obj.Updated {
+		return &Error{"precondition failed", StatusPreconditionFailed, NewErrPreconditionFailed(
+			"object %s has lastUpdateTimeUnix %d, expected %d", id, obj.Updated, *ifMatch)}
+	}
+	return m.patchObject(ctx, principal, obj, updates, ifMatch, repl,
+		propertiesToDelete, operations)
 }
 
 // patchObject patches an existing object obj with updates
 func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
-	obj *search.Result, updates *models.Object, repl *additional.ReplicationProperties, propertiesToDelete []string,
+	obj *search.Result, updates *models.Object, ifMatch *int64,
+	repl *additional.ReplicationProperties, propertiesToDelete []string,
+	operations map[string]PropertyOperation,
 ) *Error {
 	cls, id := updates.Class, updates.ID
 	primitive, refs := m.splitPrimitiveAndRefs(updates.Properties.(map[string]interface{}), cls, id)
+
+	// the vector is computed from the operations applied to the object as it
+	// was read, the stored properties are the result of applying them in the
+	// shard
+	vectorizeInput := primitive
+	if len(operations) > 0 {
+		vectorizeInput = make(map[string]interface{}, len(primitive)+len(operations))
+		for key, value := range primitive {
+			vectorizeInput[key] = value
+		}
+		old, _ := obj.Schema.(map[string]interface{})
+		for propName, op := range operations {
+			value, err := op.Apply(old[propName])
+			if err != nil {
+				return &Error{"bad request", StatusBadRequest, fmt.Errorf("property %q: %w", propName, err)}
+			}
+			vectorizeInput[propName] = value
+		}
+	}
+
 	objWithVec, err := m.mergeObjectSchemaAndVectorize(ctx, cls, obj.Schema,
-		primitive, principal, obj.Vector, updates.Vector)
+		vectorizeInput, principal, obj.Vector, updates.Vector)
 	if err != nil {
 		return &Error{"merge and vectorize", StatusInternalServerError, err}
 	}
@@ -96,6 +142,8 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 		Vector:             objWithVec.Vector,
 		UpdateTime:         m.timeSource.Now(),
 		PropertiesToDelete: propertiesToDelete,
+		IfMatch:            ifMatch,
+		PropertyOperations: operations,
 	}
 
 	if objWithVec.Additional != nil {
@@ -103,6 +151,14 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	}
 
 	if err := m.vectorRepo.Merge(ctx, mergeDoc, repl); err != nil {
+		var errPrecondition ErrPreconditionFailed
+		if errors.As(err, &errPrecondition) {
+			return &Error{"precondition failed", StatusPreconditionFailed, errPrecondition}
+		}
+		var errInvalid ErrInvalidUserInput
+		if errors.As(err, &errInvalid) {
+			return &Error{"bad request", StatusBadRequest, errInvalid}
+		}
 		return &Error{"repo.merge", StatusInternalServerError, err}
 	}
 
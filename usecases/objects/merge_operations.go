@@ -0,0 +1,278 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// Operators which can be used instead of a plain value in the properties of
+// a merge, e.g. {"tags": {"$append": ["new"]}}
+const (
+	OperatorAppend   = "$append"
+	OperatorRemove   = "$remove"
+	OperatorAddToSet = "$addToSet"
+	OperatorInc      = "$inc"
+)
+
+// PropertyOperation is an atomic modification of a single property. In
+// contrast to a plain value, which replaces the stored one, it is applied to
+// the currently stored value inside the shard while the object is locked.
+type PropertyOperation struct {
+	Operator string          `json:"operator"`
+	Value    interface{}     `json:"value"`
+	DataType schema.DataType `json:"dataType"`
+}
+
+// Apply returns the result of applying the operation to current, which is
+// the stored value of the property or nil if it is not set
+func (op PropertyOperation) Apply(current interface{}) (interface{}, error) {
+	switch op.Operator {
+	case OperatorInc:
+		return op.applyInc(current)
+	case OperatorAppend, OperatorRemove, OperatorAddToSet:
+		return op.applyArray(current)
+	default:
+		return nil, fmt.Errorf("unknown operator %q", op.Operator)
+	}
+}
+
+func (op PropertyOperation) applyInc(current interface{}) (interface{}, error) {
+	if op.DataType != schema.DataTypeInt && op.DataType != schema.DataTypeNumber {
+		return nil, fmt.Errorf("operator %s requires a property of type int or number, "+
+			"got %s", op.Operator, op.DataType)
+	}
+
+	inc, err := toFloat(op.Value)
+	if err != nil {
+		return nil, fmt.Errorf("operator %s: %w", op.Operator, err)
+	}
+	if op.DataType == schema.DataTypeInt && inc != math.Trunc(inc) {
+		return nil, fmt.Errorf("operator %s: requires an integer, got %v", op.Operator, op.Value)
+	}
+
+	var value float64
+	if current != nil {
+		if value, err = toFloat(current); err != nil {
+			return nil, fmt.Errorf("operator %s: stored value: %w", op.Operator, err)
+		}
+	}
+	return value + inc, nil
+}
+
+func (op PropertyOperation) applyArray(current interface{}) (interface{}, error) {
+	switch op.DataType {
+	case schema.DataTypeTextArray:
+		return applyArrayOperation(op, current, toString)
+	case schema.DataTypeUUIDArray:
+		return applyArrayOperation(op, current, toUUIDString)
+	case schema.DataTypeIntArray:
+		return applyArrayOperation(op, current, toInt)
+	case schema.DataTypeNumberArray:
+		return applyArrayOperation(op, current, toFloat)
+	case schema.DataTypeBooleanArray:
+		return applyArrayOperation(op, current, toBool)
+	default:
+		return nil, fmt.Errorf("operator %s requires a property of type text[], uuid[], "+
+			"int[], number[] or boolean[], got %s", op.Operator, op.DataType)
+	}
+}
+
+func applyArrayOperation[T comparable](op PropertyOperation, current interface{},
+	convert func(interface{}) (T, error),
+) ([]T, error) {
+	values, err := toTypedSlice(current, convert)
+	if err != nil {
+		return nil, fmt.Errorf("operator %s: stored value: %w", op.Operator, err)
+	}
+	operands, err := toTypedSlice(op.Value, convert)
+	if err != nil {
+		return nil, fmt.Errorf("operator %s: %w", op.Operator, err)
+	}
+
+	switch op.Operator {
+	case OperatorAppend:
+		return append(values, operands...), nil
+	case OperatorAddToSet:
+		for _, operand := range operands {
+			if !containsValue(values, operand) {
+				values = append(values, operand)
+			}
+		}
+		return values, nil
+	default: // OperatorRemove
+		out := make([]T, 0, len(values))
+		for _, value := range values {
+			if !containsValue(operands, value) {
+				out = append(out, value)
+			}
+		}
+		return out, nil
+	}
+}
+
+// toTypedSlice converts a single value or a slice of values, as
+// found in a request or in storage, to a slice of T
+func toTypedSlice[T any](in interface{}, convert func(interface{}) (T, error)) ([]T, error) {
+	if in == nil {
+		return []T{}, nil
+	}
+
+	rv := reflect.ValueOf(in)
+	if rv.Kind() != reflect.Slice {
+		value, err := convert(in)
+		if err != nil {
+			return nil, err
+		}
+		return []T{value}, nil
+	}
+
+	out := make([]T, rv.Len())
+	for i := range out {
+		value, err := convert(rv.Index(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		out[i] = value
+	}
+	return out, nil
+}
+
+func containsValue[T comparable](values []T, value T) bool {
+	for i := range values {
+		if values[i] == value {
+			return true
+		}
+	}
+	return false
+}
+
+func toString(in interface{}) (string, error) {
+	value, ok := in.(string)
+	if !ok {
+		return "", fmt.Errorf("expected a string, got %T", in)
+	}
+	return value, nil
+}
+
+func toUUIDString(in interface{}) (string, error) {
+	switch typed := in.(type) {
+	case uuid.UUID:
+		return typed.String(), nil
+	case strfmt.UUID:
+		return toUUIDString(typed.String())
+	case string:
+		parsed, err := uuid.Parse(typed)
+		if err != nil {
+			return "", fmt.Errorf("invalid uuid %q: %w", typed, err)
+		}
+		return parsed.String(), nil
+	default:
+		return "", fmt.Errorf("expected a uuid, got %T", in)
+	}
+}
+
+func toFloat(in interface{}) (float64, error) {
+	switch typed := in.(type) {
+	case float64:
+		return typed, nil
+	case float32:
+		return float64(typed), nil
+	case int:
+		return float64(typed), nil
+	case int64:
+		return float64(typed), nil
+	case json.Number:
+		return typed.Float64()
+	default:
+		return 0, fmt.Errorf("expected a number, got %T", in)
+	}
+}
+
+func toInt(in interface{}) (float64, error) {
+	value, err := toFloat(in)
+	if err != nil {
+		return 0, err
+	}
+	if value != math.Trunc(value) {
+		return 0, fmt.Errorf("expected an integer, got %v", in)
+	}
+	return value, nil
+}
+
+func toBool(in interface{}) (bool, error) {
+	value, ok := in.(bool)
+	if !ok {
+		return false, fmt.Errorf("expected a boolean, got %T", in)
+	}
+	return value, nil
+}
+
+// extractPropertyOperations removes all properties whose value is an
+// operator, such as {"$inc": 1}, from props and returns them as operations
+// on the properties of class
+func extractPropertyOperations(props map[string]interface{},
+	class *models.Class,
+) (map[string]PropertyOperation, error) {
+	var operations map[string]PropertyOperation
+	for key, value := range props {
+		operator, operand, ok := asOperation(value)
+		if !ok {
+			continue
+		}
+
+		propName := schema.LowercaseFirstLetter(key)
+		dataType, err := schema.GetPropertyDataType(class, propName)
+		if err != nil {
+			return nil, err
+		}
+
+		op := PropertyOperation{Operator: operator, Value: operand, DataType: *dataType}
+		// applying the operation to an unset property validates the operator,
+		// the data type and the operand
+		if _, err := op.Apply(nil); err != nil {
+			return nil, fmt.Errorf("property %q: %w", propName, err)
+		}
+
+		if operations == nil {
+			operations = map[string]PropertyOperation{}
+		}
+		operations[propName] = op
+		delete(props, key)
+	}
+	return operations, nil
+}
+
+// asOperation returns the operator and operand if value is a map with a
+// single key starting with "$". Property names cannot start with "$", so
+// such a map is never a value of an object property.
+func asOperation(value interface{}) (string, interface{}, bool) {
+	asMap, ok := value.(map[string]interface{})
+	if !ok || len(asMap) != 1 {
+		return "", nil, false
+	}
+	for operator, operand := range asMap {
+		if strings.HasPrefix(operator, "$") {
+			return operator, operand, true
+		}
+	}
+	return "", nil, false
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestPropertyOperationApply(t *testing.T) {
+	tests := []struct {
+		name          string
+		op            PropertyOperation
+		current       interface{}
+		expected      interface{}
+		expectedError string
+	}{
+		{
+			name:     "append to text array",
+			op:       PropertyOperation{OperatorAppend, []interface{}{"b", "a"}, schema.DataTypeTextArray},
+			current:  []string{"a"},
+			expected: []string{"a", "b", "a"},
+		},
+		{
+			name:     "append single value to unset property",
+			op:       PropertyOperation{OperatorAppend, "a", schema.DataTypeTextArray},
+			expected: []string{"a"},
+		},
+		{
+			name:     "add to set skips existing values",
+			op:       PropertyOperation{OperatorAddToSet, []interface{}{"a", "c", "c"}, schema.DataTypeTextArray},
+			current:  []string{"a", "b"},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "remove all occurrences",
+			op:       PropertyOperation{OperatorRemove, []interface{}{json.Number("1")}, schema.DataTypeIntArray},
+			current:  []float64{1, 2, 1},
+			expected: []float64{2},
+		},
+		{
+			name:     "remove from stored empty array",
+			op:       PropertyOperation{OperatorRemove, true, schema.DataTypeBooleanArray},
+			current:  []interface{}{},
+			expected: []bool{},
+		},
+		{
+			name:     "add uuid to set regardless of case",
+			op:       PropertyOperation{OperatorAddToSet, "5A1CD361-1E0D-42AE-BD52-EE09CB5F31CC", schema.DataTypeUUIDArray},
+			current:  []string{"5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"},
+			expected: []string{"5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"},
+		},
+		{
+			name:     "increment int",
+			op:       PropertyOperation{OperatorInc, json.Number("-2"), schema.DataTypeInt},
+			current:  float64(5),
+			expected: float64(3),
+		},
+		{
+			name:     "increment unset number",
+			op:       PropertyOperation{OperatorInc, 0.5, schema.DataTypeNumber},
+			expected: 0.5,
+		},
+		{
+			name:          "increment int by fraction",
+			op:            PropertyOperation{OperatorInc, 0.5, schema.DataTypeInt},
+			expectedError: "operator $inc: requires an integer, got 0.5",
+		},
+		{
+			name:          "append to scalar",
+			op:            PropertyOperation{OperatorAppend, "a", schema.DataTypeText},
+			expectedError: "operator $append requires a property of type text[], uuid[], int[], number[] or boolean[], got text",
+		},
+		{
+			name:          "append value of wrong type",
+			op:            PropertyOperation{OperatorAppend, []interface{}{"a", 1}, schema.DataTypeTextArray},
+			expectedError: "operator $append: element 1: expected a string, got int",
+		},
+		{
+			name:          "unknown operator",
+			op:            PropertyOperation{"$push", "a", schema.DataTypeTextArray},
+			expectedError: "unknown operator \"$push\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.op.Apply(test.current)
+			if test.expectedError != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
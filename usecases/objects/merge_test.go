@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
 )
@@ -46,6 +47,9 @@ func Test_MergeObject(t *testing.T) {
 		cls            = "ZooAction"
 		lastTime int64 = 12345
 		errAny         = errors.New("any error")
+
+		previousTime = lastTime - 1
+		staleTime    = lastTime - 2
 	)
 
 	tests := []struct {
@@ -53,6 +57,7 @@ func Test_MergeObject(t *testing.T) {
 		// inputs
 		previous             *models.Object
 		updated              *models.Object
+		ifMatch              *int64
 		vectorizerCalledWith *models.Object
 
 		// outputs
@@ -168,6 +173,58 @@ func Test_MergeObject(t *testing.T) {
 			wantCode: StatusInternalServerError,
 			stage:    stageCount,
 		},
+		{
+			name: "stale if-match",
+			previous: &models.Object{
+				Class:              cls,
+				LastUpdateTimeUnix: lastTime - 1,
+			},
+			updated: &models.Object{
+				Class: cls,
+				ID:    uuid,
+				Properties: map[string]interface{}{
+					"name": "My little pony zoo with extra sparkles",
+				},
+			},
+			ifMatch:  &staleTime,
+			wantCode: StatusPreconditionFailed,
+			stage:    stageObjectExists,
+		},
+		{
+			name: "concurrent update detected on merge",
+			previous: &models.Object{
+				Class:              cls,
+				Properties:         map[string]interface{}{},
+				LastUpdateTimeUnix: lastTime - 1,
+			},
+			updated: &models.Object{
+				Class: cls,
+				ID:    uuid,
+				Properties: map[string]interface{}{
+					"name": "My little pony zoo with extra sparkles",
+				},
+			},
+			ifMatch: &previousTime,
+			vectorizerCalledWith: &models.Object{
+				Class: cls,
+				Properties: map[string]interface{}{
+					"name": "My little pony zoo with extra sparkles",
+				},
+			},
+			expectedOutput: &MergeDocument{
+				UpdateTime: lastTime,
+				Class:      cls,
+				ID:         uuid,
+				Vector:     []float32{1, 2, 3},
+				PrimitiveSchema: map[string]interface{}{
+					"name": "My little pony zoo with extra sparkles",
+				},
+				IfMatch: &previousTime,
+			},
+			errMerge: NewErrPreconditionFailed("object %s has lastUpdateTimeUnix %d", uuid, lastTime),
+			wantCode: StatusPreconditionFailed,
+			stage:    stageCount,
+		},
 		{
 			name: "vectorization failure",
 			previous: &models.Object{
@@ -252,6 +309,54 @@ func Test_MergeObject(t *testing.T) {
 			},
 			stage: stageCount,
 		},
+		{
+			name: "increment property with operator",
+			previous: &models.Object{
+				Class:      cls,
+				Properties: map[string]interface{}{"employees": float64(10)},
+			},
+			updated: &models.Object{
+				Class: cls,
+				ID:    uuid,
+				Properties: map[string]interface{}{
+					"employees": map[string]interface{}{"$inc": json.Number("5")},
+				},
+			},
+			vectorizerCalledWith: &models.Object{
+				Class: cls,
+				Properties: map[string]interface{}{
+					"employees": float64(15),
+				},
+			},
+			expectedOutput: &MergeDocument{
+				UpdateTime:      lastTime,
+				Class:           cls,
+				ID:              uuid,
+				Vector:          []float32{1, 2, 3},
+				PrimitiveSchema: map[string]interface{}{},
+				PropertyOperations: map[string]PropertyOperation{
+					"employees": {
+						Operator: OperatorInc,
+						Value:    json.Number("5"),
+						DataType: schema.DataTypeInt,
+					},
+				},
+			},
+			stage: stageCount,
+		},
+		{
+			name:     "operator on a property of the wrong type",
+			previous: nil,
+			updated: &models.Object{
+				Class: cls,
+				ID:    uuid,
+				Properties: map[string]interface{}{
+					"name": map[string]interface{}{"$append": "more sparkles"},
+				},
+			},
+			wantCode: StatusBadRequest,
+			stage:    stageAuthorization,
+		},
 		{
 			name: "without properties",
 			previous: &models.Object{
@@ -437,6 +542,7 @@ func Test_MergeObject(t *testing.T) {
 						Schema:    tc.previous.Properties,
 						ClassName: tc.previous.Class,
 						Vector:    tc.previous.Vector,
+						Updated:   tc.previous.LastUpdateTimeUnix,
 					}, nil)
 			} else if tc.stage >= stageUpdateValidation {
 				m.repo.On("Object", cls, uuid, search.SelectProperties(nil), additional.Properties{}).
@@ -465,7 +571,7 @@ func Test_MergeObject(t *testing.T) {
 			// called during validation of cross-refs only.
 			m.repo.On("Exists", mock.Anything, mock.Anything).Maybe().Return(true, tc.errExists)
 
-			err := m.MergeObject(context.Background(), nil, tc.updated, nil)
+			err := m.MergeObject(context.Background(), nil, tc.updated, tc.ifMatch, nil)
 			code := 0
 			if err != nil {
 				code = err.Code
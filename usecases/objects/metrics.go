@@ -82,6 +82,14 @@ func (m *Metrics) BatchDeleteDec() {
 	m.queriesDec("batch_delete")
 }
 
+func (m *Metrics) BatchUpdateInc() {
+	m.queriesInc("batch_update")
+}
+
+func (m *Metrics) BatchUpdateDec() {
+	m.queriesDec("batch_update")
+}
+
 func (m *Metrics) AddObjectInc() {
 	m.queriesInc("add_object")
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorprecision"
 )
 
 func Test_VObject_MarshalBinary(t *testing.T) {
@@ -125,8 +126,9 @@ func Test_Replica_MarshalBinary(t *testing.T) {
 				"score": 0.055465422484,
 			},
 		},
-		Vector:    vec,
-		VectorLen: 5,
+		Vector:          vec,
+		VectorLen:       5,
+		VectorPrecision: vectorprecision.Float32,
 	}
 
 	t.Run("when object is present", func(t *testing.T) {
@@ -194,8 +196,9 @@ func Test_Replicas_MarshalBinary(t *testing.T) {
 				"score": 0.055465422484,
 			},
 		},
-		Vector:    vec1,
-		VectorLen: 5,
+		Vector:          vec1,
+		VectorLen:       5,
+		VectorPrecision: vectorprecision.Float32,
 	}
 
 	obj2 := storobj.Object{
@@ -219,8 +222,9 @@ func Test_Replicas_MarshalBinary(t *testing.T) {
 				"score": 0.055465422484,
 			},
 		},
-		Vector:    vec2,
-		VectorLen: 5,
+		Vector:          vec2,
+		VectorLen:       5,
+		VectorPrecision: vectorprecision.Float32,
 	}
 
 	t.Run("when objects are present", func(t *testing.T) {
@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -23,8 +24,10 @@ import (
 // UpdateObject updates object of class.
 // If the class contains a network ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
+// If ifMatch is set, the object is only updated if its lastUpdateTimeUnix
+// still equals ifMatch, otherwise ErrPreconditionFailed is returned.
 func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
-	class string, id strfmt.UUID, updates *models.Object,
+	class string, id strfmt.UUID, updates *models.Object, ifMatch *int64,
 	repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
 	path := fmt.Sprintf("objects/%s/%s", class, id)
@@ -45,11 +48,12 @@ func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.updateObjectToConnectorAndSchema(ctx, principal, class, id, updates, repl)
+	return m.updateObjectToConnectorAndSchema(ctx, principal, class, id, updates, ifMatch, repl)
 }
 
 func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context, principal *models.Principal,
-	className string, id strfmt.UUID, updates *models.Object, repl *additional.ReplicationProperties,
+	className string, id strfmt.UUID, updates *models.Object, ifMatch *int64,
+	repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
 	if id != updates.ID {
 		return nil, NewErrInvalidUserInput("invalid update: field 'id' is immutable")
@@ -60,6 +64,13 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context, principa
 		return nil, err
 	}
 
+	// fail early to avoid vectorizing an update which would be rejected anyway,
+	// the authoritative check happens atomically in the repo
+	if ifMatch != nil && *ifMatch != obj.Updated {
+		return nil, NewErrPreconditionFailed(
+			"object %s has lastUpdateTimeUnix %d, expected %d", id, obj.Updated, *ifMatch)
+	}
+
 	m.logger.
 		WithField("object", "kinds_update_requested").
 		WithField("original", obj).
@@ -88,8 +99,20 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context, principa
 		return nil, NewErrInternal("update object: %v", err)
 	}
 
-	err = m.vectorRepo.PutObject(ctx, updates, updates.Vector, repl)
+	if ifMatch != nil {
+		err = m.vectorRepo.PutObjectIfMatch(ctx, updates, updates.Vector, *ifMatch, repl)
+	} else {
+		err = m.vectorRepo.PutObject(ctx, updates, updates.Vector, repl)
+	}
 	if err != nil {
+		var errPrecondition ErrPreconditionFailed
+		if errors.As(err, &errPrecondition) {
+			return nil, errPrecondition
+		}
+		var errInvalid ErrInvalidUserInput
+		if errors.As(err, &errInvalid) {
+			return nil, errInvalid
+		}
 		return nil, NewErrInternal("put object: %v", err)
 	}
 
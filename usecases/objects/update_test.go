@@ -14,6 +14,7 @@ package objects
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -100,7 +101,7 @@ func Test_UpdateAction(t *testing.T) {
 			ID:         id,
 			Properties: map[string]interface{}{"foo": "baz"},
 		}
-		res, err := manager.UpdateObject(context.Background(), &models.Principal{}, "", id, payload, nil)
+		res, err := manager.UpdateObject(context.Background(), &models.Principal{}, "", id, payload, nil, nil)
 		require.Nil(t, err)
 		expected := &models.Object{
 			Class:            "ActionClass",
@@ -155,7 +156,7 @@ func Test_UpdateObject(t *testing.T) {
 	}
 	// the object might not exist
 	m.repo.On("Object", cls, id, mock.Anything, mock.Anything).Return(nil, anyErr).Once()
-	_, err := m.UpdateObject(context.Background(), &models.Principal{}, cls, id, payload, nil)
+	_, err := m.UpdateObject(context.Background(), &models.Principal{}, cls, id, payload, nil, nil)
 	if err == nil {
 		t.Fatalf("must return an error if object() fails")
 	}
@@ -179,7 +180,7 @@ func Test_UpdateObject(t *testing.T) {
 		CreationTimeUnix: beforeUpdate,
 		Vector:           vec,
 	}
-	res, err := m.UpdateObject(context.Background(), &models.Principal{}, cls, id, payload, nil)
+	res, err := m.UpdateObject(context.Background(), &models.Principal{}, cls, id, payload, nil, nil)
 	require.Nil(t, err)
 	if res.LastUpdateTimeUnix <= beforeUpdate {
 		t.Error("time after update must be greater than time before update ")
@@ -187,3 +188,82 @@ func Test_UpdateObject(t *testing.T) {
 	res.LastUpdateTimeUnix = 0 // to allow for equality
 	assert.Equal(t, expected, res)
 }
+
+func Test_UpdateObjectIfMatch(t *testing.T) {
+	var (
+		cls     = "MyClass"
+		id      = strfmt.UUID("34e9df15-0c3b-468d-ab99-f929662834c7")
+		updated = int64(1000)
+		vec     = []float32{0, 1, 2}
+	)
+
+	schema := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             cls,
+					VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+					Properties: []*models.Property{
+						{
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWhitespace,
+							Name:         "foo",
+						},
+					},
+				},
+			},
+		},
+	}
+	result := &search.Result{
+		ID:        id,
+		ClassName: cls,
+		Schema:    map[string]interface{}{"foo": "bar"},
+		Created:   updated,
+		Updated:   updated,
+	}
+	payload := func() *models.Object {
+		return &models.Object{
+			Class:      cls,
+			ID:         id,
+			Properties: map[string]interface{}{"foo": "baz"},
+		}
+	}
+	ifMatch := func(v int64) *int64 { return &v }
+
+	t.Run("stale version is rejected before writing", func(t *testing.T) {
+		m := newFakeGetManager(schema)
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything).Return(result, nil).Once()
+
+		_, err := m.UpdateObject(context.Background(), &models.Principal{}, cls, id,
+			payload(), ifMatch(updated-1), nil)
+		assert.IsType(t, ErrPreconditionFailed{}, err)
+		m.repo.AssertNotCalled(t, "PutObjectIfMatch", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("matching version is passed to the repo", func(t *testing.T) {
+		m := newFakeGetManager(schema)
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything).Return(result, nil).Once()
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(vec, nil)
+		m.repo.On("PutObjectIfMatch", mock.Anything, mock.Anything, updated).Return(nil).Once()
+
+		_, err := m.UpdateObject(context.Background(), &models.Principal{}, cls, id,
+			payload(), ifMatch(updated), nil)
+		require.Nil(t, err)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("concurrent update detected by the repo", func(t *testing.T) {
+		m := newFakeGetManager(schema)
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything).Return(result, nil).Once()
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(vec, nil)
+		m.repo.On("PutObjectIfMatch", mock.Anything, mock.Anything, updated).
+			Return(fmt.Errorf("import into index: %w",
+				NewErrPreconditionFailed("object %s has lastUpdateTimeUnix 1001", id))).Once()
+
+		_, err := m.UpdateObject(context.Background(), &models.Principal{}, cls, id,
+			payload(), ifMatch(updated), nil)
+		assert.IsType(t, ErrPreconditionFailed{}, err)
+	})
+}
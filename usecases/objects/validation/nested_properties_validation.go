@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// nestedVal validates the value of a property of data type object or
+// object[] against its nested properties. Nested values keep their JSON
+// representation, apart from numbers which are parsed like top-level numbers.
+func nestedVal(val interface{}, dataType schema.DataType,
+	nps []*models.NestedProperty,
+) (interface{}, error) {
+	if dataType == schema.DataTypeObjectArray {
+		return nestedObjectArrayVal(val, nps)
+	}
+	return nestedObjectVal(val, nps)
+}
+
+func nestedObjectVal(val interface{}, nps []*models.NestedProperty) (map[string]interface{}, error) {
+	typed, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("not an object, but %T", val)
+	}
+
+	out := make(map[string]interface{}, len(typed))
+	for key, value := range typed {
+		if value == nil {
+			continue // nil values are removed and filtered out
+		}
+
+		// like properties, nested properties are saved with lower case first
+		// letter
+		name := schema.LowercaseFirstLetter(key)
+		np := findNestedProperty(nps, name)
+		if np == nil {
+			return nil, fmt.Errorf("no such nested property '%s'", name)
+		}
+
+		data, err := nestedPropertyVal(value, np)
+		if err != nil {
+			return nil, fmt.Errorf("nested property '%s': %w", name, err)
+		}
+		out[name] = data
+	}
+
+	return out, nil
+}
+
+func nestedObjectArrayVal(val interface{}, nps []*models.NestedProperty) ([]interface{}, error) {
+	typed, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("not an object array, but %T", val)
+	}
+
+	out := make([]interface{}, len(typed))
+	for i := range typed {
+		data, err := nestedObjectVal(typed[i], nps)
+		if err != nil {
+			return nil, fmt.Errorf("at pos %d: %w", i, err)
+		}
+		out[i] = data
+	}
+
+	return out, nil
+}
+
+func nestedPropertyVal(val interface{}, np *models.NestedProperty) (interface{}, error) {
+	if dt, ok := schema.AsNested(np.DataType); ok {
+		return nestedVal(val, dt, np.NestedProperties)
+	}
+
+	dataType, _ := schema.AsPrimitive(np.DataType)
+	switch dataType {
+	case schema.DataTypeText:
+		return stringVal(val)
+	case schema.DataTypeInt:
+		return intVal(val)
+	case schema.DataTypeNumber:
+		return numberVal(val)
+	case schema.DataTypeBoolean:
+		return boolVal(val)
+	case schema.DataTypeDate:
+		if _, err := dateVal(val); err != nil {
+			return nil, err
+		}
+		return val, nil
+	case schema.DataTypeUUID:
+		asStr, err := stringVal(val)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := uuid.Parse(asStr); err != nil {
+			return nil, err
+		}
+		return asStr, nil
+	case schema.DataTypeTextArray:
+		return stringArrayVal(val, "text")
+	case schema.DataTypeIntArray:
+		return intArrayVal(val)
+	case schema.DataTypeNumberArray:
+		return numberArrayVal(val)
+	case schema.DataTypeBooleanArray:
+		return boolArrayVal(val)
+	case schema.DataTypeDateArray:
+		return dateArrayVal(val)
+	case schema.DataTypeUUIDArray:
+		if _, err := ParseUUIDArray(val); err != nil {
+			return nil, err
+		}
+		return val, nil
+	default:
+		return nil, fmt.Errorf("unrecognized data type '%v'", np.DataType)
+	}
+}
+
+func findNestedProperty(nps []*models.NestedProperty, name string) *models.NestedProperty {
+	for _, np := range nps {
+		if np.Name == name {
+			return np
+		}
+	}
+	return nil
+}
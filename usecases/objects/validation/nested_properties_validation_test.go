@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestValidator_NestedProperties(t *testing.T) {
+	class := &models.Class{
+		Class: "Person",
+		Properties: []*models.Property{
+			{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+					{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+					{Name: "movedIn", DataType: schema.DataTypeDate.PropString()},
+				},
+			},
+			{
+				Name:     "pets",
+				DataType: schema.DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+					{Name: "weights", DataType: schema.DataTypeNumberArray.PropString()},
+					{
+						Name:     "vet",
+						DataType: schema.DataTypeObject.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "id", DataType: schema.DataTypeUUID.PropString()},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	validate := func(props map[string]interface{}) (interface{}, error) {
+		obj := &models.Object{Class: "Person", Properties: props}
+		err := (&Validator{}).properties(context.Background(), obj, class)
+		return obj.Properties, err
+	}
+
+	t.Run("valid nested values", func(t *testing.T) {
+		props, err := validate(map[string]interface{}{
+			"address": map[string]interface{}{
+				"city":    "Amsterdam",
+				"zip":     json.Number("1011"),
+				"movedIn": "2021-04-01T00:00:00Z",
+				"country": nil,
+			},
+			"pets": []interface{}{
+				map[string]interface{}{
+					"name":    "Rex",
+					"weights": []interface{}{json.Number("10.5"), json.Number("12")},
+					"vet": map[string]interface{}{
+						"id": "2b0b5bd5-ad40-4d5f-9c1a-8c0b5a6b5f1e",
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"address": map[string]interface{}{
+				"city":    "Amsterdam",
+				"zip":     int64(1011),
+				"movedIn": "2021-04-01T00:00:00Z",
+			},
+			"pets": []interface{}{
+				map[string]interface{}{
+					"name":    "Rex",
+					"weights": []interface{}{json.Number("10.5"), json.Number("12")},
+					"vet": map[string]interface{}{
+						"id": "2b0b5bd5-ad40-4d5f-9c1a-8c0b5a6b5f1e",
+					},
+				},
+			},
+		}, props)
+	})
+
+	t.Run("nested keys with an uppercase first letter", func(t *testing.T) {
+		props, err := validate(map[string]interface{}{
+			"address": map[string]interface{}{
+				"City":    "Amsterdam",
+				"MovedIn": "2021-04-01T00:00:00Z",
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"address": map[string]interface{}{
+				"city":    "Amsterdam",
+				"movedIn": "2021-04-01T00:00:00Z",
+			},
+		}, props)
+	})
+
+	tests := []struct {
+		name        string
+		props       map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name:        "object is not a map",
+			props:       map[string]interface{}{"address": "Main Street"},
+			expectedErr: "invalid object property 'address' on class 'Person': not an object, but string",
+		},
+		{
+			name:        "object array is not an array",
+			props:       map[string]interface{}{"pets": map[string]interface{}{"name": "Rex"}},
+			expectedErr: "invalid object[] property 'pets' on class 'Person': not an object array, but map[string]interface {}",
+		},
+		{
+			name: "unknown nested property",
+			props: map[string]interface{}{
+				"address": map[string]interface{}{"street": "Main Street"},
+			},
+			expectedErr: "invalid object property 'address' on class 'Person': no such nested property 'street'",
+		},
+		{
+			name: "wrong nested type",
+			props: map[string]interface{}{
+				"address": map[string]interface{}{"zip": "1011AB"},
+			},
+			expectedErr: "invalid object property 'address' on class 'Person': nested property 'zip': " +
+				"requires an integer, the given value is '1011AB'",
+		},
+		{
+			name: "invalid deeply nested value",
+			props: map[string]interface{}{
+				"pets": []interface{}{
+					map[string]interface{}{"name": "Rex"},
+					map[string]interface{}{"vet": map[string]interface{}{"id": "not-a-uuid"}},
+				},
+			},
+			expectedErr: "invalid object[] property 'pets' on class 'Person': at pos 1: " +
+				"nested property 'vet': nested property 'id': invalid UUID length: 10",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := validate(test.props)
+			assert.EqualError(t, err, test.expectedErr)
+		})
+	}
+}
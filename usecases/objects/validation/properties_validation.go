@@ -77,9 +77,22 @@ func (v *Validator) properties(ctx context.Context, object interface{}, class *m
 			return err
 		}
 
-		data, err := v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
-		if err != nil {
-			return err
+		var data interface{}
+		if nestedDataType, ok := schema.AsNested(dataType.PropString()); ok {
+			prop, err := schema.GetPropertyByName(class, propertyKeyLowerCase)
+			if err != nil {
+				return err
+			}
+			data, err = nestedVal(propertyValue, nestedDataType, prop.NestedProperties)
+			if err != nil {
+				return fmt.Errorf("invalid %s property '%s' on class '%s': %s",
+					nestedDataType, propertyKeyLowerCase, className, err)
+			}
+		} else {
+			data, err = v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
+			if err != nil {
+				return err
+			}
 		}
 
 		returnSchema[propertyKeyLowerCase] = data
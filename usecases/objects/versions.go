@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// ObjectVersion is an entry of the version history of an object. Object is
+// nil if the version marks the deletion of the object.
+type ObjectVersion struct {
+	// Version is the lastUpdateTimeUnix of the object or the time of the
+	// deletion
+	Version int64
+	Deleted bool
+	Object  *search.Result
+}
+
+// StoredObjectVersion is an entry of the version history of an object as it
+// is stored in a shard, it is used to read the history from other nodes.
+// Object is nil if the version marks the deletion of the object.
+type StoredObjectVersion struct {
+	Version int64
+	Deleted bool
+	Object  *storobj.Object
+}
+
+// GetObjectVersions returns the version history of an object, newest first
+func (m *Manager) GetObjectVersions(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, additional additional.Properties,
+) ([]*models.ObjectVersion, error) {
+	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("objects/%s/%s", class, id))
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	m.metrics.GetObjectInc()
+	defer m.metrics.GetObjectDec()
+
+	versions, err := m.vectorRepo.ObjectVersions(ctx, class, id, additional)
+	if err != nil {
+		return nil, NewErrInternal("repo: object versions: %v", err)
+	}
+	if len(versions) == 0 {
+		return nil, NewErrNotFound("no object with id '%s'", id)
+	}
+
+	out := make([]*models.ObjectVersion, len(versions))
+	for i, version := range versions {
+		out[i] = &models.ObjectVersion{
+			Version: version.Version,
+			Deleted: version.Deleted,
+		}
+		if version.Object != nil {
+			out[i].Object = version.Object.ObjectWithVector(additional.Vector)
+		}
+	}
+
+	return out, nil
+}
+
+// GetObjectAsOf returns the version of an object which was current at asOf,
+// given in ms since epoch
+func (m *Manager) GetObjectAsOf(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, asOf int64, additional additional.Properties,
+) (*models.Object, error) {
+	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("objects/%s/%s", class, id))
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	m.metrics.GetObjectInc()
+	defer m.metrics.GetObjectDec()
+
+	res, err := m.vectorRepo.ObjectAsOf(ctx, class, id, asOf, additional)
+	if err != nil {
+		return nil, NewErrInternal("repo: object as of %d: %v", asOf, err)
+	}
+	if res == nil {
+		return nil, NewErrNotFound("no object with id '%s' at %d", id, asOf)
+	}
+
+	return res.ObjectWithVector(additional.Vector), nil
+}
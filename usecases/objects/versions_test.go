@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_GetObjectVersions(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	id := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, &fakeSchemaManager{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, vectorRepo,
+			nil, &fakeMetrics{})
+	}
+
+	t.Run("list versions of an object", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ObjectVersions", "Foo", id, additional.Properties{}).
+			Return([]ObjectVersion{
+				{Version: 3, Deleted: true},
+				{Version: 2, Object: &search.Result{
+					ClassName: "Foo",
+					ID:        id,
+					Schema:    map[string]interface{}{"name": "second"},
+					Updated:   2,
+					Vector:    []float32{1, 2},
+				}},
+			}, nil).Once()
+
+		versions, err := manager.GetObjectVersions(context.Background(),
+			&models.Principal{}, "Foo", id, additional.Properties{})
+		require.Nil(t, err)
+
+		require.Len(t, versions, 2)
+		assert.Equal(t, &models.ObjectVersion{Version: 3, Deleted: true}, versions[0])
+		assert.Equal(t, int64(2), versions[1].Version)
+		assert.False(t, versions[1].Deleted)
+		require.NotNil(t, versions[1].Object)
+		assert.Equal(t, "second", versions[1].Object.Properties.(map[string]interface{})["name"])
+		assert.Nil(t, versions[1].Object.Vector, "vector is only included if requested")
+	})
+
+	t.Run("list versions of a non-existing object", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ObjectVersions", "Foo", id, additional.Properties{}).
+			Return(nil, nil).Once()
+
+		_, err := manager.GetObjectVersions(context.Background(),
+			&models.Principal{}, "Foo", id, additional.Properties{})
+		assert.Equal(t, NewErrNotFound("no object with id '%s'", id), err)
+	})
+
+	t.Run("get object as of a point in time", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ObjectAsOf", "Foo", id, int64(2), additional.Properties{Vector: true}).
+			Return(&search.Result{
+				ClassName: "Foo",
+				ID:        id,
+				Updated:   2,
+				Vector:    []float32{1, 2},
+			}, nil).Once()
+
+		obj, err := manager.GetObjectAsOf(context.Background(),
+			&models.Principal{}, "Foo", id, 2, additional.Properties{Vector: true})
+		require.Nil(t, err)
+		assert.Equal(t, id, obj.ID)
+		assert.Equal(t, int64(2), obj.LastUpdateTimeUnix)
+		assert.Equal(t, models.C11yVector{1, 2}, obj.Vector)
+	})
+
+	t.Run("get object before it was created", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ObjectAsOf", "Foo", id, int64(1), additional.Properties{}).
+			Return(nil, nil).Once()
+
+		_, err := manager.GetObjectAsOf(context.Background(),
+			&models.Principal{}, "Foo", id, 1, additional.Properties{})
+		assert.Equal(t, NewErrNotFound("no object with id '%s' at %d", id, 1), err)
+	})
+}
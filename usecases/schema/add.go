@@ -230,6 +230,7 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 	}
 
 	setInvertedConfigDefaults(class)
+	setObjectTTLConfigDefaults(class)
 	for _, prop := range class.Properties {
 		m.setPropertyDefaults(prop)
 	}
@@ -240,6 +241,21 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 func (m *Manager) setPropertyDefaults(prop *models.Property) {
 	m.setPropertyDefaultTokenization(prop)
 	m.setPropertyDefaultIndexing(prop)
+	m.setNestedPropertiesDefaults(prop.NestedProperties)
+}
+
+func (m *Manager) setNestedPropertiesDefaults(nps []*models.NestedProperty) {
+	for _, np := range nps {
+		switch dataType, _ := schema.AsPrimitive(np.DataType); dataType {
+		case schema.DataTypeText, schema.DataTypeTextArray:
+			if np.Tokenization == "" {
+				np.Tokenization = models.NestedPropertyTokenizationWord
+			}
+		default:
+			// tokenization not supported for other data types
+		}
+		m.setNestedPropertiesDefaults(np.NestedProperties)
+	}
 }
 
 func (m *Manager) setPropertyDefaultTokenization(prop *models.Property) {
@@ -357,7 +373,7 @@ func (m *Manager) validateCanAddClass(
 
 	existingPropertyNames := map[string]bool{}
 	for _, property := range class.Properties {
-		if err := m.validateProperty(property, class.Class, existingPropertyNames, relaxCrossRefValidation); err != nil {
+		if err := m.validateProperty(property, class, existingPropertyNames, relaxCrossRefValidation); err != nil {
 			return err
 		}
 		existingPropertyNames[strings.ToLower(property.Name)] = true
@@ -375,6 +391,14 @@ func (m *Manager) validateCanAddClass(
 		return err
 	}
 
+	if err := validateObjectTTLConfig(class); err != nil {
+		return err
+	}
+
+	if err := validateObjectVersioningConfig(class); err != nil {
+		return err
+	}
+
 	if class.ShardingConfig != nil && class.MultiTenancyConfig != nil {
 		return fmt.Errorf("cannot have both shardingConfig and multiTenancyConfig")
 	}
@@ -384,9 +408,11 @@ func (m *Manager) validateCanAddClass(
 }
 
 func (m *Manager) validateProperty(
-	property *models.Property, className string,
+	property *models.Property, class *models.Class,
 	existingPropertyNames map[string]bool, relaxCrossRefValidation bool,
 ) error {
+	className := class.Class
+
 	if _, err := schema.ValidatePropertyName(property.Name); err != nil {
 		return err
 	}
@@ -412,10 +438,22 @@ func (m *Manager) validateProperty(
 		return err
 	}
 
+	if err := m.validatePropertyAnalyzer(property, class, propertyDataType); err != nil {
+		return err
+	}
+
+	if err := m.validatePropertyStopwordsPreset(property, propertyDataType); err != nil {
+		return err
+	}
+
 	if err := m.validatePropertyIndexing(property); err != nil {
 		return err
 	}
 
+	if err := m.validateNestedProperties(property, propertyDataType); err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
@@ -54,7 +54,7 @@ func (m *Manager) addClassProperty(ctx context.Context,
 	if err := m.setNewPropDefaults(class, prop); err != nil {
 		return err
 	}
-	if err := m.validateProperty(prop, className, existingPropertyNames, false); err != nil {
+	if err := m.validateProperty(prop, class, existingPropertyNames, false); err != nil {
 		return err
 	}
 	// migrate only after validation in completed
@@ -84,6 +84,12 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/objects",
 		},
+		{
+			methodName:       "MergeClassObjectProperty",
+			additionalArgs:   []interface{}{"somename", &models.Property{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
 		{
 			methodName:       "DeleteClassProperty",
 			additionalArgs:   []interface{}{"somename", "someprop"},
@@ -25,6 +25,8 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleAddClassCommit(ctx, tx)
 	case AddProperty:
 		return m.handleAddPropertyCommit(ctx, tx)
+	case MergeObjectProperty:
+		return m.handleMergeObjectPropertyCommit(ctx, tx)
 	case DeleteClass:
 		return m.handleDeleteClassCommit(ctx, tx)
 	case UpdateClass:
@@ -102,6 +104,21 @@ func (m *Manager) handleAddPropertyCommit(ctx context.Context,
 	return m.addClassPropertyApplyChanges(ctx, pl.ClassName, pl.Property)
 }
 
+func (m *Manager) handleMergeObjectPropertyCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(MergeObjectPropertyPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be MergeObjectPropertyPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.mergeClassObjectPropertyApplyChanges(ctx, pl.ClassName, pl.Property)
+}
+
 func (m *Manager) handleDeleteClassCommit(ctx context.Context,
 	tx *cluster.Transaction,
 ) error {
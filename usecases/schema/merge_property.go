@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// MergeClassObjectProperty adds the nested properties of prop to the
+// existing property of data type object or object[] of the same name.
+// Nested properties which already exist are left as they are.
+func (m *Manager) MergeClassObjectProperty(ctx context.Context, principal *models.Principal,
+	class string, prop *models.Property,
+) error {
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	return m.mergeClassObjectProperty(ctx, class, prop)
+}
+
+func (m *Manager) mergeClassObjectProperty(ctx context.Context,
+	className string, prop *models.Property,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	class, err := schema.GetClassByName(m.state.ObjectSchema, className)
+	if err != nil {
+		return err
+	}
+
+	var existing *models.Property
+	for _, p := range class.Properties {
+		if p.Name == schema.LowercaseFirstLetter(prop.Name) {
+			existing = p
+			break
+		}
+	}
+	if existing == nil {
+		return fmt.Errorf(schema.ErrorNoSuchProperty, prop.Name, className)
+	}
+	if _, ok := schema.AsNested(existing.DataType); !ok {
+		return fmt.Errorf("property '%s' is not of data type object or object[]",
+			existing.Name)
+	}
+
+	m.setNestedPropertiesDefaults(prop.NestedProperties)
+	merged, added := schema.MergeNestedProperties(existing.NestedProperties,
+		prop.NestedProperties)
+	if !added {
+		return nil
+	}
+
+	updated := *existing
+	updated.NestedProperties = merged
+	if err := m.validateNestedPropertiesOf(updated.Name, updated.NestedProperties); err != nil {
+		return err
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, MergeObjectProperty,
+		MergeObjectPropertyPayload{className, &updated}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally, see
+		// addClassProperty
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.mergeClassObjectPropertyApplyChanges(ctx, className, &updated)
+}
+
+// mergeClassObjectPropertyApplyChanges replaces the property with the merged
+// one and creates the indexes of its new nested properties
+func (m *Manager) mergeClassObjectPropertyApplyChanges(ctx context.Context,
+	className string, prop *models.Property,
+) error {
+	class, err := schema.GetClassByName(m.state.ObjectSchema, className)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i := range class.Properties {
+		if class.Properties[i].Name == prop.Name {
+			class.Properties[i] = prop
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf(schema.ErrorNoSuchProperty, prop.Name, className)
+	}
+
+	if err := m.saveSchema(ctx); err != nil {
+		return err
+	}
+
+	// creating the indexes of a property is idempotent, the indexes of the
+	// existing nested properties are loaded as they are
+	return m.migrator.AddProperty(ctx, className, prop)
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestMergeClassObjectProperty(t *testing.T) {
+	ctx := context.Background()
+	mgr := newSchemaManager()
+	err := mgr.AddClass(ctx, nil, &models.Class{
+		Class: "Person",
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString()},
+			{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	t.Run("new nested property is added", func(t *testing.T) {
+		err := mgr.MergeClassObjectProperty(ctx, nil, "Person", &models.Property{
+			Name:     "address",
+			DataType: schema.DataTypeObject.PropString(),
+			NestedProperties: []*models.NestedProperty{
+				{Name: "city", DataType: schema.DataTypeText.PropString()},
+				{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+			},
+		})
+		require.Nil(t, err)
+
+		class, err := mgr.GetClass(ctx, nil, "Person")
+		require.Nil(t, err)
+		nps := class.Properties[1].NestedProperties
+		require.Len(t, nps, 2)
+		assert.Equal(t, "city", nps[0].Name)
+		assert.Equal(t, "zip", nps[1].Name)
+	})
+
+	t.Run("invalid nested property", func(t *testing.T) {
+		err := mgr.MergeClassObjectProperty(ctx, nil, "Person", &models.Property{
+			Name:     "address",
+			DataType: schema.DataTypeObject.PropString(),
+			NestedProperties: []*models.NestedProperty{
+				{Name: "Street", DataType: schema.DataTypeText.PropString()},
+			},
+		})
+		assert.EqualError(t, err, "nested property 'address.Street': nested property "+
+			"names must not start with an uppercase letter")
+	})
+
+	t.Run("property which is not an object", func(t *testing.T) {
+		err := mgr.MergeClassObjectProperty(ctx, nil, "Person", &models.Property{
+			Name:     "name",
+			DataType: schema.DataTypeObject.PropString(),
+			NestedProperties: []*models.NestedProperty{
+				{Name: "first", DataType: schema.DataTypeText.PropString()},
+			},
+		})
+		assert.EqualError(t, err, "property 'name' is not of data type object or object[]")
+	})
+}
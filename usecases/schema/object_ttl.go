@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func setObjectTTLConfigDefaults(class *models.Class) {
+	if class.ObjectTTLConfig == nil {
+		return
+	}
+
+	if class.ObjectTTLConfig.DeleteOn == "" {
+		class.ObjectTTLConfig.DeleteOn = filters.InternalPropCreationTimeUnix
+	}
+}
+
+// validateObjectTTLConfig makes sure that expired objects of the class can be
+// found through the inverted index, as this is how the shards look for
+// objects to delete. An empty deleteOn is validated as the creation time, so
+// the result does not depend on whether the defaults were set yet.
+func validateObjectTTLConfig(class *models.Class) error {
+	cfg := class.ObjectTTLConfig
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	if cfg.DefaultTTL < 0 {
+		return fmt.Errorf("objectTtlConfig.defaultTtl must not be negative, got %d",
+			cfg.DefaultTTL)
+	}
+
+	if cfg.DeleteOn == "" || cfg.DeleteOn == filters.InternalPropCreationTimeUnix {
+		if cfg.DefaultTTL == 0 {
+			return fmt.Errorf("objectTtlConfig.defaultTtl must be set when deleting "+
+				"on %q", filters.InternalPropCreationTimeUnix)
+		}
+		if class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexTimestamps {
+			return fmt.Errorf("objectTtlConfig.deleteOn %q requires "+
+				"invertedIndexConfig.indexTimestamps to be enabled",
+				filters.InternalPropCreationTimeUnix)
+		}
+		return nil
+	}
+
+	prop, err := schema.GetPropertyByName(class, cfg.DeleteOn)
+	if err != nil {
+		return fmt.Errorf("objectTtlConfig.deleteOn: %w", err)
+	}
+	if dt, ok := schema.AsPrimitive(prop.DataType); !ok || dt != schema.DataTypeDate {
+		return fmt.Errorf("objectTtlConfig.deleteOn: property %q must be of data type %q",
+			prop.Name, schema.DataTypeDate)
+	}
+	if prop.IndexFilterable != nil && !*prop.IndexFilterable {
+		return fmt.Errorf("objectTtlConfig.deleteOn: property %q must be filterable",
+			prop.Name)
+	}
+
+	return nil
+}
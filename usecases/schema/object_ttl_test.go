@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_Validation_ObjectTTLConfig(t *testing.T) {
+	vFalse := false
+	class := func(cfg *models.ObjectTTLConfig, indexTimestamps bool) *models.Class {
+		c := &models.Class{
+			Class: "Session",
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				IndexTimestamps: indexTimestamps,
+			},
+			Properties: []*models.Property{
+				{Name: "expiresAt", DataType: []string{"date"}},
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "unindexed", DataType: []string{"date"}, IndexFilterable: &vFalse},
+			},
+			ObjectTTLConfig: cfg,
+		}
+		setObjectTTLConfigDefaults(c)
+		return c
+	}
+
+	tests := []struct {
+		name        string
+		class       *models.Class
+		expectedErr string
+	}{
+		{
+			name:  "no config",
+			class: class(nil, false),
+		},
+		{
+			name:  "disabled config is not validated",
+			class: class(&models.ObjectTTLConfig{DefaultTTL: -1}, false),
+		},
+		{
+			name:  "ttl on creation time",
+			class: class(&models.ObjectTTLConfig{Enabled: true, DefaultTTL: 3600}, true),
+		},
+		{
+			name:  "expiry marked by date property",
+			class: class(&models.ObjectTTLConfig{Enabled: true, DeleteOn: "expiresAt"}, false),
+		},
+		{
+			name: "empty deleteOn without defaults",
+			class: &models.Class{
+				Class:               "Session",
+				InvertedIndexConfig: &models.InvertedIndexConfig{IndexTimestamps: true},
+				ObjectTTLConfig:     &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 3600},
+			},
+		},
+		{
+			name:        "negative ttl",
+			class:       class(&models.ObjectTTLConfig{Enabled: true, DefaultTTL: -1}, true),
+			expectedErr: "objectTtlConfig.defaultTtl must not be negative, got -1",
+		},
+		{
+			name:        "missing ttl on creation time",
+			class:       class(&models.ObjectTTLConfig{Enabled: true}, true),
+			expectedErr: "objectTtlConfig.defaultTtl must be set when deleting on \"_creationTimeUnix\"",
+		},
+		{
+			name:  "creation time not indexed",
+			class: class(&models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60}, false),
+			expectedErr: "objectTtlConfig.deleteOn \"_creationTimeUnix\" requires " +
+				"invertedIndexConfig.indexTimestamps to be enabled",
+		},
+		{
+			name:        "unknown property",
+			class:       class(&models.ObjectTTLConfig{Enabled: true, DeleteOn: "foo"}, false),
+			expectedErr: "objectTtlConfig.deleteOn: no such prop with name 'foo' found in class 'Session' in the schema. Check your schema files for which properties in this class are available",
+		},
+		{
+			name:        "property not of data type date",
+			class:       class(&models.ObjectTTLConfig{Enabled: true, DeleteOn: "title"}, false),
+			expectedErr: "objectTtlConfig.deleteOn: property \"title\" must be of data type \"date\"",
+		},
+		{
+			name:        "property not filterable",
+			class:       class(&models.ObjectTTLConfig{Enabled: true, DeleteOn: "unindexed"}, false),
+			expectedErr: "objectTtlConfig.deleteOn: property \"unindexed\" must be filterable",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateObjectTTLConfig(test.class)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func Test_UpdateClass_ObjectTTLConfig(t *testing.T) {
+	class := func(cfg *models.ObjectTTLConfig) *models.Class {
+		return &models.Class{
+			Class:               "Session",
+			InvertedIndexConfig: &models.InvertedIndexConfig{IndexTimestamps: true},
+			ObjectTTLConfig:     cfg,
+		}
+	}
+
+	sm := newSchemaManager()
+	require.Nil(t, sm.AddClass(context.Background(), nil, class(nil)))
+
+	t.Run("enable ttl without deleteOn", func(t *testing.T) {
+		err := sm.UpdateClass(context.Background(), nil, "Session",
+			class(&models.ObjectTTLConfig{Enabled: true, DefaultTTL: 3600}))
+		require.Nil(t, err)
+
+		cfg := sm.getClassByName("Session").ObjectTTLConfig
+		require.NotNil(t, cfg)
+		assert.Equal(t, filters.InternalPropCreationTimeUnix, cfg.DeleteOn)
+		assert.Equal(t, int64(3600), cfg.DefaultTTL)
+	})
+
+	t.Run("enable ttl without a default ttl", func(t *testing.T) {
+		err := sm.UpdateClass(context.Background(), nil, "Session",
+			class(&models.ObjectTTLConfig{Enabled: true}))
+		assert.EqualError(t, err, "objectTtlConfig.defaultTtl must be set when deleting "+
+			"on \"_creationTimeUnix\"")
+	})
+}
@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// validateObjectVersioningConfig makes sure that the history of an object is
+// bounded, as every write of an object adds a version to it
+func validateObjectVersioningConfig(class *models.Class) error {
+	cfg := class.ObjectVersioningConfig
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	if cfg.MaxVersions < 0 {
+		return fmt.Errorf("objectVersioningConfig.maxVersions must not be negative, got %d",
+			cfg.MaxVersions)
+	}
+
+	if cfg.MaxAgeHours < 0 {
+		return fmt.Errorf("objectVersioningConfig.maxAgeHours must not be negative, got %d",
+			cfg.MaxAgeHours)
+	}
+
+	if cfg.MaxVersions == 0 && cfg.MaxAgeHours == 0 {
+		return fmt.Errorf("objectVersioningConfig requires maxVersions or maxAgeHours to be set")
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_Validation_ObjectVersioningConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         *models.ObjectVersioningConfig
+		expectedErr string
+	}{
+		{
+			name: "no config",
+		},
+		{
+			name: "disabled config is not validated",
+			cfg:  &models.ObjectVersioningConfig{MaxVersions: -1},
+		},
+		{
+			name: "max versions",
+			cfg:  &models.ObjectVersioningConfig{Enabled: true, MaxVersions: 10},
+		},
+		{
+			name: "max age",
+			cfg:  &models.ObjectVersioningConfig{Enabled: true, MaxAgeHours: 24},
+		},
+		{
+			name:        "negative max versions",
+			cfg:         &models.ObjectVersioningConfig{Enabled: true, MaxVersions: -1},
+			expectedErr: "objectVersioningConfig.maxVersions must not be negative, got -1",
+		},
+		{
+			name:        "negative max age",
+			cfg:         &models.ObjectVersioningConfig{Enabled: true, MaxVersions: 1, MaxAgeHours: -1},
+			expectedErr: "objectVersioningConfig.maxAgeHours must not be negative, got -1",
+		},
+		{
+			name:        "unbounded history",
+			cfg:         &models.ObjectVersioningConfig{Enabled: true},
+			expectedErr: "objectVersioningConfig requires maxVersions or maxAgeHours to be set",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateObjectVersioningConfig(&models.Class{
+				Class:                  "Article",
+				ObjectVersioningConfig: test.cfg,
+			})
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
@@ -195,6 +195,10 @@ func (ccc *classConfigComparison) diff() []string {
 		ccc.right.InvertedIndexConfig, "inverted index config")
 	ccc.compare(ccc.left.ModuleConfig,
 		ccc.right.ModuleConfig, "module config")
+	ccc.compare(ccc.left.ObjectTTLConfig,
+		ccc.right.ObjectTTLConfig, "object ttl config")
+	ccc.compare(ccc.left.ObjectVersioningConfig,
+		ccc.right.ObjectVersioningConfig, "object versioning config")
 	ccc.compare(ccc.left.ReplicationConfig,
 		ccc.right.ReplicationConfig, "replication config")
 	ccc.compare(ccc.left.ShardingConfig,
@@ -25,6 +25,8 @@ const (
 	// write-only
 	AddClass    cluster.TransactionType = "add_class"
 	AddProperty cluster.TransactionType = "add_property"
+	// MergeObjectProperty adds nested properties to an object property
+	MergeObjectProperty cluster.TransactionType = "merge_object_property"
 	// AddPartitions to a specific class
 	AddPartitions cluster.TransactionType = "add_partitions"
 
@@ -47,6 +49,11 @@ type AddPropertyPayload struct {
 	Property  *models.Property `json:"property"`
 }
 
+type MergeObjectPropertyPayload struct {
+	ClassName string           `json:"className"`
+	Property  *models.Property `json:"property"`
+}
+
 // Partition represents properties of a specific partition (physical shard)
 type Partition struct {
 	Name  string   `json:"name"`
@@ -86,6 +93,8 @@ func UnmarshalTransaction(txType cluster.TransactionType,
 		return unmarshalRawJson[AddClassPayload](payload)
 	case AddProperty:
 		return unmarshalRawJson[AddPropertyPayload](payload)
+	case MergeObjectProperty:
+		return unmarshalRawJson[MergeObjectPropertyPayload](payload)
 	case DeleteClass:
 		return unmarshalRawJson[DeleteClassPayload](payload)
 	case UpdateClass:
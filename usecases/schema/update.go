@@ -47,6 +47,14 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
+	if err := validateObjectTTLConfig(updated); err != nil {
+		return err
+	}
+
+	if err := validateObjectVersioningConfig(updated); err != nil {
+		return err
+	}
+
 	if err := m.parseVectorIndexConfig(ctx, updated); err != nil {
 		return err
 	}
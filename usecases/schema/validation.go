@@ -17,6 +17,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -58,7 +59,8 @@ func (m *Manager) validatePropertyTokenization(tokenization string, propertyData
 		case schema.DataTypeText, schema.DataTypeTextArray:
 			switch tokenization {
 			case models.PropertyTokenizationField, models.PropertyTokenizationWord,
-				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase:
+				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+				models.PropertyTokenizationTrigram, models.PropertyTokenizationCjk:
 				return nil
 			}
 		default:
@@ -73,9 +75,74 @@ func (m *Manager) validatePropertyTokenization(tokenization string, propertyData
 	if tokenization == "" {
 		return nil
 	}
+	if propertyDataType.IsNested() {
+		return fmt.Errorf("Tokenization is not allowed for data type '%s'", propertyDataType.AsNested())
+	}
 	return fmt.Errorf("Tokenization is not allowed for reference data type")
 }
 
+// validatePropertyAnalyzer checks that the text analyzer referenced by the
+// property is defined in the inverted index config of its class
+func (m *Manager) validatePropertyAnalyzer(prop *models.Property, class *models.Class,
+	propertyDataType schema.PropertyDataType,
+) error {
+	if prop.Analyzer == "" {
+		return nil
+	}
+
+	if propertyDataType.IsNested() {
+		return fmt.Errorf("Analyzer is not allowed for data type '%s'", propertyDataType.AsNested())
+	}
+	if !propertyDataType.IsPrimitive() {
+		return fmt.Errorf("Analyzer is not allowed for reference data type")
+	}
+	switch primitiveDataType := propertyDataType.AsPrimitive(); primitiveDataType {
+	case schema.DataTypeText, schema.DataTypeTextArray,
+		schema.DataTypeString, schema.DataTypeStringArray:
+	default:
+		return fmt.Errorf("Analyzer is not allowed for data type '%s'", primitiveDataType)
+	}
+
+	if class.InvertedIndexConfig != nil {
+		for _, analyzer := range class.InvertedIndexConfig.Analyzers {
+			if analyzer != nil && analyzer.Name == prop.Analyzer {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("Analyzer '%s' of property '%s' is not defined in the invertedIndexConfig of class '%s'",
+		prop.Analyzer, prop.Name, class.Class)
+}
+
+// validatePropertyStopwordsPreset checks that the stopword preset of the
+// property exists, it replaces the preset of the class for this property
+func (m *Manager) validatePropertyStopwordsPreset(prop *models.Property,
+	propertyDataType schema.PropertyDataType,
+) error {
+	if prop.StopwordsPreset == "" {
+		return nil
+	}
+
+	if propertyDataType.IsNested() {
+		return fmt.Errorf("StopwordsPreset is not allowed for data type '%s'", propertyDataType.AsNested())
+	}
+	if !propertyDataType.IsPrimitive() {
+		return fmt.Errorf("StopwordsPreset is not allowed for reference data type")
+	}
+	switch primitiveDataType := propertyDataType.AsPrimitive(); primitiveDataType {
+	case schema.DataTypeText, schema.DataTypeTextArray,
+		schema.DataTypeString, schema.DataTypeStringArray:
+	default:
+		return fmt.Errorf("StopwordsPreset is not allowed for data type '%s'", primitiveDataType)
+	}
+
+	if _, ok := stopwords.Presets[prop.StopwordsPreset]; !ok {
+		return fmt.Errorf("stopwordsPreset '%s' of property '%s' does not exist",
+			prop.StopwordsPreset, prop.Name)
+	}
+	return nil
+}
+
 func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 	if prop.IndexInverted != nil {
 		if prop.IndexFilterable != nil || prop.IndexSearchable != nil {
@@ -102,6 +169,103 @@ func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 	return nil
 }
 
+// validateNestedProperties checks that properties of data type object and
+// object[] declare their nested properties and that these are valid. Nested
+// properties may be of any of the nested leaf data types or objects again,
+// but not references.
+func (m *Manager) validateNestedProperties(prop *models.Property,
+	propertyDataType schema.PropertyDataType,
+) error {
+	if !propertyDataType.IsNested() {
+		if len(prop.NestedProperties) > 0 {
+			return fmt.Errorf("property '%s': nestedProperties are only allowed for "+
+				"data types object and object[]", prop.Name)
+		}
+		return nil
+	}
+
+	if len(prop.NestedProperties) == 0 {
+		return fmt.Errorf("property '%s': nestedProperties are required for data type '%s'",
+			prop.Name, propertyDataType.AsNested())
+	}
+	return m.validateNestedPropertiesOf(prop.Name, prop.NestedProperties)
+}
+
+func (m *Manager) validateNestedPropertiesOf(path string, nps []*models.NestedProperty) error {
+	existingNames := map[string]bool{}
+	for _, np := range nps {
+		if np == nil {
+			return fmt.Errorf("property '%s': nested property must not be empty", path)
+		}
+		npPath := path + schema.NestedPathSeparator + np.Name
+
+		if _, err := schema.ValidatePropertyName(np.Name); err != nil {
+			return fmt.Errorf("nested property '%s': %w", npPath, err)
+		}
+		// a path segment starting with an uppercase letter is read as the class
+		// of a reference in filters, see filters.ParsePath
+		if _, err := schema.ValidateClassName(np.Name); err == nil {
+			return fmt.Errorf("nested property '%s': nested property names must not "+
+				"start with an uppercase letter", npPath)
+		}
+		if existingNames[strings.ToLower(np.Name)] {
+			return fmt.Errorf("nested property '%s': already in use or provided multiple times", npPath)
+		}
+		existingNames[strings.ToLower(np.Name)] = true
+
+		if nestedDataType, ok := schema.AsNested(np.DataType); ok {
+			if len(np.NestedProperties) == 0 {
+				return fmt.Errorf("nested property '%s': nestedProperties are required for data type '%s'",
+					npPath, nestedDataType)
+			}
+			if np.Tokenization != "" {
+				return fmt.Errorf("nested property '%s': Tokenization is not allowed for data type '%s'",
+					npPath, nestedDataType)
+			}
+			if np.IndexSearchable != nil && *np.IndexSearchable {
+				return fmt.Errorf("nested property '%s': `indexSearchable` is allowed only for text/text[] data types",
+					npPath)
+			}
+			if err := m.validateNestedPropertiesOf(npPath, np.NestedProperties); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !schema.IsNestedLeafDataType(np.DataType) {
+			return fmt.Errorf("nested property '%s': data type %v is not supported for nested properties",
+				npPath, np.DataType)
+		}
+		if len(np.NestedProperties) > 0 {
+			return fmt.Errorf("nested property '%s': nestedProperties are only allowed for "+
+				"data types object and object[]", npPath)
+		}
+
+		dataType, _ := schema.AsPrimitive(np.DataType)
+		isText := dataType == schema.DataTypeText || dataType == schema.DataTypeTextArray
+		if np.Tokenization != "" {
+			if !isText {
+				return fmt.Errorf("nested property '%s': Tokenization is not allowed for data type '%s'",
+					npPath, dataType)
+			}
+			switch np.Tokenization {
+			case models.PropertyTokenizationField, models.PropertyTokenizationWord,
+				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+				models.PropertyTokenizationTrigram, models.PropertyTokenizationCjk:
+			default:
+				return fmt.Errorf("nested property '%s': Tokenization '%s' is not allowed for data type '%s'",
+					npPath, np.Tokenization, dataType)
+			}
+		}
+		if np.IndexSearchable != nil && *np.IndexSearchable && !isText {
+			return fmt.Errorf("nested property '%s': `indexSearchable` is allowed only for text/text[] data types",
+				npPath)
+		}
+	}
+
+	return nil
+}
+
 func (m *Manager) validateVectorSettings(ctx context.Context, class *models.Class) error {
 	if err := m.validateVectorizer(ctx, class); err != nil {
 		return err
@@ -501,6 +501,267 @@ func Test_Validation_PropertyTokenization(t *testing.T) {
 	})
 }
 
+func Test_Validation_PropertyAnalyzer(t *testing.T) {
+	class := &models.Class{
+		Class: "SomeClass",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Analyzers: []*models.TextAnalyzerConfig{
+				{Name: "english", Filters: []string{"lowercase", "snowball_en"}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		analyzer         string
+		propertyDataType schema.PropertyDataType
+		expectedErrMsg   string
+	}{
+		{
+			name:             "no analyzer",
+			propertyDataType: newFakePropertyDataType(schema.DataTypeInt),
+		},
+		{
+			name:             "text with defined analyzer",
+			analyzer:         "english",
+			propertyDataType: newFakePropertyDataType(schema.DataTypeText),
+		},
+		{
+			name:             "text[] with defined analyzer",
+			analyzer:         "english",
+			propertyDataType: newFakePropertyDataType(schema.DataTypeTextArray),
+		},
+		{
+			name:             "text with undefined analyzer",
+			analyzer:         "german",
+			propertyDataType: newFakePropertyDataType(schema.DataTypeText),
+			expectedErrMsg:   "Analyzer 'german' of property 'prop' is not defined in the invertedIndexConfig of class 'SomeClass'",
+		},
+		{
+			name:             "int with analyzer",
+			analyzer:         "english",
+			propertyDataType: newFakePropertyDataType(schema.DataTypeInt),
+			expectedErrMsg:   "Analyzer is not allowed for data type 'int'",
+		},
+		{
+			name:             "reference with analyzer",
+			analyzer:         "english",
+			propertyDataType: newFakePropertyDataType(""),
+			expectedErrMsg:   "Analyzer is not allowed for reference data type",
+		},
+	}
+
+	m := newSchemaManager()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prop := &models.Property{Name: "prop", Analyzer: tc.analyzer}
+			err := m.validatePropertyAnalyzer(prop, class, tc.propertyDataType)
+			if tc.expectedErrMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_PropertyStopwordsPreset(t *testing.T) {
+	testCases := []struct {
+		name             string
+		preset           string
+		propertyDataType schema.PropertyDataType
+		expectedErrMsg   string
+	}{
+		{
+			name:             "no preset",
+			propertyDataType: newFakePropertyDataType(schema.DataTypeInt),
+		},
+		{
+			name:             "text with german preset",
+			preset:           "de",
+			propertyDataType: newFakePropertyDataType(schema.DataTypeText),
+		},
+		{
+			name:             "text[] with none preset",
+			preset:           "none",
+			propertyDataType: newFakePropertyDataType(schema.DataTypeTextArray),
+		},
+		{
+			name:             "text with unknown preset",
+			preset:           "klingon",
+			propertyDataType: newFakePropertyDataType(schema.DataTypeText),
+			expectedErrMsg:   "stopwordsPreset 'klingon' of property 'prop' does not exist",
+		},
+		{
+			name:             "int with preset",
+			preset:           "de",
+			propertyDataType: newFakePropertyDataType(schema.DataTypeInt),
+			expectedErrMsg:   "StopwordsPreset is not allowed for data type 'int'",
+		},
+		{
+			name:             "reference with preset",
+			preset:           "de",
+			propertyDataType: newFakePropertyDataType(""),
+			expectedErrMsg:   "StopwordsPreset is not allowed for reference data type",
+		},
+	}
+
+	m := newSchemaManager()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prop := &models.Property{Name: "prop", StopwordsPreset: tc.preset}
+			err := m.validatePropertyStopwordsPreset(prop, tc.propertyDataType)
+			if tc.expectedErrMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_NestedProperties(t *testing.T) {
+	vTrue := true
+	testCases := []struct {
+		name           string
+		prop           *models.Property
+		expectedErrMsg string
+	}{
+		{
+			name: "object with nested properties",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString(), Tokenization: "field"},
+					{
+						Name:     "location",
+						DataType: schema.DataTypeObjectArray.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "object without nested properties",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+			},
+			expectedErrMsg: "property 'address': nestedProperties are required for data type 'object'",
+		},
+		{
+			name: "text with nested properties",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeText.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+			expectedErrMsg: "property 'address': nestedProperties are only allowed for data types object and object[]",
+		},
+		{
+			name: "nested object without nested properties",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "location", DataType: schema.DataTypeObject.PropString()},
+				},
+			},
+			expectedErrMsg: "nested property 'address.location': nestedProperties are required for data type 'object'",
+		},
+		{
+			name: "nested reference",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: []string{"City"}},
+				},
+			},
+			expectedErrMsg: "nested property 'address.city': data type [City] is not supported for nested properties",
+		},
+		{
+			name: "duplicate nested property",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+					{Name: "cITY", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+			expectedErrMsg: "nested property 'address.cITY': already in use or provided multiple times",
+		},
+		{
+			name: "nested property name starting with an uppercase letter",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "City", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+			expectedErrMsg: "nested property 'address.City': nested property names must not " +
+				"start with an uppercase letter",
+		},
+		{
+			name: "invalid nested property name",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "zip-code", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+			expectedErrMsg: "nested property 'address.zip-code': 'zip-code' is not a valid property name. " +
+				"Property names in Weaviate are restricted to valid GraphQL names, which must be “/[_A-Za-z][_0-9A-Za-z]*/”.",
+		},
+		{
+			name: "tokenization on nested int",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "zip", DataType: schema.DataTypeInt.PropString(), Tokenization: "word"},
+				},
+			},
+			expectedErrMsg: "nested property 'address.zip': Tokenization is not allowed for data type 'int'",
+		},
+		{
+			name: "searchable nested int",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "zip", DataType: schema.DataTypeInt.PropString(), IndexSearchable: &vTrue},
+				},
+			},
+			expectedErrMsg: "nested property 'address.zip': `indexSearchable` is allowed only for text/text[] data types",
+		},
+	}
+
+	m := newSchemaManager()
+	sch := schema.Empty()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pdt, err := sch.FindPropertyDataType(tc.prop.DataType)
+			require.Nil(t, err)
+
+			err = m.validateNestedProperties(tc.prop, pdt)
+			if tc.expectedErrMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			}
+		})
+	}
+}
+
 func Test_Validation_PropertyIndexing(t *testing.T) {
 	t.Run("validates indexInverted / indexFilterable / indexSearchable combinations", func(t *testing.T) {
 		vFalse := false
@@ -621,6 +882,14 @@ func (pdt *fakePropertyDataType) AsPrimitive() schema.DataType {
 	return pdt.primitiveDataType
 }
 
+func (pdt *fakePropertyDataType) IsNested() bool {
+	return false
+}
+
+func (pdt *fakePropertyDataType) AsNested() schema.DataType {
+	return ""
+}
+
 func (pdt *fakePropertyDataType) IsReference() bool {
 	return !pdt.IsPrimitive()
 }
@@ -71,6 +71,12 @@ type RemoteIndexClient interface {
 		mergeDoc objects.MergeDocument) error
 	MultiGetObjects(ctx context.Context, hostname, indexName, shardName string,
 		ids []strfmt.UUID) ([]*storobj.Object, error)
+	ObjectsByDocID(ctx context.Context, hostname, indexName, shardName string,
+		docIDs []uint64) ([]*storobj.Object, error)
+	ObjectVersions(ctx context.Context, hostname, indexName, shardName string,
+		id strfmt.UUID) ([]objects.StoredObjectVersion, error)
+	ObjectAsOf(ctx context.Context, hostname, indexName, shardName string,
+		id strfmt.UUID, asOf int64) (*storobj.Object, error)
 	SearchShard(ctx context.Context, hostname, indexName, shardName string,
 		searchVector []float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
@@ -234,6 +240,54 @@ func (ri *RemoteIndex) MultiGetObjects(ctx context.Context, shardName string,
 	return ri.client.MultiGetObjects(ctx, host, ri.class, shardName, ids)
 }
 
+func (ri *RemoteIndex) ObjectsByDocID(ctx context.Context, shardName string,
+	docIDs []uint64,
+) ([]*storobj.Object, error) {
+	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
+	if !ok {
+		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode())
+	if !ok {
+		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode())
+	}
+
+	return ri.client.ObjectsByDocID(ctx, host, ri.class, shardName, docIDs)
+}
+
+func (ri *RemoteIndex) ObjectVersions(ctx context.Context, shardName string,
+	id strfmt.UUID,
+) ([]objects.StoredObjectVersion, error) {
+	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
+	if !ok {
+		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode())
+	if !ok {
+		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode())
+	}
+
+	return ri.client.ObjectVersions(ctx, host, ri.class, shardName, id)
+}
+
+func (ri *RemoteIndex) ObjectAsOf(ctx context.Context, shardName string,
+	id strfmt.UUID, asOf int64,
+) (*storobj.Object, error) {
+	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
+	if !ok {
+		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode())
+	if !ok {
+		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode())
+	}
+
+	return ri.client.ObjectAsOf(ctx, host, ri.class, shardName, id, asOf)
+}
+
 func (ri *RemoteIndex) SearchShard(ctx context.Context, shardName string,
 	searchVector []float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
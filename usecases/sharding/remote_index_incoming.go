@@ -51,6 +51,12 @@ type RemoteIndexIncomingRepo interface {
 		mergeDoc objects.MergeDocument) error
 	IncomingMultiGetObjects(ctx context.Context, shardName string,
 		ids []strfmt.UUID) ([]*storobj.Object, error)
+	IncomingObjectsByDocID(ctx context.Context, shardName string,
+		docIDs []uint64) ([]*storobj.Object, error)
+	IncomingObjectVersions(ctx context.Context, shardName string,
+		id strfmt.UUID) ([]objects.StoredObjectVersion, error)
+	IncomingObjectAsOf(ctx context.Context, shardName string,
+		id strfmt.UUID, asOf int64) (*storobj.Object, error)
 	IncomingSearch(ctx context.Context, shardName string,
 		vector []float32, distance float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
@@ -178,6 +184,39 @@ func (rii *RemoteIndexIncoming) MultiGetObjects(ctx context.Context, indexName,
 	return index.IncomingMultiGetObjects(ctx, shardName, ids)
 }
 
+func (rii *RemoteIndexIncoming) ObjectsByDocID(ctx context.Context, indexName,
+	shardName string, docIDs []uint64,
+) ([]*storobj.Object, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingObjectsByDocID(ctx, shardName, docIDs)
+}
+
+func (rii *RemoteIndexIncoming) ObjectVersions(ctx context.Context, indexName,
+	shardName string, id strfmt.UUID,
+) ([]objects.StoredObjectVersion, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingObjectVersions(ctx, shardName, id)
+}
+
+func (rii *RemoteIndexIncoming) ObjectAsOf(ctx context.Context, indexName,
+	shardName string, id strfmt.UUID, asOf int64,
+) (*storobj.Object, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingObjectAsOf(ctx, shardName, id, asOf)
+}
+
 func (rii *RemoteIndexIncoming) Search(ctx context.Context, indexName, shardName string,
 	vector []float32, distance float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
@@ -77,6 +77,11 @@ func (i *typeInspector) extendResWithType(res *aggregation.Result, propName stri
 			return err
 		}
 
+		if propType.IsNested() {
+			// nested properties are aggregated by their paths, not as a whole
+			return nil
+		}
+
 		if propType.IsPrimitive() {
 			prop.SchemaType = string(propType.AsPrimitive())
 		} else {
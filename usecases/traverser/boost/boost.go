@@ -0,0 +1,186 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package boost
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+const (
+	ScoreModeMultiply = "multiply"
+	ScoreModeSum      = "sum"
+	ScoreModeAvg      = "avg"
+	ScoreModeMax      = "max"
+	ScoreModeMin      = "min"
+
+	BoostModeMultiply = "multiply"
+	BoostModeSum      = "sum"
+	BoostModeReplace  = "replace"
+)
+
+// scoreFunction scores a single object by its property values
+type scoreFunction interface {
+	// score returns false if the object has no value for the property and
+	// the function therefore does not apply to it
+	score(props map[string]interface{}) (float64, bool)
+	String() string
+}
+
+// Booster re-scores search results by combining their primary score with
+// decay and field value factor functions over their properties, similar to
+// a function score query
+type Booster struct {
+	functions []scoreFunction
+	scoreMode string
+	boostMode string
+}
+
+// New validates the boost params against the class and parses the origins
+// and scales of the decay functions
+func New(class *models.Class, params searchparams.Boost) (*Booster, error) {
+	if len(params.Decay) == 0 && len(params.FieldValueFactors) == 0 {
+		return nil, fmt.Errorf("at least one decay or fieldValueFactor function is required")
+	}
+
+	b := &Booster{scoreMode: params.ScoreMode, boostMode: params.BoostMode}
+	switch b.scoreMode {
+	case "":
+		b.scoreMode = ScoreModeMultiply
+	case ScoreModeMultiply, ScoreModeSum, ScoreModeAvg, ScoreModeMax, ScoreModeMin:
+	default:
+		return nil, fmt.Errorf("unrecognized scoreMode %q", b.scoreMode)
+	}
+	switch b.boostMode {
+	case "":
+		b.boostMode = BoostModeMultiply
+	case BoostModeMultiply, BoostModeSum, BoostModeReplace:
+	default:
+		return nil, fmt.Errorf("unrecognized boostMode %q", b.boostMode)
+	}
+
+	for i, d := range params.Decay {
+		f, err := newDecay(class, d)
+		if err != nil {
+			return nil, fmt.Errorf("decay function at pos %d: %w", i, err)
+		}
+		b.functions = append(b.functions, f)
+	}
+	for i, fvf := range params.FieldValueFactors {
+		f, err := newFieldValueFactor(class, fvf)
+		if err != nil {
+			return nil, fmt.Errorf("fieldValueFactor function at pos %d: %w", i, err)
+		}
+		b.functions = append(b.functions, f)
+	}
+
+	return b, nil
+}
+
+// Boost sets the boosted score of each result, explains it in its
+// explainScore and sorts the results by their boosted score. The primary
+// score of a result is determined by the caller, as it depends on the kind
+// of search which retrieved it.
+func (b *Booster) Boost(in []search.Result,
+	primary func(res search.Result) float64,
+) []search.Result {
+	for i := range in {
+		props, _ := in[i].Schema.(map[string]interface{})
+		primaryScore := primary(in[i])
+		functionScore, explanations, ok := b.functionScore(props)
+
+		score := primaryScore
+		if ok {
+			score = b.combine(primaryScore, functionScore)
+		}
+		in[i].Score = float32(score)
+
+		explanation := fmt.Sprintf("(boost) primary score %v, function score %v (%s), "+
+			"%s boosted score %v", primaryScore, functionScore, strings.Join(explanations, ", "),
+			b.boostMode, score)
+		if !ok {
+			explanation = fmt.Sprintf("(boost) primary score %v, no function applied", primaryScore)
+		}
+		if in[i].ExplainScore == "" {
+			in[i].ExplainScore = explanation
+		} else {
+			in[i].ExplainScore = fmt.Sprintf("%v\n%v", in[i].ExplainScore, explanation)
+		}
+		if _, ok := in[i].AdditionalProperties["explainScore"]; ok {
+			in[i].AdditionalProperties["explainScore"] = in[i].ExplainScore
+		}
+	}
+
+	sort.SliceStable(in, func(i, j int) bool {
+		return in[i].Score > in[j].Score
+	})
+
+	return in
+}
+
+// functionScore combines the scores of all functions which apply to the
+// object according to the score mode
+func (b *Booster) functionScore(props map[string]interface{}) (float64, []string, bool) {
+	var scores []float64
+	var explanations []string
+	for _, f := range b.functions {
+		s, ok := f.score(props)
+		if !ok {
+			explanations = append(explanations, fmt.Sprintf("%s: missing", f))
+			continue
+		}
+		scores = append(scores, s)
+		explanations = append(explanations, fmt.Sprintf("%s: %v", f, s))
+	}
+	if len(scores) == 0 {
+		return 0, explanations, false
+	}
+
+	combined := scores[0]
+	for _, s := range scores[1:] {
+		switch b.scoreMode {
+		case ScoreModeMultiply:
+			combined *= s
+		case ScoreModeSum, ScoreModeAvg:
+			combined += s
+		case ScoreModeMax:
+			if s > combined {
+				combined = s
+			}
+		case ScoreModeMin:
+			if s < combined {
+				combined = s
+			}
+		}
+	}
+	if b.scoreMode == ScoreModeAvg {
+		combined /= float64(len(scores))
+	}
+
+	return combined, explanations, true
+}
+
+func (b *Booster) combine(primaryScore, functionScore float64) float64 {
+	switch b.boostMode {
+	case BoostModeSum:
+		return primaryScore + functionScore
+	case BoostModeReplace:
+		return functionScore
+	default:
+		return primaryScore * functionScore
+	}
+}
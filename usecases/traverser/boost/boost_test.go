@@ -0,0 +1,404 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package boost
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func testClass() *models.Class {
+	return &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "published", DataType: []string{"date"}},
+			{Name: "price", DataType: []string{"number"}},
+			{Name: "views", DataType: []string{"int"}},
+			{Name: "location", DataType: []string{"geoCoordinates"}},
+		},
+	}
+}
+
+func geo(lat, lon float32) *models.GeoCoordinates {
+	return &models.GeoCoordinates{Latitude: &lat, Longitude: &lon}
+}
+
+func TestNew_Validation(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      searchparams.Boost
+		expectedErr string
+	}{
+		{
+			name:        "no functions",
+			params:      searchparams.Boost{},
+			expectedErr: "at least one decay or fieldValueFactor function is required",
+		},
+		{
+			name: "unknown score mode",
+			params: searchparams.Boost{
+				ScoreMode:         "median",
+				FieldValueFactors: []searchparams.BoostFieldValueFactor{{Property: "views"}},
+			},
+			expectedErr: "unrecognized scoreMode \"median\"",
+		},
+		{
+			name: "unknown boost mode",
+			params: searchparams.Boost{
+				BoostMode:         "divide",
+				FieldValueFactors: []searchparams.BoostFieldValueFactor{{Property: "views"}},
+			},
+			expectedErr: "unrecognized boostMode \"divide\"",
+		},
+		{
+			name: "decay on text property",
+			params: searchparams.Boost{
+				Decay: []searchparams.BoostDecay{{Property: "title", Origin: "a", Scale: "b"}},
+			},
+			expectedErr: "decay function at pos 0: property \"title\" is of type \"text\", " +
+				"but decay functions require a date, number, int or geoCoordinates property",
+		},
+		{
+			name: "decay on unknown property",
+			params: searchparams.Boost{
+				Decay: []searchparams.BoostDecay{{Property: "author", Scale: "7d"}},
+			},
+			expectedErr: "decay function at pos 0: no such prop with name 'author' found in class 'Article' in the schema. Check your schema files for which properties in this class are available",
+		},
+		{
+			name: "unknown decay function",
+			params: searchparams.Boost{
+				Decay: []searchparams.BoostDecay{{Property: "published", Function: "step", Scale: "7d"}},
+			},
+			expectedErr: "decay function at pos 0: unrecognized function \"step\"",
+		},
+		{
+			name: "decay out of range",
+			params: searchparams.Boost{
+				Decay: []searchparams.BoostDecay{{Property: "published", Scale: "7d", Decay: 1.5}},
+			},
+			expectedErr: "decay function at pos 0: decay must be between 0 and 1, got 1.5",
+		},
+		{
+			name: "invalid date origin",
+			params: searchparams.Boost{
+				Decay: []searchparams.BoostDecay{{Property: "published", Origin: "yesterday", Scale: "7d"}},
+			},
+			expectedErr: "decay function at pos 0: property \"published\": origin must be \"now\" or a RFC3339 timestamp",
+		},
+		{
+			name: "missing scale",
+			params: searchparams.Boost{
+				Decay: []searchparams.BoostDecay{{Property: "price", Origin: "10", Scale: "0"}},
+			},
+			expectedErr: "decay function at pos 0: scale must be greater than 0",
+		},
+		{
+			name: "invalid geo origin",
+			params: searchparams.Boost{
+				Decay: []searchparams.BoostDecay{{Property: "location", Origin: "52.3", Scale: "10km"}},
+			},
+			expectedErr: "decay function at pos 0: property \"location\": origin must be of the form \"lat,lon\", got \"52.3\"",
+		},
+		{
+			name: "field value factor on date property",
+			params: searchparams.Boost{
+				FieldValueFactors: []searchparams.BoostFieldValueFactor{{Property: "published"}},
+			},
+			expectedErr: "fieldValueFactor function at pos 0: property \"published\" is of type \"date\", " +
+				"but fieldValueFactor functions require a number or int property",
+		},
+		{
+			name: "unknown modifier",
+			params: searchparams.Boost{
+				FieldValueFactors: []searchparams.BoostFieldValueFactor{{Property: "views", Modifier: "cube"}},
+			},
+			expectedErr: "fieldValueFactor function at pos 0: unrecognized modifier \"cube\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New(testClass(), test.params)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestDecay_Functions(t *testing.T) {
+	// all functions score 1 at the origin (plus offset) and the decay value
+	// at a distance of scale from it
+	for _, function := range []string{DecayGauss, DecayLinear, DecayExp} {
+		t.Run(function, func(t *testing.T) {
+			d, err := newDecay(testClass(), searchparams.BoostDecay{
+				Property: "price", Function: function, Origin: "100", Scale: "20",
+				Offset: "5", Decay: 0.25,
+			})
+			require.Nil(t, err)
+
+			score, ok := d.score(map[string]interface{}{"price": 104.0})
+			require.True(t, ok)
+			assert.InDelta(t, 1, score, 1e-9)
+
+			score, ok = d.score(map[string]interface{}{"price": 75.0})
+			require.True(t, ok)
+			assert.InDelta(t, 0.25, score, 1e-9)
+
+			_, ok = d.score(map[string]interface{}{})
+			assert.False(t, ok)
+		})
+	}
+
+	t.Run("linear reaches zero", func(t *testing.T) {
+		d, err := newDecay(testClass(), searchparams.BoostDecay{
+			Property: "views", Function: DecayLinear, Origin: "0", Scale: "10",
+		})
+		require.Nil(t, err)
+
+		score, ok := d.score(map[string]interface{}{"views": int64(30)})
+		require.True(t, ok)
+		assert.Equal(t, 0.0, score)
+	})
+}
+
+func TestDecay_Dates(t *testing.T) {
+	fixedNow := time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return fixedNow }
+	defer func() { now = time.Now }()
+
+	d, err := newDecay(testClass(), searchparams.BoostDecay{
+		Property: "published", Function: DecayExp, Origin: "now", Scale: "7d",
+	})
+	require.Nil(t, err)
+
+	score, ok := d.score(map[string]interface{}{
+		"published": fixedNow.Add(-7 * 24 * time.Hour).Format(time.RFC3339),
+	})
+	require.True(t, ok)
+	assert.InDelta(t, 0.5, score, 1e-9)
+
+	score, ok = d.score(map[string]interface{}{
+		"published": fixedNow.Add(-14 * 24 * time.Hour).Format(time.RFC3339),
+	})
+	require.True(t, ok)
+	assert.InDelta(t, 0.25, score, 1e-9)
+
+	d, err = newDecay(testClass(), searchparams.BoostDecay{
+		Property: "published", Function: DecayExp, Origin: "2023-06-01T00:00:00Z",
+		Scale: "12h",
+	})
+	require.Nil(t, err)
+
+	score, ok = d.score(map[string]interface{}{"published": "2023-06-01T12:00:00Z"})
+	require.True(t, ok)
+	assert.InDelta(t, 0.5, score, 1e-9)
+}
+
+func TestDecay_Geo(t *testing.T) {
+	d, err := newDecay(testClass(), searchparams.BoostDecay{
+		Property: "location", Function: DecayLinear, Origin: "52.37, 4.89",
+		Scale: "100km", Decay: 0.5,
+	})
+	require.Nil(t, err)
+
+	score, ok := d.score(map[string]interface{}{"location": geo(52.37, 4.89)})
+	require.True(t, ok)
+	assert.InDelta(t, 1, score, 1e-6)
+
+	// Amsterdam to Berlin is about 577km, which is beyond the 200km at which
+	// the linear function reaches zero
+	score, ok = d.score(map[string]interface{}{"location": geo(52.52, 13.405)})
+	require.True(t, ok)
+	assert.Equal(t, 0.0, score)
+
+	// Amsterdam to Utrecht is about 35km
+	score, ok = d.score(map[string]interface{}{"location": geo(52.09, 5.12)})
+	require.True(t, ok)
+	assert.InDelta(t, 0.825, score, 0.01)
+}
+
+func TestFieldValueFactor(t *testing.T) {
+	missing := 9.0
+	tests := []struct {
+		name     string
+		params   searchparams.BoostFieldValueFactor
+		props    map[string]interface{}
+		expected float64
+		applies  bool
+	}{
+		{
+			name:     "plain value",
+			params:   searchparams.BoostFieldValueFactor{Property: "views"},
+			props:    map[string]interface{}{"views": 4.0},
+			expected: 4,
+			applies:  true,
+		},
+		{
+			name:     "factor and sqrt",
+			params:   searchparams.BoostFieldValueFactor{Property: "views", Factor: 4, Modifier: ModifierSqrt},
+			props:    map[string]interface{}{"views": 4.0},
+			expected: 4,
+			applies:  true,
+		},
+		{
+			name:     "log1p",
+			params:   searchparams.BoostFieldValueFactor{Property: "price", Modifier: ModifierLog1p},
+			props:    map[string]interface{}{"price": 99.0},
+			expected: 2,
+			applies:  true,
+		},
+		{
+			name:     "reciprocal",
+			params:   searchparams.BoostFieldValueFactor{Property: "price", Modifier: ModifierReciprocal},
+			props:    map[string]interface{}{"price": 4.0},
+			expected: 0.25,
+			applies:  true,
+		},
+		{
+			name:     "negative sqrt",
+			params:   searchparams.BoostFieldValueFactor{Property: "price", Modifier: ModifierSqrt},
+			props:    map[string]interface{}{"price": -4.0},
+			expected: 0,
+			applies:  true,
+		},
+		{
+			name:     "missing value",
+			params:   searchparams.BoostFieldValueFactor{Property: "views", Modifier: ModifierSquare, Missing: &missing},
+			props:    map[string]interface{}{},
+			expected: 81,
+			applies:  true,
+		},
+		{
+			name:    "missing value without default",
+			params:  searchparams.BoostFieldValueFactor{Property: "views"},
+			props:   map[string]interface{}{},
+			applies: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := newFieldValueFactor(testClass(), test.params)
+			require.Nil(t, err)
+
+			score, ok := f.score(test.props)
+			assert.Equal(t, test.applies, ok)
+			assert.InDelta(t, test.expected, score, 1e-9)
+		})
+	}
+}
+
+func TestBooster_Boost(t *testing.T) {
+	results := func() []search.Result {
+		return []search.Result{
+			{
+				ID:           strfmt.UUID("a"),
+				Score:        3,
+				ExplainScore: "(bm25)",
+				Schema:       map[string]interface{}{"price": 150.0, "views": 2.0},
+			},
+			{
+				ID:     strfmt.UUID("b"),
+				Score:  2,
+				Schema: map[string]interface{}{"price": 100.0, "views": 3.0},
+			},
+			{
+				ID:     strfmt.UUID("c"),
+				Score:  1,
+				Schema: map[string]interface{}{},
+			},
+		}
+	}
+	primary := func(res search.Result) float64 { return float64(res.Score) }
+	priceDecay := searchparams.BoostDecay{
+		Property: "price", Function: DecayLinear, Origin: "100", Scale: "50",
+	}
+	viewsFactor := searchparams.BoostFieldValueFactor{Property: "views"}
+
+	t.Run("multiply", func(t *testing.T) {
+		b, err := New(testClass(), searchparams.Boost{
+			Decay:             []searchparams.BoostDecay{priceDecay},
+			FieldValueFactors: []searchparams.BoostFieldValueFactor{viewsFactor},
+		})
+		require.Nil(t, err)
+
+		res := b.Boost(results(), primary)
+		require.Len(t, res, 3)
+		// b: 2 * (1 * 3), a: 3 * (0.5 * 2), c: no function applies
+		assert.Equal(t, strfmt.UUID("b"), res[0].ID)
+		assert.InDelta(t, 6, res[0].Score, 1e-6)
+		assert.Equal(t, strfmt.UUID("a"), res[1].ID)
+		assert.InDelta(t, 3, res[1].Score, 1e-6)
+		assert.Equal(t, strfmt.UUID("c"), res[2].ID)
+		assert.InDelta(t, 1, res[2].Score, 1e-6)
+
+		assert.Equal(t, "(bm25)\n(boost) primary score 3, function score 1 "+
+			"(linear(price): 0.5, fieldValueFactor(views): 2), multiply boosted score 3",
+			res[1].ExplainScore)
+		assert.Equal(t, "(boost) primary score 1, no function applied", res[2].ExplainScore)
+	})
+
+	t.Run("sum and replace", func(t *testing.T) {
+		b, err := New(testClass(), searchparams.Boost{
+			Decay:             []searchparams.BoostDecay{priceDecay},
+			FieldValueFactors: []searchparams.BoostFieldValueFactor{viewsFactor},
+			ScoreMode:         ScoreModeSum,
+			BoostMode:         BoostModeReplace,
+		})
+		require.Nil(t, err)
+
+		res := b.Boost(results(), primary)
+		require.Len(t, res, 3)
+		assert.Equal(t, strfmt.UUID("b"), res[0].ID)
+		assert.InDelta(t, 4, res[0].Score, 1e-6)
+		assert.Equal(t, strfmt.UUID("a"), res[1].ID)
+		assert.InDelta(t, 2.5, res[1].Score, 1e-6)
+	})
+
+	t.Run("max and sum", func(t *testing.T) {
+		b, err := New(testClass(), searchparams.Boost{
+			Decay:             []searchparams.BoostDecay{priceDecay},
+			FieldValueFactors: []searchparams.BoostFieldValueFactor{viewsFactor},
+			ScoreMode:         ScoreModeMax,
+			BoostMode:         BoostModeSum,
+		})
+		require.Nil(t, err)
+
+		res := b.Boost(results(), primary)
+		require.Len(t, res, 3)
+		assert.Equal(t, strfmt.UUID("a"), res[0].ID)
+		assert.InDelta(t, 5, res[0].Score, 1e-6)
+		assert.Equal(t, strfmt.UUID("b"), res[1].ID)
+		assert.InDelta(t, 5, res[1].Score, 1e-6)
+	})
+
+	t.Run("explainScore in additional properties is updated", func(t *testing.T) {
+		b, err := New(testClass(), searchparams.Boost{
+			FieldValueFactors: []searchparams.BoostFieldValueFactor{viewsFactor},
+		})
+		require.Nil(t, err)
+
+		in := results()[:1]
+		in[0].AdditionalProperties = map[string]interface{}{"explainScore": "(bm25)"}
+		res := b.Boost(in, primary)
+		assert.Equal(t, res[0].ExplainScore, res[0].AdditionalProperties["explainScore"])
+	})
+}
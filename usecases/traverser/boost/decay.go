@@ -0,0 +1,268 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package boost
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+const (
+	DecayGauss  = "gauss"
+	DecayLinear = "linear"
+	DecayExp    = "exp"
+
+	DefaultDecay = 0.5
+)
+
+// now is replaced in tests to make the "now" origin of dates predictable
+var now = time.Now
+
+// decay scores an object by the distance of its property value from an
+// origin. Dates are compared in seconds, geo coordinates in meters.
+type decay struct {
+	property string
+	function string
+	dataType schema.DataType
+	origin   float64
+	// geoOrigin is only set for geoCoordinates properties
+	geoOrigin []float32
+	scale     float64
+	offset    float64
+	decay     float64
+	geo       distancer.Provider
+}
+
+func newDecay(class *models.Class, params searchparams.BoostDecay) (*decay, error) {
+	dt, err := schema.GetPropertyDataType(class, params.Property)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &decay{
+		property: params.Property,
+		function: params.Function,
+		dataType: *dt,
+		decay:    params.Decay,
+	}
+	switch d.function {
+	case "":
+		d.function = DecayGauss
+	case DecayGauss, DecayLinear, DecayExp:
+	default:
+		return nil, fmt.Errorf("unrecognized function %q", d.function)
+	}
+	if d.decay == 0 {
+		d.decay = DefaultDecay
+	}
+	if d.decay <= 0 || d.decay >= 1 {
+		return nil, fmt.Errorf("decay must be between 0 and 1, got %v", d.decay)
+	}
+
+	switch d.dataType {
+	case schema.DataTypeDate:
+		err = d.parseDate(params)
+	case schema.DataTypeNumber, schema.DataTypeInt:
+		err = d.parseNumber(params)
+	case schema.DataTypeGeoCoordinates:
+		err = d.parseGeo(params)
+	default:
+		return nil, fmt.Errorf("property %q is of type %q, but decay functions "+
+			"require a date, number, int or geoCoordinates property", d.property, d.dataType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("property %q: %w", d.property, err)
+	}
+
+	if d.scale <= 0 {
+		return nil, fmt.Errorf("scale must be greater than 0")
+	}
+	if d.offset < 0 {
+		return nil, fmt.Errorf("offset must not be negative")
+	}
+
+	return d, nil
+}
+
+func (d *decay) parseDate(params searchparams.BoostDecay) error {
+	origin := now()
+	if params.Origin != "" && params.Origin != "now" {
+		t, err := time.Parse(time.RFC3339, params.Origin)
+		if err != nil {
+			return fmt.Errorf("origin must be \"now\" or a RFC3339 timestamp: %w", err)
+		}
+		origin = t
+	}
+	d.origin = timeToSeconds(origin)
+
+	var err error
+	if d.scale, err = parseDuration(params.Scale); err != nil {
+		return fmt.Errorf("scale: %w", err)
+	}
+	if params.Offset != "" {
+		if d.offset, err = parseDuration(params.Offset); err != nil {
+			return fmt.Errorf("offset: %w", err)
+		}
+	}
+	return nil
+}
+
+func (d *decay) parseNumber(params searchparams.BoostDecay) error {
+	var err error
+	if d.origin, err = strconv.ParseFloat(params.Origin, 64); err != nil {
+		return fmt.Errorf("origin must be a number: %w", err)
+	}
+	if d.scale, err = strconv.ParseFloat(params.Scale, 64); err != nil {
+		return fmt.Errorf("scale must be a number: %w", err)
+	}
+	if params.Offset != "" {
+		if d.offset, err = strconv.ParseFloat(params.Offset, 64); err != nil {
+			return fmt.Errorf("offset must be a number: %w", err)
+		}
+	}
+	return nil
+}
+
+func (d *decay) parseGeo(params searchparams.BoostDecay) error {
+	latLon := strings.Split(params.Origin, ",")
+	if len(latLon) != 2 {
+		return fmt.Errorf("origin must be of the form \"lat,lon\", got %q", params.Origin)
+	}
+	lat, err := strconv.ParseFloat(strings.TrimSpace(latLon[0]), 32)
+	if err != nil {
+		return fmt.Errorf("origin latitude: %w", err)
+	}
+	lon, err := strconv.ParseFloat(strings.TrimSpace(latLon[1]), 32)
+	if err != nil {
+		return fmt.Errorf("origin longitude: %w", err)
+	}
+	d.geoOrigin = []float32{float32(lat), float32(lon)}
+	d.geo = distancer.NewGeoProvider()
+
+	if d.scale, err = parseDistance(params.Scale); err != nil {
+		return fmt.Errorf("scale: %w", err)
+	}
+	if params.Offset != "" {
+		if d.offset, err = parseDistance(params.Offset); err != nil {
+			return fmt.Errorf("offset: %w", err)
+		}
+	}
+	return nil
+}
+
+func (d *decay) score(props map[string]interface{}) (float64, bool) {
+	dist, ok := d.distance(props[d.property])
+	if !ok {
+		return 0, false
+	}
+
+	dist = math.Max(0, dist-d.offset)
+	switch d.function {
+	case DecayLinear:
+		s := d.scale / (1 - d.decay)
+		return math.Max(0, (s-dist)/s), true
+	case DecayExp:
+		lambda := math.Log(d.decay) / d.scale
+		return math.Exp(lambda * dist), true
+	default:
+		sigmaSquared := -d.scale * d.scale / (2 * math.Log(d.decay))
+		return math.Exp(-dist * dist / (2 * sigmaSquared)), true
+	}
+}
+
+// distance returns the distance of the value from the origin, or false if
+// the object does not have a valid value
+func (d *decay) distance(value interface{}) (float64, bool) {
+	switch d.dataType {
+	case schema.DataTypeDate:
+		var t time.Time
+		switch v := value.(type) {
+		case time.Time:
+			t = v
+		case string:
+			parsed, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return 0, false
+			}
+			t = parsed
+		default:
+			return 0, false
+		}
+		return math.Abs(timeToSeconds(t) - d.origin), true
+	case schema.DataTypeGeoCoordinates:
+		geo, ok := value.(*models.GeoCoordinates)
+		if !ok || geo == nil || geo.Latitude == nil || geo.Longitude == nil {
+			return 0, false
+		}
+		dist, ok, err := d.geo.SingleDist(d.geoOrigin,
+			[]float32{*geo.Latitude, *geo.Longitude})
+		if err != nil || !ok {
+			return 0, false
+		}
+		return float64(dist), true
+	default:
+		v, ok := toFloat64(value)
+		if !ok {
+			return 0, false
+		}
+		return math.Abs(v - d.origin), true
+	}
+}
+
+func (d *decay) String() string {
+	return fmt.Sprintf("%s(%s)", d.function, d.property)
+}
+
+func timeToSeconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+// parseDuration extends time.ParseDuration by days, e.g. "7d"
+func parseDuration(in string) (float64, error) {
+	if strings.HasSuffix(in, "d") {
+		d, err := strconv.ParseFloat(strings.TrimSuffix(in, "d"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", in)
+		}
+		return d * 24 * 60 * 60, nil
+	}
+	d, err := time.ParseDuration(in)
+	if err != nil {
+		return 0, err
+	}
+	return d.Seconds(), nil
+}
+
+// parseDistance parses a distance in meters, which may have a unit of
+// "m" or "km"
+func parseDistance(in string) (float64, error) {
+	factor := 1.0
+	number := in
+	if strings.HasSuffix(in, "km") {
+		factor, number = 1000, strings.TrimSuffix(in, "km")
+	} else {
+		number = strings.TrimSuffix(in, "m")
+	}
+	d, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid distance %q", in)
+	}
+	return d * factor, nil
+}
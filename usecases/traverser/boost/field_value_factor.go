@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package boost
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+const (
+	ModifierNone       = "none"
+	ModifierLog1p      = "log1p"
+	ModifierLn1p       = "ln1p"
+	ModifierSquare     = "square"
+	ModifierSqrt       = "sqrt"
+	ModifierReciprocal = "reciprocal"
+)
+
+// fieldValueFactor scores an object by the value of a number or int
+// property, e.g. its popularity
+type fieldValueFactor struct {
+	property string
+	factor   float64
+	modifier string
+	missing  *float64
+}
+
+func newFieldValueFactor(class *models.Class,
+	params searchparams.BoostFieldValueFactor,
+) (*fieldValueFactor, error) {
+	dt, err := schema.GetPropertyDataType(class, params.Property)
+	if err != nil {
+		return nil, err
+	}
+	if *dt != schema.DataTypeNumber && *dt != schema.DataTypeInt {
+		return nil, fmt.Errorf("property %q is of type %q, but fieldValueFactor "+
+			"functions require a number or int property", params.Property, *dt)
+	}
+
+	f := &fieldValueFactor{
+		property: params.Property,
+		factor:   params.Factor,
+		modifier: params.Modifier,
+		missing:  params.Missing,
+	}
+	if f.factor == 0 {
+		f.factor = 1
+	}
+	switch f.modifier {
+	case "":
+		f.modifier = ModifierNone
+	case ModifierNone, ModifierLog1p, ModifierLn1p, ModifierSquare,
+		ModifierSqrt, ModifierReciprocal:
+	default:
+		return nil, fmt.Errorf("unrecognized modifier %q", f.modifier)
+	}
+
+	return f, nil
+}
+
+func (f *fieldValueFactor) score(props map[string]interface{}) (float64, bool) {
+	v, ok := toFloat64(props[f.property])
+	if !ok {
+		if f.missing == nil {
+			return 0, false
+		}
+		v = *f.missing
+	}
+
+	v *= f.factor
+	switch f.modifier {
+	case ModifierLog1p:
+		v = math.Log10(1 + v)
+	case ModifierLn1p:
+		v = math.Log1p(v)
+	case ModifierSquare:
+		v = v * v
+	case ModifierSqrt:
+		v = math.Sqrt(v)
+	case ModifierReciprocal:
+		v = 1 / v
+	}
+
+	// negative values are not defined for the logarithm and square root
+	// and would otherwise turn the whole score into NaN
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, true
+	}
+	return v, true
+}
+
+func (f *fieldValueFactor) String() string {
+	return fmt.Sprintf("fieldValueFactor(%s)", f.property)
+}
+
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
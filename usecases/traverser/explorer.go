@@ -33,6 +33,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 	uc "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/traverser/grouper"
+	"github.com/weaviate/weaviate/usecases/traverser/highlighter"
 	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
 )
 
@@ -40,12 +41,13 @@ import (
 // contain monitoring or authorization checks. It should thus never be directly
 // used by an API, but through a Traverser.
 type Explorer struct {
-	search           vectorClassSearch
-	logger           logrus.FieldLogger
-	modulesProvider  ModulesProvider
-	schemaGetter     uc.SchemaGetter
-	nearParamsVector *nearParamsVector
-	metrics          explorerMetrics
+	search             vectorClassSearch
+	logger             logrus.FieldLogger
+	modulesProvider    ModulesProvider
+	schemaGetter       uc.SchemaGetter
+	highlightAnalyzers highlighter.Analyzers
+	nearParamsVector   *nearParamsVector
+	metrics            explorerMetrics
 }
 
 type explorerMetrics interface {
@@ -107,6 +109,10 @@ func (e *Explorer) SetSchemaGetter(sg uc.SchemaGetter) {
 	e.schemaGetter = sg
 }
 
+func (e *Explorer) SetHighlightAnalyzers(analyzers highlighter.Analyzers) {
+	e.highlightAnalyzers = analyzers
+}
+
 // GetClass from search and connector repo
 func (e *Explorer) GetClass(ctx context.Context,
 	params dto.GetParams,
@@ -130,6 +136,10 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
+	if err := e.validateMMR(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'mmr' parameter")
+	}
+
 	if params.KeywordRanking != nil {
 		return e.getClassKeywordBased(ctx, params)
 	}
@@ -166,6 +176,11 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		return nil, errors.Errorf("explorer: get class: vector search: %v", err)
 	}
 
+	res, err = e.applyBoost(params, res)
+	if err != nil {
+		return nil, err
+	}
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -204,11 +219,21 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 		params.AdditionalProperties.Vector = true
 	}
 
-	res, err := e.search.VectorClassSearch(ctx, params)
+	var res []search.Result
+	if params.MMR != nil {
+		res, err = e.mmrVectorClassSearch(ctx, params)
+	} else {
+		res, err = e.search.VectorClassSearch(ctx, params)
+	}
 	if err != nil {
 		return nil, errors.Errorf("explorer: get class: vector search: %v", err)
 	}
 
+	res, err = e.applyBoost(params, res)
+	if err != nil {
+		return nil, err
+	}
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -326,6 +351,11 @@ func (e *Explorer) getClassList(ctx context.Context,
 		}
 	}
 
+	res, err = e.applyBoost(params, res)
+	if err != nil {
+		return nil, err
+	}
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -359,6 +389,10 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 	if err != nil {
 		return nil, fmt.Errorf("search results to get response: %w", err)
 	}
+	hl, err := e.newHighlighter(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "highlights")
+	}
 	for _, res := range input {
 		additionalProperties := make(map[string]interface{})
 
@@ -429,6 +463,12 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 			additionalProperties["isConsistent"] = res.IsConsistent
 		}
 
+		if hl != nil {
+			if props, ok := res.Schema.(map[string]interface{}); ok {
+				additionalProperties["highlights"] = hl.Highlight(props)
+			}
+		}
+
 		if len(additionalProperties) > 0 {
 			if additionalProperties["group"] != nil {
 				e.extractAdditionalPropertiesFromGroupRefs(additionalProperties["group"], params.Properties)
@@ -678,6 +718,40 @@ func (e *Explorer) replicationEnabled(params dto.GetParams) (bool, error) {
 	return cls.ReplicationConfig != nil && cls.ReplicationConfig.Factor > 1, nil
 }
 
+// newHighlighter returns nil if no highlights are requested. Highlights are
+// only available for bm25 and hybrid queries, as their query text determines
+// the terms to highlight.
+func (e *Explorer) newHighlighter(params dto.GetParams) (*highlighter.Highlighter, error) {
+	if params.AdditionalProperties.Highlights == nil {
+		return nil, nil
+	}
+
+	var query string
+	switch {
+	case params.KeywordRanking != nil:
+		query = params.KeywordRanking.Query
+	case params.HybridSearch != nil:
+		query = params.HybridSearch.Query
+	default:
+		return nil, fmt.Errorf("highlights require a bm25 or hybrid query")
+	}
+
+	if e.schemaGetter == nil {
+		return nil, fmt.Errorf("schemaGetter not set")
+	}
+	if e.highlightAnalyzers == nil {
+		return nil, fmt.Errorf("highlightAnalyzers not set")
+	}
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	cls := sch.GetClass(schema.ClassName(params.ClassName))
+	if cls == nil {
+		return nil, fmt.Errorf("class not found in schema: %q", params.ClassName)
+	}
+
+	return highlighter.New(cls, query, *params.AdditionalProperties.Highlights,
+		e.highlightAnalyzers)
+}
+
 func ExtractDistanceFromParams(params dto.GetParams) (distance float64, withDistance bool) {
 	if params.NearVector != nil {
 		distance = params.NearVector.Distance
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/traverser/boost"
+)
+
+// applyBoost re-scores and re-orders the retrieved results if a boost is
+// set. The primary score is the bm25 or hybrid score for keyword and hybrid
+// searches, the min-max normalized negated distance for vector searches and
+// 1 for plain lists. Distances are normalized over the retrieved set rather
+// than mapped one by one, as they can be negative (e.g. for dot) and their
+// range depends on the distance metric.
+func (e *Explorer) applyBoost(params dto.GetParams,
+	res []search.Result,
+) ([]search.Result, error) {
+	if params.Boost == nil {
+		return res, nil
+	}
+
+	if e.schemaGetter == nil {
+		return nil, fmt.Errorf("schemaGetter not set")
+	}
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(params.ClassName))
+	if class == nil {
+		return nil, fmt.Errorf("class not found in schema: %q", params.ClassName)
+	}
+
+	booster, err := boost.New(class, *params.Boost)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid 'boost' parameter")
+	}
+
+	var primary func(res search.Result) float64
+	switch {
+	case params.KeywordRanking != nil || params.HybridSearch != nil:
+		primary = func(res search.Result) float64 { return float64(res.Score) }
+	case params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0:
+		primary = distanceScore(res)
+	default:
+		primary = func(res search.Result) float64 { return 1 }
+	}
+
+	return booster.Boost(res, primary), nil
+}
+
+// distanceScore scales the distances of the results to [0, 1], so that the
+// closest result scores 1 and the furthest one 0. If all distances are equal
+// all results are considered best matches.
+func distanceScore(res []search.Result) func(res search.Result) float64 {
+	if len(res) == 0 {
+		return func(search.Result) float64 { return 1 }
+	}
+
+	closest, furthest := res[0].Dist, res[0].Dist
+	for _, r := range res {
+		if r.Dist < closest {
+			closest = r.Dist
+		}
+		if r.Dist > furthest {
+			furthest = r.Dist
+		}
+	}
+
+	return func(res search.Result) float64 {
+		if furthest == closest {
+			return 1
+		}
+		return float64(furthest-res.Dist) / float64(furthest-closest)
+	}
+}
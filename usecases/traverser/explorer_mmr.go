@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/traverser/mmr"
+)
+
+func (e *Explorer) validateMMR(params dto.GetParams) error {
+	if params.MMR == nil {
+		return nil
+	}
+	if params.NearVector == nil && params.NearObject == nil && len(params.ModuleParams) == 0 {
+		return fmt.Errorf("mmr requires a vector search such as nearVector, nearObject or nearText")
+	}
+	if lambda := params.MMR.Lambda; lambda != nil && (*lambda < 0 || *lambda > 1) {
+		return fmt.Errorf("lambda must be between 0 and 1, got %v", *lambda)
+	}
+	// the limit flags of a search by distance or of an offset without a
+	// limit can't be turned into a number of candidates to fetch
+	if params.Pagination.Limit < 1 {
+		return fmt.Errorf("mmr requires a limit")
+	}
+	if params.MMR.FetchK != 0 && params.MMR.FetchK < params.Pagination.Limit {
+		return fmt.Errorf("fetchK must not be less than the limit of %d, got %d",
+			params.Pagination.Limit, params.MMR.FetchK)
+	}
+	return nil
+}
+
+// mmrVectorClassSearch over-fetches candidates from the vector index and
+// re-selects the requested number of results from them by maximal marginal
+// relevance, using the distancer of the class
+func (e *Explorer) mmrVectorClassSearch(ctx context.Context,
+	params dto.GetParams,
+) ([]search.Result, error) {
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(params.ClassName))
+	if class == nil {
+		return nil, fmt.Errorf("mmr: class %q not found in schema", params.ClassName)
+	}
+	hnswConfig, err := typeAssertVectorIndex(class)
+	if err != nil {
+		return nil, fmt.Errorf("mmr: %w", err)
+	}
+	selector, err := mmr.New(hnswConfig.Distance)
+	if err != nil {
+		return nil, fmt.Errorf("mmr: %w", err)
+	}
+
+	limit := params.Pagination.Limit
+	lambda := mmr.DefaultLambda
+	if params.MMR.Lambda != nil {
+		lambda = *params.MMR.Lambda
+	}
+	fetchK := params.MMR.FetchK
+	if fetchK == 0 {
+		fetchK = mmr.DefaultFetchKFactor * limit
+	}
+
+	// the candidates need their vectors to be compared with each other
+	params.Pagination = &filters.Pagination{
+		Offset: params.Pagination.Offset,
+		Limit:  fetchK,
+	}
+	params.AdditionalProperties.Vector = true
+
+	candidates, err := e.search.VectorClassSearch(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := selector.Select(candidates, lambda, limit)
+	if err != nil {
+		return nil, fmt.Errorf("mmr: %w", err)
+	}
+	return res, nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
@@ -1040,6 +1041,89 @@ func Test_Explorer_GetClass(t *testing.T) {
 		})
 	})
 
+	t.Run("when the highlights _additional prop is set", func(t *testing.T) {
+		class := &models.Class{
+			Class: "BestClass",
+			Properties: []*models.Property{
+				{Name: "name", DataType: schema.DataTypeText.PropString(), Tokenization: "word"},
+			},
+		}
+
+		t.Run("with a bm25 query", func(t *testing.T) {
+			params := dto.GetParams{
+				ClassName:      "BestClass",
+				Pagination:     &filters.Pagination{Limit: 100},
+				KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: "foo"},
+				AdditionalProperties: additional.Properties{
+					Highlights: &additional.HighlightParams{Properties: []string{"name"}},
+				},
+			}
+
+			searchResults := []search.Result{
+				{
+					ID: "id1",
+					Schema: map[string]interface{}{
+						"name": "Foo Bar",
+					},
+				},
+			}
+
+			search := &fakeVectorSearcher{}
+			log, _ := test.NewNullLogger()
+			metrics := &fakeMetrics{}
+			explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+			explorer.SetSchemaGetter(&fakeSchemaGetter{
+				schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+			})
+			explorer.SetHighlightAnalyzers(fakeHighlightAnalyzers{})
+			search.
+				On("ClassSearch", params).
+				Return(searchResults, nil)
+
+			res, err := explorer.GetClass(context.Background(), params)
+			require.Nil(t, err)
+			search.AssertExpectations(t)
+
+			require.Len(t, res, 1)
+			assert.Equal(t,
+				map[string]interface{}{
+					"name": "Foo Bar",
+					"_additional": map[string]interface{}{
+						"highlights": []*additional.Highlight{
+							{Property: "name", Fragments: []string{"<em>Foo</em> Bar"}},
+						},
+					},
+				}, res[0])
+		})
+
+		t.Run("without a keyword query", func(t *testing.T) {
+			params := dto.GetParams{
+				ClassName:  "BestClass",
+				Pagination: &filters.Pagination{Limit: 100},
+				AdditionalProperties: additional.Properties{
+					Highlights: &additional.HighlightParams{},
+				},
+			}
+
+			searchResults := []search.Result{}
+
+			search := &fakeVectorSearcher{}
+			log, _ := test.NewNullLogger()
+			metrics := &fakeMetrics{}
+			explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+			explorer.SetSchemaGetter(&fakeSchemaGetter{
+				schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+			})
+			explorer.SetHighlightAnalyzers(fakeHighlightAnalyzers{})
+			search.
+				On("ClassSearch", params).
+				Return(searchResults, nil)
+
+			_, err := explorer.GetClass(context.Background(), params)
+			assert.EqualError(t, err, "highlights: highlights require a bm25 or hybrid query")
+		})
+	})
+
 	t.Run("when the lastUpdateTimeUnix _additional prop is set", func(t *testing.T) {
 		params := dto.GetParams{
 			ClassName:  "BestClass",
@@ -2878,3 +2962,286 @@ func getFakeModulesProviderWithCustomExtenders(
 func getFakeModulesProvider() ModulesProvider {
 	return &fakeModulesProvider{}
 }
+
+func Test_Explorer_GetClass_With_MMR(t *testing.T) {
+	log, _ := test.NewNullLogger()
+	schemaGetter := newFakeSchemaGetter("BestClass")
+	schemaGetter.SetVectorIndexConfig(hnsw.UserConfig{Distance: hnsw.DistanceCosine})
+
+	t.Run("over-fetches candidates and re-selects them", func(t *testing.T) {
+		lambda := 0.5
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{1, 0},
+			},
+			Pagination: &filters.Pagination{Limit: 2},
+			MMR:        &searchparams.MMR{Lambda: &lambda, FetchK: 3},
+		}
+
+		searchResults := []search.Result{
+			{ID: "id1", Dist: 0.1, Vector: []float32{1, 0.1}, Schema: map[string]interface{}{"name": "first"}},
+			{ID: "id2", Dist: 0.11, Vector: []float32{1, 0.12}, Schema: map[string]interface{}{"name": "duplicate"}},
+			{ID: "id3", Dist: 0.3, Vector: []float32{0.5, 1}, Schema: map[string]interface{}{"name": "different"}},
+		}
+
+		search := &fakeVectorSearcher{}
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{1, 0}
+		expectedParamsToSearch.Pagination = &filters.Pagination{Limit: 3}
+		expectedParamsToSearch.AdditionalProperties.Vector = true
+		search.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(schemaGetter)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		search.AssertExpectations(t)
+
+		require.Len(t, res, 2)
+		assert.Equal(t, map[string]interface{}{"name": "first"}, res[0])
+		assert.Equal(t, map[string]interface{}{"name": "different"}, res[1])
+	})
+
+	t.Run("without a vector search", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 2},
+			MMR:        &searchparams.MMR{},
+		}
+
+		search := &fakeVectorSearcher{}
+		metrics := &fakeMetrics{}
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(schemaGetter)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.EqualError(t, err, "invalid 'mmr' parameter: mmr requires a vector search "+
+			"such as nearVector, nearObject or nearText")
+	})
+
+	t.Run("with fetchK less than limit", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{1, 0},
+			},
+			Pagination: &filters.Pagination{Limit: 5},
+			MMR:        &searchparams.MMR{FetchK: 3},
+		}
+
+		search := &fakeVectorSearcher{}
+		metrics := &fakeMetrics{}
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(schemaGetter)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.EqualError(t, err, "invalid 'mmr' parameter: fetchK must not be less "+
+			"than the limit of 5, got 3")
+	})
+
+	t.Run("with an offset but without a limit", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{1, 0},
+			},
+			Pagination: &filters.Pagination{Offset: 5, Limit: filters.LimitFlagNotSet},
+			MMR:        &searchparams.MMR{},
+		}
+
+		search := &fakeVectorSearcher{}
+		metrics := &fakeMetrics{}
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(schemaGetter)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.EqualError(t, err, "invalid 'mmr' parameter: mmr requires a limit")
+		search.AssertNotCalled(t, "VectorClassSearch", mock.Anything)
+	})
+}
+
+func Test_Explorer_GetClass_With_Boost(t *testing.T) {
+	log, _ := test.NewNullLogger()
+	schemaGetter := newFakeSchemaGetter("BestClass")
+	schemaGetter.SetProperties([]*models.Property{
+		{Name: "name", DataType: []string{"text"}},
+		{Name: "price", DataType: []string{"number"}},
+	})
+
+	priceBoost := &searchparams.Boost{
+		Decay: []searchparams.BoostDecay{{
+			Property: "price", Function: "linear", Origin: "10", Scale: "10",
+		}},
+	}
+
+	t.Run("vector search is re-ordered by the boosted score", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{1, 0},
+			},
+			Pagination: &filters.Pagination{Limit: 3},
+			Boost:      priceBoost,
+			AdditionalProperties: additional.Properties{
+				Score: true,
+			},
+		}
+
+		searchResults := []search.Result{
+			{ID: "id1", Dist: 0, Schema: map[string]interface{}{"name": "expensive", "price": 25.0}},
+			{ID: "id2", Dist: 0.5, Schema: map[string]interface{}{"name": "cheap", "price": 10.0}},
+			{ID: "id3", Dist: 1, Schema: map[string]interface{}{"name": "affordable", "price": 15.0}},
+		}
+
+		search := &fakeVectorSearcher{}
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{1, 0}
+		search.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(schemaGetter)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		search.AssertExpectations(t)
+
+		require.Len(t, res, 3)
+		// cheap: 0.5 * 1, expensive: 1 * 0.25, affordable: 0 * 0.75
+		cheap := res[0].(map[string]interface{})
+		assert.Equal(t, "cheap", cheap["name"])
+		assert.Equal(t, float32(0.5), cheap["_additional"].(map[string]interface{})["score"])
+		expensive := res[1].(map[string]interface{})
+		assert.Equal(t, "expensive", expensive["name"])
+		assert.Equal(t, float32(0.25), expensive["_additional"].(map[string]interface{})["score"])
+		affordable := res[2].(map[string]interface{})
+		assert.Equal(t, "affordable", affordable["name"])
+		assert.Equal(t, float32(0), affordable["_additional"].(map[string]interface{})["score"])
+	})
+
+	t.Run("vector search with negative dot distances", func(t *testing.T) {
+		dotSchemaGetter := newFakeSchemaGetter("BestClass")
+		dotSchemaGetter.SetProperties(schemaGetter.schema.Objects.Classes[0].Properties)
+		dotSchemaGetter.SetVectorIndexConfig(hnsw.UserConfig{Distance: hnsw.DistanceDot})
+
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{1, 0},
+			},
+			Pagination: &filters.Pagination{Limit: 3},
+			Boost:      priceBoost,
+			AdditionalProperties: additional.Properties{
+				Score: true,
+			},
+		}
+
+		searchResults := []search.Result{
+			{ID: "id1", Dist: -2, Schema: map[string]interface{}{"name": "expensive", "price": 25.0}},
+			{ID: "id2", Dist: -1, Schema: map[string]interface{}{"name": "cheap", "price": 10.0}},
+			{ID: "id3", Dist: 0.5, Schema: map[string]interface{}{"name": "affordable", "price": 15.0}},
+		}
+
+		search := &fakeVectorSearcher{}
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{1, 0}
+		search.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(dotSchemaGetter)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		search.AssertExpectations(t)
+
+		require.Len(t, res, 3)
+		// cheap: 0.6 * 1, expensive: 1 * 0.25, affordable: 0 * 0.75
+		cheap := res[0].(map[string]interface{})
+		assert.Equal(t, "cheap", cheap["name"])
+		assert.Equal(t, float32(0.6), cheap["_additional"].(map[string]interface{})["score"])
+		expensive := res[1].(map[string]interface{})
+		assert.Equal(t, "expensive", expensive["name"])
+		assert.Equal(t, float32(0.25), expensive["_additional"].(map[string]interface{})["score"])
+		affordable := res[2].(map[string]interface{})
+		assert.Equal(t, "affordable", affordable["name"])
+		assert.Equal(t, float32(0), affordable["_additional"].(map[string]interface{})["score"])
+	})
+
+	t.Run("boost is explained in the explainScore of a bm25 search", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type:  "bm25",
+				Query: "cheap",
+			},
+			Pagination: &filters.Pagination{Limit: 2},
+			Boost:      priceBoost,
+			AdditionalProperties: additional.Properties{
+				ExplainScore: true,
+			},
+		}
+
+		searchResults := []search.Result{
+			{
+				ID: "id1", Score: 2, ExplainScore: "BM25F_cheap_frequency:1",
+				Schema: map[string]interface{}{"name": "cheap", "price": 15.0},
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		search.
+			On("ClassSearch", params).
+			Return(searchResults, nil)
+
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(schemaGetter)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		search.AssertExpectations(t)
+
+		require.Len(t, res, 1)
+		assert.Equal(t, "BM25F_cheap_frequency:1\n(boost) primary score 2, function score 0.75 "+
+			"(linear(price): 0.75), multiply boosted score 1.5",
+			res[0].(map[string]interface{})["_additional"].(map[string]interface{})["explainScore"])
+	})
+
+	t.Run("with an invalid boost", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 2},
+			Boost: &searchparams.Boost{
+				FieldValueFactors: []searchparams.BoostFieldValueFactor{{Property: "name"}},
+			},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		searcher.
+			On("ClassSearch", params).
+			Return([]search.Result{}, nil)
+
+		metrics := &fakeMetrics{}
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(schemaGetter)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.EqualError(t, err, "invalid 'boost' parameter: fieldValueFactor function "+
+			"at pos 0: property \"name\" is of type \"text\", but fieldValueFactor "+
+			"functions require a number or int property")
+	})
+}
@@ -15,6 +15,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"unicode"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -35,6 +37,7 @@ import (
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/traverser/highlighter"
 )
 
 type fakeLocks struct{}
@@ -240,6 +243,12 @@ func (f *fakeSchemaGetter) SetVectorIndexConfig(cfg hnsw.UserConfig) {
 	}
 }
 
+func (f *fakeSchemaGetter) SetProperties(props []*models.Property) {
+	for _, cls := range f.schema.Objects.Classes {
+		cls.Properties = props
+	}
+}
+
 func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
 	return f.schema
 }
@@ -879,3 +888,37 @@ type fakeMetrics struct {
 func (m *fakeMetrics) AddUsageDimensions(class, query, op string, dims int) {
 	m.Called(class, query, op, dims)
 }
+
+// fakeHighlightAnalyzers split text into lowercased words
+type fakeHighlightAnalyzers struct{}
+
+func (fakeHighlightAnalyzers) Analyzer(class *models.Class,
+	prop *models.Property,
+) (highlighter.Analyzer, error) {
+	return fakeHighlightAnalyzer{}, nil
+}
+
+type fakeHighlightAnalyzer struct{}
+
+func (fakeHighlightAnalyzer) QueryTerms(query string) []string {
+	return strings.Fields(strings.ToLower(query))
+}
+
+func (fakeHighlightAnalyzer) TermSpans(text []rune) []highlighter.TermSpan {
+	var out []highlighter.TermSpan
+	start := -1
+	for i := 0; i <= len(text); i++ {
+		if i < len(text) && !unicode.IsSpace(text[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			term := strings.ToLower(string(text[start:i]))
+			out = append(out, highlighter.TermSpan{Start: start, End: i, Terms: []string{term}})
+			start = -1
+		}
+	}
+	return out
+}
@@ -0,0 +1,327 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package highlighter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	DefaultFragmentSize      = 100
+	DefaultNumberOfFragments = 3
+	DefaultPreTag            = "<em>"
+	DefaultPostTag           = "</em>"
+)
+
+// Analyzers provide the analyzer which the inverted index uses for a
+// property
+type Analyzers interface {
+	Analyzer(class *models.Class, prop *models.Property) (Analyzer, error)
+}
+
+// Analyzer splits text into terms the same way the inverted index does for
+// the property
+type Analyzer interface {
+	// QueryTerms returns the terms which bm25 scores for the query
+	QueryTerms(query string) []string
+	// TermSpans returns the parts of the text from which the indexed terms
+	// are created, together with those terms
+	TermSpans(text []rune) []TermSpan
+}
+
+// TermSpan is a part of a text, given as rune offsets, together with the
+// terms which are indexed for it
+type TermSpan struct {
+	Start int
+	End   int
+	Terms []string
+}
+
+// Highlighter creates fragments of the stored text around the terms which
+// match the query. The stored values are tokenized again with the
+// tokenization or text analyzer of their property, so that the same terms
+// are highlighted which were scored by bm25.
+type Highlighter struct {
+	params     additional.HighlightParams
+	properties []*propertyHighlighter
+}
+
+type propertyHighlighter struct {
+	name       string
+	analyzer   Analyzer
+	queryTerms map[string]struct{}
+}
+
+// New creates a highlighter for the query. If no properties are set in the
+// params, all text properties of the class are highlighted.
+func New(class *models.Class, query string, params additional.HighlightParams,
+	analyzers Analyzers,
+) (*Highlighter, error) {
+	if params.FragmentSize == 0 {
+		params.FragmentSize = DefaultFragmentSize
+	}
+	if params.NumberOfFragments == 0 {
+		params.NumberOfFragments = DefaultNumberOfFragments
+	}
+	if params.PreTag == "" && params.PostTag == "" {
+		params.PreTag, params.PostTag = DefaultPreTag, DefaultPostTag
+	}
+	if params.FragmentSize < 0 {
+		return nil, fmt.Errorf("fragmentSize must be positive, got %d", params.FragmentSize)
+	}
+	if params.NumberOfFragments < 0 {
+		return nil, fmt.Errorf("numberOfFragments must be positive, got %d", params.NumberOfFragments)
+	}
+
+	propNames := params.Properties
+	if len(propNames) == 0 {
+		for _, prop := range class.Properties {
+			if isText(prop) {
+				propNames = append(propNames, prop.Name)
+			}
+		}
+	}
+
+	h := &Highlighter{params: params, properties: make([]*propertyHighlighter, 0, len(propNames))}
+	for _, propName := range propNames {
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil {
+			return nil, err
+		}
+		if !isText(prop) {
+			return nil, fmt.Errorf("cannot highlight property '%s' of data type %v, only text properties can be highlighted",
+				prop.Name, prop.DataType)
+		}
+
+		analyzer, err := analyzers.Analyzer(class, prop)
+		if err != nil {
+			return nil, err
+		}
+
+		queryTerms := map[string]struct{}{}
+		for _, term := range analyzer.QueryTerms(query) {
+			queryTerms[term] = struct{}{}
+		}
+		h.properties = append(h.properties, &propertyHighlighter{
+			name:       prop.Name,
+			analyzer:   analyzer,
+			queryTerms: queryTerms,
+		})
+	}
+
+	return h, nil
+}
+
+func isText(prop *models.Property) bool {
+	dt, ok := schema.AsPrimitive(prop.DataType)
+	return ok && (dt == schema.DataTypeText || dt == schema.DataTypeTextArray)
+}
+
+// Highlight returns the fragments of each property of the object in which
+// at least one query term was found
+func (h *Highlighter) Highlight(properties map[string]interface{}) []*additional.Highlight {
+	out := make([]*additional.Highlight, 0, len(h.properties))
+	for _, prop := range h.properties {
+		var candidates []fragment
+		for _, value := range textValues(properties[prop.name]) {
+			candidates = append(candidates, h.candidates(prop, []rune(value))...)
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		out = append(out, &additional.Highlight{
+			Property:  prop.name,
+			Fragments: h.render(h.selectFragments(candidates)),
+		})
+	}
+	return out
+}
+
+func textValues(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, elem := range v {
+			if s, ok := elem.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+type span struct {
+	start, end int
+}
+
+// fragment is a window of a text value which contains at least one match
+type fragment struct {
+	text       []rune
+	start, end int
+	matches    []span
+	// position of the fragment among all fragments, so that the selected
+	// fragments can be returned in the order of the text
+	position int
+}
+
+// matches returns the merged ranges of the text which consist of query terms
+func (p *propertyHighlighter) matches(text []rune) []span {
+	var out []span
+	for _, token := range p.analyzer.TermSpans(text) {
+		if len(token.Terms) == 0 {
+			continue
+		}
+		matched := true
+		for _, term := range token.Terms {
+			if _, ok := p.queryTerms[term]; !ok {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		// overlapping n-grams are merged into a single match
+		if len(out) > 0 && token.Start <= out[len(out)-1].end {
+			if token.End > out[len(out)-1].end {
+				out[len(out)-1].end = token.End
+			}
+			continue
+		}
+		out = append(out, span{start: token.Start, end: token.End})
+	}
+	return out
+}
+
+// candidates creates a fragment around the first match which is not part of
+// the previous fragment and the matches following it, until all matches are
+// part of a fragment
+func (h *Highlighter) candidates(prop *propertyHighlighter, text []rune) []fragment {
+	matches := prop.matches(text)
+	size := h.params.FragmentSize
+
+	var out []fragment
+	for i := 0; i < len(matches); {
+		// the window is centered on as many matches as fit into it
+		first, last := matches[i], matches[i]
+		for k := i + 1; k < len(matches) && matches[k].end-first.start <= size; k++ {
+			last = matches[k]
+		}
+		pad := (size - (last.end - first.start)) / 2
+		if pad < 0 {
+			pad = 0
+		}
+		start := first.start - pad
+		if start < 0 {
+			start = 0
+		}
+		end := start + size
+		if end > len(text) {
+			end = len(text)
+			if start = end - size; start < 0 {
+				start = 0
+			}
+		}
+		if end < last.end {
+			end = last.end
+		}
+		start = snapStart(text, start, first.start)
+
+		j := i + 1
+		for j < len(matches) && matches[j].end <= end {
+			j++
+		}
+		end = snapEnd(text, end, matches[j-1].end)
+
+		out = append(out, fragment{text: text, start: start, end: end, matches: matches[i:j]})
+		i = j
+	}
+	return out
+}
+
+// snapStart moves the start of a fragment forward to the beginning of a
+// word, but not past the first match
+func snapStart(text []rune, start, limit int) int {
+	if start == 0 || unicode.IsSpace(text[start-1]) {
+		return start
+	}
+	for i := start; i < limit; i++ {
+		if unicode.IsSpace(text[i]) {
+			return i + 1
+		}
+	}
+	return start
+}
+
+// snapEnd moves the end of a fragment back to the end of a word, but not
+// before the last match
+func snapEnd(text []rune, end, limit int) int {
+	if end == len(text) || unicode.IsSpace(text[end]) {
+		return end
+	}
+	for i := end; i > limit; i-- {
+		if unicode.IsSpace(text[i-1]) {
+			return i - 1
+		}
+	}
+	return end
+}
+
+// selectFragments keeps the fragments with the most matches
+func (h *Highlighter) selectFragments(candidates []fragment) []fragment {
+	for i := range candidates {
+		candidates[i].position = i
+	}
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return len(candidates[a].matches) > len(candidates[b].matches)
+	})
+	if len(candidates) > h.params.NumberOfFragments {
+		candidates = candidates[:h.params.NumberOfFragments]
+	}
+	sort.Slice(candidates, func(a, b int) bool {
+		return candidates[a].position < candidates[b].position
+	})
+	return candidates
+}
+
+func (h *Highlighter) render(fragments []fragment) []string {
+	out := make([]string, len(fragments))
+	for i, f := range fragments {
+		var sb strings.Builder
+		pos := f.start
+		for _, m := range f.matches {
+			sb.WriteString(string(f.text[pos:m.start]))
+			sb.WriteString(h.params.PreTag)
+			sb.WriteString(string(f.text[m.start:m.end]))
+			sb.WriteString(h.params.PostTag)
+			pos = m.end
+		}
+		sb.WriteString(string(f.text[pos:f.end]))
+		out[i] = strings.TrimSpace(sb.String())
+	}
+	return out
+}
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package highlighter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/traverser/highlighter"
+)
+
+func testClass() *models.Class {
+	return &models.Class{
+		Class: "Article",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Stopwords: &models.StopwordConfig{Preset: "en"},
+			Analyzers: []*models.TextAnalyzerConfig{
+				{Name: "english", Tokenization: "word", Filters: []string{"lowercase", "snowball_en"}},
+			},
+		},
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString(), Tokenization: "word"},
+			{Name: "tags", DataType: schema.DataTypeTextArray.PropString(), Tokenization: "field"},
+			{Name: "body", DataType: schema.DataTypeText.PropString(), Analyzer: "english"},
+			{Name: "bodyCJK", DataType: schema.DataTypeText.PropString(), Tokenization: "cjk"},
+			{Name: "count", DataType: schema.DataTypeInt.PropString()},
+		},
+	}
+}
+
+func TestHighlighter(t *testing.T) {
+	t.Run("highlights the query terms", func(t *testing.T) {
+		h, err := highlighter.New(testClass(), "the journey to the Moon",
+			additional.HighlightParams{Properties: []string{"title"}}, inverted.HighlightAnalyzers{})
+		require.Nil(t, err)
+
+		highlights := h.Highlight(map[string]interface{}{"title": "The Journey to the moon!"})
+		assert.Equal(t, []*additional.Highlight{{
+			Property:  "title",
+			Fragments: []string{"The <em>Journey</em> to the <em>moon</em>!"},
+		}}, highlights)
+	})
+
+	t.Run("skips properties without matches", func(t *testing.T) {
+		h, err := highlighter.New(testClass(), "moon", additional.HighlightParams{},
+			inverted.HighlightAnalyzers{})
+		require.Nil(t, err)
+
+		highlights := h.Highlight(map[string]interface{}{"title": "the sun", "body": "to the moon"})
+		require.Len(t, highlights, 1)
+		assert.Equal(t, "body", highlights[0].Property)
+	})
+
+	t.Run("uses the text analyzer of the property", func(t *testing.T) {
+		h, err := highlighter.New(testClass(), "running", additional.HighlightParams{
+			Properties: []string{"body"}, PreTag: "[", PostTag: "]",
+		}, inverted.HighlightAnalyzers{})
+		require.Nil(t, err)
+
+		highlights := h.Highlight(map[string]interface{}{"body": "She runs, he ran and they run."})
+		require.Len(t, highlights, 1)
+		assert.Equal(t, []string{"She [runs], he ran and they [run]."}, highlights[0].Fragments)
+	})
+
+	t.Run("highlights elements of text arrays", func(t *testing.T) {
+		h, err := highlighter.New(testClass(), "new york", additional.HighlightParams{Properties: []string{"tags"}},
+			inverted.HighlightAnalyzers{})
+		require.Nil(t, err)
+
+		highlights := h.Highlight(map[string]interface{}{"tags": []interface{}{"new york", "new jersey"}})
+		require.Len(t, highlights, 1)
+		assert.Equal(t, []string{"<em>new york</em>"}, highlights[0].Fragments)
+	})
+
+	t.Run("merges overlapping cjk bigrams", func(t *testing.T) {
+		h, err := highlighter.New(testClass(), "北京大学", additional.HighlightParams{Properties: []string{"bodyCJK"}},
+			inverted.HighlightAnalyzers{})
+		require.Nil(t, err)
+
+		highlights := h.Highlight(map[string]interface{}{"bodyCJK": "我在北京大学学习"})
+		require.Len(t, highlights, 1)
+		assert.Equal(t, []string{"我在<em>北京大学</em>学习"}, highlights[0].Fragments)
+	})
+
+	t.Run("limits the size and number of fragments", func(t *testing.T) {
+		h, err := highlighter.New(testClass(), "moon", additional.HighlightParams{
+			Properties: []string{"title"}, FragmentSize: 20, NumberOfFragments: 2,
+		}, inverted.HighlightAnalyzers{})
+		require.Nil(t, err)
+
+		text := "the moon is bright tonight, far away from the sun and the stars " +
+			"but the moon and the moon again are close"
+		highlights := h.Highlight(map[string]interface{}{"title": text})
+		require.Len(t, highlights, 1)
+		assert.Equal(t, []string{
+			"the <em>moon</em> is bright",
+			"<em>moon</em> and the <em>moon</em>",
+		}, highlights[0].Fragments)
+	})
+
+	t.Run("invalid properties", func(t *testing.T) {
+		_, err := highlighter.New(testClass(), "moon", additional.HighlightParams{Properties: []string{"count"}},
+			inverted.HighlightAnalyzers{})
+		assert.ErrorContains(t, err, "only text properties can be highlighted")
+
+		_, err = highlighter.New(testClass(), "moon", additional.HighlightParams{Properties: []string{"unknown"}},
+			inverted.HighlightAnalyzers{})
+		assert.NotNil(t, err)
+	})
+}
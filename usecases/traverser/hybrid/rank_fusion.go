@@ -13,6 +13,7 @@ package hybrid
 
 import (
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/go-openapi/strfmt"
@@ -93,14 +94,154 @@ func FusionReciprocal(weights []float64, results [][]*Result) []*Result {
 		i++
 	}
 
-	sort.Slice(concat, func(i, j int) bool {
-		a_b := float64(concat[j].Score - concat[i].Score)
+	sortFused(concat)
+	return concat
+}
+
+// FusionRelativeScore combines the result sets by their min-max normalized
+// scores. Unlike FusionReciprocal this keeps the magnitude of the score
+// differences within a result set. The score of each result must be set
+// before fusing, with higher scores being better matches.
+func FusionRelativeScore(weights []float64, results [][]*Result, names []string) []*Result {
+	return fusionNormalized(weights, results, names, normalizeMinMax)
+}
+
+// FusionDistributionBased combines the result sets by their scores
+// normalized to the range of three standard deviations around the mean of
+// their result set. This makes the fusion less sensitive to outliers than
+// FusionRelativeScore.
+func FusionDistributionBased(weights []float64, results [][]*Result, names []string) []*Result {
+	return fusionNormalized(weights, results, names, normalizeDistribution)
+}
+
+func fusionNormalized(weights []float64, results [][]*Result, names []string,
+	normalize func(scores []float64) []float64,
+) []*Result {
+	mapResults := map[strfmt.UUID]*Result{}
+	totals := map[strfmt.UUID]float64{}
+	for resultSetIndex, result := range results {
+		scores := make([]float64, len(result))
+		for i, res := range result {
+			scores[i] = float64(res.Score)
+		}
+		normalized := normalize(scores)
+
+		for i, res := range result {
+			docId := res.ID
+			score := weights[resultSetIndex] * normalized[i]
+			explanation := fmt.Sprintf(
+				"(hybrid) Document %v contributed %v to the score: "+
+					"(%v) normalized score %v * weight %v, original score %v",
+				docId, score, names[resultSetIndex], normalized[i],
+				weights[resultSetIndex], scores[i])
+
+			previousResult, ok := mapResults[docId]
+			if ok {
+				previousResult.ExplainScore = fmt.Sprintf("%v\n%v\n%v",
+					previousResult.ExplainScore, res.ExplainScore, explanation)
+				totals[docId] += score
+				continue
+			}
+
+			res.ExplainScore = fmt.Sprintf("%v\n%v", res.ExplainScore, explanation)
+			totals[docId] = score
+			mapResults[docId] = res
+		}
+	}
+
+	var (
+		concat = make([]*Result, len(mapResults))
+		i      = 0
+	)
+	for docId, res := range mapResults {
+		if res.AdditionalProperties == nil {
+			res.AdditionalProperties = map[string]interface{}{}
+		}
+		res.AdditionalProperties["explainScore"] = res.ExplainScore
+		res.AdditionalProperties["score"] = totals[docId]
+		res.Score = float32(totals[docId])
+		concat[i] = res
+		i++
+	}
+
+	sortFused(concat)
+	return concat
+}
+
+// normalizeMinMax scales the scores to [0, 1] by the lowest and highest
+// score. If all scores are equal they are all considered best matches.
+func normalizeMinMax(scores []float64) []float64 {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	lowest, highest := scores[0], scores[0]
+	for _, score := range scores {
+		if score < lowest {
+			lowest = score
+		}
+		if score > highest {
+			highest = score
+		}
+	}
+
+	out := make([]float64, len(scores))
+	for i, score := range scores {
+		if highest == lowest {
+			out[i] = 1
+			continue
+		}
+		out[i] = (score - lowest) / (highest - lowest)
+	}
+	return out
+}
+
+// normalizeDistribution scales the scores to [0, 1] by the range of three
+// standard deviations around their mean, scores outside of it are clamped.
+// If all scores are equal they are all considered best matches.
+func normalizeDistribution(scores []float64) []float64 {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	var mean float64
+	for _, score := range scores {
+		mean += score
+	}
+	mean /= float64(len(scores))
+
+	var variance float64
+	for _, score := range scores {
+		variance += (score - mean) * (score - mean)
+	}
+	stdDev := math.Sqrt(variance / float64(len(scores)))
+
+	lowest, highest := mean-3*stdDev, mean+3*stdDev
+	out := make([]float64, len(scores))
+	for i, score := range scores {
+		if stdDev == 0 {
+			out[i] = 1
+			continue
+		}
+		normalized := (score - lowest) / (highest - lowest)
+		if normalized < 0 {
+			normalized = 0
+		} else if normalized > 1 {
+			normalized = 1
+		}
+		out[i] = normalized
+	}
+	return out
+}
+
+func sortFused(results []*Result) {
+	sort.Slice(results, func(i, j int) bool {
+		a_b := float64(results[j].Score - results[i].Score)
 		if a_b*a_b < 1e-14 {
-			return concat[i].SecondarySortValue > concat[j].SecondarySortValue
+			return results[i].SecondarySortValue > results[j].SecondarySortValue
 		}
-		return float64(concat[i].Score) > float64(concat[j].Score)
+		return float64(results[i].Score) > float64(results[j].Score)
 	})
-	return concat
 }
 
 func FusionScoreConcatenate(results [][]*search.Result) []*search.Result {
@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hybrid
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestNormalizeMinMax(t *testing.T) {
+	t.Run("scales to the lowest and highest score", func(t *testing.T) {
+		out := normalizeMinMax([]float64{4, 2, 3, 0})
+		assert.Equal(t, []float64{1, 0.5, 0.75, 0}, out)
+	})
+
+	t.Run("equal scores", func(t *testing.T) {
+		out := normalizeMinMax([]float64{2, 2})
+		assert.Equal(t, []float64{1, 1}, out)
+	})
+
+	t.Run("no scores", func(t *testing.T) {
+		assert.Len(t, normalizeMinMax(nil), 0)
+	})
+}
+
+func TestNormalizeDistribution(t *testing.T) {
+	t.Run("scales to three standard deviations around the mean", func(t *testing.T) {
+		// mean 5, standard deviation 2
+		out := normalizeDistribution([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+		require.Len(t, out, 8)
+		assert.InDelta(t, 0.25, out[0], 1e-9)
+		assert.InDelta(t, 5.0/12, out[1], 1e-9)
+		assert.InDelta(t, 0.5, out[4], 1e-9)
+		assert.InDelta(t, 10.0/12, out[7], 1e-9)
+	})
+
+	t.Run("clamps outliers", func(t *testing.T) {
+		scores := make([]float64, 100)
+		scores[0] = 1000
+		out := normalizeDistribution(scores)
+		assert.Equal(t, float64(1), out[0])
+		assert.Greater(t, out[1], float64(0))
+	})
+
+	t.Run("equal scores", func(t *testing.T) {
+		out := normalizeDistribution([]float64{3, 3, 3})
+		assert.Equal(t, []float64{1, 1, 1}, out)
+	})
+}
+
+func TestFusionRelativeScore(t *testing.T) {
+	result := func(id strfmt.UUID, score float32) *Result {
+		return &Result{Result: &search.Result{ID: id, Score: score, ExplainScore: "(test)"}}
+	}
+
+	sparse := []*Result{result("a", 3), result("b", 1)}
+	dense := []*Result{result("b", 0.9), result("c", 0.7), result("d", 0.5)}
+
+	fused := FusionRelativeScore([]float64{0.25, 0.75},
+		[][]*Result{sparse, dense}, []string{"bm25", "vector"})
+
+	require.Len(t, fused, 4)
+	ids := make([]strfmt.UUID, len(fused))
+	for i := range fused {
+		ids[i] = fused[i].ID
+	}
+	assert.Equal(t, []strfmt.UUID{"b", "c", "a", "d"}, ids)
+	assert.InDelta(t, 0.75, fused[0].Score, 1e-6)
+	assert.InDelta(t, 0.375, fused[1].Score, 1e-6)
+	assert.InDelta(t, 0.25, fused[2].Score, 1e-6)
+	assert.InDelta(t, 0, fused[3].Score, 1e-6)
+
+	explanation := fused[0].ExplainScore
+	assert.Contains(t, explanation, "(bm25) normalized score 0 * weight 0.25")
+	assert.Contains(t, explanation, "(vector) normalized score 1 * weight 0.75")
+	assert.Equal(t, explanation, fused[0].AdditionalProperties["explainScore"])
+	assert.InDelta(t, 0.75, fused[0].AdditionalProperties["score"], 1e-6)
+}
+
+func TestFusionDistributionBased(t *testing.T) {
+	result := func(id strfmt.UUID, score float32) *Result {
+		return &Result{Result: &search.Result{ID: id, Score: score}}
+	}
+
+	sparse := []*Result{result("a", 100), result("b", 2), result("c", 1)}
+	dense := []*Result{result("c", 0.9), result("b", 0.8), result("a", 0.1)}
+
+	fused := FusionDistributionBased([]float64{0.5, 0.5},
+		[][]*Result{sparse, dense}, []string{"bm25", "vector"})
+
+	require.Len(t, fused, 3)
+	// sparse scores are normalized to 0.735, 0.384 and 0.380 and dense
+	// scores to 0.266, 0.594 and 0.641
+	assert.Equal(t, strfmt.UUID("c"), fused[0].ID)
+	assert.InDelta(t, 0.510, fused[0].Score, 1e-3)
+	assert.Equal(t, strfmt.UUID("a"), fused[1].ID)
+	assert.InDelta(t, 0.500, fused[1].Score, 1e-3)
+	assert.Equal(t, strfmt.UUID("b"), fused[2].ID)
+	assert.InDelta(t, 0.489, fused[2].Score, 1e-3)
+	assert.Contains(t, fused[0].ExplainScore, "(bm25) normalized score")
+	assert.Contains(t, fused[0].ExplainScore, "(vector) normalized score")
+}
@@ -95,11 +95,13 @@ func NewSearcher(params *Params, logger logrus.FieldLogger,
 	}
 }
 
-// Search executes sparse and dense searches and combines the result sets using Reciprocal Rank Fusion
+// Search executes sparse and dense searches and combines the result sets
+// using the configured fusion type, Reciprocal Rank Fusion by default
 func (s *Searcher) Search(ctx context.Context) (Results, error) {
 	var (
 		found   [][]*Result
 		weights []float64
+		names   []string
 	)
 
 	if s.params.Query != "" {
@@ -113,6 +115,7 @@ func (s *Searcher) Search(ctx context.Context) (Results, error) {
 
 			found = append(found, res)
 			weights = append(weights, 1-alpha)
+			names = append(names, "bm25")
 		}
 
 		if alpha > 0 {
@@ -123,6 +126,7 @@ func (s *Searcher) Search(ctx context.Context) (Results, error) {
 
 			found = append(found, res)
 			weights = append(weights, alpha)
+			names = append(names, "vector")
 		}
 	} else {
 		ss := s.params.SubSearches
@@ -145,10 +149,21 @@ func (s *Searcher) Search(ctx context.Context) (Results, error) {
 
 			found = append(found, res)
 			weights = append(weights, weight)
+			names = append(names, subsearch.Type)
 		}
 	}
 
-	fused := FusionReciprocal(weights, found)
+	var fused []*Result
+	switch s.params.FusionType {
+	case "", searchparams.FusionTypeRanked:
+		fused = FusionReciprocal(weights, found)
+	case searchparams.FusionTypeRelativeScore:
+		fused = FusionRelativeScore(weights, found, names)
+	case searchparams.FusionTypeDistributionBased:
+		fused = FusionDistributionBased(weights, found, names)
+	default:
+		return nil, fmt.Errorf("unknown hybrid fusion type %q", s.params.FusionType)
+	}
 
 	if s.params.Limit >= 1 && (len(fused) > s.params.Limit) { //-1 is possible?
 		s.logger.Debugf("found more hybrid search results than limit, "+
@@ -179,6 +194,7 @@ func (s *Searcher) sparseSearch() ([]*Result, error) {
 	out := make([]*Result, len(res))
 	for i, obj := range res {
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
+		sr.Score = dists[i]
 		sr.SecondarySortValue = sr.Score
 		sr.ExplainScore = "(bm25)" + sr.ExplainScore
 		out[i] = &Result{obj.DocID(), &sr}
@@ -200,7 +216,8 @@ func (s *Searcher) denseSearch(ctx context.Context) ([]*Result, error) {
 	out := make([]*Result, len(res))
 	for i, obj := range res {
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
-		sr.SecondarySortValue = 1 - sr.Dist
+		sr.Score = 1 - sr.Dist
+		sr.SecondarySortValue = sr.Score
 		sr.ExplainScore = fmt.Sprintf(
 			"(vector) %v %v ", truncateVectorString(10, vector),
 			res[i].ExplainScore())
@@ -240,6 +257,7 @@ func (s *Searcher) sparseSubSearch(
 	out := make([]*Result, len(res))
 	for i, obj := range res {
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
+		sr.Score = dists[i]
 		sr.ExplainScore = "(bm25)" + sr.ExplainScore
 		out[i] = &Result{obj.DocID(), &sr}
 	}
@@ -268,6 +286,7 @@ func (s *Searcher) nearTextSubSearch(ctx context.Context,
 	out := make([]*Result, len(res))
 	for i, obj := range res {
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
+		sr.Score = 1 - sr.Dist
 		sr.ExplainScore = fmt.Sprintf("(vector) %v %v ",
 			truncateVectorString(10, vector), res[i].ExplainScore())
 		out[i] = &Result{obj.DocID(), &sr}
@@ -289,6 +308,7 @@ func (s *Searcher) nearVectorSubSearch(
 	out := make([]*Result, len(res))
 	for i, obj := range res {
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
+		sr.Score = 1 - sr.Dist
 		sr.ExplainScore = fmt.Sprintf("(vector) %v %v ",
 			truncateVectorString(10, sp.Vector), res[i].ExplainScore())
 		out[i] = &Result{obj.DocID(), &sr}
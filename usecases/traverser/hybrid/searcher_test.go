@@ -198,6 +198,63 @@ func TestSearcher(t *testing.T) {
 				assert.Equal(t, res[1].Result.Dist, float32(0.008))
 			},
 		},
+		{
+			name: "combined hybrid search with relative score fusion",
+			f: func(t *testing.T) {
+				params := &Params{
+					HybridSearch: &searchparams.HybridSearch{
+						Type:       "hybrid",
+						Alpha:      0.5,
+						Query:      "some query",
+						Vector:     []float32{1, 2, 3},
+						FusionType: searchparams.FusionTypeRelativeScore,
+					},
+					Class: class,
+				}
+				sparse := func() ([]*storobj.Object, []float32, error) {
+					return []*storobj.Object{
+						{Object: models.Object{Class: class, ID: "1889a225-3b28-477d-b8fc-5f6071bb4731"}},
+						{Object: models.Object{Class: class, ID: "79a636c2-3314-442e-a4d1-e94d7c0afc3a"}},
+					}, []float32{2.5, 0.5}, nil
+				}
+				dense := func([]float32) ([]*storobj.Object, []float32, error) {
+					return []*storobj.Object{
+						{Object: models.Object{Class: class, ID: "79a636c2-3314-442e-a4d1-e94d7c0afc3a"}},
+						{Object: models.Object{Class: class, ID: "1889a225-3b28-477d-b8fc-5f6071bb4731"}},
+					}, []float32{0.1, 0.9}, nil
+				}
+				s := NewSearcher(params, logger, sparse, dense, nil, nil)
+				res, err := s.Search(ctx)
+				require.Nil(t, err)
+				require.Len(t, res, 2)
+				assert.InDelta(t, 0.5, res[0].Score, 1e-6)
+				assert.InDelta(t, 0.5, res[1].Score, 1e-6)
+				for _, r := range res {
+					assert.Contains(t, r.ExplainScore, "(bm25) normalized score")
+					assert.Contains(t, r.ExplainScore, "(vector) normalized score")
+				}
+			},
+		},
+		{
+			name: "with unknown fusion type",
+			f: func(t *testing.T) {
+				params := &Params{
+					HybridSearch: &searchparams.HybridSearch{
+						Type:       "hybrid",
+						Alpha:      0.5,
+						Query:      "some query",
+						Vector:     []float32{1, 2, 3},
+						FusionType: "someFusion",
+					},
+					Class: class,
+				}
+				sparse := func() ([]*storobj.Object, []float32, error) { return nil, nil, nil }
+				dense := func([]float32) ([]*storobj.Object, []float32, error) { return nil, nil, nil }
+				s := NewSearcher(params, logger, sparse, dense, nil, nil)
+				_, err := s.Search(ctx)
+				assert.EqualError(t, err, `unknown hybrid fusion type "someFusion"`)
+			},
+		},
 		{
 			name: "with sparse subsearch filter",
 			f: func(t *testing.T) {
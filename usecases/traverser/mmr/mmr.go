@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package mmr
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	// DefaultLambda weighs relevance and diversity equally
+	DefaultLambda = 0.5
+	// DefaultFetchKFactor is the number of candidates fetched for each result
+	// if fetchK is not set
+	DefaultFetchKFactor = 4
+)
+
+// Selector re-selects the results of a vector search by maximal marginal
+// relevance, so that the selected results are both close to the search
+// vector and far from each other.
+type Selector struct {
+	distancer distancer.Provider
+	normalize bool
+}
+
+// New creates a Selector which compares results with the distancer of the
+// given vector index distance metric
+func New(distance string) (*Selector, error) {
+	var (
+		distProv distancer.Provider
+		// the cosine distancer expects normalized vectors, the vector index
+		// normalizes them internally but results contain the original ones
+		normalize bool
+	)
+
+	switch distance {
+	case "", hnsw.DistanceCosine:
+		distProv = distancer.NewCosineDistanceProvider()
+		normalize = true
+	case hnsw.DistanceDot:
+		distProv = distancer.NewDotProductProvider()
+	case hnsw.DistanceL2Squared:
+		distProv = distancer.NewL2SquaredProvider()
+	case hnsw.DistanceManhattan:
+		distProv = distancer.NewManhattanProvider()
+	case hnsw.DistanceHamming:
+		distProv = distancer.NewHammingProvider()
+	default:
+		return nil, errors.Errorf("unrecognized distance metric %q", distance)
+	}
+
+	return &Selector{distancer: distProv, normalize: normalize}, nil
+}
+
+// Select picks up to limit results from the candidates, one at a time. Each
+// pick maximizes lambda times the relevance of a candidate, minus 1-lambda
+// times its similarity to the closest result picked before. The relevance is
+// taken from the distance of the candidate to the search vector, so the
+// candidates must be the results of a vector search including their vectors.
+func (s *Selector) Select(candidates []search.Result, lambda float64,
+	limit int,
+) ([]search.Result, error) {
+	if lambda < 0 || lambda > 1 {
+		return nil, fmt.Errorf("lambda must be between 0 and 1, got %v", lambda)
+	}
+
+	vectors := make([][]float32, len(candidates))
+	for i, candidate := range candidates {
+		if len(candidate.Vector) == 0 {
+			return nil, fmt.Errorf("candidate %s has no vector", candidate.ID)
+		}
+		vectors[i] = candidate.Vector
+		if s.normalize {
+			vectors[i] = distancer.Normalize(candidate.Vector)
+		}
+	}
+
+	var (
+		selected = make([]search.Result, 0, limit)
+		picked   = make([]bool, len(candidates))
+		// closest is the distance of each candidate to the closest
+		// candidate picked so far
+		closest = make([]float64, len(candidates))
+	)
+	for i := range closest {
+		closest[i] = math.Inf(1)
+	}
+
+	for len(selected) < limit && len(selected) < len(candidates) {
+		best, bestScore := -1, math.Inf(-1)
+		for i, candidate := range candidates {
+			if picked[i] {
+				continue
+			}
+
+			// the similarity is the negative distance, with the first pick
+			// there are no results to be diverse from
+			score := -lambda * float64(candidate.Dist)
+			if len(selected) > 0 {
+				score += (1 - lambda) * closest[i]
+			}
+
+			if best == -1 || score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+
+		picked[best] = true
+		selected = append(selected, candidates[best])
+
+		for i := range candidates {
+			if picked[i] {
+				continue
+			}
+
+			dist, _, err := s.distancer.SingleDist(vectors[best], vectors[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "distance between %s and %s",
+					candidates[best].ID, candidates[i].ID)
+			}
+			if float64(dist) < closest[i] {
+				closest[i] = float64(dist)
+			}
+		}
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package mmr
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSelector(t *testing.T) {
+	// a and b are near-duplicates, c is less relevant but different
+	candidates := []search.Result{
+		{ID: "a", Dist: 0.1, Vector: []float32{1, 0}},
+		{ID: "b", Dist: 0.11, Vector: []float32{10, 0.1}},
+		{ID: "c", Dist: 0.3, Vector: []float32{0, 1}},
+		{ID: "d", Dist: 0.9, Vector: []float32{-1, 0}},
+	}
+
+	ids := func(results []search.Result) []strfmt.UUID {
+		out := make([]strfmt.UUID, len(results))
+		for i := range results {
+			out[i] = results[i].ID
+		}
+		return out
+	}
+
+	t.Run("relevance only", func(t *testing.T) {
+		s, err := New(hnsw.DistanceCosine)
+		require.Nil(t, err)
+
+		res, err := s.Select(candidates, 1, 3)
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"a", "b", "c"}, ids(res))
+	})
+
+	t.Run("relevance and diversity", func(t *testing.T) {
+		s, err := New(hnsw.DistanceCosine)
+		require.Nil(t, err)
+
+		res, err := s.Select(candidates, 0.5, 3)
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"a", "d", "c"}, ids(res))
+	})
+
+	t.Run("mostly relevance", func(t *testing.T) {
+		s, err := New(hnsw.DistanceCosine)
+		require.Nil(t, err)
+
+		res, err := s.Select(candidates, 0.8, 2)
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"a", "c"}, ids(res))
+	})
+
+	t.Run("with less candidates than limit", func(t *testing.T) {
+		s, err := New(hnsw.DistanceCosine)
+		require.Nil(t, err)
+
+		res, err := s.Select(candidates, 0.5, 10)
+		require.Nil(t, err)
+		assert.Len(t, res, 4)
+	})
+
+	t.Run("with the distancer of the class", func(t *testing.T) {
+		s, err := New(hnsw.DistanceL2Squared)
+		require.Nil(t, err)
+
+		// without normalization b is far from a
+		res, err := s.Select(candidates, 0.5, 2)
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"a", "b"}, ids(res))
+	})
+
+	t.Run("with invalid lambda", func(t *testing.T) {
+		s, err := New(hnsw.DistanceCosine)
+		require.Nil(t, err)
+
+		_, err = s.Select(candidates, 1.5, 2)
+		assert.EqualError(t, err, "lambda must be between 0 and 1, got 1.5")
+	})
+
+	t.Run("without vectors", func(t *testing.T) {
+		s, err := New(hnsw.DistanceCosine)
+		require.Nil(t, err)
+
+		_, err = s.Select([]search.Result{{ID: "a", Dist: 0.1}}, 0.5, 2)
+		assert.EqualError(t, err, "candidate a has no vector")
+	})
+
+	t.Run("with unknown distance", func(t *testing.T) {
+		_, err := New("foo")
+		assert.EqualError(t, err, `unrecognized distance metric "foo"`)
+	})
+}